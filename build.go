@@ -310,6 +310,10 @@ func runCommand(cmd string, target target) {
 
 	case "test":
 		test(strings.Fields(extraTags), "github.com/syncthing/syncthing/lib/...", "github.com/syncthing/syncthing/cmd/...")
+		// The database tests once more, against the SQLite backend
+		coverage = false
+		os.Setenv("STTESTBACKEND", "sqlite")
+		test(strings.Fields(extraTags), "github.com/syncthing/syncthing/lib/db")
 
 	case "bench":
 		bench(strings.Fields(extraTags), "github.com/syncthing/syncthing/lib/...", "github.com/syncthing/syncthing/cmd/...")
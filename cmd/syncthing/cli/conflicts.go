@@ -0,0 +1,59 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"net/url"
+
+	"github.com/alecthomas/kong"
+)
+
+type conflictCommand struct {
+	FolderID string `arg:""`
+	Name     string `arg:"" help:"Name of the conflict copy"`
+}
+
+type conflictsCommand struct {
+	List struct {
+		FolderID string `arg:"" optional:"" help:"Only show conflicts in the given folder"`
+	} `cmd:"" help:"Show conflicts"`
+	KeepLocal     conflictCommand `cmd:"" help:"Resolve a conflict by keeping the local version"`
+	KeepRemote    conflictCommand `cmd:"" help:"Resolve a conflict by keeping the remote version"`
+	KeepBoth      conflictCommand `cmd:"" help:"Resolve a conflict by keeping both versions, giving the conflict copy a regular name"`
+	MergeByRename struct {
+		conflictCommand
+		To string `arg:"" help:"New name for the conflict copy"`
+	} `cmd:"" help:"Resolve a conflict by renaming the conflict copy"`
+}
+
+func (c *conflictsCommand) Run(ctx Context, kongCtx *kong.Context) error {
+	query := make(url.Values)
+	resolution := kongCtx.Selected().Name
+	switch resolution {
+	case "list":
+		if c.List.FolderID != "" {
+			query.Set("folder", c.List.FolderID)
+		}
+		return indexDumpOutput("folder/conflicts?"+query.Encode(), ctx.clientFactory)
+	case "keep-local":
+		query.Set("folder", c.KeepLocal.FolderID)
+		query.Set("name", c.KeepLocal.Name)
+	case "keep-remote":
+		query.Set("folder", c.KeepRemote.FolderID)
+		query.Set("name", c.KeepRemote.Name)
+	case "keep-both":
+		query.Set("folder", c.KeepBoth.FolderID)
+		query.Set("name", c.KeepBoth.Name)
+	case "merge-by-rename":
+		query.Set("folder", c.MergeByRename.FolderID)
+		query.Set("name", c.MergeByRename.Name)
+		query.Set("to", c.MergeByRename.To)
+	default:
+		return nil
+	}
+	return emptyPost("folder/conflicts/"+resolution+"?"+query.Encode(), ctx.clientFactory)
+}
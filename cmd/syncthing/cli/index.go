@@ -15,6 +15,7 @@ type indexCommand struct {
 	DumpSize struct{} `cmd:"" help:"Print the db size of different categories of information"`
 	Check    struct{} `cmd:"" help:"Check the database for inconsistencies"`
 	Account  struct{} `cmd:"" help:"Print key and value size statistics per key type"`
+	Migrate  struct{} `cmd:"" help:"Copy the LevelDB database into a new SQLite database"`
 }
 
 func (*indexCommand) Run(kongCtx *kong.Context) error {
@@ -27,6 +28,8 @@ func (*indexCommand) Run(kongCtx *kong.Context) error {
 		return indexCheck()
 	case "account":
 		return indexAccount()
+	case "migrate":
+		return indexMigrate()
 	}
 	return nil
 }
@@ -150,6 +150,13 @@ func indexDump() error {
 			od.Unmarshal(it.Value())
 			fmt.Printf("[pendingDevice] D:%v V:%v\n", device, od)
 
+		case db.KeyTypeConflict:
+			folder := binary.BigEndian.Uint32(key[1:])
+			name := string(key[5:])
+			var c db.Conflict
+			c.Unmarshal(it.Value())
+			fmt.Printf("[conflict] F:%d N:%s V:%v\n", folder, name, c)
+
 		default:
 			fmt.Printf("[??? %d]\n  %x\n  %x\n", key[0], key, it.Value())
 		}
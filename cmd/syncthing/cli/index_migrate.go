@@ -0,0 +1,50 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/locations"
+)
+
+// indexMigrate copies the LevelDB database into a new SQLite database,
+// which is used once the database backend option is set to "sqlite".
+// Syncthing must not be running, which opening either database checks.
+func indexMigrate() error {
+	path := locations.Get(locations.DatabaseSQLite)
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%s already exists, remove it first to migrate again", path)
+	}
+
+	src, err := getDB()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := backend.OpenSQLite(path)
+	if err != nil {
+		return err
+	}
+	n, err := backend.Migrate(dst, src)
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		for _, suffix := range []string{"", "-wal", "-shm", ".lock"} {
+			os.Remove(path + suffix)
+		}
+		return err
+	}
+
+	fmt.Printf("Copied %d entries to %s\n", n, path)
+	fmt.Println(`Set the "databaseBackend" option to "sqlite" to use it.`)
+	return nil
+}
@@ -10,7 +10,10 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"net/url"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"github.com/alecthomas/kong"
 	"github.com/syncthing/syncthing/lib/config"
@@ -25,12 +28,20 @@ type defaultIgnoresCommand struct {
 	Path string `arg:""`
 }
 
+type folderRestoreCommand struct {
+	FolderID string    `arg:""`
+	Time     time.Time `arg:"" help:"Time to restore to, in RFC 3339 format (e.g. 2006-01-02T15:04:05Z)"`
+	Prefix   string    `help:"Only restore files in the given subdirectory"`
+	DryRun   bool      `help:"Only show which files would be restored"`
+}
+
 type operationCommand struct {
 	Restart        struct{}              `cmd:"" help:"Restart syncthing"`
 	Shutdown       struct{}              `cmd:"" help:"Shutdown syncthing"`
 	Upgrade        struct{}              `cmd:"" help:"Upgrade syncthing (if a newer version is available)"`
 	FolderOverride folderOverrideCommand `cmd:"" help:"Override changes on folder (remote for sendonly, local for receiveonly). WARNING: Destructive - deletes/changes your data"`
 	DefaultIgnores defaultIgnoresCommand `cmd:"" help:"Set the default ignores (config) from a file"`
+	FolderRestore  folderRestoreCommand  `cmd:"" help:"Restore a folder to its state at a given time from the versioner archive. WARNING: Replaces the current files"`
 }
 
 func (*operationCommand) Run(ctx Context, kongCtx *kong.Context) error {
@@ -106,3 +117,22 @@ func (d *defaultIgnoresCommand) Run(ctx Context) error {
 	_, err = client.PutJSON("config/defaults/ignores", config.Ignores{Lines: lines})
 	return err
 }
+
+func (f *folderRestoreCommand) Run(ctx Context) error {
+	client, err := ctx.clientFactory.getClient()
+	if err != nil {
+		return err
+	}
+	query := make(url.Values)
+	query.Set("folder", f.FolderID)
+	query.Set("time", f.Time.Format(time.RFC3339))
+	if f.Prefix != "" {
+		query.Set("prefix", f.Prefix)
+	}
+	query.Set("dryrun", strconv.FormatBool(f.DryRun))
+	response, err := client.Post("folder/restore?"+query.Encode(), "")
+	if err != nil {
+		return err
+	}
+	return prettyPrintResponse(response)
+}
@@ -380,7 +380,7 @@ func (options serveOptions) Run() error {
 		if err == nil {
 			// Use leveldb database locks to protect against concurrent upgrades
 			var ldb backend.Backend
-			ldb, err = syncthing.OpenDBBackend(locations.Get(locations.Database), config.TuningAuto)
+			ldb, err = syncthing.OpenDBBackend(config.DatabaseBackendLevelDB, config.TuningAuto)
 			if err != nil {
 				err = upgradeViaRest()
 			} else {
@@ -582,8 +582,7 @@ func syncthingMain(options serveOptions) {
 		})
 	}
 
-	dbFile := locations.Get(locations.Database)
-	ldb, err := syncthing.OpenDBBackend(dbFile, cfgWrapper.Options().DatabaseTuning)
+	ldb, err := syncthing.OpenDBBackend(cfgWrapper.Options().DatabaseBackend, cfgWrapper.Options().DatabaseTuning)
 	if err != nil {
 		l.Warnln("Error opening database:", err)
 		os.Exit(1)
@@ -756,7 +755,17 @@ func auditWriter(auditFile string) io.Writer {
 }
 
 func resetDB() error {
-	return os.RemoveAll(locations.Get(locations.Database))
+	if err := os.RemoveAll(locations.Get(locations.Database)); err != nil {
+		return err
+	}
+	// The SQLite database comes with its write-ahead log and index.
+	sqlite := locations.Get(locations.DatabaseSQLite)
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		if err := os.Remove(sqlite + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
 }
 
 func autoUpgradePossible(options serveOptions) bool {
@@ -16,6 +16,10 @@ angular.module('syncthing.core')
             LocaleService.autoConfigLocale();
 
             if (!$scope.authenticated) {
+                // Offer OIDC login, if it's enabled.
+                $http.get(authUrlbase + '/oidc').then(function (response) {
+                    $scope.login.oidc = response.data.enabled;
+                });
                 // Can't proceed yet - wait for the page reload after successful login.
                 return;
             }
@@ -569,7 +573,7 @@ angular.module('syncthing.core')
             // This function should match IsAuthEnabled() in guiconfiguration.go
             var guiCfg = $scope.config && $scope.config.gui;
             if (guiCfg) {
-                return guiCfg.authMode === 'ldap' || (guiCfg.user && guiCfg.password);
+                return guiCfg.authMode === 'ldap' || (guiCfg.user && guiCfg.password) || (guiCfg.accounts && guiCfg.accounts.length > 0) || (guiCfg.oidc && guiCfg.oidc.issuer && guiCfg.oidc.clientID && guiCfg.oidc.redirectURL);
             }
             return false;
         };
@@ -591,7 +595,7 @@ angular.module('syncthing.core')
                 && !$scope.isAuthEnabled()
                 && !guiCfg.insecureAdminAccess;
 
-            if ((guiCfg.user && guiCfg.password) || guiCfg.authMode === 'ldap') {
+            if ($scope.isAuthEnabled()) {
                 $scope.dismissNotification('authenticationUserAndPassword');
             }
         }
@@ -1018,7 +1022,7 @@ angular.module('syncthing.core')
             if (status === 'stopped' || status === 'outofsync' || status === 'error' || status === 'faileditems' || status === 'localunencrypted') {
                 return 'danger';
             }
-            if (status === 'unshared' || status === 'scan-waiting' || status === 'sync-waiting' || status === 'clean-waiting') {
+            if (status === 'unshared' || status === 'scan-waiting' || status === 'sync-waiting' || status === 'sync-suspended' || status === 'clean-waiting') {
                 return 'warning';
             }
 
@@ -1207,6 +1211,7 @@ angular.module('syncthing.core')
                 case 'localadditions':
                     return 'fa-check';
                 case 'paused':
+                case 'sync-suspended':
                     return 'fa-pause';
                 case 'scanning':
                     return 'fa-search';
@@ -1247,6 +1252,8 @@ angular.module('syncthing.core')
                     return $translate.instant('Stopped');
                 case 'sync-preparing':
                     return $translate.instant('Preparing to Sync');
+                case 'sync-suspended':
+                    return $translate.instant('Suspended on Metered Network');
                 case 'sync-waiting':
                     return $translate.instant('Waiting to Sync');
                 case 'syncing':
@@ -2180,7 +2187,12 @@ angular.module('syncthing.core')
                 params: { current: newvalue }
             }).success(function (data) {
                 $scope.directoryList = data;
-            }).error($scope.emitHTTPError);
+            }).error(function (data, status, headers, config) {
+                // Only admins may browse, others go without completion
+                if (status !== 403) {
+                    $scope.emitHTTPError(data, status, headers, config);
+                }
+            });
         });
 
         $scope.$watch('currentFolder.label', function (newvalue) {
@@ -40,6 +40,7 @@ import (
 	"golang.org/x/text/transform"
 	"golang.org/x/text/unicode/norm"
 
+	"github.com/syncthing/syncthing/lib/audit"
 	"github.com/syncthing/syncthing/lib/build"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/connections"
@@ -57,6 +58,7 @@ import (
 	"github.com/syncthing/syncthing/lib/tlsutil"
 	"github.com/syncthing/syncthing/lib/upgrade"
 	"github.com/syncthing/syncthing/lib/ur"
+	"github.com/syncthing/syncthing/lib/webhook"
 )
 
 const (
@@ -69,6 +71,10 @@ const (
 	httpsCertLifetimeDays = 820
 )
 
+// Event streams send a keepalive after this long without events. A var so
+// that tests can shorten it.
+var eventStreamKeepalive = 25 * time.Second
+
 type service struct {
 	suture.Service
 
@@ -83,6 +89,9 @@ type service struct {
 	connectionsService   connections.Service
 	fss                  model.FolderSummaryService
 	urService            *ur.Service
+	webhooks             *webhook.Service
+	audit                *audit.Service
+	tokenUsage           *apiTokenUsage
 	noUpgrade            bool
 	tlsDefaultCommonName string
 	configChanged        chan struct{} // signals intentional listener close due to config change
@@ -104,7 +113,7 @@ type Service interface {
 	WaitForStart() error
 }
 
-func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonName string, m model.Model, defaultSub, diskSub events.BufferedSubscription, evLogger events.Logger, discoverer discover.Manager, connectionsService connections.Service, urService *ur.Service, fss model.FolderSummaryService, errors, systemLog logger.Recorder, noUpgrade bool) Service {
+func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonName string, m model.Model, defaultSub, diskSub events.BufferedSubscription, evLogger events.Logger, discoverer discover.Manager, connectionsService connections.Service, urService *ur.Service, fss model.FolderSummaryService, webhooks *webhook.Service, trail *audit.Service, errors, systemLog logger.Recorder, noUpgrade bool) Service {
 	return &service{
 		id:      id,
 		cfg:     cfg,
@@ -120,6 +129,9 @@ func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonNam
 		connectionsService:   connectionsService,
 		fss:                  fss,
 		urService:            urService,
+		webhooks:             webhooks,
+		audit:                trail,
+		tokenUsage:           newAPITokenUsage(),
 		guiErrors:            errors,
 		systemLog:            systemLog,
 		noUpgrade:            noUpgrade,
@@ -257,8 +269,10 @@ func (s *service) Serve(ctx context.Context) error {
 	restMux.HandlerFunc(http.MethodGet, "/rest/folder/versions", s.getFolderVersions)         // folder
 	restMux.HandlerFunc(http.MethodGet, "/rest/folder/errors", s.getFolderErrors)             // folder [perpage] [page]
 	restMux.HandlerFunc(http.MethodGet, "/rest/folder/pullerrors", s.getFolderErrors)         // folder (deprecated)
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/conflicts", s.getFolderConflicts)       // [folder]
 	restMux.HandlerFunc(http.MethodGet, "/rest/events", s.getIndexEvents)                     // [since] [limit] [timeout] [events]
 	restMux.HandlerFunc(http.MethodGet, "/rest/events/disk", s.getDiskEvents)                 // [since] [limit] [timeout]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events/stream", s.getEventStream)              // [since] [events]
 	restMux.HandlerFunc(http.MethodGet, "/rest/noauth/health", s.getHealth)                   // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/stats/device", s.getDeviceStats)               // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/stats/folder", s.getFolderStats)               // -
@@ -278,24 +292,33 @@ func (s *service) Serve(ctx context.Context) error {
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/debug", s.getSystemDebug)               // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/log", s.getSystemLog)                   // [since]
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/log.txt", s.getSystemLogTxt)            // [since]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/metered", s.getSystemMetered)           // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/webhooks", s.getSystemWebhooks)         // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/audit", s.getSystemAudit)               // [since] [limit] [user] [action] [folder] [device] [from] [to]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/audit/verify", s.getSystemAuditVerify)  // -
 
 	// The POST handlers
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/prio", s.postDBPrio)                          // folder file
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/ignores", s.postDBIgnores)                    // folder
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/override", s.postDBOverride)                  // folder
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/revert", s.postDBRevert)                      // folder
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/scan", s.postDBScan)                          // folder [sub...] [delay]
-	restMux.HandlerFunc(http.MethodPost, "/rest/folder/versions", s.postFolderVersionsRestore)   // folder <body>
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/error", s.postSystemError)                // <body>
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/error/clear", s.postSystemErrorClear)     // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/ping", s.restPing)                        // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/reset", s.postSystemReset)                // [folder]
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/restart", s.postSystemRestart)            // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/shutdown", s.postSystemShutdown)          // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/upgrade", s.postSystemUpgrade)            // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/pause", s.makeDevicePauseHandler(true))   // [device]
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/resume", s.makeDevicePauseHandler(false)) // [device]
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/debug", s.postSystemDebug)                // [enable] [disable]
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/prio", s.postDBPrio)                               // folder file
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/ignores", s.postDBIgnores)                         // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/override", s.postDBOverride)                       // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/revert", s.postDBRevert)                           // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/scan", s.postDBScan)                               // folder [sub...] [delay]
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/versions", s.postFolderVersionsRestore)        // folder <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/restore", s.postFolderRestore)                 // folder time [prefix] [dryrun]
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/masschange/confirm", s.postMassChangeConfirm)  // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/deletions/approve", s.postDeletionsApprove)    // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/conflicts/:resolution", s.postFolderConflicts) // folder name [to]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/error", s.postSystemError)                     // <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/error/clear", s.postSystemErrorClear)          // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/ping", s.restPing)                             // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/reset", s.postSystemReset)                     // [folder]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/restart", s.postSystemRestart)                 // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/shutdown", s.postSystemShutdown)               // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/upgrade", s.postSystemUpgrade)                 // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/pause", s.makeDevicePauseHandler(true))        // [device]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/resume", s.makeDevicePauseHandler(false))      // [device]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/debug", s.postSystemDebug)                     // [enable] [disable]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/metered", s.postSystemMetered)                 // metered
 
 	// The DELETE handlers
 	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/devices", s.deletePendingDevices) // device
@@ -304,9 +327,10 @@ func (s *service) Serve(ctx context.Context) error {
 	// Config endpoints
 
 	configBuilder := &configMuxBuilder{
-		Router: restMux,
-		id:     s.id,
-		cfg:    s.cfg,
+		Router:     restMux,
+		id:         s.id,
+		cfg:        s.cfg,
+		tokenUsage: s.tokenUsage,
 	}
 
 	configBuilder.registerConfig("/rest/config")
@@ -322,6 +346,8 @@ func (s *service) Serve(ctx context.Context) error {
 	configBuilder.registerOptions("/rest/config/options")
 	configBuilder.registerLDAP("/rest/config/ldap")
 	configBuilder.registerGUI("/rest/config/gui")
+	configBuilder.registerAPITokens("/rest/config/apikeys")
+	configBuilder.registerAPIToken("/rest/config/apikeys/:name")
 
 	// Deprecated config endpoints
 	configBuilder.registerConfigDeprecated("/rest/system/config") // POST instead of PUT
@@ -369,6 +395,17 @@ func (s *service) Serve(ctx context.Context) error {
 	// Add our version and ID as a header to responses
 	handler = withDetailsMiddleware(s.id, handler)
 
+	// Restrict requests made with API tokens to what their scope permits
+	handler = apiTokenMiddleware(guiCfg, s.tokenUsage, handler)
+
+	// Restrict requests made by GUI users to what their role permits
+	handler = userRoleMiddleware(handler)
+
+	// Record who changed what in the audit trail
+	if s.audit != nil {
+		handler = auditMiddleware(s.audit, guiCfg, handler)
+	}
+
 	// Wrap everything in basic auth, if user/password is set.
 	if guiCfg.IsAuthEnabled() {
 		sessionCookieName := "sessionid-" + s.id.Short().String()
@@ -378,6 +415,16 @@ func (s *service) Serve(ctx context.Context) error {
 
 		// Logout is a no-op without a valid session cookie, so /noauth/ is fine here
 		restMux.Handler(http.MethodPost, "/rest/noauth/auth/logout", handleLogout(sessionCookieName))
+
+		if guiCfg.OIDC.IsEnabled() {
+			oidc := newOIDCAuth(sessionCookieName, guiCfg, s.evLogger)
+			restMux.HandlerFunc(http.MethodGet, "/rest/noauth/auth/oidc", func(w http.ResponseWriter, _ *http.Request) {
+				// Tells the login page to offer OIDC login
+				sendJSON(w, map[string]bool{"enabled": true})
+			})
+			restMux.HandlerFunc(http.MethodGet, oidcLoginPath, oidc.login)
+			restMux.HandlerFunc(http.MethodGet, oidcCallbackPath, oidc.callback)
+		}
 	}
 
 	// Redirect to HTTPS if we are supposed to
@@ -486,8 +533,8 @@ func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 	// No action required when this changes, so mask the fact that it changed at all.
 	from.GUI.Debugging = to.GUI.Debugging
 
-	if to.GUI == from.GUI {
-		// No GUI changes, we're done here.
+	if reflect.DeepEqual(to.GUI, from.GUI) && reflect.DeepEqual(to.LDAP, from.LDAP) {
+		// No GUI or LDAP changes, we're done here.
 		return true
 	}
 
@@ -843,6 +890,25 @@ func (s *service) postDBRevert(_ http.ResponseWriter, r *http.Request) {
 	go s.model.Revert(folder)
 }
 
+func (s *service) postMassChangeConfirm(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	if err := s.model.ConfirmMassChange(qs.Get("folder")); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+	}
+}
+
+func (s *service) postDeletionsApprove(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	approved, err := s.model.ApproveDeletions(qs.Get("folder"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, map[string]int{
+		"approved": approved,
+	})
+}
+
 func getPagingParams(qs url.Values) (int, int) {
 	page, err := strconv.Atoi(qs.Get("page"))
 	if err != nil || page < 1 {
@@ -867,14 +933,22 @@ func (s *service) getDBNeed(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
 	}
+	// Deletions held back are part of the rest, but need approval before
+	// they are applied.
+	held, err := s.model.HeldDeletions(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 
 	// Convert the struct to a more loose structure, and inject the size.
 	sendJSON(w, map[string]interface{}{
-		"progress": toJsonFileInfoSlice(progress),
-		"queued":   toJsonFileInfoSlice(queued),
-		"rest":     toJsonFileInfoSlice(rest),
-		"page":     page,
-		"perpage":  perpage,
+		"progress":      toJsonFileInfoSlice(progress),
+		"queued":        toJsonFileInfoSlice(queued),
+		"rest":          toJsonFileInfoSlice(rest),
+		"heldDeletions": toJsonFileInfoSlice(held),
+		"page":          page,
+		"perpage":       perpage,
 	})
 }
 
@@ -937,12 +1011,19 @@ func (s *service) getDeviceStats(w http.ResponseWriter, _ *http.Request) {
 	sendJSON(w, stats)
 }
 
-func (s *service) getFolderStats(w http.ResponseWriter, _ *http.Request) {
+func (s *service) getFolderStats(w http.ResponseWriter, r *http.Request) {
 	stats, err := s.model.FolderStatistics()
 	if err != nil {
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return
 	}
+	if user, ok := guiUserFromRequest(r); ok {
+		for folder := range stats {
+			if !user.seesFolder(folder) {
+				delete(stats, folder)
+			}
+		}
+	}
 	sendJSON(w, stats)
 }
 
@@ -1372,11 +1453,14 @@ func (s *service) postDBIgnores(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = s.model.SetIgnores(qs.Get("folder"), data["ignore"])
+	folder := qs.Get("folder")
+	old, _, _ := s.model.LoadIgnores(folder)
+	err = s.model.SetIgnores(folder, data["ignore"])
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	auditChange(r, "ignores", old, data["ignore"])
 
 	s.getDBIgnores(w, r)
 }
@@ -1417,7 +1501,18 @@ func (s *service) getEvents(w http.ResponseWriter, r *http.Request, eventSub eve
 	f.Flush()
 
 	// If there are no events available return an empty slice, as this gets serialized as `[]`
-	evs := eventSub.Since(since, []events.Event{}, timeout)
+	deadline := time.Now().Add(timeout)
+	var evs []events.Event
+	for {
+		all := eventSub.Since(since, []events.Event{}, time.Until(deadline))
+		evs = visibleEvents(r, all)
+		if len(evs) > 0 || len(all) == 0 || !time.Now().Before(deadline) {
+			break
+		}
+		// None of the events are for the user to see; wait for more
+		// rather than have the client ask again right away.
+		since = all[len(all)-1].SubscriptionID
+	}
 	if 0 < limit && limit < len(evs) {
 		evs = evs[len(evs)-limit:]
 	}
@@ -1425,6 +1520,55 @@ func (s *service) getEvents(w http.ResponseWriter, r *http.Request, eventSub eve
 	sendJSON(w, evs)
 }
 
+// getEventStream streams events as server-sent events, each with its ID
+// so that a reconnecting client resumes after the last one it got, as
+// given by the Last-Event-ID header or the since parameter.
+func (s *service) getEventStream(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	eventSub := s.getEventSub(s.getEventMask(qs.Get("events")))
+	lastID, _ := strconv.Atoi(qs.Get("since"))
+	if id, err := strconv.Atoi(r.Header.Get("Last-Event-ID")); err == nil {
+		lastID = id
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	f := w.(http.Flusher)
+	f.Flush()
+
+	for {
+		if eventSub.Mask()&(events.FolderSummary|events.FolderCompletion) != 0 {
+			s.fss.OnEventRequest()
+		}
+
+		evs := eventSub.Since(lastID, nil, eventStreamKeepalive)
+		if err := r.Context().Err(); err != nil {
+			return
+		}
+		if len(evs) == 0 {
+			// A comment, to detect when the client has gone away and keep
+			// proxies from timing out the connection.
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+			f.Flush()
+			continue
+		}
+		for _, ev := range visibleEvents(r, evs) {
+			bs, err := json.Marshal(ev)
+			if err != nil {
+				l.Debugln("Marshalling event:", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.SubscriptionID, ev.Type, bs); err != nil {
+				return
+			}
+		}
+		lastID = evs[len(evs)-1].SubscriptionID
+		f.Flush()
+	}
+}
+
 func (*service) getEventMask(evs string) events.EventType {
 	eventMask := DefaultEventMask
 	if evs != "" {
@@ -1527,7 +1671,7 @@ func (s *service) makeDevicePauseHandler(paused bool) http.HandlerFunc {
 
 		var msg string
 		var status int
-		_, err := s.cfg.Modify(func(cfg *config.Configuration) {
+		waiter, err := modifyConfig(r, s.cfg, func(cfg *config.Configuration) {
 			if deviceStr == "" {
 				for i := range cfg.Devices {
 					cfg.Devices[i].Paused = paused
@@ -1556,10 +1700,46 @@ func (s *service) makeDevicePauseHandler(paused bool) http.HandlerFunc {
 			http.Error(w, msg, status)
 		} else if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
+		} else {
+			waiter.Wait()
 		}
 	}
 }
 
+func (s *service) getSystemMetered(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, s.model.NetworkMetered())
+}
+
+// postSystemMetered sets whether the network should be considered metered,
+// regardless of the other conditions.
+func (s *service) postSystemMetered(w http.ResponseWriter, r *http.Request) {
+	metered, err := strconv.ParseBool(r.URL.Query().Get("metered"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	waiter, err := modifyConfig(r, s.cfg, func(cfg *config.Configuration) {
+		cfg.Options.Metered = metered
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	waiter.Wait()
+}
+
+// getSystemWebhooks returns the log of the latest event deliveries to
+// webhooks.
+func (s *service) getSystemWebhooks(w http.ResponseWriter, _ *http.Request) {
+	deliveries := []webhook.Delivery{}
+	if s.webhooks != nil {
+		deliveries = append(deliveries, s.webhooks.Deliveries()...)
+	}
+	sendJSON(w, map[string][]webhook.Delivery{
+		"deliveries": deliveries,
+	})
+}
+
 func (s *service) postDBScan(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	folder := qs.Get("folder")
@@ -1674,6 +1854,34 @@ func (s *service) postFolderVersionsRestore(w http.ResponseWriter, r *http.Reque
 	sendJSON(w, errorStringMap(ferr))
 }
 
+func (s *service) postFolderRestore(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	at, err := time.Parse(time.RFC3339, qs.Get("time"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var dryRun bool
+	if v := qs.Get("dryrun"); v != "" {
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	restored, err := s.model.RestoreFolderToTime(qs.Get("folder"), qs.Get("prefix"), at, dryRun)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sendJSON(w, map[string]interface{}{
+		"dryRun":   dryRun,
+		"restored": restored,
+	})
+}
+
 func (s *service) getFolderErrors(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	folder := qs.Get("folder")
@@ -1703,6 +1911,50 @@ func (s *service) getFolderErrors(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (s *service) getFolderConflicts(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+
+	folders := []string{folder}
+	if folder == "" {
+		folders = folders[:0]
+		for _, fcfg := range s.cfg.FolderList() {
+			folders = append(folders, fcfg.ID)
+		}
+	}
+
+	conflicts := make([]model.FolderConflict, 0)
+	for _, folder := range folders {
+		fc, err := s.model.FolderConflicts(folder)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		conflicts = append(conflicts, fc...)
+	}
+
+	sendJSON(w, map[string]interface{}{
+		"folder":    folder,
+		"conflicts": conflicts,
+	})
+}
+
+// postFolderConflicts resolves a conflict in the way given as the last path
+// element: keep-local, keep-remote, keep-both or merge-by-rename.
+func (s *service) postFolderConflicts(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	resolution := model.ConflictResolution(httprouter.ParamsFromContext(r.Context()).ByName("resolution"))
+	err := s.model.ResolveFolderConflict(qs.Get("folder"), qs.Get("name"), resolution, qs.Get("to"))
+	switch {
+	case errors.Is(err, model.ErrNoSuchConflict), errors.Is(err, model.ErrFolderMissing):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, model.ErrUnknownResolution):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 func (*service) getSystemBrowse(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	current := qs.Get("current")
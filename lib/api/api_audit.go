@@ -0,0 +1,213 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/audit"
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+// An auditRequest is a request to be recorded in the audit trail, and the
+// changes made on its behalf.
+type auditRequest struct {
+	trail   *audit.Service
+	changes *audit.ChangeSet
+}
+
+type auditRequestKey struct{}
+
+// auditMiddleware records the requests which change something in the
+// audit trail, with who made them and what they changed.
+func auditMiddleware(trail *audit.Service, guiCfg config.GUIConfiguration, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions ||
+			!strings.HasPrefix(r.URL.Path, "/rest/") || isNoAuthPath(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ar := &auditRequest{trail: trail, changes: audit.NewChangeSet()}
+		r = r.WithContext(context.WithValue(r.Context(), auditRequestKey{}, ar))
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
+
+		trail.Record(audit.Entry{
+			Actor:   auditActor(r, guiCfg),
+			Action:  auditAction(r.URL.Path),
+			Request: r.Method + " " + r.URL.Path,
+			Status:  sw.status,
+			Folder:  requestedID(r, "folder", "/rest/config/folders/"),
+			Device:  requestedID(r, "device", "/rest/config/devices/"),
+			Changes: ar.changes.Changes(),
+		})
+	})
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// auditActor returns who made the request, as far as known: the GUI user,
+// the API key and the address.
+func auditActor(r *http.Request, guiCfg config.GUIConfiguration) audit.Actor {
+	actor := audit.Actor{Address: r.RemoteAddr}
+	if user, ok := guiUserFromRequest(r); ok {
+		actor.User, actor.Role = user.name, user.role
+	}
+	for _, key := range apiKeysFromHeaders(r) {
+		if !guiCfg.IsValidAPIKey(key) {
+			continue
+		}
+		// The first valid key is the one the request is authorized
+		// with, as in apiTokenMiddleware.
+		actor.APIKey = "main"
+		if token, ok := guiCfg.APIToken(key); ok {
+			actor.APIKey = token.Name
+		}
+		break
+	}
+	return actor
+}
+
+// auditAction returns the name of what a request to the path does.
+func auditAction(path string) string {
+	switch path {
+	case "/rest/db/override":
+		return "override"
+	case "/rest/db/revert":
+		return "revert"
+	case "/rest/db/ignores":
+		return "ignores"
+	case "/rest/db/scan":
+		return "scan"
+	case "/rest/system/pause":
+		return "pause"
+	case "/rest/system/resume":
+		return "resume"
+	case "/rest/system/restart":
+		return "restart"
+	case "/rest/system/shutdown":
+		return "shutdown"
+	case "/rest/system/upgrade":
+		return "upgrade"
+	case "/rest/system/reset":
+		return "reset"
+	}
+	if strings.HasPrefix(path, "/rest/config") || strings.HasPrefix(path, "/rest/system/config") {
+		return audit.ActionConfig
+	}
+	return strings.TrimPrefix(path, "/rest/")
+}
+
+// modifyConfig modifies the configuration as cfg.Modify, noting the
+// changes for the audit trail of the request.
+func modifyConfig(r *http.Request, cfg config.Wrapper, fn config.ModifyFunction) (config.Waiter, error) {
+	if ar, ok := r.Context().Value(auditRequestKey{}).(*auditRequest); ok {
+		return ar.trail.Modify(ar.changes, fn)
+	}
+	return cfg.Modify(fn)
+}
+
+// auditChange notes a change other than to the configuration for the
+// audit trail of the request.
+func auditChange(r *http.Request, path string, from, to interface{}) {
+	ar, ok := r.Context().Value(auditRequestKey{}).(*auditRequest)
+	if !ok {
+		return
+	}
+	c := audit.Change{Path: path}
+	c.Old, _ = json.Marshal(from)
+	c.New, _ = json.Marshal(to)
+	ar.changes.Add(c)
+}
+
+// getSystemAudit returns the entries of the audit trail selected by the
+// query parameters, oldest first.
+func (s *service) getSystemAudit(w http.ResponseWriter, r *http.Request) {
+	if s.audit == nil {
+		sendJSON(w, map[string][]audit.Entry{"entries": {}})
+		return
+	}
+
+	qs := r.URL.Query()
+	f := audit.Filter{
+		User:   qs.Get("user"),
+		Action: qs.Get("action"),
+		Folder: qs.Get("folder"),
+		Device: qs.Get("device"),
+	}
+	var err error
+	if v := qs.Get("since"); v != "" {
+		if f.Since, err = strconv.ParseInt(v, 10, 64); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if v := qs.Get("limit"); v != "" {
+		if f.Limit, err = strconv.Atoi(v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if v := qs.Get("from"); v != "" {
+		if f.From, err = time.Parse(time.RFC3339, v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if v := qs.Get("to"); v != "" {
+		if f.To, err = time.Parse(time.RFC3339, v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	entries, err := s.audit.Query(f)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if entries == nil {
+		entries = []audit.Entry{}
+	}
+	sendJSON(w, map[string][]audit.Entry{
+		"entries": entries,
+	})
+}
+
+// getSystemAuditVerify checks the chain of hashes of the audit trail.
+func (s *service) getSystemAuditVerify(w http.ResponseWriter, _ *http.Request) {
+	if s.audit == nil {
+		sendJSON(w, audit.Verification{Valid: true})
+		return
+	}
+	v, err := s.audit.Verify()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sendJSON(w, v)
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/audit"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestAuditMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cfg := config.Wrap("", config.New(protocol.LocalDeviceID), protocol.LocalDeviceID, events.NoopLogger)
+	go cfg.Serve(ctx)
+	waiter, err := cfg.Modify(func(cfg *config.Configuration) {
+		cfg.Folders = []config.FolderConfiguration{{ID: "default", Path: t.TempDir()}}
+		cfg.Options.AuditTrailEnabled = true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+	trail := audit.New(filepath.Join(t.TempDir(), "audit-trail.log"), cfg, events.NoopLogger)
+
+	guiCfg := config.GUIConfiguration{
+		APIKey:    "abc123",
+		APITokens: []config.APIToken{{Name: "ci", Key: "def456", Scope: config.APITokenScopeAdmin}},
+	}
+	handler := auditMiddleware(trail, guiCfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			return
+		}
+		waiter, err := modifyConfig(r, cfg, func(cfg *config.Configuration) {
+			cfg.Folders[0].Paused = !cfg.Folders[0].Paused
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		waiter.Wait()
+	}))
+
+	// Looking isn't recorded, changing is, with who did it.
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/rest/config/folders/default", nil))
+	req := httptest.NewRequest(http.MethodPatch, "/rest/config/folders/default", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), withGUIUser(req, guiUser{name: "alice", role: config.GUIRoleAdmin}))
+	req = httptest.NewRequest(http.MethodPost, "/rest/system/pause", nil)
+	req.Header.Set("X-API-Key", "def456")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	entries, err := trail.Query(audit.Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, expected 2", len(entries))
+	}
+
+	e := entries[0]
+	if e.Actor.User != "alice" || e.Actor.Role != config.GUIRoleAdmin || e.Actor.Address == "" || e.Action != audit.ActionConfig || e.Folder != "default" || e.Status != http.StatusOK {
+		t.Errorf("unexpected entry %+v", e)
+	}
+	if len(e.Changes) != 1 || e.Changes[0].Path != "folders[default].paused" || string(e.Changes[0].New) != "true" {
+		t.Errorf("unexpected changes %+v", e.Changes)
+	}
+	if e := entries[1]; e.Actor.APIKey != "ci" || e.Action != "pause" || e.Request != "POST /rest/system/pause" || len(e.Changes) != 1 {
+		t.Errorf("unexpected entry %+v", e)
+	}
+}
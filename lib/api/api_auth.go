@@ -7,6 +7,7 @@
 package api
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net"
@@ -23,7 +24,7 @@ import (
 )
 
 var (
-	sessions    = make(map[string]bool)
+	sessions    = make(map[string]guiUser)
 	sessionsMut = sync.NewMutex()
 )
 
@@ -92,19 +93,24 @@ func basicAuthAndSessionMiddleware(cookieName, shortID string, guiCfg config.GUI
 			// later removed on logout or when timing out.
 			if cookie.Name == cookieName {
 				sessionsMut.Lock()
-				_, ok := sessions[cookie.Value]
+				user, ok := sessions[cookie.Value]
 				sessionsMut.Unlock()
+				if ok && !user.external {
+					// The account may have changed or gone away since
+					// the session was created.
+					user, ok = configuredUser(user.name, guiCfg)
+				}
 				if ok {
-					next.ServeHTTP(w, r)
+					next.ServeHTTP(w, withGUIUser(r, user))
 					return
 				}
 			}
 		}
 
 		// Fall back to Basic auth if provided
-		if username, ok := attemptBasicAuth(r, guiCfg, ldapCfg, evLogger); ok {
-			createSession(cookieName, username, guiCfg, evLogger, w, r)
-			next.ServeHTTP(w, r)
+		if user, ok := attemptBasicAuth(r, guiCfg, ldapCfg, evLogger); ok {
+			createSession(cookieName, user, guiCfg, evLogger, w, r)
+			next.ServeHTTP(w, withGUIUser(r, user))
 			return
 		}
 
@@ -125,6 +131,150 @@ func basicAuthAndSessionMiddleware(cookieName, shortID string, guiCfg config.GUI
 	})
 }
 
+// apiTokenMiddleware restricts requests made with API tokens to what the
+// scope of the token permits, and records when each token was last used.
+func apiTokenMiddleware(guiCfg config.GUIConfiguration, usage *apiTokenUsage, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, key := range apiKeysFromHeaders(r) {
+			if !guiCfg.IsValidAPIKey(key) {
+				continue
+			}
+			// The first valid key is the one the request is authorized
+			// with, as in hasValidAPIKeyHeader.
+			if token, ok := guiCfg.APIToken(key); ok {
+				usage.record(token.Name)
+				if !isNoAuthPath(r.URL.Path) && !apiTokenPermits(token, r) {
+					forbidden(w)
+					return
+				}
+				r = withAPIToken(r, token)
+			}
+			break
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+type apiTokenKey struct{}
+
+func withAPIToken(r *http.Request, token config.APIToken) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), apiTokenKey{}, token))
+}
+
+func apiTokenFromRequest(r *http.Request) (config.APIToken, bool) {
+	token, ok := r.Context().Value(apiTokenKey{}).(config.APIToken)
+	return token, ok
+}
+
+// apiTokenPermits returns whether the scope of the token permits the
+// request. Folder and device scoped tokens are limited to the endpoints
+// concerning a single folder or device, and only the ones in their scope.
+// Only admin tokens may change the configuration, as that of a folder or
+// device alone covers running commands and accepting other devices.
+func apiTokenPermits(token config.APIToken, r *http.Request) bool {
+	if token.Scope == config.APITokenScopeAdmin {
+		return true
+	}
+	if isAdminOnlyPath(r.URL.Path) {
+		return false
+	}
+	looking := r.Method == http.MethodGet || r.Method == http.MethodHead
+	if !looking && isAdminChangePath(r.URL.Path) {
+		return false
+	}
+	switch token.Scope {
+	case config.APITokenScopeReadOnly:
+		return looking
+	case config.APITokenScopeFolder:
+		id, ok := scopedID(r, "folder", []string{"/rest/db/", "/rest/folder/"}, "/rest/config/folders/")
+		return ok && slices.Contains(token.Folders, id)
+	case config.APITokenScopeDevice:
+		id, ok := scopedID(r, "device", []string{"/rest/db/completion"}, "/rest/config/devices/")
+		return ok && slices.Contains(token.Devices, id)
+	default:
+		return false
+	}
+}
+
+// isAdminOnlyPath returns whether only admins may access the path, as it
+// gives access to the keys, the debugging functions, the audit trail or
+// the file system.
+func isAdminOnlyPath(path string) bool {
+	// Local variable instead of module var to prevent accidental mutation
+	adminOnlyPaths := []string{
+		"/rest/config",
+		"/rest/system/config",
+	}
+
+	// Local variable instead of module var to prevent accidental mutation
+	adminOnlyPrefixes := []string{
+		"/rest/config/gui",
+		"/rest/config/ldap",
+		"/rest/config/apikeys",
+		"/rest/system/config/",
+		"/rest/system/audit",
+		"/rest/system/browse",
+		"/rest/debug/",
+	}
+
+	return slices.Contains(adminOnlyPaths, path) ||
+		slices.ContainsFunc(adminOnlyPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(path, prefix)
+		})
+}
+
+// requestedID returns the folder or device ID the request concerns, as
+// given by the query parameter or the config path.
+func requestedID(r *http.Request, param, configPrefix string) string {
+	if id, ok := strings.CutPrefix(r.URL.Path, configPrefix); ok {
+		return id
+	}
+	return r.URL.Query().Get(param)
+}
+
+// scopedID returns the folder or device ID the request concerns, if it's
+// to one of the given endpoints concerning a single one, as given by the
+// query parameter, or to the config of a single one. The endpoints are
+// prefixes when they end in a slash, and exact paths otherwise.
+func scopedID(r *http.Request, param string, endpoints []string, configPrefix string) (string, bool) {
+	if id, ok := strings.CutPrefix(r.URL.Path, configPrefix); ok {
+		return id, id != "" && !strings.Contains(id, "/")
+	}
+	for _, endpoint := range endpoints {
+		if r.URL.Path == endpoint || strings.HasSuffix(endpoint, "/") && strings.HasPrefix(r.URL.Path, endpoint) {
+			id := r.URL.Query().Get(param)
+			return id, id != ""
+		}
+	}
+	return "", false
+}
+
+// apiTokenUsage tracks when each API token was last used. This is not
+// persisted, to not save the config on every request.
+type apiTokenUsage struct {
+	mut  sync.Mutex
+	used map[string]time.Time
+}
+
+func newAPITokenUsage() *apiTokenUsage {
+	return &apiTokenUsage{
+		mut:  sync.NewMutex(),
+		used: make(map[string]time.Time),
+	}
+}
+
+func (u *apiTokenUsage) record(name string) {
+	u.mut.Lock()
+	u.used[name] = time.Now().Truncate(time.Second)
+	u.mut.Unlock()
+}
+
+func (u *apiTokenUsage) lastUsed(name string) time.Time {
+	u.mut.Lock()
+	defer u.mut.Unlock()
+	return u.used[name]
+}
+
 func passwordAuthHandler(cookieName string, guiCfg config.GUIConfiguration, ldapCfg config.LDAPConfiguration, evLogger events.Logger) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var req struct {
@@ -137,8 +287,8 @@ func passwordAuthHandler(cookieName string, guiCfg config.GUIConfiguration, ldap
 			return
 		}
 
-		if auth(req.Username, req.Password, guiCfg, ldapCfg) {
-			createSession(cookieName, req.Username, guiCfg, evLogger, w, r)
+		if user, ok := auth(req.Username, req.Password, guiCfg, ldapCfg); ok {
+			createSession(cookieName, user, guiCfg, evLogger, w, r)
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
@@ -149,56 +299,58 @@ func passwordAuthHandler(cookieName string, guiCfg config.GUIConfiguration, ldap
 	})
 }
 
-func attemptBasicAuth(r *http.Request, guiCfg config.GUIConfiguration, ldapCfg config.LDAPConfiguration, evLogger events.Logger) (string, bool) {
+func attemptBasicAuth(r *http.Request, guiCfg config.GUIConfiguration, ldapCfg config.LDAPConfiguration, evLogger events.Logger) (guiUser, bool) {
 	username, password, ok := r.BasicAuth()
 	if !ok {
-		return "", false
+		return guiUser{}, false
 	}
 
 	l.Debugln("Sessionless HTTP request with authentication; this is expensive.")
 
-	if auth(username, password, guiCfg, ldapCfg) {
-		return username, true
+	if user, ok := auth(username, password, guiCfg, ldapCfg); ok {
+		return user, true
 	}
 
 	usernameFromIso := string(iso88591ToUTF8([]byte(username)))
 	passwordFromIso := string(iso88591ToUTF8([]byte(password)))
-	if auth(usernameFromIso, passwordFromIso, guiCfg, ldapCfg) {
-		return usernameFromIso, true
+	if user, ok := auth(usernameFromIso, passwordFromIso, guiCfg, ldapCfg); ok {
+		return user, true
 	}
 
 	emitLoginAttempt(false, username, r.RemoteAddr, evLogger)
 	antiBruteForceSleep()
-	return "", false
+	return guiUser{}, false
 }
 
-func createSession(cookieName string, username string, guiCfg config.GUIConfiguration, evLogger events.Logger, w http.ResponseWriter, r *http.Request) {
+func createSession(cookieName string, user guiUser, guiCfg config.GUIConfiguration, evLogger events.Logger, w http.ResponseWriter, r *http.Request) {
 	sessionid := rand.String(32)
 	sessionsMut.Lock()
-	sessions[sessionid] = true
+	sessions[sessionid] = user
 	sessionsMut.Unlock()
 
-	// Best effort detection of whether the connection is HTTPS --
-	// either directly to us, or as used by the client towards a reverse
-	// proxy who sends us headers.
-	connectionIsHTTPS := r.TLS != nil ||
-		strings.ToLower(r.Header.Get("x-forwarded-proto")) == "https" ||
-		strings.Contains(strings.ToLower(r.Header.Get("forwarded")), "proto=https")
-	// If the connection is HTTPS, or *should* be HTTPS, set the Secure
-	// bit in cookies.
-	useSecureCookie := connectionIsHTTPS || guiCfg.UseTLS()
-
 	http.SetCookie(w, &http.Cookie{
 		Name:  cookieName,
 		Value: sessionid,
 		// In HTTP spec Max-Age <= 0 means delete immediately,
 		// but in http.Cookie MaxAge = 0 means unspecified (session) and MaxAge < 0 means delete immediately
 		MaxAge: 0,
-		Secure: useSecureCookie,
+		Secure: useSecureCookie(r, guiCfg),
 		Path:   "/",
 	})
 
-	emitLoginAttempt(true, username, r.RemoteAddr, evLogger)
+	emitLoginAttempt(true, user.name, r.RemoteAddr, evLogger)
+}
+
+// useSecureCookie returns whether to set the Secure bit in cookies, which
+// is when the connection is HTTPS, or *should* be HTTPS.
+func useSecureCookie(r *http.Request, guiCfg config.GUIConfiguration) bool {
+	// Best effort detection of whether the connection is HTTPS --
+	// either directly to us, or as used by the client towards a reverse
+	// proxy who sends us headers.
+	connectionIsHTTPS := r.TLS != nil ||
+		strings.ToLower(r.Header.Get("x-forwarded-proto")) == "https" ||
+		strings.Contains(strings.ToLower(r.Header.Get("forwarded")), "proto=https")
+	return connectionIsHTTPS || guiCfg.UseTLS()
 }
 
 func handleLogout(cookieName string) http.Handler {
@@ -227,7 +379,7 @@ func handleLogout(cookieName string) http.Handler {
 	})
 }
 
-func auth(username string, password string, guiCfg config.GUIConfiguration, ldapCfg config.LDAPConfiguration) bool {
+func auth(username string, password string, guiCfg config.GUIConfiguration, ldapCfg config.LDAPConfiguration) (guiUser, bool) {
 	if guiCfg.AuthMode == config.AuthModeLDAP {
 		return authLDAP(username, password, ldapCfg)
 	} else {
@@ -235,11 +387,21 @@ func auth(username string, password string, guiCfg config.GUIConfiguration, ldap
 	}
 }
 
-func authStatic(username string, password string, guiCfg config.GUIConfiguration) bool {
-	return guiCfg.CompareHashedPassword(password) == nil && username == guiCfg.User
+func authStatic(username string, password string, guiCfg config.GUIConfiguration) (guiUser, bool) {
+	if guiCfg.CompareHashedPassword(password) == nil && username == guiCfg.User {
+		return guiUser{name: username, role: config.GUIRoleAdmin}, true
+	}
+	if a, ok := guiCfg.Account(username); ok && a.CompareHashedPassword(password) == nil {
+		return guiUser{name: a.Name, role: a.Role, folders: a.Folders}, true
+	}
+	return guiUser{}, false
 }
 
-func authLDAP(username string, password string, cfg config.LDAPConfiguration) bool {
+// authLDAP binds as the user, and if a search is configured, finds the
+// user and their groups. The role of the user is that of the first of
+// their groups with a group role, if there are any group roles; otherwise
+// everyone who can bind is an admin.
+func authLDAP(username string, password string, cfg config.LDAPConfiguration) (guiUser, bool) {
 	address := cfg.Address
 	hostname, _, err := net.SplitHostPort(address)
 	if err != nil {
@@ -257,14 +419,14 @@ func authLDAP(username string, password string, cfg config.LDAPConfiguration) bo
 
 	if err != nil {
 		l.Warnln("LDAP Dial:", err)
-		return false
+		return guiUser{}, false
 	}
 
 	if cfg.Transport == config.LDAPTransportStartTLS {
 		err = connection.StartTLS(&tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify})
 		if err != nil {
 			l.Warnln("LDAP Start TLS:", err)
-			return false
+			return guiUser{}, false
 		}
 	}
 
@@ -274,17 +436,21 @@ func authLDAP(username string, password string, cfg config.LDAPConfiguration) bo
 	err = connection.Bind(bindDN, password)
 	if err != nil {
 		l.Warnln("LDAP Bind:", err)
-		return false
+		return guiUser{}, false
 	}
 
 	if cfg.SearchFilter == "" && cfg.SearchBaseDN == "" {
+		if len(cfg.GroupRoles) > 0 {
+			l.Warnln("LDAP configuration: searchFilter and searchBaseDN must be set to use group roles.")
+			return guiUser{}, false
+		}
 		// We're done here.
-		return true
+		return ldapUser(cfg, username, nil)
 	}
 
 	if cfg.SearchFilter == "" || cfg.SearchBaseDN == "" {
 		l.Warnln("LDAP configuration: both searchFilter and searchBaseDN must be set, or neither.")
-		return false
+		return guiUser{}, false
 	}
 
 	// If a search filter and search base is set we do an LDAP search for
@@ -294,19 +460,35 @@ func authLDAP(username string, password string, cfg config.LDAPConfiguration) bo
 	searchString := formatOptionalPercentS(cfg.SearchFilter, escapeForLDAPFilter(username))
 	const sizeLimit = 2  // we search for up to two users -- we only want to match one, so getting any number >1 is a failure.
 	const timeLimit = 60 // Search for up to a minute...
-	searchReq := ldap.NewSearchRequest(cfg.SearchBaseDN, ldap.ScopeWholeSubtree, ldap.DerefFindingBaseObj, sizeLimit, timeLimit, false, searchString, nil, nil)
+	var attributes []string
+	if len(cfg.GroupRoles) > 0 {
+		attributes = []string{cfg.GroupAttribute}
+	}
+	searchReq := ldap.NewSearchRequest(cfg.SearchBaseDN, ldap.ScopeWholeSubtree, ldap.DerefFindingBaseObj, sizeLimit, timeLimit, false, searchString, attributes, nil)
 
 	res, err := connection.Search(searchReq)
 	if err != nil {
 		l.Warnln("LDAP Search:", err)
-		return false
+		return guiUser{}, false
 	}
 	if len(res.Entries) != 1 {
 		l.Infof("Wrong number of LDAP search results, %d != 1", len(res.Entries))
-		return false
+		return guiUser{}, false
 	}
 
-	return true
+	return ldapUser(cfg, username, res.Entries[0].GetAttributeValues(cfg.GroupAttribute))
+}
+
+// ldapUser returns the user with the role of the first of their groups
+// that has one, or else the default role. Without either, or when the role
+// is invalid, the user may not log in.
+func ldapUser(cfg config.LDAPConfiguration, username string, groups []string) (guiUser, bool) {
+	gr, ok := cfg.UserRole(groups)
+	if !ok {
+		l.Infof("LDAP user %q has no valid role", username)
+		return guiUser{}, false
+	}
+	return guiUser{name: username, role: gr.Role, folders: gr.Folders, external: true}, true
 }
 
 // escapeForLDAPFilter escapes a value that will be used in a filter clause
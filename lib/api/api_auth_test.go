@@ -7,9 +7,13 @@
 package api
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
 )
 
 var guiCfg config.GUIConfiguration
@@ -22,7 +26,7 @@ func init() {
 func TestStaticAuthOK(t *testing.T) {
 	t.Parallel()
 
-	ok := authStatic("user", "pass", guiCfg)
+	_, ok := authStatic("user", "pass", guiCfg)
 	if !ok {
 		t.Fatalf("should pass auth")
 	}
@@ -31,7 +35,7 @@ func TestStaticAuthOK(t *testing.T) {
 func TestSimpleAuthUsernameFail(t *testing.T) {
 	t.Parallel()
 
-	ok := authStatic("userWRONG", "pass", guiCfg)
+	_, ok := authStatic("userWRONG", "pass", guiCfg)
 	if ok {
 		t.Fatalf("should fail auth")
 	}
@@ -40,7 +44,7 @@ func TestSimpleAuthUsernameFail(t *testing.T) {
 func TestStaticAuthPasswordFail(t *testing.T) {
 	t.Parallel()
 
-	ok := authStatic("user", "passWRONG", guiCfg)
+	_, ok := authStatic("user", "passWRONG", guiCfg)
 	if ok {
 		t.Fatalf("should fail auth")
 	}
@@ -110,3 +114,284 @@ func TestEscapeForLDAPDN(t *testing.T) {
 		}
 	}
 }
+
+func TestAPITokenPermits(t *testing.T) {
+	t.Parallel()
+
+	readOnly := config.APIToken{Scope: config.APITokenScopeReadOnly}
+	folder := config.APIToken{Scope: config.APITokenScopeFolder, Folders: []string{"default"}}
+	device := config.APIToken{Scope: config.APITokenScopeDevice, Devices: []string{"AAAAAAA"}}
+	admin := config.APIToken{Scope: config.APITokenScopeAdmin}
+
+	cases := []struct {
+		token    config.APIToken
+		method   string
+		url      string
+		expected bool
+	}{
+		{readOnly, http.MethodGet, "/rest/db/status?folder=default", true},
+		{readOnly, http.MethodPost, "/rest/db/scan?folder=default", false},
+		{readOnly, http.MethodGet, "/rest/config/folders", true},
+		{readOnly, http.MethodGet, "/rest/config", false},
+		{readOnly, http.MethodGet, "/rest/config/gui", false},
+		{readOnly, http.MethodGet, "/rest/config/apikeys", false},
+		{readOnly, http.MethodGet, "/rest/system/audit?folder=default", false},
+		{readOnly, http.MethodGet, "/rest/system/browse?current=/", false},
+		{folder, http.MethodPost, "/rest/db/scan?folder=default", true},
+		{folder, http.MethodPost, "/rest/db/scan?folder=other", false},
+		{folder, http.MethodPost, "/rest/db/scan", false},
+		{folder, http.MethodGet, "/rest/config/folders/default", true},
+		{folder, http.MethodDelete, "/rest/config/folders/default", false},
+		{folder, http.MethodPut, "/rest/config/folders/default", false},
+		{folder, http.MethodPatch, "/rest/config/folders/default", false},
+		{folder, http.MethodDelete, "/rest/config/folders/other", false},
+		{folder, http.MethodGet, "/rest/system/status", false},
+		{folder, http.MethodGet, "/rest/folder/versions?folder=default", true},
+		{folder, http.MethodGet, "/rest/config/folders/default/extra", false},
+		{folder, http.MethodPut, "/rest/config/options?folder=default", false},
+		{folder, http.MethodPut, "/rest/config/devices?folder=default", false},
+		{folder, http.MethodPut, "/rest/config/folders?folder=default", false},
+		{folder, http.MethodPost, "/rest/config/folders?folder=default", false},
+		{folder, http.MethodPost, "/rest/system/shutdown?folder=default", false},
+		{folder, http.MethodPost, "/rest/system/restart?folder=default", false},
+		{folder, http.MethodPost, "/rest/system/upgrade?folder=default", false},
+		{folder, http.MethodGet, "/rest/system/browse?folder=default", false},
+		{device, http.MethodGet, "/rest/db/completion?device=AAAAAAA", true},
+		{device, http.MethodPost, "/rest/system/pause?device=AAAAAAA", false},
+		{device, http.MethodPut, "/rest/config/devices?device=AAAAAAA", false},
+		{device, http.MethodPost, "/rest/system/shutdown?device=AAAAAAA", false},
+		{device, http.MethodGet, "/rest/config/devices/AAAAAAA", true},
+		{device, http.MethodPut, "/rest/config/devices/AAAAAAA", false},
+		{device, http.MethodPatch, "/rest/config/devices/AAAAAAA", false},
+		{device, http.MethodGet, "/rest/db/status?folder=default", false},
+		{admin, http.MethodGet, "/rest/config/gui", true},
+		{admin, http.MethodPost, "/rest/system/shutdown", true},
+	}
+	for _, tc := range cases {
+		r := httptest.NewRequest(tc.method, tc.url, nil)
+		if res := apiTokenPermits(tc.token, r); res != tc.expected {
+			t.Errorf("%s scope, %s %s: got %v, expected %v", tc.token.Scope, tc.method, tc.url, res, tc.expected)
+		}
+	}
+}
+
+func TestAPITokenMiddleware(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GUIConfiguration{
+		APIKey: "mainkeymainkeymainkey",
+		APITokens: []config.APIToken{
+			{Name: "monitoring", Key: "monitoringmonitoring", Scope: config.APITokenScopeReadOnly},
+			{Name: "expired", Key: "expiredexpiredexpired", Scope: config.APITokenScopeAdmin, Expires: time.Now().Add(-time.Hour)},
+		},
+	}
+	usage := newAPITokenUsage()
+	handler := apiTokenMiddleware(cfg, usage, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	cases := []struct {
+		method   string
+		key      string
+		expected int
+	}{
+		{http.MethodPost, "monitoringmonitoring", http.StatusForbidden},
+		{http.MethodGet, "monitoringmonitoring", http.StatusOK},
+		{http.MethodPost, "mainkeymainkeymainkey", http.StatusOK},
+		// Not valid, so left to the other middlewares
+		{http.MethodPost, "expiredexpiredexpired", http.StatusOK},
+	}
+	for _, tc := range cases {
+		r := httptest.NewRequest(tc.method, "/rest/system/restart", nil)
+		r.Header.Set("Authorization", "Bearer "+tc.key)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		if w.Code != tc.expected {
+			t.Errorf("%s with %s: got status %d, expected %d", tc.method, tc.key, w.Code, tc.expected)
+		}
+	}
+
+	if usage.lastUsed("monitoring").IsZero() {
+		t.Error("token use wasn't recorded")
+	}
+	if cfg.IsValidAPIKey("expiredexpiredexpired") {
+		t.Error("expired token is valid")
+	}
+}
+
+func TestStaticAuthAccount(t *testing.T) {
+	t.Parallel()
+
+	cfg := guiCfg.Copy()
+	cfg.Accounts = []config.GUIAccount{{Name: "helpdesk", Password: "secret", Role: config.GUIRoleViewer, Folders: []string{"default"}}}
+	if err := cfg.HashAccountPasswords(); err != nil {
+		t.Fatal(err)
+	}
+
+	user, ok := authStatic("helpdesk", "secret", cfg)
+	if !ok {
+		t.Fatal("should pass auth")
+	}
+	if user.role != config.GUIRoleViewer || !user.seesFolder("default") || user.seesFolder("other") {
+		t.Errorf("unexpected user %+v", user)
+	}
+	if _, ok := authStatic("helpdesk", "pass", cfg); ok {
+		t.Error("should fail auth")
+	}
+	if user, _ := authStatic("user", "pass", cfg); !user.isAdmin() {
+		t.Error("GUI user should be an admin")
+	}
+}
+
+func TestUserPermits(t *testing.T) {
+	t.Parallel()
+
+	viewer := guiUser{role: config.GUIRoleViewer}
+	operator := guiUser{role: config.GUIRoleOperator, folders: []string{"default"}}
+	admin := guiUser{role: config.GUIRoleAdmin, folders: []string{"default"}}
+
+	cases := []struct {
+		user     guiUser
+		method   string
+		url      string
+		expected bool
+	}{
+		{viewer, http.MethodGet, "/rest/db/status?folder=default", true},
+		{viewer, http.MethodGet, "/rest/config", true},
+		{viewer, http.MethodGet, "/rest/config/apikeys", false},
+		{operator, http.MethodGet, "/rest/system/audit", false},
+		{viewer, http.MethodGet, "/rest/system/browse?current=/", false},
+		{operator, http.MethodGet, "/rest/system/browse?current=/", false},
+		{viewer, http.MethodPost, "/rest/db/scan?folder=default", false},
+		{viewer, http.MethodPut, "/rest/config/folders/default", false},
+		{operator, http.MethodPost, "/rest/db/scan?folder=default", true},
+		{operator, http.MethodPost, "/rest/db/scan?folder=other", false},
+		{operator, http.MethodPost, "/rest/db/scan", false},
+		{operator, http.MethodGet, "/rest/config/folders/other", false},
+		{operator, http.MethodPost, "/rest/system/pause", true},
+		{operator, http.MethodPost, "/rest/system/restart", true},
+		{operator, http.MethodPost, "/rest/system/shutdown", false},
+		{operator, http.MethodDelete, "/rest/config/folders/default", false},
+		{operator, http.MethodDelete, "/rest/cluster/pending/devices?device=AAAAAAA", false},
+		{admin, http.MethodDelete, "/rest/config/folders/other", true},
+		{admin, http.MethodPost, "/rest/system/shutdown", true},
+		{admin, http.MethodGet, "/rest/system/browse?current=/", true},
+	}
+	for _, tc := range cases {
+		r := httptest.NewRequest(tc.method, tc.url, nil)
+		if res := userPermits(tc.user, r); res != tc.expected {
+			t.Errorf("%s, %s %s: got %v, expected %v", tc.user.role, tc.method, tc.url, res, tc.expected)
+		}
+	}
+}
+
+func TestVisibleConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Configuration{
+		GUI: config.GUIConfiguration{
+			APIKey:    "mainkeymainkeymainkey",
+			APITokens: []config.APIToken{{Name: "monitoring", Key: "monitoringmonitoring", Scope: config.APITokenScopeReadOnly}},
+		},
+		Folders: []config.FolderConfiguration{
+			{ID: "default"},
+			{
+				ID:      "other",
+				SFTP:    config.SFTPConfiguration{Password: "sftppassword"},
+				Devices: []config.FolderDeviceConfiguration{{EncryptionPassword: "encryption"}},
+			},
+		},
+		Options: config.OptionsConfiguration{
+			Webhooks: []config.Webhook{{URL: "https://example.com/hook", Secret: "hooksecret"}},
+		},
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/rest/config", nil)
+	if res := visibleConfig(r, cfg); res.GUI.APIKey != cfg.GUI.APIKey || len(res.Folders) != 2 {
+		t.Error("config should be unchanged when authenticated by API key")
+	}
+
+	r = withGUIUser(r, guiUser{role: config.GUIRoleOperator, folders: []string{"other"}})
+	res := visibleConfig(r, cfg)
+	if res.GUI.APIKey == cfg.GUI.APIKey || res.GUI.APITokens[0].Key == cfg.GUI.APITokens[0].Key {
+		t.Error("keys should be redacted")
+	}
+	if len(res.Folders) != 1 || res.Folders[0].ID != "other" {
+		t.Fatalf("unexpected folders %v", res.Folders)
+	}
+	if res.Folders[0].SFTP.Password != "REDACTED" || res.Folders[0].Devices[0].EncryptionPassword != "REDACTED" || res.Options.Webhooks[0].Secret != "REDACTED" {
+		t.Error("passwords and secrets should be redacted")
+	}
+	if cfg.GUI.APITokens[0].Key != "monitoringmonitoring" || cfg.Folders[1].Devices[0].EncryptionPassword != "encryption" || cfg.Options.Webhooks[0].Secret != "hooksecret" {
+		t.Error("original config was modified")
+	}
+}
+
+func TestUserRoleMiddleware(t *testing.T) {
+	t.Parallel()
+
+	cfg := guiCfg.Copy()
+	cfg.Accounts = []config.GUIAccount{{Name: "helpdesk", Password: "secret", Role: config.GUIRoleViewer}}
+	if err := cfg.HashAccountPasswords(); err != nil {
+		t.Fatal(err)
+	}
+	handler := basicAuthAndSessionMiddleware("sessionid-test", "test", cfg, config.LDAPConfiguration{}, userRoleMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})), events.NoopLogger)
+
+	r := httptest.NewRequest(http.MethodGet, "/rest/system/status", nil)
+	r.SetBasicAuth("helpdesk", "secret")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET: got status %d, expected %d", w.Code, http.StatusOK)
+	}
+	cookies := w.Result().Cookies()
+	if !hasSessionCookie(cookies) {
+		t.Fatal("expected session cookie")
+	}
+
+	// The session carries the role
+	r = httptest.NewRequest(http.MethodPost, "/rest/system/restart", nil)
+	for _, cookie := range cookies {
+		r.AddCookie(cookie)
+	}
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("POST: got status %d, expected %d", w.Code, http.StatusForbidden)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/rest/system/restart", nil)
+	r.SetBasicAuth("user", "pass")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("POST as admin: got status %d, expected %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestVisibleEvents(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Configuration{GUI: config.GUIConfiguration{APIKey: "mainkeymainkeymainkey"}}
+	evs := []events.Event{
+		{SubscriptionID: 1, Type: events.ConfigSaved, Data: cfg},
+		{SubscriptionID: 2, Type: events.FolderSummary, Data: map[string]interface{}{"folder": "default"}},
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/rest/events", nil)
+	if res := visibleEvents(withAPIToken(r, config.APIToken{Scope: config.APITokenScopeAdmin}), evs); len(res) != 2 || res[0].Data.(config.Configuration).GUI.APIKey != cfg.GUI.APIKey {
+		t.Errorf("admin token should see all events unchanged, got %v", res)
+	}
+
+	res := visibleEvents(withAPIToken(r, config.APIToken{Scope: config.APITokenScopeReadOnly}), evs)
+	if len(res) != 1 || res[0].Type != events.FolderSummary {
+		t.Errorf("read-only token should not see the configuration, got %v", res)
+	}
+
+	res = visibleEvents(withGUIUser(r, guiUser{role: config.GUIRoleViewer}), evs)
+	if len(res) != 2 || res[0].Data.(config.Configuration).GUI.APIKey != "REDACTED" {
+		t.Errorf("viewer should see the configuration redacted, got %v", res)
+	}
+}
@@ -180,12 +180,22 @@ func (m *csrfManager) load() {
 }
 
 func hasValidAPIKeyHeader(r *http.Request, validator apiKeyValidator) bool {
-	if key := r.Header.Get("X-API-Key"); validator.IsValidAPIKey(key) {
-		return true
+	for _, key := range apiKeysFromHeaders(r) {
+		if validator.IsValidAPIKey(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// apiKeysFromHeaders returns the API keys given in the request headers.
+func apiKeysFromHeaders(r *http.Request) []string {
+	var keys []string
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		keys = append(keys, key)
 	}
 	if auth := r.Header.Get("Authorization"); strings.HasPrefix(strings.ToLower(auth), "bearer ") {
-		bearerToken := auth[len("bearer "):]
-		return validator.IsValidAPIKey(bearerToken)
+		keys = append(keys, auth[len("bearer "):])
 	}
-	return false
+	return keys
 }
@@ -0,0 +1,515 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	_ "crypto/sha512" // for crypto.SHA384 and crypto.SHA512
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	oidcLoginPath    = "/rest/noauth/auth/oidc/login"
+	oidcCallbackPath = "/rest/noauth/auth/oidc/callback"
+
+	// A login must be completed at the provider within this time.
+	oidcLoginTimeout = 10 * time.Minute
+	// At most this many logins may be in progress at once, as anyone can
+	// start one.
+	maxOIDCLogins = 1000
+	// Allowed difference between our clock and that of the provider.
+	oidcClockSkew = time.Minute
+)
+
+// oidcAuth logs users in to the GUI with the OpenID Connect authorization
+// code flow: the login handler sends the user to the provider, which sends
+// them back to the callback handler with a code that we exchange for an ID
+// token, which names the user and gives their role.
+type oidcAuth struct {
+	cookieName string
+	guiCfg     config.GUIConfiguration
+	evLogger   events.Logger
+	client     *http.Client
+
+	mut    sync.Mutex
+	logins map[string]oidcLogin // by state
+}
+
+// oidcLogin is a login in progress at the provider.
+type oidcLogin struct {
+	nonce    string
+	verifier string // for PKCE
+	started  time.Time
+}
+
+func newOIDCAuth(cookieName string, guiCfg config.GUIConfiguration, evLogger events.Logger) *oidcAuth {
+	return &oidcAuth{
+		cookieName: cookieName,
+		guiCfg:     guiCfg,
+		evLogger:   evLogger,
+		client:     &http.Client{Timeout: 30 * time.Second},
+		mut:        sync.NewMutex(),
+		logins:     make(map[string]oidcLogin),
+	}
+}
+
+// The state is also kept in a cookie, so that the callback can only
+// complete a login started by the same browser.
+func (a *oidcAuth) stateCookieName() string {
+	return a.cookieName + "-oidc"
+}
+
+func (a *oidcAuth) login(w http.ResponseWriter, r *http.Request) {
+	md, err := a.discover(r.Context())
+	if err != nil {
+		l.Warnln("OIDC discovery:", err)
+		http.Error(w, "OIDC provider unavailable", http.StatusBadGateway)
+		return
+	}
+
+	state := rand.String(32)
+	login := oidcLogin{
+		nonce:    rand.String(32),
+		verifier: rand.String(64),
+		started:  time.Now(),
+	}
+	a.mut.Lock()
+	for s, pending := range a.logins {
+		if time.Since(pending.started) > oidcLoginTimeout {
+			delete(a.logins, s)
+		}
+	}
+	full := len(a.logins) >= maxOIDCLogins
+	if !full {
+		a.logins[state] = login
+	}
+	a.mut.Unlock()
+	if full {
+		http.Error(w, "Too many logins in progress", http.StatusServiceUnavailable)
+		return
+	}
+
+	challenge := sha256.Sum256([]byte(login.verifier))
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {a.guiCfg.OIDC.ClientID},
+		"redirect_uri":          {a.guiCfg.OIDC.RedirectURL},
+		"scope":                 {a.scopes()},
+		"state":                 {state},
+		"nonce":                 {login.nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	authURL, err := url.Parse(md.AuthorizationEndpoint)
+	if err != nil {
+		l.Warnln("OIDC authorization endpoint:", err)
+		http.Error(w, "OIDC provider unavailable", http.StatusBadGateway)
+		return
+	}
+	query := authURL.Query()
+	for k, v := range params {
+		query[k] = v
+	}
+	authURL.RawQuery = query.Encode()
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     a.stateCookieName(),
+		Value:    state,
+		MaxAge:   int(oidcLoginTimeout / time.Second),
+		Secure:   useSecureCookie(r, a.guiCfg),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Path:     "/",
+	})
+	http.Redirect(w, r, authURL.String(), http.StatusFound)
+}
+
+func (a *oidcAuth) callback(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	state := qs.Get("state")
+	cookie, err := r.Cookie(a.stateCookieName())
+	if state == "" || err != nil || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+		http.Error(w, "Invalid login state", http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:   a.stateCookieName(),
+		Value:  "",
+		MaxAge: -1,
+		Path:   "/",
+	})
+
+	a.mut.Lock()
+	login, ok := a.logins[state]
+	delete(a.logins, state)
+	a.mut.Unlock()
+	if !ok || time.Since(login.started) > oidcLoginTimeout {
+		http.Error(w, "Login expired, please try again", http.StatusBadRequest)
+		return
+	}
+	if e := qs.Get("error"); e != "" {
+		l.Infof("OIDC login from %s failed: %s: %s", r.RemoteAddr, e, qs.Get("error_description"))
+		forbidden(w)
+		return
+	}
+
+	user, err := a.exchange(r.Context(), qs.Get("code"), login)
+	if err != nil {
+		l.Infof("OIDC login from %s failed: %v", r.RemoteAddr, err)
+		emitLoginAttempt(false, user.name, r.RemoteAddr, a.evLogger)
+		antiBruteForceSleep()
+		forbidden(w)
+		return
+	}
+
+	createSession(a.cookieName, user, a.guiCfg, a.evLogger, w, r)
+	// Relative to the callback path, so that it works behind a reverse
+	// proxy with a prefix.
+	http.Redirect(w, r, "../../../../", http.StatusFound)
+}
+
+// exchange exchanges the code for an ID token and returns the user it
+// names, with the name, if any, even when the user may not log in.
+func (a *oidcAuth) exchange(ctx context.Context, code string, login oidcLogin) (guiUser, error) {
+	if code == "" {
+		return guiUser{}, errors.New("no code")
+	}
+	md, err := a.discover(ctx)
+	if err != nil {
+		return guiUser{}, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {a.guiCfg.OIDC.RedirectURL},
+		"code_verifier": {login.verifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return guiUser{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(a.guiCfg.OIDC.ClientID), url.QueryEscape(a.guiCfg.OIDC.ClientSecret))
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := a.getJSON(req, &tokens); err != nil {
+		return guiUser{}, fmt.Errorf("token request: %w", err)
+	}
+
+	keys, err := a.keys(ctx, md.JWKSURI)
+	if err != nil {
+		return guiUser{}, err
+	}
+	claims, err := verifyIDToken(tokens.IDToken, keys, md.Issuer, a.guiCfg.OIDC.ClientID, login.nonce, time.Now())
+	if err != nil {
+		return guiUser{}, fmt.Errorf("ID token: %w", err)
+	}
+	return oidcUser(a.guiCfg.OIDC, claims)
+}
+
+// oidcUser returns the user the claims of an ID token name, with the role
+// and folders the claims give them, or else the default role.
+func oidcUser(cfg config.OIDCConfiguration, claims map[string]interface{}) (guiUser, error) {
+	name, _ := claims[cfg.UserClaim].(string)
+	if name == "" {
+		return guiUser{}, fmt.Errorf("no %s claim", cfg.UserClaim)
+	}
+	var values []string
+	switch v := claims[cfg.RoleClaim].(type) {
+	case string:
+		values = []string{v}
+	case []interface{}:
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	cr, ok := cfg.UserRole(values)
+	if !ok {
+		return guiUser{name: name}, fmt.Errorf("user %q has no valid role", name)
+	}
+	return guiUser{name: name, role: cr.Role, folders: cr.Folders, external: true}, nil
+}
+
+func (a *oidcAuth) scopes() string {
+	scopes := strings.Fields(a.guiCfg.OIDC.Scopes)
+	for _, s := range scopes {
+		if s == "openid" {
+			return strings.Join(scopes, " ")
+		}
+	}
+	return strings.Join(append([]string{"openid"}, scopes...), " ")
+}
+
+// oidcProviderMetadata is what we use of the metadata of the provider.
+type oidcProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// discover fetches the metadata of the provider. It's fetched anew for
+// each login, which is rare enough, to pick up any changes.
+func (a *oidcAuth) discover(ctx context.Context) (oidcProviderMetadata, error) {
+	issuer := strings.TrimSuffix(a.guiCfg.OIDC.Issuer, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return oidcProviderMetadata{}, err
+	}
+	var md oidcProviderMetadata
+	if err := a.getJSON(req, &md); err != nil {
+		return oidcProviderMetadata{}, err
+	}
+	if strings.TrimSuffix(md.Issuer, "/") != issuer {
+		return oidcProviderMetadata{}, fmt.Errorf("provider is for issuer %q", md.Issuer)
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return oidcProviderMetadata{}, errors.New("provider metadata is incomplete")
+	}
+	return md, nil
+}
+
+// keys fetches the keys the provider signs ID tokens with, by key ID.
+func (a *oidcAuth) keys(ctx context.Context, jwksURI string) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := a.getJSON(req, &set); err != nil {
+		return nil, fmt.Errorf("fetching keys: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			l.Debugf("Ignoring OIDC key %q: %v", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func (a *oidcAuth) getJSON(req *http.Request, v interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	bs, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, bs)
+	}
+	return json.Unmarshal(bs, v)
+}
+
+// A jsonWebKey is an RSA or elliptic curve public key, as in RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exp := new(big.Int).SetBytes(e)
+		if !exp.IsInt64() || exp.Int64() > 1<<31-1 {
+			return nil, errors.New("exponent too large")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return key, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// verifyIDToken verifies the signature and claims of an ID token, and
+// returns the claims.
+func verifyIDToken(token string, keys map[string]crypto.PublicKey, issuer, clientID, nonce string, now time.Time) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("signature: %w", err)
+	}
+	key, ok := keys[header.Kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", header.Kid)
+	}
+	if err := verifyJWTSignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("claims: %w", err)
+	}
+	if iss, _ := claims["iss"].(string); iss != issuer {
+		return nil, fmt.Errorf("issued by %q", iss)
+	}
+	var audiences []interface{}
+	switch aud := claims["aud"].(type) {
+	case string:
+		audiences = []interface{}{aud}
+	case []interface{}:
+		audiences = aud
+	}
+	if !containsValue(audiences, clientID) {
+		return nil, errors.New("not issued to us")
+	}
+	exp, _ := claims["exp"].(float64)
+	if now.After(time.Unix(int64(exp), 0).Add(oidcClockSkew)) {
+		return nil, errors.New("expired")
+	}
+	if n, _ := claims["nonce"].(string); subtle.ConstantTimeCompare([]byte(n), []byte(nonce)) != 1 {
+		return nil, errors.New("nonce mismatch")
+	}
+	return claims, nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	bs, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, v)
+}
+
+func verifyJWTSignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			break
+		}
+		if err := rsa.VerifyPKCS1v15(key, hash, digest, sig); err != nil {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") {
+			break
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("invalid signature")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("algorithm %q doesn't match key", alg)
+}
+
+func containsValue(list []interface{}, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,314 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"crypto"
+	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// testOIDCProvider is a minimal OpenID provider, which logs in whoever
+// comes by with the claims it's given.
+type testOIDCProvider struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]interface{}
+
+	mut   sync.Mutex
+	codes map[string]url.Values // authorization request by code
+}
+
+func newTestOIDCProvider(t *testing.T, claims map[string]interface{}) *testOIDCProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(cryptorand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &testOIDCProvider{
+		key:    key,
+		claims: claims,
+		mut:    sync.NewMutex(),
+		codes:  make(map[string]url.Values),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		sendJSON(w, map[string]string{
+			"issuer":                 p.URL,
+			"authorization_endpoint": p.URL + "/authorize",
+			"token_endpoint":         p.URL + "/token",
+			"jwks_uri":               p.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		sendJSON(w, map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		qs := r.URL.Query()
+		code := rand.String(16)
+		p.mut.Lock()
+		p.codes[code] = qs
+		p.mut.Unlock()
+		http.Redirect(w, r, qs.Get("redirect_uri")+"?code="+code+"&state="+qs.Get("state"), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, _ := r.BasicAuth(); id != "syncthing" || secret != "s3cret" {
+			http.Error(w, "invalid_client", http.StatusUnauthorized)
+			return
+		}
+		p.mut.Lock()
+		req, ok := p.codes[r.FormValue("code")]
+		delete(p.codes, r.FormValue("code"))
+		p.mut.Unlock()
+		challenge := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+		if !ok || req.Get("code_challenge") != base64.RawURLEncoding.EncodeToString(challenge[:]) {
+			http.Error(w, "invalid_grant", http.StatusBadRequest)
+			return
+		}
+		claims := map[string]interface{}{
+			"iss":   p.URL,
+			"aud":   "syncthing",
+			"exp":   time.Now().Add(time.Minute).Unix(),
+			"nonce": req.Get("nonce"),
+		}
+		for k, v := range p.claims {
+			claims[k] = v
+		}
+		sendJSON(w, map[string]string{"id_token": p.sign(t, "RS256", claims)})
+	})
+
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func (p *testOIDCProvider) sign(t *testing.T, alg string, claims map[string]interface{}) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": "test", "typ": "JWT"})
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(cryptorand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestOIDCLogin(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		claims  map[string]interface{}
+		status  int
+		role    string
+		folders []string
+	}{
+		{"viewer", map[string]interface{}{"preferred_username": "alice", "groups": []string{"staff", "helpdesk"}}, http.StatusOK, config.GUIRoleViewer, []string{"default"}},
+		{"admin", map[string]interface{}{"preferred_username": "bob", "groups": "admins"}, http.StatusOK, config.GUIRoleAdmin, nil},
+		{"no role", map[string]interface{}{"preferred_username": "carol", "groups": []string{"staff"}}, http.StatusForbidden, "", nil},
+		{"no name", map[string]interface{}{"groups": []string{"admins"}}, http.StatusForbidden, "", nil},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := newTestOIDCProvider(t, tc.claims)
+			mux := http.NewServeMux()
+			srv := httptest.NewServer(mux)
+			t.Cleanup(srv.Close)
+			var guiCfg config.GUIConfiguration
+			guiCfg.OIDC = config.OIDCConfiguration{
+				Issuer:       provider.URL,
+				ClientID:     "syncthing",
+				ClientSecret: "s3cret",
+				RedirectURL:  srv.URL + oidcCallbackPath,
+				Scopes:       "profile",
+				UserClaim:    "preferred_username",
+				RoleClaim:    "groups",
+				ClaimRoles: []config.OIDCClaimRole{
+					{Value: "admins", Role: config.GUIRoleAdmin},
+					{Value: "helpdesk", Role: config.GUIRoleViewer, Folders: []string{"default"}},
+				},
+			}
+			cookieName := "sessionid-" + rand.String(8)
+			oidc := newOIDCAuth(cookieName, guiCfg, events.NoopLogger)
+			mux.HandleFunc(oidcLoginPath, oidc.login)
+			mux.HandleFunc(oidcCallbackPath, oidc.callback)
+			mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			jar, err := cookiejar.New(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cli := &http.Client{Jar: jar}
+			resp, err := cli.Get(srv.URL + oidcLoginPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tc.status {
+				t.Fatalf("got status %d, expected %d", resp.StatusCode, tc.status)
+			}
+
+			base, _ := url.Parse(srv.URL)
+			var session string
+			for _, cookie := range jar.Cookies(base) {
+				if cookie.Name == cookieName {
+					session = cookie.Value
+				}
+			}
+			if tc.role == "" {
+				if session != "" {
+					t.Error("unexpected session")
+				}
+				return
+			}
+
+			sessionsMut.Lock()
+			user, ok := sessions[session]
+			sessionsMut.Unlock()
+			if !ok {
+				t.Fatal("no session")
+			}
+			if user.name != tc.claims["preferred_username"] || user.role != tc.role || len(user.folders) != len(tc.folders) || !user.external {
+				t.Errorf("unexpected user %+v", user)
+			}
+		})
+	}
+}
+
+func TestOIDCCallbackRequiresState(t *testing.T) {
+	t.Parallel()
+
+	provider := newTestOIDCProvider(t, map[string]interface{}{"preferred_username": "alice"})
+	var guiCfg config.GUIConfiguration
+	guiCfg.OIDC = config.OIDCConfiguration{Issuer: provider.URL, ClientID: "syncthing", ClientSecret: "s3cret", RedirectURL: "https://syncthing.example.com" + oidcCallbackPath}
+	oidc := newOIDCAuth("sessionid-test", guiCfg, events.NoopLogger)
+
+	// Start a login, but complete it without the state cookie, as when
+	// someone else's browser is sent to the callback.
+	w := httptest.NewRecorder()
+	oidc.login(w, httptest.NewRequest(http.MethodGet, oidcLoginPath, nil))
+	if w.Code != http.StatusFound {
+		t.Fatalf("got status %d, expected %d", w.Code, http.StatusFound)
+	}
+	authURL, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redirect := authURL.Query().Get("redirect_uri"); redirect != guiCfg.OIDC.RedirectURL {
+		t.Errorf("redirect URL %q, expected the configured one", redirect)
+	}
+	state := authURL.Query().Get("state")
+
+	w = httptest.NewRecorder()
+	oidc.callback(w, httptest.NewRequest(http.MethodGet, oidcCallbackPath+"?code=x&state="+state, nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("got status %d, expected %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestOIDCUser(t *testing.T) {
+	t.Parallel()
+
+	claims := map[string]interface{}{"preferred_username": "alice", "groups": []interface{}{"staff"}}
+	cfg := config.OIDCConfiguration{UserClaim: "preferred_username", RoleClaim: "groups"}
+
+	// Without claim roles or a default role, nobody may log in.
+	if _, err := oidcUser(cfg, claims); err == nil {
+		t.Error("user without role was accepted")
+	}
+
+	cfg.DefaultRole = config.GUIRoleViewer
+	if user, err := oidcUser(cfg, claims); err != nil || user.role != config.GUIRoleViewer {
+		t.Errorf("unexpected user %+v, %v", user, err)
+	}
+
+	// An invalid role refuses its users rather than giving them the
+	// default role.
+	cfg.ClaimRoles = []config.OIDCClaimRole{{Value: "staff", Role: "superuser"}}
+	if _, err := oidcUser(cfg, claims); err == nil {
+		t.Error("user with invalid role was accepted")
+	}
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	t.Parallel()
+
+	provider := newTestOIDCProvider(t, nil)
+	keys := map[string]crypto.PublicKey{"test": &provider.key.PublicKey}
+	now := time.Now()
+	valid := map[string]interface{}{
+		"iss":   "https://issuer",
+		"aud":   []string{"other", "syncthing"},
+		"exp":   now.Add(time.Minute).Unix(),
+		"nonce": "nonce",
+		"sub":   "alice",
+	}
+	with := func(k string, v interface{}) map[string]interface{} {
+		claims := make(map[string]interface{})
+		for k, v := range valid {
+			claims[k] = v
+		}
+		claims[k] = v
+		return claims
+	}
+
+	if claims, err := verifyIDToken(provider.sign(t, "RS256", valid), keys, "https://issuer", "syncthing", "nonce", now); err != nil {
+		t.Fatal(err)
+	} else if claims["sub"] != "alice" {
+		t.Errorf("unexpected claims %v", claims)
+	}
+
+	cases := map[string]string{
+		"wrong issuer":   provider.sign(t, "RS256", with("iss", "https://other")),
+		"wrong audience": provider.sign(t, "RS256", with("aud", "other")),
+		"expired":        provider.sign(t, "RS256", with("exp", now.Add(-time.Hour).Unix())),
+		"wrong nonce":    provider.sign(t, "RS256", with("nonce", "other")),
+		"wrong alg":      provider.sign(t, "HS256", valid),
+		"tampered":       provider.sign(t, "RS256", valid) + "A",
+		"malformed":      "abc.def",
+	}
+	for name, token := range cases {
+		if _, err := verifyIDToken(token, keys, "https://issuer", "syncthing", "nonce", now); err == nil {
+			t.Errorf("%s: token was accepted", name)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/audit"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"golang.org/x/exp/slices"
+)
+
+// A guiUser is who a request is authenticated as, by password or session.
+// Requests authenticated by API key have none, and are restricted only by
+// the scope of the key.
+type guiUser struct {
+	name    string
+	role    string
+	folders []string // the folders a viewer or operator sees, all if empty
+	// The role and folders were given by an external source at login, as
+	// opposed to by the GUI configuration.
+	external bool
+}
+
+func (u guiUser) isAdmin() bool {
+	return u.role == config.GUIRoleAdmin
+}
+
+func (u guiUser) seesFolder(id string) bool {
+	return u.isAdmin() || len(u.folders) == 0 || slices.Contains(u.folders, id)
+}
+
+// configuredUser returns the GUI user or account with the given name.
+func configuredUser(name string, guiCfg config.GUIConfiguration) (guiUser, bool) {
+	if name == "" {
+		return guiUser{}, false
+	}
+	if name == guiCfg.User && guiCfg.Password != "" {
+		return guiUser{name: name, role: config.GUIRoleAdmin}, true
+	}
+	if a, ok := guiCfg.Account(name); ok {
+		return guiUser{name: a.Name, role: a.Role, folders: a.Folders}, true
+	}
+	return guiUser{}, false
+}
+
+type guiUserKey struct{}
+
+func withGUIUser(r *http.Request, user guiUser) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), guiUserKey{}, user))
+}
+
+func guiUserFromRequest(r *http.Request) (guiUser, bool) {
+	user, ok := r.Context().Value(guiUserKey{}).(guiUser)
+	return user, ok
+}
+
+// userRoleMiddleware restricts requests made by GUI users to what their
+// role and folders permit.
+func userRoleMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, ok := guiUserFromRequest(r); ok && !isNoAuthPath(r.URL.Path) && !userPermits(user, r) {
+			forbidden(w)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// userPermits returns whether the role and folders of the user permit the
+// request. Viewers may only look, and operators may do anything but change
+// the configuration or the installation. What they may look at in the
+// configuration and events is further limited by the handlers.
+func userPermits(user guiUser, r *http.Request) bool {
+	if user.isAdmin() {
+		return true
+	}
+	path := r.URL.Path
+	if len(user.folders) > 0 && isFolderPath(path) {
+		if !user.seesFolder(requestedID(r, "folder", "/rest/config/folders/")) {
+			return false
+		}
+	}
+	if strings.HasPrefix(path, "/rest/config/apikeys") || strings.HasPrefix(path, "/rest/system/audit") || strings.HasPrefix(path, "/rest/system/browse") || strings.HasPrefix(path, "/rest/debug/") {
+		return false
+	}
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return true
+	}
+	return user.role == config.GUIRoleOperator && !isAdminChangePath(path)
+}
+
+// isFolderPath returns whether requests for the path concern a single
+// folder.
+func isFolderPath(path string) bool {
+	return strings.HasPrefix(path, "/rest/db/") ||
+		strings.HasPrefix(path, "/rest/folder/") ||
+		strings.HasPrefix(path, "/rest/config/folders/")
+}
+
+// isAdminChangePath returns whether only admins may make changes through
+// the path, as these are to the configuration or the installation.
+func isAdminChangePath(path string) bool {
+	// Local variable instead of module var to prevent accidental mutation
+	adminChangePrefixes := []string{
+		"/rest/config",
+		"/rest/cluster/",
+		"/rest/system/config",
+		"/rest/system/debug",
+		"/rest/system/metered",
+		"/rest/system/reset",
+		"/rest/system/shutdown",
+		"/rest/system/upgrade",
+	}
+
+	return slices.ContainsFunc(adminChangePrefixes, func(prefix string) bool {
+		return strings.HasPrefix(path, prefix)
+	})
+}
+
+// seesSecrets returns whether the request may see the secrets in the
+// configuration: it's by an admin, or with the API key or an admin token.
+func seesSecrets(r *http.Request) bool {
+	if user, ok := guiUserFromRequest(r); ok && !user.isAdmin() {
+		return false
+	}
+	if token, ok := apiTokenFromRequest(r); ok && token.Scope != config.APITokenScopeAdmin {
+		return false
+	}
+	return true
+}
+
+// redactFor redacts the secrets in the configuration, or part of it, that
+// v points to, unless the request may see them.
+func redactFor(r *http.Request, v interface{}) {
+	if !seesSecrets(r) {
+		audit.Redact(v)
+	}
+}
+
+// visibleConfig returns the configuration as the request may see it:
+// without secrets unless it may see them, and with only the folders the
+// user sees.
+func visibleConfig(r *http.Request, cfg config.Configuration) config.Configuration {
+	redactFor(r, &cfg)
+	cfg.Folders = visibleFolders(r, cfg.Folders)
+	return cfg
+}
+
+// visibleGUI returns the GUI configuration as the request may see it.
+func visibleGUI(r *http.Request, gui config.GUIConfiguration) config.GUIConfiguration {
+	redactFor(r, &gui)
+	return gui
+}
+
+// visibleFolders returns the folders the user of the request sees.
+func visibleFolders(r *http.Request, folders []config.FolderConfiguration) []config.FolderConfiguration {
+	user, ok := guiUserFromRequest(r)
+	if !ok || user.isAdmin() || len(user.folders) == 0 {
+		return folders
+	}
+	visible := make([]config.FolderConfiguration, 0, len(user.folders))
+	for _, folder := range folders {
+		if user.seesFolder(folder.ID) {
+			visible = append(visible, folder)
+		}
+	}
+	return visible
+}
+
+// visibleEvents returns the events the request may see, less those
+// concerning folders the user doesn't see and with the configuration
+// redacted. Requests with tokens other than admin ones don't see the
+// configuration at all.
+func visibleEvents(r *http.Request, evs []events.Event) []events.Event {
+	user, hasUser := guiUserFromRequest(r)
+	token, hasToken := apiTokenFromRequest(r)
+	if seesSecrets(r) {
+		return evs
+	}
+	visible := make([]events.Event, 0, len(evs))
+	for _, ev := range evs {
+		if cfg, ok := ev.Data.(config.Configuration); ok {
+			if hasToken && token.Scope != config.APITokenScopeAdmin {
+				continue
+			}
+			ev.Data = visibleConfig(r, cfg)
+		} else if folder := eventFolder(ev); folder != "" && hasUser && !user.seesFolder(folder) {
+			continue
+		}
+		visible = append(visible, ev)
+	}
+	return visible
+}
+
+// eventFolder returns the folder the event concerns, if any.
+func eventFolder(ev events.Event) string {
+	switch data := ev.Data.(type) {
+	case map[string]interface{}:
+		folder, _ := data["folder"].(string)
+		return folder
+	case map[string]string:
+		return data["folder"]
+	default:
+		return ""
+	}
+}
@@ -7,6 +7,7 @@
 package api
 
 import (
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"context"
@@ -25,6 +26,7 @@ import (
 	"time"
 
 	"github.com/d4l3k/messagediff"
+	"github.com/julienschmidt/httprouter"
 	"github.com/syncthing/syncthing/lib/assets"
 	"github.com/syncthing/syncthing/lib/build"
 	"github.com/syncthing/syncthing/lib/config"
@@ -148,7 +150,7 @@ func TestStopAfterBrokenConfig(t *testing.T) {
 	}
 	w := config.Wrap("/dev/null", cfg, protocol.LocalDeviceID, events.NoopLogger)
 
-	srv := New(protocol.LocalDeviceID, w, "", "syncthing", nil, nil, nil, events.NoopLogger, nil, nil, nil, nil, nil, nil, false).(*service)
+	srv := New(protocol.LocalDeviceID, w, "", "syncthing", nil, nil, nil, events.NoopLogger, nil, nil, nil, nil, nil, nil, nil, nil, false).(*service)
 	defer os.Remove(token)
 
 	srv.started = make(chan string)
@@ -926,7 +928,7 @@ func startHTTP(cfg config.Wrapper) (string, context.CancelFunc, error) {
 
 	// Instantiate the API service
 	urService := ur.New(cfg, m, connections, false)
-	svc := New(protocol.LocalDeviceID, cfg, assetDir, "syncthing", m, eventSub, diskEventSub, events.NoopLogger, discoverer, connections, urService, mockedSummary, errorLog, systemLog, false).(*service)
+	svc := New(protocol.LocalDeviceID, cfg, assetDir, "syncthing", m, eventSub, diskEventSub, events.NoopLogger, discoverer, connections, urService, mockedSummary, nil, nil, errorLog, systemLog, false).(*service)
 	defer os.Remove(token)
 	svc.started = addrChan
 
@@ -1467,7 +1469,7 @@ func TestEventMasks(t *testing.T) {
 	cfg := newMockedConfig()
 	defSub := new(eventmocks.BufferedSubscription)
 	diskSub := new(eventmocks.BufferedSubscription)
-	svc := New(protocol.LocalDeviceID, cfg, "", "syncthing", nil, defSub, diskSub, events.NoopLogger, nil, nil, nil, nil, nil, nil, false).(*service)
+	svc := New(protocol.LocalDeviceID, cfg, "", "syncthing", nil, defSub, diskSub, events.NoopLogger, nil, nil, nil, nil, nil, nil, nil, nil, false).(*service)
 	defer os.Remove(token)
 
 	if mask := svc.getEventMask(""); mask != DefaultEventMask {
@@ -1495,6 +1497,69 @@ func TestEventMasks(t *testing.T) {
 	}
 }
 
+func TestEventStream(t *testing.T) {
+	// Not parallel, as it changes the keepalive interval.
+	defer func(d time.Duration) { eventStreamKeepalive = d }(eventStreamKeepalive)
+	eventStreamKeepalive = 50 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evLogger := events.NewLogger()
+	go evLogger.Serve(ctx)
+	cfg := newMockedConfig()
+	svc := New(protocol.LocalDeviceID, cfg, "", "syncthing", nil, nil, nil, evLogger, nil, nil, nil, nil, nil, nil, nil, nil, false).(*service)
+	defer os.Remove(token)
+
+	srv := httptest.NewServer(http.HandlerFunc(svc.getEventStream))
+	defer srv.Close()
+
+	// The subscription is created by the first request, which then gets
+	// nothing but keepalives.
+	readStream := func(lastEventID string, until string) []string {
+		t.Helper()
+		reqCtx, reqCancel := context.WithTimeout(ctx, 10*time.Second)
+		defer reqCancel()
+		req, _ := http.NewRequestWithContext(reqCtx, http.MethodGet, srv.URL+"?events=StateChanged", nil)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+			t.Fatalf("unexpected content type %q", ct)
+		}
+		var lines []string
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+			if scanner.Text() == until {
+				return lines
+			}
+		}
+		t.Fatalf("stream ended without %q: %v, %q", until, scanner.Err(), lines)
+		return nil
+	}
+	readStream("", ": keepalive")
+
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "a"})
+	evLogger.Log(events.FolderSummary, map[string]string{"folder": "a"})
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "b"})
+
+	lines := readStream("", "id: 2")
+	if lines[0] != "id: 1" || lines[1] != "event: StateChanged" || !strings.Contains(lines[2], `"folder":"a"`) {
+		t.Errorf("unexpected first event %q", lines)
+	}
+
+	// Resuming skips the events already seen.
+	lines = readStream("1", "")
+	if lines[0] != "id: 2" || !strings.Contains(lines[2], `"folder":"b"`) {
+		t.Errorf("unexpected resumed event %q", lines)
+	}
+}
+
 func TestBrowse(t *testing.T) {
 	t.Parallel()
 
@@ -1758,3 +1823,131 @@ func runningInContainer() bool {
 	}
 	return false
 }
+
+func TestConfigAPITokens(t *testing.T) {
+	t.Parallel()
+
+	w := config.Wrap(filepath.Join(t.TempDir(), "config.xml"), config.Configuration{}, protocol.LocalDeviceID, events.NoopLogger)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Serve(ctx)
+	builder := &configMuxBuilder{
+		Router:     httprouter.New(),
+		id:         protocol.LocalDeviceID,
+		cfg:        w,
+		tokenUsage: newAPITokenUsage(),
+	}
+	builder.registerAPITokens("/rest/config/apikeys")
+	builder.registerAPIToken("/rest/config/apikeys/:name")
+
+	do := func(method, path, body string, status int) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		builder.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+		if rec.Code != status {
+			t.Fatalf("%s %s: got status %d, expected %d: %s", method, path, rec.Code, status, rec.Body)
+		}
+		return rec
+	}
+
+	rec := do(http.MethodPost, "/rest/config/apikeys", `{"name": "monitoring", "scope": "read-only"}`, http.StatusOK)
+	var created config.APIToken
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatal(err)
+	}
+	if len(created.Key) != 32 || !w.GUI().IsValidAPIKey(created.Key) {
+		t.Errorf("unexpected key %q", created.Key)
+	}
+
+	do(http.MethodPost, "/rest/config/apikeys", `{"name": "monitoring", "scope": "read-only"}`, http.StatusConflict)
+	do(http.MethodPost, "/rest/config/apikeys", `{"name": "other", "scope": "everything"}`, http.StatusBadRequest)
+
+	builder.tokenUsage.record("monitoring")
+	rec = do(http.MethodGet, "/rest/config/apikeys", "", http.StatusOK)
+	if strings.Contains(rec.Body.String(), created.Key) {
+		t.Error("listed tokens include the key")
+	}
+	var listed []apiTokenInfo
+	if err := json.Unmarshal(rec.Body.Bytes(), &listed); err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) != 1 || listed[0].Name != "monitoring" || listed[0].LastUsed.IsZero() {
+		t.Errorf("unexpected tokens %+v", listed)
+	}
+
+	do(http.MethodDelete, "/rest/config/apikeys/monitoring", "", http.StatusOK)
+	do(http.MethodDelete, "/rest/config/apikeys/monitoring", "", http.StatusNotFound)
+	if w.GUI().IsValidAPIKey(created.Key) {
+		t.Error("deleted token is still valid")
+	}
+}
+
+func TestConfigRedacted(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Configuration{
+		GUI: config.GUIConfiguration{APIKey: "mainkeymainkeymainkey"},
+		Folders: []config.FolderConfiguration{{
+			ID:      "default",
+			Devices: []config.FolderDeviceConfiguration{{DeviceID: protocol.LocalDeviceID, EncryptionPassword: "folderpassword"}},
+		}},
+		Options: config.OptionsConfiguration{
+			Webhooks: []config.Webhook{{URL: "https://example.com/hook", Secret: "hooksecret"}},
+		},
+	}
+	cfg.Defaults.Folder.SFTP.Password = "sftppassword"
+	w := config.Wrap(filepath.Join(t.TempDir(), "config.xml"), cfg, protocol.LocalDeviceID, events.NoopLogger)
+	builder := &configMuxBuilder{
+		Router: httprouter.New(),
+		id:     protocol.LocalDeviceID,
+		cfg:    w,
+	}
+	builder.registerConfig("/rest/config")
+	builder.registerGUI("/rest/config/gui")
+	builder.registerFolders("/rest/config/folders")
+	builder.registerFolder("/rest/config/folders/:id")
+	builder.registerDefaultFolder("/rest/config/defaults/folder")
+	builder.registerOptions("/rest/config/options")
+
+	// The secret each endpoint holds
+	cases := []struct {
+		path, secret string
+	}{
+		{"/rest/config", "mainkeymainkeymainkey"},
+		{"/rest/config/gui", "mainkeymainkeymainkey"},
+		{"/rest/config/folders", "folderpassword"},
+		{"/rest/config/folders/default", "folderpassword"},
+		{"/rest/config/defaults/folder", "sftppassword"},
+		{"/rest/config/options", "hooksecret"},
+	}
+	get := func(r *http.Request) string {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		builder.ServeHTTP(rec, r)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: got status %d, expected %d", r.URL.Path, rec.Code, http.StatusOK)
+		}
+		return rec.Body.String()
+	}
+
+	for _, tc := range cases {
+		r := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		for who, r := range map[string]*http.Request{
+			"admin token": withAPIToken(r, config.APIToken{Name: "ci", Scope: config.APITokenScopeAdmin}),
+			"admin":       withGUIUser(r, guiUser{role: config.GUIRoleAdmin}),
+		} {
+			if body := get(r); !strings.Contains(body, tc.secret) {
+				t.Errorf("%s: %s should see %q", tc.path, who, tc.secret)
+			}
+		}
+		for who, r := range map[string]*http.Request{
+			"read-only token": withAPIToken(r, config.APIToken{Name: "monitoring", Scope: config.APITokenScopeReadOnly}),
+			"viewer":          withGUIUser(r, guiUser{role: config.GUIRoleViewer}),
+			"operator":        withGUIUser(r, guiUser{role: config.GUIRoleOperator}),
+		} {
+			if body := get(r); strings.Contains(body, tc.secret) {
+				t.Errorf("%s: %s sees %q", tc.path, who, tc.secret)
+			}
+		}
+	}
+}
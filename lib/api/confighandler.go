@@ -10,23 +10,26 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/julienschmidt/httprouter"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
 	"github.com/syncthing/syncthing/lib/structutil"
 )
 
 type configMuxBuilder struct {
 	*httprouter.Router
-	id  protocol.DeviceID
-	cfg config.Wrapper
+	id         protocol.DeviceID
+	cfg        config.Wrapper
+	tokenUsage *apiTokenUsage
 }
 
 func (c *configMuxBuilder) registerConfig(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.RawCopy())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, visibleConfig(r, c.cfg.RawCopy()))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -35,8 +38,8 @@ func (c *configMuxBuilder) registerConfig(path string) {
 }
 
 func (c *configMuxBuilder) registerConfigDeprecated(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.RawCopy())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, visibleConfig(r, c.cfg.RawCopy()))
 	})
 
 	c.HandlerFunc(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
@@ -57,8 +60,10 @@ func (c *configMuxBuilder) registerConfigRequiresRestart(path string) {
 }
 
 func (c *configMuxBuilder) registerFolders(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.FolderList())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		folders := visibleFolders(r, c.cfg.FolderList())
+		redactFor(r, &folders)
+		sendJSON(w, folders)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -76,7 +81,7 @@ func (c *configMuxBuilder) registerFolders(path string) {
 				return
 			}
 		}
-		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 			cfg.SetFolders(folders)
 		})
 		if err != nil {
@@ -92,8 +97,10 @@ func (c *configMuxBuilder) registerFolders(path string) {
 }
 
 func (c *configMuxBuilder) registerDevices(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.DeviceList())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		devices := c.cfg.DeviceList()
+		redactFor(r, &devices)
+		sendJSON(w, devices)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -111,7 +118,7 @@ func (c *configMuxBuilder) registerDevices(path string) {
 				return
 			}
 		}
-		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 			cfg.SetDevices(devices)
 		})
 		if err != nil {
@@ -127,12 +134,13 @@ func (c *configMuxBuilder) registerDevices(path string) {
 }
 
 func (c *configMuxBuilder) registerFolder(path string) {
-	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		folder, ok := c.cfg.Folder(p.ByName("id"))
 		if !ok {
 			http.Error(w, "No folder with given ID", http.StatusNotFound)
 			return
 		}
+		redactFor(r, &folder)
 		sendJSON(w, folder)
 	})
 
@@ -174,8 +182,9 @@ func (c *configMuxBuilder) registerDevice(path string) {
 		return device, true
 	}
 
-	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		if device, ok := deviceFromParams(w, p); ok {
+			redactFor(r, &device)
 			sendJSON(w, device)
 		}
 	})
@@ -206,8 +215,10 @@ func (c *configMuxBuilder) registerDevice(path string) {
 }
 
 func (c *configMuxBuilder) registerDefaultFolder(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.DefaultFolder())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		folder := c.cfg.DefaultFolder()
+		redactFor(r, &folder)
+		sendJSON(w, folder)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -222,8 +233,10 @@ func (c *configMuxBuilder) registerDefaultFolder(path string) {
 }
 
 func (c *configMuxBuilder) registerDefaultDevice(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.DefaultDevice())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		device := c.cfg.DefaultDevice()
+		redactFor(r, &device)
+		sendJSON(w, device)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -248,7 +261,7 @@ func (c *configMuxBuilder) registerDefaultIgnores(path string) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 			cfg.Defaults.Ignores = ignores
 		})
 		if err != nil {
@@ -260,8 +273,10 @@ func (c *configMuxBuilder) registerDefaultIgnores(path string) {
 }
 
 func (c *configMuxBuilder) registerOptions(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.Options())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		opts := c.cfg.Options()
+		redactFor(r, &opts)
+		sendJSON(w, opts)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -276,8 +291,10 @@ func (c *configMuxBuilder) registerOptions(path string) {
 }
 
 func (c *configMuxBuilder) registerLDAP(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.LDAP())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		ldap := c.cfg.LDAP()
+		redactFor(r, &ldap)
+		sendJSON(w, ldap)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -292,8 +309,8 @@ func (c *configMuxBuilder) registerLDAP(path string) {
 }
 
 func (c *configMuxBuilder) registerGUI(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.GUI())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, visibleGUI(r, c.cfg.GUI()))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -307,6 +324,100 @@ func (c *configMuxBuilder) registerGUI(path string) {
 	})
 }
 
+// apiTokenInfo is an API token as listed, without its key.
+type apiTokenInfo struct {
+	Name     string    `json:"name"`
+	Scope    string    `json:"scope"`
+	Folders  []string  `json:"folders"`
+	Devices  []string  `json:"devices"`
+	Expires  time.Time `json:"expires"`
+	LastUsed time.Time `json:"lastUsed"`
+}
+
+func (c *configMuxBuilder) registerAPITokens(path string) {
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
+		tokens := c.cfg.GUI().APITokens
+		infos := make([]apiTokenInfo, len(tokens))
+		for i, t := range tokens {
+			infos[i] = apiTokenInfo{
+				Name:     t.Name,
+				Scope:    t.Scope,
+				Folders:  t.Folders,
+				Devices:  t.Devices,
+				Expires:  t.Expires,
+				LastUsed: c.tokenUsage.lastUsed(t.Name),
+			}
+		}
+		sendJSON(w, infos)
+	})
+
+	// A new token gets a random key, which is returned only here.
+	c.HandlerFunc(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
+		var token config.APIToken
+		if err := unmarshalTo(r.Body, &token); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		token.Key = rand.String(32)
+		if err := token.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var exists bool
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
+			for _, t := range cfg.GUI.APITokens {
+				if t.Name == token.Name {
+					exists = true
+					return
+				}
+			}
+			cfg.GUI.APITokens = append(cfg.GUI.APITokens, token)
+		})
+		if exists {
+			http.Error(w, "An API token with the given name already exists", http.StatusConflict)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		waiter.Wait()
+		if err := c.cfg.Save(); err != nil {
+			l.Warnln("Saving config:", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sendJSON(w, token)
+	})
+}
+
+func (c *configMuxBuilder) registerAPIToken(path string) {
+	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		name := p.ByName("name")
+		var found bool
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
+			tokens := cfg.GUI.APITokens[:0]
+			for _, t := range cfg.GUI.APITokens {
+				if t.Name == name {
+					found = true
+					continue
+				}
+				tokens = append(tokens, t)
+			}
+			cfg.GUI.APITokens = tokens
+		})
+		if !found {
+			http.Error(w, "No API token with given name", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		c.finish(w, waiter)
+	})
+}
+
 func (c *configMuxBuilder) adjustConfig(w http.ResponseWriter, r *http.Request) {
 	to, err := config.ReadJSON(r.Body, c.id)
 	r.Body.Close()
@@ -315,9 +426,17 @@ func (c *configMuxBuilder) adjustConfig(w http.ResponseWriter, r *http.Request)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if err := to.LDAP.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := to.GUI.OIDC.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	var errMsg string
 	var status int
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if to.GUI.Password != cfg.GUI.Password {
 			if err := to.GUI.SetPassword(to.GUI.Password); err != nil {
 				l.Warnln("hashing password:", err)
@@ -326,6 +445,12 @@ func (c *configMuxBuilder) adjustConfig(w http.ResponseWriter, r *http.Request)
 				return
 			}
 		}
+		if err := to.GUI.HashAccountPasswords(); err != nil {
+			l.Warnln("hashing password:", err)
+			errMsg = err.Error()
+			status = http.StatusInternalServerError
+			return
+		}
 		*cfg = to
 	})
 	if errMsg != "" {
@@ -342,7 +467,7 @@ func (c *configMuxBuilder) adjustFolder(w http.ResponseWriter, r *http.Request,
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if defaults {
 			cfg.Defaults.Folder = folder
 		} else {
@@ -361,7 +486,7 @@ func (c *configMuxBuilder) adjustDevice(w http.ResponseWriter, r *http.Request,
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if defaults {
 			cfg.Defaults.Device = device
 		} else {
@@ -380,7 +505,7 @@ func (c *configMuxBuilder) adjustOptions(w http.ResponseWriter, r *http.Request,
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		cfg.Options = opts
 	})
 	if err != nil {
@@ -397,9 +522,13 @@ func (c *configMuxBuilder) adjustGUI(w http.ResponseWriter, r *http.Request, gui
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if err := gui.OIDC.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	var errMsg string
 	var status int
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if gui.Password != oldPassword {
 			if err := gui.SetPassword(gui.Password); err != nil {
 				l.Warnln("hashing password:", err)
@@ -408,6 +537,12 @@ func (c *configMuxBuilder) adjustGUI(w http.ResponseWriter, r *http.Request, gui
 				return
 			}
 		}
+		if err := gui.HashAccountPasswords(); err != nil {
+			l.Warnln("hashing password:", err)
+			errMsg = err.Error()
+			status = http.StatusInternalServerError
+			return
+		}
 		cfg.GUI = gui
 	})
 	if errMsg != "" {
@@ -424,7 +559,11 @@ func (c *configMuxBuilder) adjustLDAP(w http.ResponseWriter, r *http.Request, ld
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	if err := ldap.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		cfg.LDAP = ldap
 	})
 	if err != nil {
@@ -23,6 +23,16 @@ func getRedactedConfig(s *service) config.Configuration {
 	if rawConf.GUI.User != "" {
 		rawConf.GUI.User = "REDACTED"
 	}
+	for i := range rawConf.GUI.APITokens {
+		rawConf.GUI.APITokens[i].Key = "REDACTED"
+	}
+	for i := range rawConf.GUI.Accounts {
+		rawConf.GUI.Accounts[i].Name = "REDACTED"
+		rawConf.GUI.Accounts[i].Password = "REDACTED"
+	}
+	if rawConf.GUI.OIDC.ClientSecret != "" {
+		rawConf.GUI.OIDC.ClientSecret = "REDACTED"
+	}
 	return rawConf
 }
 
@@ -0,0 +1,214 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package audit keeps a trail of who changed what: the changes made
+// through the GUI and REST API, with the user, API key and address they
+// were made by, and the changes to the configuration. The trail is
+// appended to, rotated by size and chained by hashes, such that changes to
+// it can be told.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// The actions recorded by the service itself. Those of REST requests are
+// named by the API.
+const (
+	ActionConfig = "config"
+	ActionLogin  = "login"
+)
+
+// The Service records entries in the audit trail when it's enabled. It
+// records the changes to the configuration, as those of whoever made them
+// when they were made through Modify, or as made by Syncthing otherwise,
+// and the attempts to log in to the GUI.
+type Service struct {
+	cfg      config.Wrapper
+	evLogger events.Logger
+
+	mut      sync.Mutex
+	trail    *trail
+	expected *ChangeSet // gets the changes of the next commit
+}
+
+func New(path string, cfg config.Wrapper, evLogger events.Logger) *Service {
+	s := &Service{
+		cfg:      cfg,
+		evLogger: evLogger,
+		mut:      sync.NewMutex(),
+		trail:    &trail{path: path},
+	}
+	cfg.Subscribe(s)
+	return s
+}
+
+func (s *Service) Serve(ctx context.Context) error {
+	defer func() {
+		s.mut.Lock()
+		if err := s.trail.close(); err != nil {
+			l.Warnln("Closing audit trail:", err)
+		}
+		s.mut.Unlock()
+	}()
+
+	sub := s.evLogger.Subscribe(events.LoginAttempt)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case ev, ok := <-sub.C():
+			if !ok {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			s.processEvent(ev)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *Service) processEvent(ev events.Event) {
+	data, ok := ev.Data.(map[string]interface{})
+	if !ok {
+		return
+	}
+	user, _ := data["username"].(string)
+	address, _ := data["remoteAddress"].(string)
+	success, _ := data["success"].(bool)
+	details, _ := json.Marshal(map[string]bool{"success": success})
+	s.Record(Entry{
+		Actor:   Actor{User: user, Address: address},
+		Action:  ActionLogin,
+		Details: details,
+	})
+}
+
+// Record appends the entry to the trail, if enabled. Entries which turn
+// the trail off are recorded regardless, so that it can be told when it
+// was off.
+func (s *Service) Record(e Entry) {
+	opts := s.cfg.Options()
+	if !opts.AuditTrailEnabled && !changesTrail(e) {
+		return
+	}
+	e.Time = time.Now().UTC()
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	e, err := s.trail.append(e, int64(opts.AuditTrailMaxSizeMiB)<<20, opts.AuditTrailMaxFiles)
+	if err != nil {
+		l.Warnln("Writing audit trail:", err)
+		return
+	}
+	l.Debugf("Recorded %s by %+v (seq %d)", e.Action, e.Actor, e.Seq)
+}
+
+func changesTrail(e Entry) bool {
+	for _, c := range e.Changes {
+		if c.Path == "options.auditTrailEnabled" {
+			return true
+		}
+	}
+	return false
+}
+
+// Query returns the entries of the trail selected by the filter, oldest
+// first.
+func (s *Service) Query(f Filter) ([]Entry, error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return s.trail.query(f)
+}
+
+// Verify checks the chain of hashes of the trail.
+func (s *Service) Verify() (Verification, error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return s.trail.verify()
+}
+
+// A ChangeSet collects the changes made on behalf of someone, to be
+// recorded as theirs.
+type ChangeSet struct {
+	mut     sync.Mutex
+	changes []Change
+}
+
+func NewChangeSet() *ChangeSet {
+	return &ChangeSet{mut: sync.NewMutex()}
+}
+
+func (c *ChangeSet) Add(changes ...Change) {
+	c.mut.Lock()
+	c.changes = append(c.changes, changes...)
+	c.mut.Unlock()
+}
+
+func (c *ChangeSet) Changes() []Change {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return append([]Change(nil), c.changes...)
+}
+
+// Modify modifies the configuration as config.Wrapper.Modify, adding the
+// changes to the set rather than recording them as made by Syncthing. The
+// changes have been added by the time the returned waiter is done.
+func (s *Service) Modify(set *ChangeSet, fn config.ModifyFunction) (config.Waiter, error) {
+	waiter, err := s.cfg.Modify(func(cfg *config.Configuration) {
+		from := cfg.Copy()
+		fn(cfg)
+		// Modifications are serialized, and this one is committed before
+		// the next one is made, if at all.
+		if !reflect.DeepEqual(from, *cfg) {
+			s.mut.Lock()
+			s.expected = set
+			s.mut.Unlock()
+		}
+	})
+	if err != nil {
+		// The modification was rejected and won't be committed.
+		s.mut.Lock()
+		if s.expected == set {
+			s.expected = nil
+		}
+		s.mut.Unlock()
+	}
+	return waiter, err
+}
+
+func (s *Service) CommitConfiguration(from, to config.Configuration) bool {
+	changes := Diff(from, to)
+
+	s.mut.Lock()
+	set := s.expected
+	s.expected = nil
+	s.mut.Unlock()
+
+	if set != nil {
+		set.Add(changes...)
+	} else if len(changes) > 0 {
+		s.Record(Entry{
+			Actor:   Actor{Syncthing: true},
+			Action:  ActionConfig,
+			Changes: changes,
+		})
+	}
+	return true
+}
+
+func (s *Service) String() string {
+	return fmt.Sprintf("audit.Service@%p", s)
+}
@@ -0,0 +1,291 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func appendEntries(t *testing.T, tr *trail, users ...string) {
+	t.Helper()
+	for _, user := range users {
+		e := Entry{Time: time.Now().UTC(), Actor: Actor{User: user}, Action: "test"}
+		if _, err := tr.append(e, 1000, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestTrailRotation(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit-trail.log")
+	tr := &trail{path: path}
+	for i := 0; i < 10; i++ {
+		appendEntries(t, tr, "alice", "bob")
+	}
+	if err := tr.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The entries are rotated into files of less than 1000 bytes, of
+	// which two are kept besides the current one.
+	files, err := tr.files()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Fatalf("got files %v, expected three", files)
+	}
+	for _, name := range files {
+		if info, err := os.Stat(name); err != nil {
+			t.Fatal(err)
+		} else if info.Size() > 1000 {
+			t.Errorf("%s is %d bytes", name, info.Size())
+		}
+	}
+
+	v, err := tr.verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.Valid || v.LastSeq != 20 || v.FirstSeq <= 1 || v.Entries != int(v.LastSeq-v.FirstSeq+1) {
+		t.Errorf("unexpected verification %+v", v)
+	}
+
+	// A reopened trail carries on the chain.
+	tr = &trail{path: path}
+	appendEntries(t, tr, "carol")
+	tr.close()
+	if v, err := tr.verify(); err != nil {
+		t.Fatal(err)
+	} else if !v.Valid || v.LastSeq != 21 {
+		t.Errorf("unexpected verification %+v", v)
+	}
+}
+
+func TestTrailTampering(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]func(lines [][]byte) [][]byte{
+		"modified": func(lines [][]byte) [][]byte {
+			lines[1] = bytes.Replace(lines[1], []byte("bob"), []byte("eve"), 1)
+			return lines
+		},
+		"removed": func(lines [][]byte) [][]byte {
+			return append(lines[:1], lines[2:]...)
+		},
+		"reordered": func(lines [][]byte) [][]byte {
+			lines[0], lines[1] = lines[1], lines[0]
+			return lines
+		},
+		"removed from start": func(lines [][]byte) [][]byte {
+			return lines[1:]
+		},
+		"removed from end": func(lines [][]byte) [][]byte {
+			return lines[:2]
+		},
+		"all removed": func(lines [][]byte) [][]byte {
+			return nil
+		},
+	}
+	for name, tamper := range cases {
+		tamper := tamper
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "audit-trail.log")
+			tr := &trail{path: path}
+			appendEntries(t, tr, "alice", "bob", "carol")
+			tr.close()
+
+			bs, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines := bytes.SplitAfter(bs, []byte("\n"))
+			if err := os.WriteFile(path, bytes.Join(tamper(lines), nil), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			v, err := tr.verify()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v.Valid || v.Error == "" {
+				t.Errorf("tampering went unnoticed: %+v", v)
+			}
+		})
+	}
+}
+
+func TestTrailTruncation(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit-trail.log")
+	tr := &trail{path: path}
+	appendEntries(t, tr, "alice", "bob", "carol")
+	tr.close()
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.SplitAfter(bs, []byte("\n"))
+
+	// What's left of an entry that wasn't completely written is removed
+	// when the trail is opened again.
+	torn := append(bytes.Join(lines, nil), lines[2][:20]...)
+	if err := os.WriteFile(path, torn, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tr = &trail{path: path}
+	appendEntries(t, tr, "dave")
+	tr.close()
+	if v, err := tr.verify(); err != nil {
+		t.Fatal(err)
+	} else if !v.Valid || v.LastSeq != 4 {
+		t.Errorf("unexpected verification %+v", v)
+	}
+
+	// Entries removed from the end stay missing when more are appended.
+	if err := os.WriteFile(path, bytes.Join(lines[:2], nil), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tr = &trail{path: path}
+	appendEntries(t, tr, "eve")
+	tr.close()
+	if v, err := tr.verify(); err != nil {
+		t.Fatal(err)
+	} else if v.Valid || v.Error == "" {
+		t.Errorf("truncation went unnoticed: %+v", v)
+	}
+
+	// As does removing the state.
+	if err := os.Remove(tr.statePath()); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := tr.verify(); err != nil {
+		t.Fatal(err)
+	} else if v.Valid || v.Error == "" {
+		t.Errorf("missing state went unnoticed: %+v", v)
+	}
+}
+
+func TestTrailQuery(t *testing.T) {
+	t.Parallel()
+
+	tr := &trail{path: filepath.Join(t.TempDir(), "audit-trail.log")}
+	appendEntries(t, tr, "alice", "bob", "alice")
+	if _, err := tr.append(Entry{
+		Actor:   Actor{User: "bob"},
+		Action:  ActionConfig,
+		Changes: []Change{{Path: "folders[default].devices[" + protocol.LocalDeviceID.String() + "]", Old: []byte("{}")}},
+	}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	tr.close()
+
+	cases := []struct {
+		filter Filter
+		seqs   []int64
+	}{
+		{Filter{}, []int64{1, 2, 3, 4}},
+		{Filter{User: "alice"}, []int64{1, 3}},
+		{Filter{Since: 2}, []int64{3, 4}},
+		{Filter{Limit: 3}, []int64{2, 3, 4}},
+		{Filter{Action: ActionConfig}, []int64{4}},
+		{Filter{Folder: "default"}, []int64{4}},
+		{Filter{Device: protocol.LocalDeviceID.String()}, []int64{4}},
+		{Filter{From: time.Now().Add(time.Hour)}, nil},
+	}
+	for _, tc := range cases {
+		entries, err := tr.query(tc.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var seqs []int64
+		for _, e := range entries {
+			seqs = append(seqs, e.Seq)
+		}
+		if !reflect.DeepEqual(seqs, tc.seqs) {
+			t.Errorf("%+v: got entries %v, expected %v", tc.filter, seqs, tc.seqs)
+		}
+	}
+}
+
+func TestServiceAttribution(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cfg := config.Wrap("", config.New(protocol.LocalDeviceID), protocol.LocalDeviceID, events.NoopLogger)
+	go cfg.Serve(ctx)
+	waiter, err := cfg.Modify(func(cfg *config.Configuration) {
+		cfg.Folders = []config.FolderConfiguration{{ID: "default", Path: t.TempDir()}}
+		cfg.Options.AuditTrailEnabled = true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+
+	s := New(filepath.Join(t.TempDir(), "audit-trail.log"), cfg, events.NoopLogger)
+
+	// The changes made through the service go to the change set, those
+	// made otherwise are recorded as made by Syncthing.
+	set := NewChangeSet()
+	waiter, err = s.Modify(set, func(cfg *config.Configuration) {
+		cfg.Folders[0].Paused = true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+	waiter, err = cfg.Modify(func(cfg *config.Configuration) {
+		cfg.Folders[0].Label = "Default"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+
+	if changes := set.Changes(); len(changes) != 1 || changes[0].Path != "folders[default].paused" {
+		t.Errorf("unexpected changes %+v", changes)
+	}
+	entries, err := s.Query(Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || !entries[0].Actor.Syncthing || len(entries[0].Changes) != 1 || entries[0].Changes[0].Path != "folders[default].label" {
+		t.Fatalf("unexpected entries %+v", entries)
+	}
+
+	// Turning off the trail is recorded, but nothing after it.
+	waiter, err = cfg.Modify(func(cfg *config.Configuration) {
+		cfg.Options.AuditTrailEnabled = false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+	s.Record(Entry{Action: "test"})
+	if entries, err := s.Query(Filter{Since: 1}); err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 1 || entries[0].Changes[0].Path != "options.auditTrailEnabled" {
+		t.Errorf("unexpected entries %+v", entries)
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+// The values of these fields are secret, and only whether they changed is
+// recorded.
+var secretFields = map[string]bool{
+	"apiKey":             true,
+	"password":           true,
+	"key":                true, // of API tokens
+	"clientSecret":       true,
+	"encryptionPassword": true,
+	"secret":             true, // of webhooks
+}
+
+// Lists of objects with one of these fields, unique within the list, are
+// compared element by element, so that a change to one folder or device
+// reads as such rather than as a change to all of them.
+var keyFields = []string{"id", "deviceID", "name", "url", "group", "value"}
+
+const redacted = `"REDACTED"`
+
+// Diff returns the changes from one configuration to the other, with the
+// values of secrets redacted.
+func Diff(from, to config.Configuration) []Change {
+	a, err := generic(from)
+	if err != nil {
+		l.Debugln("Diffing configuration:", err)
+		return nil
+	}
+	b, err := generic(to)
+	if err != nil {
+		l.Debugln("Diffing configuration:", err)
+		return nil
+	}
+	var changes []Change
+	diff("", a, b, &changes)
+	return changes
+}
+
+// generic returns the JSON form of the value, as decoded into maps and
+// slices.
+func generic(v interface{}) (interface{}, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	err = json.Unmarshal(bs, &res)
+	return res, err
+}
+
+func diff(path string, from, to interface{}, changes *[]Change) {
+	if reflect.DeepEqual(from, to) || isEmpty(from) && isEmpty(to) {
+		return
+	}
+
+	switch from := from.(type) {
+	case map[string]interface{}:
+		to, ok := to.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for _, k := range unionKeys(from, to) {
+			field := k
+			if path != "" {
+				field = path + "." + k
+			}
+			if secretFields[k] {
+				if !reflect.DeepEqual(from[k], to[k]) {
+					*changes = append(*changes, Change{Path: field, Old: secret(from[k]), New: secret(to[k])})
+				}
+				continue
+			}
+			diff(field, from[k], to[k], changes)
+		}
+		return
+
+	case []interface{}:
+		to, ok := to.([]interface{})
+		if !ok {
+			break
+		}
+		key := commonKey(from, to)
+		if key == "" {
+			break
+		}
+		fromByKey := make(map[string]interface{}, len(from))
+		for _, v := range from {
+			fromByKey[keyOf(v, key)] = v
+		}
+		toByKey := make(map[string]interface{}, len(to))
+		for _, v := range to {
+			toByKey[keyOf(v, key)] = v
+		}
+		// Removed and changed elements in their old order, then the
+		// added ones in their new order.
+		for _, v := range from {
+			k := keyOf(v, key)
+			diff(fmt.Sprintf("%s[%s]", path, k), v, toByKey[k], changes)
+		}
+		for _, v := range to {
+			k := keyOf(v, key)
+			if _, ok := fromByKey[k]; !ok {
+				diff(fmt.Sprintf("%s[%s]", path, k), nil, v, changes)
+			}
+		}
+		return
+	}
+
+	*changes = append(*changes, Change{Path: path, Old: value(from), New: value(to)})
+}
+
+// isEmpty returns whether the value is null or an empty list or object,
+// which are all the same as far as the configuration is concerned.
+func isEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// commonKey returns the key field of the objects in both lists, if any.
+func commonKey(a, b []interface{}) string {
+	for _, key := range keyFields {
+		if uniqueKeys(a, key) && uniqueKeys(b, key) {
+			return key
+		}
+	}
+	return ""
+}
+
+func uniqueKeys(list []interface{}, key string) bool {
+	seen := make(map[string]struct{}, len(list))
+	for _, v := range list {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		k, ok := obj[key].(string)
+		if !ok || k == "" {
+			return false
+		}
+		if _, ok := seen[k]; ok {
+			return false
+		}
+		seen[k] = struct{}{}
+	}
+	return true
+}
+
+func keyOf(v interface{}, key string) string {
+	k, _ := v.(map[string]interface{})[key].(string)
+	return k
+}
+
+// Redact replaces the values of the secrets in what v points to, such as a
+// configuration, the same way as in a diff. Lists and maps are copied
+// before they are changed, so that values shared with others stay as they
+// are.
+func Redact(v interface{}) {
+	redactValue(reflect.ValueOf(v).Elem())
+}
+
+func redactValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if secretFields[name] && field.Type.Kind() == reflect.String {
+				if v.Field(i).String() != "" {
+					v.Field(i).SetString("REDACTED")
+				}
+				continue
+			}
+			redactValue(v.Field(i))
+		}
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(c, v)
+		v.Set(c)
+		for i := 0; i < c.Len(); i++ {
+			redactValue(c.Index(i))
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String || v.Type().Elem().Kind() != reflect.String {
+			return
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			val := iter.Value()
+			if secretFields[iter.Key().String()] && val.String() != "" {
+				val = reflect.ValueOf("REDACTED").Convert(v.Type().Elem())
+			}
+			c.SetMapIndex(iter.Key(), val)
+		}
+		v.Set(c)
+	}
+}
+
+// value returns the JSON of the value, less its secrets, or nil for no
+// value.
+func value(v interface{}) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+	bs, err := json.Marshal(redact(v))
+	if err != nil {
+		return nil
+	}
+	return bs
+}
+
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for k, field := range v {
+			if secretFields[k] {
+				res[k] = secret(field)
+			} else {
+				res[k] = redact(field)
+			}
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, elem := range v {
+			res[i] = redact(elem)
+		}
+		return res
+	default:
+		return v
+	}
+}
+
+// secret returns the JSON of a secret value, which is redacted unless
+// empty.
+func secret(v interface{}) json.RawMessage {
+	switch v {
+	case nil:
+		return nil
+	case "":
+		return json.RawMessage(`""`)
+	default:
+		return json.RawMessage(redacted)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package audit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	device1, _ := protocol.DeviceIDFromString("AIR6LPZ-7K4PTTV-UXQSMUU-CPQ5YWH-OEDFIIQ-JUG777G-2YQXXR5-YD6AWQR")
+	device2, _ := protocol.DeviceIDFromString("GYRZZQB-IRNPV4Z-T7TC52W-EQYJ3TT-FDQW6MW-DFLMU42-SSSU6EM-FBK2VAY")
+	from := config.Configuration{
+		Folders: []config.FolderConfiguration{{
+			ID:    "default",
+			Label: "Default",
+			Devices: []config.FolderDeviceConfiguration{
+				{DeviceID: device1},
+				{DeviceID: device2, EncryptionPassword: "old"},
+			},
+		}},
+		GUI: config.GUIConfiguration{APIKey: "old"},
+	}
+	to := from.Copy()
+	to.Folders[0].Devices = to.Folders[0].Devices[:1]
+	to.Folders = append(to.Folders, config.FolderConfiguration{ID: "photos"})
+	to.GUI.APIKey = "new"
+	to.GUI.APITokens = []config.APIToken{{Name: "ci", Key: "secret"}}
+
+	changes := Diff(from, to)
+	byPath := make(map[string]Change, len(changes))
+	for _, c := range changes {
+		byPath[c.Path] = c
+	}
+	if len(byPath) != 4 {
+		t.Errorf("unexpected changes %+v", changes)
+	}
+
+	unshared, ok := byPath["folders[default].devices["+device2.String()+"]"]
+	if !ok || unshared.New != nil || !strings.Contains(string(unshared.Old), `"encryptionPassword":"REDACTED"`) {
+		t.Errorf("unexpected change for the unshared device: %s", unshared.Old)
+	}
+	if added, ok := byPath["folders[photos]"]; !ok || added.Old != nil || added.New == nil {
+		t.Error("missing change for the added folder")
+	}
+	if key := byPath["gui.apiKey"]; string(key.Old) != redacted || string(key.New) != redacted {
+		t.Errorf("API key not redacted: %s, %s", key.Old, key.New)
+	}
+	if tokens := byPath["gui.apiTokens"]; strings.Contains(string(tokens.New), "secret") {
+		t.Errorf("API token key not redacted: %s", tokens.New)
+	}
+}
@@ -0,0 +1,566 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/osutil"
+)
+
+// An Entry is one thing done, by whom and to what. Each entry holds the
+// hash of the one before it, so that entries can't be changed or removed
+// from the middle of the trail without that being noticed.
+type Entry struct {
+	Seq      int64           `json:"seq"`
+	Time     time.Time       `json:"time"`
+	Actor    Actor           `json:"actor"`
+	Action   string          `json:"action"`
+	Request  string          `json:"request,omitempty"` // method and path of the REST request
+	Status   int             `json:"status,omitempty"`  // of the response to the request
+	Folder   string          `json:"folder,omitempty"`
+	Device   string          `json:"device,omitempty"`
+	Changes  []Change        `json:"changes,omitempty"`
+	Details  json.RawMessage `json:"details,omitempty"`
+	PrevHash string          `json:"prevHash"`
+	Hash     string          `json:"hash"`
+}
+
+// An Actor is who did something: the GUI user, the API key or token and
+// the address they came from, as far as they are known. Changes Syncthing
+// makes by itself, such as on accepting a folder shared by another device,
+// are made by Syncthing.
+type Actor struct {
+	User      string `json:"user,omitempty"`
+	Role      string `json:"role,omitempty"`
+	APIKey    string `json:"apiKey,omitempty"` // the name of the API token, or "main" for the API key
+	Address   string `json:"address,omitempty"`
+	Syncthing bool   `json:"syncthing,omitempty"`
+}
+
+// A Change is a value that changed, at a path into the JSON form of the
+// configuration such as "folders[default].paused". Old or new is absent
+// when the value was added or removed.
+type Change struct {
+	Path string          `json:"path"`
+	Old  json.RawMessage `json:"old,omitempty"`
+	New  json.RawMessage `json:"new,omitempty"`
+}
+
+// A Filter selects entries of the trail. The zero filter selects all of
+// them.
+type Filter struct {
+	Since  int64 // entries after this sequence number
+	From   time.Time
+	To     time.Time
+	User   string
+	Action string
+	Folder string // entries concerning or changing the folder
+	Device string // entries concerning or changing the device
+	Limit  int    // at most this many of the latest entries
+}
+
+func (f Filter) matches(e Entry) bool {
+	switch {
+	case e.Seq <= f.Since:
+		return false
+	case !f.From.IsZero() && e.Time.Before(f.From):
+		return false
+	case !f.To.IsZero() && e.Time.After(f.To):
+		return false
+	case f.User != "" && e.Actor.User != f.User:
+		return false
+	case f.Action != "" && e.Action != f.Action:
+		return false
+	case f.Folder != "" && e.Folder != f.Folder && !changesPath(e, "folders["+f.Folder+"]"):
+		return false
+	case f.Device != "" && e.Device != f.Device && !changesPath(e, "devices["+f.Device+"]"):
+		return false
+	default:
+		return true
+	}
+}
+
+func changesPath(e Entry, part string) bool {
+	for _, c := range e.Changes {
+		if strings.Contains(c.Path, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// The Verification of a trail tells whether the chain of hashes is
+// intact and complete, and if not, where it's broken. The hash of the
+// latest entry may also be kept elsewhere, to later tell that the trail
+// wasn't replaced as a whole.
+type Verification struct {
+	Valid    bool   `json:"valid"`
+	Entries  int    `json:"entries"`
+	FirstSeq int64  `json:"firstSeq,omitempty"`
+	LastSeq  int64  `json:"lastSeq,omitempty"`
+	LastHash string `json:"lastHash,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// hashEntry returns the hash of all of the entry but the hash itself,
+// including the hash of the entry before it.
+func hashEntry(e Entry) (string, error) {
+	e.Hash = ""
+	bs, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bs)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// A trail is the set of files the entries are appended to: the current
+// one at the path, and the rotated ones next to it, named after the
+// sequence number of their last entry. The chain of hashes carries on
+// across files.
+type trail struct {
+	path     string
+	fd       *os.File
+	size     int64
+	firstSeq int64  // of the oldest entry kept
+	seq      int64  // of the last entry
+	hash     string // of the last entry
+}
+
+// The trailState is kept in a file next to the trail, to tell when entries
+// were removed from its start or end, which the chain of hashes alone
+// doesn't show.
+type trailState struct {
+	FirstSeq int64  `json:"firstSeq"`
+	LastSeq  int64  `json:"lastSeq"`
+	LastHash string `json:"lastHash"`
+}
+
+func (t *trail) append(e Entry, maxSize int64, maxFiles int) (Entry, error) {
+	if t.fd == nil {
+		if err := t.open(); err != nil {
+			return e, err
+		}
+	}
+
+	e.Seq = t.seq + 1
+	e.PrevHash = t.hash
+	hash, err := hashEntry(e)
+	if err != nil {
+		return e, err
+	}
+	e.Hash = hash
+	line, err := json.Marshal(e)
+	if err != nil {
+		return e, err
+	}
+	line = append(line, '\n')
+
+	if maxSize > 0 && t.size > 0 && t.size+int64(len(line)) > maxSize {
+		if err := t.rotate(maxFiles); err != nil {
+			return e, err
+		}
+	}
+
+	n, err := t.fd.Write(line)
+	t.size += int64(n)
+	if err != nil {
+		return e, err
+	}
+	if t.firstSeq == 0 {
+		t.firstSeq = e.Seq
+	}
+	t.seq, t.hash = e.Seq, e.Hash
+	return e, t.writeState()
+}
+
+// open opens the current file for appending, carrying on from the last
+// entry of the trail, or from the last one written if entries were
+// removed from the end, so that the gap shows.
+func (t *trail) open() error {
+	files, err := t.files()
+	if err != nil {
+		return err
+	}
+	var last Entry
+	var complete int64 // size of the complete lines of the current file
+	for i := len(files) - 1; i >= 0 && last.Hash == ""; i-- {
+		err := scanFile(files[i], func(line []byte) error {
+			if line[len(line)-1] != '\n' {
+				// What's left of an entry that wasn't completely written,
+				// as on a crash.
+				return nil
+			}
+			if files[i] == t.path {
+				complete += int64(len(line))
+			}
+			var e Entry
+			if json.Unmarshal(line, &e) == nil && e.Hash != "" {
+				last = e
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	state, ok, err := t.readState()
+	if err != nil {
+		return err
+	}
+	t.seq, t.hash = last.Seq, last.Hash
+	switch {
+	case ok:
+		t.firstSeq = state.FirstSeq
+		if state.LastSeq >= last.Seq && state.LastHash != last.Hash {
+			l.Warnf("Audit trail is missing entry %d or those before it", state.LastSeq)
+			t.seq, t.hash = state.LastSeq, state.LastHash
+		}
+	case last.Hash != "":
+		l.Warnln("Audit trail state is missing; recreating it")
+		first, err := firstEntry(files)
+		if err != nil {
+			return err
+		}
+		t.firstSeq = first.Seq
+	}
+
+	fd, err := os.OpenFile(t.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := fd.Stat()
+	if err != nil {
+		fd.Close()
+		return err
+	}
+	t.size = info.Size()
+	if t.size > complete {
+		if err := fd.Truncate(complete); err != nil {
+			fd.Close()
+			return err
+		}
+		t.size = complete
+	}
+	t.fd = fd
+	return nil
+}
+
+func (t *trail) statePath() string {
+	return t.path + ".state"
+}
+
+// readState returns the state of the trail, and false if there is none.
+func (t *trail) readState() (trailState, bool, error) {
+	var state trailState
+	bs, err := os.ReadFile(t.statePath())
+	if os.IsNotExist(err) {
+		return state, false, nil
+	} else if err != nil {
+		return state, false, err
+	}
+	if err := json.Unmarshal(bs, &state); err != nil {
+		return state, false, fmt.Errorf("audit trail state: %w", err)
+	}
+	return state, true, nil
+}
+
+func (t *trail) writeState() error {
+	bs, err := json.Marshal(trailState{FirstSeq: t.firstSeq, LastSeq: t.seq, LastHash: t.hash})
+	if err != nil {
+		return err
+	}
+	fd, err := osutil.CreateAtomic(t.statePath())
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(bs); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
+// firstEntry returns the first entry of the first of the files that has
+// any.
+func firstEntry(files []string) (Entry, error) {
+	var first Entry
+	for _, name := range files {
+		err := scanFile(name, func(line []byte) error {
+			if json.Unmarshal(line, &first) == nil && first.Hash != "" {
+				return io.EOF
+			}
+			return nil
+		})
+		if err != nil && err != io.EOF {
+			return first, err
+		} else if err == io.EOF {
+			break
+		}
+	}
+	return first, nil
+}
+
+func (t *trail) close() error {
+	if t.fd == nil {
+		return nil
+	}
+	err := t.fd.Close()
+	t.fd = nil
+	return err
+}
+
+// rotate moves the current file aside and starts a new one, removing the
+// oldest rotated files when there are more than maxFiles, if set.
+func (t *trail) rotate(maxFiles int) error {
+	if err := t.close(); err != nil {
+		return err
+	}
+	ext := filepath.Ext(t.path)
+	rotated := fmt.Sprintf("%s.%d%s", strings.TrimSuffix(t.path, ext), t.seq, ext)
+	if err := os.Rename(t.path, rotated); err != nil {
+		return err
+	}
+
+	if maxFiles > 0 {
+		files, err := t.files()
+		if err != nil {
+			return err
+		}
+		// The last one is the current file, which doesn't exist yet.
+		rotated := files[:len(files)-1]
+		if remove := len(rotated) - maxFiles; remove > 0 {
+			// The state is updated first, so that entries are never
+			// missing from the start of the trail as far as it says.
+			first, err := firstEntry(rotated[remove:])
+			if err != nil {
+				return err
+			}
+			t.firstSeq = first.Seq
+			if err := t.writeState(); err != nil {
+				return err
+			}
+			for _, name := range rotated[:remove] {
+				if err := os.Remove(name); err != nil {
+					l.Warnln("Removing rotated audit trail:", err)
+				}
+			}
+		}
+	}
+
+	fd, err := os.OpenFile(t.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	t.fd = fd
+	t.size = 0
+	return nil
+}
+
+// files returns the files of the trail, oldest first: the rotated ones,
+// and then the current one.
+func (t *trail) files() ([]string, error) {
+	dir, name := filepath.Split(t.path)
+	ext := filepath.Ext(name)
+	prefix := strings.TrimSuffix(name, ext) + "."
+
+	entries, err := os.ReadDir(filepath.Clean(dir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	type rotatedFile struct {
+		name string
+		seq  int64
+	}
+	var rotated []rotatedFile
+	for _, entry := range entries {
+		n := entry.Name()
+		if len(n) <= len(prefix)+len(ext) || !strings.HasPrefix(n, prefix) || !strings.HasSuffix(n, ext) {
+			continue
+		}
+		seq, err := strconv.ParseInt(n[len(prefix):len(n)-len(ext)], 10, 64)
+		if err != nil {
+			continue
+		}
+		rotated = append(rotated, rotatedFile{filepath.Join(dir, n), seq})
+	}
+	sort.Slice(rotated, func(a, b int) bool {
+		return rotated[a].seq < rotated[b].seq
+	})
+
+	files := make([]string, 0, len(rotated)+1)
+	for _, r := range rotated {
+		files = append(files, r.name)
+	}
+	return append(files, t.path), nil
+}
+
+// query returns the entries selected by the filter, oldest first.
+// Lines which aren't entries are skipped; verify tells about those.
+func (t *trail) query(f Filter) ([]Entry, error) {
+	files, err := t.files()
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	for _, name := range files {
+		err := scanFile(name, func(line []byte) error {
+			var e Entry
+			if json.Unmarshal(line, &e) != nil || !f.matches(e) {
+				return nil
+			}
+			entries = append(entries, e)
+			if f.Limit > 0 && len(entries) > 2*f.Limit {
+				entries = append(entries[:0], entries[len(entries)-f.Limit:]...)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if f.Limit > 0 && len(entries) > f.Limit {
+		entries = entries[len(entries)-f.Limit:]
+	}
+	return entries, nil
+}
+
+var errBrokenChain = errors.New("broken chain")
+
+// verify checks that each entry is as it was written, and follows the
+// entry before it, and that no entries are missing from the start or the
+// end of the trail as its state tells them.
+func (t *trail) verify() (Verification, error) {
+	var v Verification
+	files, err := t.files()
+	if err != nil {
+		return v, err
+	}
+	state, hasState, err := t.readState()
+	if err != nil {
+		return v, err
+	}
+	var stateHash string // of the entry the state says is the last
+	for _, name := range files {
+		lineNo := 0
+		err := scanFile(name, func(line []byte) error {
+			lineNo++
+			if err := v.check(line); err != nil {
+				v.Error = fmt.Sprintf("%s line %d: %v", filepath.Base(name), lineNo, err)
+				return errBrokenChain
+			}
+			if v.LastSeq == state.LastSeq {
+				stateHash = v.LastHash
+			}
+			return nil
+		})
+		if errors.Is(err, errBrokenChain) {
+			return v, nil
+		} else if err != nil {
+			return v, err
+		}
+	}
+
+	switch {
+	case !hasState:
+		if v.Entries > 0 {
+			v.Error = "the state of the trail is missing"
+			return v, nil
+		}
+	case v.Entries == 0:
+		if state.LastSeq > 0 {
+			v.Error = fmt.Sprintf("entries %d to %d are missing", state.FirstSeq, state.LastSeq)
+			return v, nil
+		}
+	case v.FirstSeq > state.FirstSeq:
+		v.Error = fmt.Sprintf("entries %d to %d are missing from the start", state.FirstSeq, v.FirstSeq-1)
+		return v, nil
+	case v.LastSeq < state.LastSeq:
+		v.Error = fmt.Sprintf("entries %d to %d are missing from the end", v.LastSeq+1, state.LastSeq)
+		return v, nil
+	case stateHash != state.LastHash:
+		v.Error = fmt.Sprintf("entry %d is not the one written", state.LastSeq)
+		return v, nil
+	}
+	v.Valid = true
+	return v, nil
+}
+
+func (v *Verification) check(line []byte) error {
+	var e Entry
+	if err := json.Unmarshal(line, &e); err != nil {
+		return errors.New("not an entry")
+	}
+	hash, err := hashEntry(e)
+	if err != nil {
+		return err
+	}
+	written, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if hash != e.Hash || !bytes.Equal(written, bytes.TrimSuffix(line, []byte{'\n'})) {
+		return fmt.Errorf("entry %d was modified", e.Seq)
+	}
+	if v.Entries > 0 {
+		if e.Seq != v.LastSeq+1 {
+			return fmt.Errorf("entries %d to %d are missing", v.LastSeq+1, e.Seq-1)
+		}
+		if e.PrevHash != v.LastHash {
+			return fmt.Errorf("entry %d doesn't follow entry %d", e.Seq, v.LastSeq)
+		}
+	} else {
+		// The entries before the first may have been rotated away.
+		v.FirstSeq = e.Seq
+	}
+	v.Entries++
+	v.LastSeq, v.LastHash = e.Seq, e.Hash
+	return nil
+}
+
+// scanFile calls fn with each line of the file, including the newline
+// unless it's the last line and incomplete. A file that doesn't exist has
+// no lines.
+func scanFile(name string, fn func(line []byte) error) error {
+	fd, err := os.Open(name)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	br := bufio.NewReader(fd)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			if err := fn(line); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
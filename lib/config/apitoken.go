@@ -0,0 +1,94 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// The scopes of API tokens.
+const (
+	APITokenScopeAdmin    = "admin"
+	APITokenScopeReadOnly = "read-only"
+	APITokenScopeFolder   = "folder"
+	APITokenScopeDevice   = "device"
+)
+
+func (t APIToken) Copy() APIToken {
+	c := t
+	c.Folders = append([]string(nil), t.Folders...)
+	c.Devices = append([]string(nil), t.Devices...)
+	return c
+}
+
+// Expired returns whether the token is past its expiry time, if it has
+// one.
+func (t APIToken) Expired(now time.Time) bool {
+	return !t.Expires.IsZero() && !now.Before(t.Expires)
+}
+
+// Validate returns an error describing what is wrong with the token, if
+// anything.
+func (t APIToken) Validate() error {
+	if t.Name == "" {
+		return errors.New("token has no name")
+	}
+	if len(t.Key) < 16 {
+		return errors.New("key must be at least 16 characters")
+	}
+	switch t.Scope {
+	case APITokenScopeAdmin, APITokenScopeReadOnly, APITokenScopeFolder, APITokenScopeDevice:
+	default:
+		return fmt.Errorf("unknown scope %q", t.Scope)
+	}
+	return nil
+}
+
+// APIToken returns the API token with the given key, unless it has
+// expired.
+func (c GUIConfiguration) APIToken(key string) (APIToken, bool) {
+	if key == "" {
+		return APIToken{}, false
+	}
+	now := time.Now()
+	for _, t := range c.APITokens {
+		if subtle.ConstantTimeCompare([]byte(t.Key), []byte(key)) == 1 {
+			if t.Expired(now) {
+				return APIToken{}, false
+			}
+			return t, true
+		}
+	}
+	return APIToken{}, false
+}
+
+// validAPITokens returns the tokens, less those that are invalid or have
+// the same name or key as an earlier one.
+func validAPITokens(tokens []APIToken) []APIToken {
+	valid := tokens[:0]
+	names := make(map[string]struct{}, len(tokens))
+	keys := make(map[string]struct{}, len(tokens))
+	for _, t := range tokens {
+		if err := t.Validate(); err != nil {
+			l.Warnf("Ignoring invalid API token %q: %v", t.Name, err)
+			continue
+		}
+		_, dupName := names[t.Name]
+		_, dupKey := keys[t.Key]
+		if dupName || dupKey {
+			l.Warnf("Ignoring API token %q with the same name or key as another", t.Name)
+			continue
+		}
+		names[t.Name] = struct{}{}
+		keys[t.Key] = struct{}{}
+		valid = append(valid, t)
+	}
+	return valid
+}
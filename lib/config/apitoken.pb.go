@@ -0,0 +1,561 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/apitoken.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// An APIToken is a named API key with limited access. The scope is one of
+// "admin" (full access, as the main API key), "read-only" (GET requests),
+// "folder" (requests concerning the listed folders) or "device" (requests
+// concerning the listed devices). Only admin tokens have access to the GUI
+// configuration and the configuration as a whole, as these hold the keys.
+// A token is no longer valid past its expiry time, if set.
+type APIToken struct {
+	Name    string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name,attr"`
+	Key     string    `protobuf:"bytes,2,opt,name=key,proto3" json:"key" xml:"key,attr"`
+	Scope   string    `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope" xml:"scope,attr"`
+	Folders []string  `protobuf:"bytes,4,rep,name=folders,proto3" json:"folders" xml:"folder"`
+	Devices []string  `protobuf:"bytes,5,rep,name=devices,proto3" json:"devices" xml:"device"`
+	Expires time.Time `protobuf:"bytes,6,opt,name=expires,proto3,stdtime" json:"expires" xml:"expires,attr"`
+}
+
+func (m *APIToken) Reset()         { *m = APIToken{} }
+func (m *APIToken) String() string { return proto.CompactTextString(m) }
+func (*APIToken) ProtoMessage()    {}
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2afe6d7055f4b882, []int{0}
+}
+func (m *APIToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APIToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_APIToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *APIToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIToken.Merge(m, src)
+}
+func (m *APIToken) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *APIToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIToken proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*APIToken)(nil), "config.APIToken")
+}
+
+func init() { proto.RegisterFile("lib/config/apitoken.proto", fileDescriptor_2afe6d7055f4b882) }
+
+var fileDescriptor_2afe6d7055f4b882 = []byte{
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0x41, 0xee, 0x9a, 0x40,
+	0x18, 0xc5, 0xa1, 0x28, 0xea, 0xd4, 0xb4, 0x0d, 0x2b, 0xea, 0x62, 0xc6, 0x18, 0x9b, 0xd8, 0xb4,
+	0x81, 0xa4, 0x5d, 0x34, 0x6d, 0x9a, 0x34, 0xba, 0x6b, 0xba, 0x69, 0x88, 0xab, 0xee, 0x00, 0x47,
+	0x9c, 0x08, 0x0c, 0x81, 0xb1, 0xd1, 0x5b, 0x78, 0x84, 0xde, 0xa2, 0x57, 0x70, 0xa7, 0xcb, 0xae,
+	0xa6, 0x51, 0x76, 0x2c, 0x39, 0xc1, 0x3f, 0xcc, 0x00, 0xba, 0xfb, 0x7e, 0x2f, 0xef, 0xbd, 0x0f,
+	0xf2, 0x0d, 0x78, 0x1d, 0x12, 0xcf, 0xf6, 0x69, 0xbc, 0x26, 0x81, 0xed, 0x26, 0x84, 0xd1, 0x2d,
+	0x8e, 0xad, 0x24, 0xa5, 0x8c, 0x1a, 0xba, 0x94, 0x47, 0x28, 0xa0, 0x34, 0x08, 0xb1, 0x2d, 0x54,
+	0x6f, 0xb7, 0xb6, 0x19, 0x89, 0x70, 0xc6, 0xdc, 0x28, 0x91, 0xc6, 0xd1, 0x00, 0xef, 0x99, 0x1c,
+	0x27, 0x7f, 0x35, 0xd0, 0x9f, 0xff, 0xfc, 0xbe, 0xac, 0x6a, 0x8c, 0xaf, 0xa0, 0x13, 0xbb, 0x11,
+	0x36, 0xd5, 0xb1, 0x3a, 0x1b, 0x2c, 0x66, 0x05, 0x47, 0x82, 0x4b, 0x8e, 0x5e, 0xee, 0xa3, 0xf0,
+	0xcb, 0xa4, 0x82, 0xf7, 0x2e, 0x63, 0xe9, 0xa4, 0x38, 0x4f, 0x07, 0x2d, 0x39, 0xc2, 0x65, 0x7c,
+	0x02, 0xda, 0x16, 0x1f, 0xcc, 0x67, 0x22, 0xfc, 0xa6, 0xe0, 0xa8, 0xc2, 0x92, 0xa3, 0x17, 0x22,
+	0xbb, 0xc5, 0x87, 0x36, 0xda, 0x6f, 0xc0, 0xa9, 0x2c, 0xc6, 0x1c, 0x74, 0x33, 0x9f, 0x26, 0xd8,
+	0xd4, 0x44, 0xf4, 0x5d, 0xc1, 0x91, 0x14, 0x4a, 0x8e, 0x5e, 0x89, 0xb0, 0xa0, 0x36, 0x0e, 0xee,
+	0xe8, 0x48, 0xa3, 0xf1, 0x0d, 0xf4, 0xd6, 0x34, 0x5c, 0xe1, 0x34, 0x33, 0x3b, 0x63, 0xad, 0xde,
+	0xdf, 0x48, 0x25, 0x47, 0x43, 0x51, 0x23, 0xb9, 0xaa, 0xd0, 0xe5, 0xe8, 0x34, 0x96, 0xaa, 0x60,
+	0x85, 0x7f, 0x13, 0x1f, 0x67, 0x66, 0xf7, 0x5e, 0x50, 0x4b, 0x6d, 0x81, 0x64, 0x51, 0x20, 0x47,
+	0xa7, 0xb1, 0x18, 0x14, 0xf4, 0xf0, 0x3e, 0x21, 0x29, 0xce, 0x4c, 0x7d, 0xac, 0xce, 0x9e, 0x7f,
+	0x18, 0x59, 0xf2, 0x0c, 0x56, 0x73, 0x06, 0x6b, 0xd9, 0x9c, 0x61, 0xf1, 0xf9, 0xc4, 0x91, 0x52,
+	0x2d, 0xa8, 0x23, 0x25, 0x47, 0x86, 0x58, 0x50, 0xb3, 0xfc, 0xd5, 0xe3, 0x7f, 0xa4, 0x16, 0xe7,
+	0xe9, 0xf0, 0x51, 0x74, 0x9a, 0xc8, 0xe2, 0xc7, 0xe9, 0x0a, 0x95, 0xcb, 0x15, 0x2a, 0xa7, 0x1b,
+	0x54, 0x2f, 0x37, 0xa8, 0x1e, 0x73, 0xa8, 0xfc, 0xc9, 0xa1, 0x7a, 0xc9, 0xa1, 0xf2, 0x2f, 0x87,
+	0xca, 0xaf, 0xb7, 0x01, 0x61, 0x9b, 0x9d, 0x67, 0xf9, 0x34, 0xb2, 0xb3, 0x43, 0xec, 0xb3, 0x0d,
+	0x89, 0x83, 0x87, 0xe9, 0xfe, 0x92, 0x3c, 0x5d, 0x7c, 0xe4, 0xc7, 0xa7, 0x01, 0x00, 0x56, 0x38,
+	0x86, 0xbf, 0x5e, 0x02, 0x00, 0x00,
+}
+
+func (m *APIToken) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *APIToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expires, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expires):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintApitoken(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Devices[iNdEx])
+			copy(dAtA[i:], m.Devices[iNdEx])
+			i = encodeVarintApitoken(dAtA, i, uint64(len(m.Devices[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintApitoken(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintApitoken(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintApitoken(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApitoken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApitoken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApitoken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *APIToken) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovApitoken(uint64(l))
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovApitoken(uint64(l))
+		}
+	}
+	if len(m.Devices) > 0 {
+		for _, s := range m.Devices {
+			l = len(s)
+			n += 1 + l + sovApitoken(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expires)
+	n += 1 + l + sovApitoken(uint64(l))
+	return n
+}
+
+func sovApitoken(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApitoken(x uint64) (n int) {
+	return sovApitoken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *APIToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApitoken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expires, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApitoken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApitoken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipApitoken(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowApitoken
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApitoken
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthApitoken
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupApitoken
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthApitoken
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthApitoken        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowApitoken          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupApitoken = fmt.Errorf("proto: unexpected end of group")
+)
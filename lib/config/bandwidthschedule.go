@@ -0,0 +1,153 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// BandwidthLimits are the rate limits in effect at some point in time, in
+// KiB/s. Zero or less means unlimited.
+type BandwidthLimits struct {
+	MaxSendKbps int `json:"maxSendKbps"`
+	MaxRecvKbps int `json:"maxRecvKbps"`
+	// The schedule the limits come from, if any.
+	Schedule *BandwidthSchedule `json:"schedule,omitempty"`
+}
+
+// BandwidthLimitsAt returns the overall rate limits in effect at the given
+// time.
+func (opts OptionsConfiguration) BandwidthLimitsAt(t time.Time) BandwidthLimits {
+	return bandwidthLimitsAt(opts.MaxSendKbps, opts.MaxRecvKbps, opts.BandwidthSchedules, t)
+}
+
+// BandwidthLimitsAt returns the rate limits for the device in effect at the
+// given time.
+func (cfg DeviceConfiguration) BandwidthLimitsAt(t time.Time) BandwidthLimits {
+	return bandwidthLimitsAt(cfg.MaxSendKbps, cfg.MaxRecvKbps, cfg.BandwidthSchedules, t)
+}
+
+func bandwidthLimitsAt(send, recv int, schedules []BandwidthSchedule, t time.Time) BandwidthLimits {
+	for i := range schedules {
+		if schedules[i].ActiveAt(t) {
+			s := schedules[i]
+			return BandwidthLimits{
+				MaxSendKbps: s.MaxSendKbps,
+				MaxRecvKbps: s.MaxRecvKbps,
+				Schedule:    &s,
+			}
+		}
+	}
+	return BandwidthLimits{MaxSendKbps: send, MaxRecvKbps: recv}
+}
+
+// ActiveAt returns true if the time falls within the schedule. Invalid
+// schedules are never active.
+func (s BandwidthSchedule) ActiveAt(t time.Time) bool {
+	return windowActiveAt(s.Days, s.Start, s.End, t)
+}
+
+// Validate returns an error describing what is wrong with the schedule, if
+// anything.
+func (s BandwidthSchedule) Validate() error {
+	_, _, _, err := parseWindow(s.Days, s.Start, s.End)
+	return err
+}
+
+// windowActiveAt returns true if the time falls within the weekly window
+// given by days, start and end. Invalid windows are never active.
+func windowActiveAt(daysStr, startStr, endStr string, t time.Time) bool {
+	days, start, end, err := parseWindow(daysStr, startStr, endStr)
+	if err != nil {
+		return false
+	}
+
+	t = t.Local()
+	minute := t.Hour()*60 + t.Minute()
+	today := t.Weekday()
+	yesterday := (today + 6) % 7
+
+	if end <= start {
+		// The window extends past midnight, into the next day.
+		return days[today] && minute >= start || days[yesterday] && minute < end
+	}
+	return days[today] && minute >= start && minute < end
+}
+
+// parseWindow returns the days a weekly window applies to, and its start
+// and end in minutes since midnight.
+func parseWindow(daysStr, startStr, endStr string) (days [7]bool, start, end int, err error) {
+	if start, err = parseClock(startStr); err != nil {
+		return days, 0, 0, fmt.Errorf("start: %w", err)
+	}
+	if end, err = parseClock(endStr); err != nil {
+		return days, 0, 0, fmt.Errorf("end: %w", err)
+	}
+
+	if strings.TrimSpace(daysStr) == "" {
+		for i := range days {
+			days[i] = true
+		}
+		return days, start, end, nil
+	}
+
+	for _, part := range strings.Split(daysStr, ",") {
+		from, to, isRange := strings.Cut(part, "-")
+		first, ok := weekdays[strings.ToLower(strings.TrimSpace(from))]
+		if !ok {
+			return days, 0, 0, fmt.Errorf("unknown day %q", from)
+		}
+		last := first
+		if isRange {
+			if last, ok = weekdays[strings.ToLower(strings.TrimSpace(to))]; !ok {
+				return days, 0, 0, fmt.Errorf("unknown day %q", to)
+			}
+		}
+		// Ranges may wrap around the end of the week, as in "fri-mon".
+		for d := first; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == last {
+				break
+			}
+		}
+	}
+	return days, start, end, nil
+}
+
+// validBandwidthSchedules returns the schedules, less those that are
+// invalid.
+func validBandwidthSchedules(schedules []BandwidthSchedule, what string) []BandwidthSchedule {
+	valid := schedules[:0]
+	for _, s := range schedules {
+		if err := s.Validate(); err != nil {
+			l.Warnf("Ignoring invalid bandwidth schedule for %s: %v", what, err)
+			continue
+		}
+		valid = append(valid, s)
+	}
+	return valid
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,473 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/bandwidthschedule.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A BandwidthSchedule overrides the rate limits during a window of time on
+// certain days of the week.
+type BandwidthSchedule struct {
+	// Days are given as a comma separated list of days and day ranges,
+	// such as "mon-fri" or "sat,sun". Empty means every day.
+	Days string `protobuf:"bytes,1,opt,name=days,proto3" json:"days" xml:"days,attr,omitempty"`
+	// Start and end are given as "15:04" in local time. An end that is
+	// not after the start means the window extends past midnight.
+	Start       string `protobuf:"bytes,2,opt,name=start,proto3" json:"start" xml:"start,attr"`
+	End         string `protobuf:"bytes,3,opt,name=end,proto3" json:"end" xml:"end,attr"`
+	MaxSendKbps int    `protobuf:"varint,4,opt,name=max_send_kbps,json=maxSendKbps,proto3,casttype=int" json:"maxSendKbps" xml:"maxSendKbps,attr"`
+	MaxRecvKbps int    `protobuf:"varint,5,opt,name=max_recv_kbps,json=maxRecvKbps,proto3,casttype=int" json:"maxRecvKbps" xml:"maxRecvKbps,attr"`
+}
+
+func (m *BandwidthSchedule) Reset()         { *m = BandwidthSchedule{} }
+func (m *BandwidthSchedule) String() string { return proto.CompactTextString(m) }
+func (*BandwidthSchedule) ProtoMessage()    {}
+func (*BandwidthSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2853b44393614d11, []int{0}
+}
+func (m *BandwidthSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BandwidthSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BandwidthSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BandwidthSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandwidthSchedule.Merge(m, src)
+}
+func (m *BandwidthSchedule) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *BandwidthSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandwidthSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BandwidthSchedule proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BandwidthSchedule)(nil), "config.BandwidthSchedule")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/bandwidthschedule.proto", fileDescriptor_2853b44393614d11)
+}
+
+var fileDescriptor_2853b44393614d11 = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x4f, 0xf2, 0x40,
+	0x1c, 0xc6, 0xdb, 0xb7, 0x40, 0x5e, 0xfa, 0xe6, 0x35, 0x58, 0x13, 0x53, 0x1d, 0xee, 0x48, 0x83,
+	0x09, 0x46, 0x02, 0x83, 0x83, 0x89, 0x71, 0xb1, 0x71, 0x63, 0x31, 0x65, 0x73, 0x21, 0x6d, 0xef,
+	0xa4, 0x8d, 0xf4, 0xda, 0xb4, 0x07, 0x96, 0x6f, 0xe1, 0x47, 0xf0, 0x4b, 0xf8, 0x1d, 0xd8, 0x60,
+	0x74, 0xba, 0x04, 0xba, 0x75, 0x64, 0x74, 0x32, 0xbd, 0x5a, 0x29, 0x84, 0xad, 0xcf, 0xf3, 0x7f,
+	0x9e, 0xdf, 0x93, 0x26, 0x27, 0x6b, 0x63, 0xd7, 0xea, 0xd9, 0x3e, 0x79, 0x76, 0x47, 0x3d, 0xcb,
+	0x24, 0xe8, 0xd5, 0x45, 0xd4, 0x89, 0x6c, 0x07, 0xa3, 0xc9, 0x18, 0x77, 0x83, 0xd0, 0xa7, 0xbe,
+	0x52, 0xcb, 0xef, 0xe7, 0x75, 0x1c, 0xd3, 0xdc, 0xd2, 0x3e, 0x24, 0xf9, 0x58, 0x2f, 0xe2, 0x83,
+	0x9f, 0xb8, 0xf2, 0x28, 0x57, 0x90, 0x39, 0x8b, 0x54, 0xb1, 0x29, 0xb6, 0xeb, 0xfa, 0x5d, 0xca,
+	0x20, 0xd7, 0x1b, 0x06, 0xcf, 0x62, 0x6f, 0x7c, 0xab, 0x65, 0xa2, 0x63, 0x52, 0x1a, 0x76, 0x7c,
+	0xcf, 0xa5, 0xd8, 0x0b, 0xe8, 0x4c, 0x4b, 0x17, 0xad, 0x93, 0x03, 0xbe, 0xc1, 0x9b, 0xca, 0xbd,
+	0x5c, 0x8d, 0xa8, 0x19, 0x52, 0xf5, 0x0f, 0x47, 0x5e, 0xa5, 0x0c, 0xe6, 0xc6, 0x86, 0xc1, 0x06,
+	0x67, 0x72, 0xc5, 0xcb, 0x19, 0x4a, 0xde, 0x4a, 0x23, 0x0f, 0x2a, 0x37, 0xb2, 0x84, 0x09, 0x52,
+	0x25, 0x0e, 0xb8, 0x48, 0x19, 0xcc, 0xe4, 0x86, 0xc1, 0x23, 0x5e, 0xc7, 0x04, 0xfd, 0x96, 0xff,
+	0x16, 0xc2, 0xc8, 0x22, 0x8a, 0x23, 0xff, 0xf7, 0xcc, 0x78, 0x18, 0x61, 0x82, 0x86, 0x2f, 0x56,
+	0x10, 0xa9, 0x95, 0xa6, 0xd8, 0xae, 0xea, 0x0f, 0x29, 0x83, 0xff, 0x3c, 0x33, 0x1e, 0x60, 0x82,
+	0xfa, 0x56, 0x90, 0xfd, 0xdd, 0x29, 0x47, 0x95, 0xbc, 0x1c, 0xf9, 0xc5, 0xa0, 0xe4, 0x12, 0x9a,
+	0x2e, 0x5a, 0x8d, 0xfd, 0x9b, 0x51, 0x26, 0x14, 0x4b, 0x21, 0xb6, 0xa7, 0xf9, 0x52, 0x75, 0x67,
+	0xc9, 0xc0, 0xf6, 0x74, 0x7f, 0xa9, 0xf0, 0x0e, 0x2d, 0xed, 0xdc, 0x8c, 0x32, 0x41, 0xef, 0xcf,
+	0x57, 0x40, 0x58, 0xae, 0x80, 0x30, 0x5f, 0x03, 0x71, 0xb9, 0x06, 0xe2, 0x5b, 0x02, 0x84, 0xf7,
+	0x04, 0x88, 0xcb, 0x04, 0x08, 0x9f, 0x09, 0x10, 0x9e, 0x2e, 0x47, 0x2e, 0x75, 0x26, 0x56, 0xd7,
+	0xf6, 0xbd, 0x5e, 0x34, 0x23, 0x36, 0x75, 0x5c, 0x32, 0x2a, 0x7d, 0x6d, 0xdf, 0x8b, 0x55, 0xe3,
+	0x6f, 0xe1, 0xfa, 0x7b, 0x00, 0x58, 0x11, 0x10, 0x58, 0x44, 0x02, 0x00, 0x00,
+}
+
+func (m *BandwidthSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BandwidthSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BandwidthSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRecvKbps != 0 {
+		i = encodeVarintBandwidthschedule(dAtA, i, uint64(m.MaxRecvKbps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxSendKbps != 0 {
+		i = encodeVarintBandwidthschedule(dAtA, i, uint64(m.MaxSendKbps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintBandwidthschedule(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintBandwidthschedule(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Days) > 0 {
+		i -= len(m.Days)
+		copy(dAtA[i:], m.Days)
+		i = encodeVarintBandwidthschedule(dAtA, i, uint64(len(m.Days)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBandwidthschedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBandwidthschedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BandwidthSchedule) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Days)
+	if l > 0 {
+		n += 1 + l + sovBandwidthschedule(uint64(l))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovBandwidthschedule(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovBandwidthschedule(uint64(l))
+	}
+	if m.MaxSendKbps != 0 {
+		n += 1 + sovBandwidthschedule(uint64(m.MaxSendKbps))
+	}
+	if m.MaxRecvKbps != 0 {
+		n += 1 + sovBandwidthschedule(uint64(m.MaxRecvKbps))
+	}
+	return n
+}
+
+func sovBandwidthschedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBandwidthschedule(x uint64) (n int) {
+	return sovBandwidthschedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BandwidthSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBandwidthschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BandwidthSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BandwidthSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandwidthschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBandwidthschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandwidthschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Days = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandwidthschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBandwidthschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandwidthschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandwidthschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBandwidthschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandwidthschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSendKbps", wireType)
+			}
+			m.MaxSendKbps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandwidthschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSendKbps |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecvKbps", wireType)
+			}
+			m.MaxRecvKbps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandwidthschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecvKbps |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBandwidthschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBandwidthschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBandwidthschedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBandwidthschedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBandwidthschedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBandwidthschedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBandwidthschedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBandwidthschedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBandwidthschedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBandwidthschedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBandwidthschedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBandwidthschedule = fmt.Errorf("proto: unexpected end of group")
+)
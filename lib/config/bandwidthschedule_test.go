@@ -0,0 +1,73 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBandwidthScheduleActiveAt(t *testing.T) {
+	// 2024-01-05 is a Friday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, 0, 0, time.Local)
+	}
+
+	cases := []struct {
+		sched  BandwidthSchedule
+		t      time.Time
+		active bool
+	}{
+		{BandwidthSchedule{Start: "08:00", End: "17:00"}, at(5, 8, 0), true},
+		{BandwidthSchedule{Start: "08:00", End: "17:00"}, at(5, 16, 59), true},
+		{BandwidthSchedule{Start: "08:00", End: "17:00"}, at(5, 17, 0), false},
+		{BandwidthSchedule{Start: "08:00", End: "17:00"}, at(5, 7, 59), false},
+		{BandwidthSchedule{Days: "mon-fri", Start: "08:00", End: "17:00"}, at(5, 12, 0), true},
+		{BandwidthSchedule{Days: "mon-fri", Start: "08:00", End: "17:00"}, at(6, 12, 0), false},
+		{BandwidthSchedule{Days: "Sat, Sun", Start: "08:00", End: "17:00"}, at(6, 12, 0), true},
+		{BandwidthSchedule{Days: "fri-mon", Start: "08:00", End: "17:00"}, at(8, 12, 0), true},
+		{BandwidthSchedule{Days: "fri-mon", Start: "08:00", End: "17:00"}, at(9, 12, 0), false},
+		// Windows past midnight belong to the day they start on.
+		{BandwidthSchedule{Days: "fri", Start: "22:00", End: "06:00"}, at(5, 23, 0), true},
+		{BandwidthSchedule{Days: "fri", Start: "22:00", End: "06:00"}, at(6, 5, 59), true},
+		{BandwidthSchedule{Days: "fri", Start: "22:00", End: "06:00"}, at(5, 5, 0), false},
+		{BandwidthSchedule{Days: "fri", Start: "22:00", End: "06:00"}, at(6, 23, 0), false},
+		// Equal start and end is the whole day.
+		{BandwidthSchedule{Start: "00:00", End: "00:00"}, at(5, 13, 0), true},
+		// Invalid schedules are never active.
+		{BandwidthSchedule{Days: "someday", Start: "08:00", End: "17:00"}, at(5, 12, 0), false},
+		{BandwidthSchedule{Start: "8am", End: "17:00"}, at(5, 12, 0), false},
+	}
+
+	for i, tc := range cases {
+		if res := tc.sched.ActiveAt(tc.t); res != tc.active {
+			t.Errorf("%d: %+v at %v: got %v, expected %v", i, tc.sched, tc.t, res, tc.active)
+		}
+	}
+}
+
+func TestBandwidthLimitsAt(t *testing.T) {
+	opts := OptionsConfiguration{
+		MaxSendKbps: 1000,
+		MaxRecvKbps: 2000,
+		BandwidthSchedules: []BandwidthSchedule{
+			{Start: "08:00", End: "17:00", MaxSendKbps: 100},
+			{Start: "12:00", End: "13:00", MaxSendKbps: 10},
+		},
+	}
+
+	limits := opts.BandwidthLimitsAt(time.Date(2024, 1, 5, 20, 0, 0, 0, time.Local))
+	if limits.MaxSendKbps != 1000 || limits.MaxRecvKbps != 2000 || limits.Schedule != nil {
+		t.Errorf("unexpected limits outside of schedules: %+v", limits)
+	}
+
+	// The first matching schedule wins.
+	limits = opts.BandwidthLimitsAt(time.Date(2024, 1, 5, 12, 30, 0, 0, time.Local))
+	if limits.MaxSendKbps != 100 || limits.MaxRecvKbps != 0 || limits.Schedule == nil || limits.Schedule.Start != "08:00" {
+		t.Errorf("unexpected limits within schedule: %+v", limits)
+	}
+}
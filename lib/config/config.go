@@ -31,7 +31,7 @@ import (
 
 const (
 	OldestHandledVersion = 10
-	CurrentVersion       = 37
+	CurrentVersion       = 38
 	MaxRescanIntervalS   = 365 * 24 * 60 * 60
 )
 
@@ -223,6 +223,7 @@ func (cfg Configuration) Copy() Configuration {
 
 	newCfg.Options = cfg.Options.Copy()
 	newCfg.GUI = cfg.GUI.Copy()
+	newCfg.LDAP = cfg.LDAP.Copy()
 
 	// DeviceIDs are values
 	newCfg.IgnoredDevices = make([]ObservedDevice, len(cfg.IgnoredDevices))
@@ -252,6 +253,7 @@ func (cfg *Configuration) prepare(myID protocol.DeviceID) error {
 	}
 
 	cfg.GUI.prepare()
+	cfg.LDAP.prepare()
 
 	guiPWIsSet := cfg.GUI.User != "" && cfg.GUI.Password != ""
 	cfg.Options.prepare(guiPWIsSet)
@@ -92,6 +92,11 @@ func TestDefaultValues(t *testing.T) {
 			RawStunServers:            []string{"default"},
 			AnnounceLANAddresses:      true,
 			FeatureFlags:              []string{},
+			BandwidthSchedules:        []BandwidthSchedule{},
+			MeteredInterfaces:         []string{},
+			MeteredSubnets:            []string{},
+			Webhooks:                  []Webhook{},
+			AuditTrailMaxSizeMiB:      10,
 			ConnectionPriorityTCPLAN:  10,
 			ConnectionPriorityQUICLAN: 20,
 			ConnectionPriorityTCPWAN:  30,
@@ -118,17 +123,31 @@ func TestDefaultValues(t *testing.T) {
 				WeakHashThresholdPct: 25,
 				MarkerName:           ".stfolder",
 				MaxConcurrentWrites:  2,
+				Priority:             1,
+				SyncWindows:          []SyncWindow{},
+				Hooks:                []FolderHook{},
 				XattrFilter: XattrFilter{
 					Entries:            []XattrFilterEntry{},
 					MaxSingleEntrySize: 1024,
 					MaxTotalSize:       4096,
 				},
+				Merge: MergeConfiguration{
+					Patterns:   []string{},
+					MaxSizeKiB: 1024,
+				},
+				MassChange: MassChangeConfiguration{
+					MinFiles: 100,
+				},
+				Snapshot: SnapshotConfiguration{
+					TimeoutS: 600,
+				},
 			},
 			Device: DeviceConfiguration{
-				Addresses:       []string{"dynamic"},
-				AllowedNetworks: []string{},
-				Compression:     protocol.CompressionMetadata,
-				IgnoredFolders:  []ObservedFolder{},
+				Addresses:          []string{"dynamic"},
+				AllowedNetworks:    []string{},
+				Compression:        protocol.CompressionMetadata,
+				IgnoredFolders:     []ObservedFolder{},
+				BandwidthSchedules: []BandwidthSchedule{},
 			},
 			Ignores: Ignores{
 				Lines: []string{},
@@ -194,28 +213,36 @@ func TestDeviceConfig(t *testing.T) {
 				MarkerName:           DefaultMarkerName,
 				JunctionsAsDirs:      true,
 				MaxConcurrentWrites:  maxConcurrentWritesDefault,
+				Priority:             1,
+				SyncWindows:          []SyncWindow{},
+				Hooks:                []FolderHook{},
 				XattrFilter: XattrFilter{
 					Entries: []XattrFilterEntry{},
 				},
+				Merge: MergeConfiguration{
+					Patterns: []string{},
+				},
 			},
 		}
 
 		expectedDevices := []DeviceConfiguration{
 			{
-				DeviceID:        device1,
-				Name:            "node one",
-				Addresses:       []string{"tcp://a"},
-				Compression:     protocol.CompressionMetadata,
-				AllowedNetworks: []string{},
-				IgnoredFolders:  []ObservedFolder{},
+				DeviceID:           device1,
+				Name:               "node one",
+				Addresses:          []string{"tcp://a"},
+				Compression:        protocol.CompressionMetadata,
+				AllowedNetworks:    []string{},
+				IgnoredFolders:     []ObservedFolder{},
+				BandwidthSchedules: []BandwidthSchedule{},
 			},
 			{
-				DeviceID:        device4,
-				Name:            "node two",
-				Addresses:       []string{"tcp://b"},
-				Compression:     protocol.CompressionMetadata,
-				AllowedNetworks: []string{},
-				IgnoredFolders:  []ObservedFolder{},
+				DeviceID:           device4,
+				Name:               "node two",
+				Addresses:          []string{"tcp://b"},
+				Compression:        protocol.CompressionMetadata,
+				AllowedNetworks:    []string{},
+				IgnoredFolders:     []ObservedFolder{},
+				BandwidthSchedules: []BandwidthSchedule{},
 			},
 		}
 		expectedDeviceIDs := []protocol.DeviceID{device1, device4}
@@ -290,6 +317,13 @@ func TestOverriddenValues(t *testing.T) {
 		StunKeepaliveMinS:         900,
 		RawStunServers:            []string{"foo"},
 		FeatureFlags:              []string{"feature"},
+		BandwidthSchedules:        []BandwidthSchedule{},
+		MeteredInterfaces:         []string{},
+		MeteredSubnets:            []string{},
+		Webhooks:                  []Webhook{},
+		AuditTrailEnabled:         true,
+		AuditTrailMaxSizeMiB:      20,
+		AuditTrailMaxFiles:        5,
 		ConnectionPriorityTCPLAN:  40,
 		ConnectionPriorityQUICLAN: 45,
 		ConnectionPriorityTCPWAN:  50,
@@ -318,30 +352,34 @@ func TestDeviceAddressesDynamic(t *testing.T) {
 	name, _ := os.Hostname()
 	expected := map[protocol.DeviceID]DeviceConfiguration{
 		device1: {
-			DeviceID:        device1,
-			Addresses:       []string{"dynamic"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:           device1,
+			Addresses:          []string{"dynamic"},
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 		device2: {
-			DeviceID:        device2,
-			Addresses:       []string{"dynamic"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:           device2,
+			Addresses:          []string{"dynamic"},
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 		device3: {
-			DeviceID:        device3,
-			Addresses:       []string{"dynamic"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:           device3,
+			Addresses:          []string{"dynamic"},
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 		device4: {
-			DeviceID:        device4,
-			Name:            name, // Set when auto created
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:           device4,
+			Name:               name, // Set when auto created
+			Addresses:          []string{"dynamic"},
+			Compression:        protocol.CompressionMetadata,
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 	}
 
@@ -361,33 +399,39 @@ func TestDeviceCompression(t *testing.T) {
 	name, _ := os.Hostname()
 	expected := map[protocol.DeviceID]DeviceConfiguration{
 		device1: {
-			DeviceID:        device1,
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:           device1,
+			Addresses:          []string{"dynamic"},
+			Compression:        protocol.CompressionMetadata,
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 		device2: {
-			DeviceID:        device2,
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:             device2,
+			Addresses:            []string{"dynamic"},
+			Compression:          protocol.CompressionMetadata,
+			CompressionAlgorithm: protocol.CompressionAlgorithmZstd,
+			CompressionLevel:     9,
+			AllowedNetworks:      []string{},
+			IgnoredFolders:       []ObservedFolder{},
+			BandwidthSchedules:   []BandwidthSchedule{},
 		},
-		device3: {
-			DeviceID:        device3,
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionNever,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+		device3: { // invalid compression level reset to the default
+			DeviceID:           device3,
+			Addresses:          []string{"dynamic"},
+			Compression:        protocol.CompressionNever,
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 		device4: {
-			DeviceID:        device4,
-			Name:            name, // Set when auto created
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:           device4,
+			Name:               name, // Set when auto created
+			Addresses:          []string{"dynamic"},
+			Compression:        protocol.CompressionMetadata,
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 	}
 
@@ -407,30 +451,34 @@ func TestDeviceAddressesStatic(t *testing.T) {
 	name, _ := os.Hostname()
 	expected := map[protocol.DeviceID]DeviceConfiguration{
 		device1: {
-			DeviceID:        device1,
-			Addresses:       []string{"tcp://192.0.2.1", "tcp://192.0.2.2"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:           device1,
+			Addresses:          []string{"tcp://192.0.2.1", "tcp://192.0.2.2"},
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 		device2: {
-			DeviceID:        device2,
-			Addresses:       []string{"tcp://192.0.2.3:6070", "tcp://[2001:db8::42]:4242"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:           device2,
+			Addresses:          []string{"tcp://192.0.2.3:6070", "tcp://[2001:db8::42]:4242"},
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 		device3: {
-			DeviceID:        device3,
-			Addresses:       []string{"tcp://[2001:db8::44]:4444", "tcp://192.0.2.4:6090"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:           device3,
+			Addresses:          []string{"tcp://[2001:db8::44]:4444", "tcp://192.0.2.4:6090"},
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 		device4: {
-			DeviceID:        device4,
-			Name:            name, // Set when auto created
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:           device4,
+			Name:               name, // Set when auto created
+			Addresses:          []string{"dynamic"},
+			Compression:        protocol.CompressionMetadata,
+			AllowedNetworks:    []string{},
+			IgnoredFolders:     []ObservedFolder{},
+			BandwidthSchedules: []BandwidthSchedule{},
 		},
 	}
 
@@ -0,0 +1,71 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/databasebackend.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DatabaseBackend int32
+
+const (
+	DatabaseBackendLevelDB DatabaseBackend = 0
+	DatabaseBackendSQLite  DatabaseBackend = 1
+)
+
+var DatabaseBackend_name = map[int32]string{
+	0: "DATABASE_BACKEND_LEVELDB",
+	1: "DATABASE_BACKEND_SQLITE",
+}
+
+var DatabaseBackend_value = map[string]int32{
+	"DATABASE_BACKEND_LEVELDB": 0,
+	"DATABASE_BACKEND_SQLITE":  1,
+}
+
+func (DatabaseBackend) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_35419c964dd70c78, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("config.DatabaseBackend", DatabaseBackend_name, DatabaseBackend_value)
+}
+
+func init() { proto.RegisterFile("lib/config/databasebackend.proto", fileDescriptor_35419c964dd70c78) }
+
+var fileDescriptor_35419c964dd70c78 = []byte{
+	// 258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xc9, 0x4c, 0xd2,
+	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x4f, 0x49, 0x2c, 0x49, 0x4c, 0x4a, 0x2c, 0x4e, 0x4d,
+	0x4a, 0x4c, 0xce, 0x4e, 0xcd, 0x4b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xc8,
+	0x4a, 0x29, 0x17, 0xa5, 0x16, 0xe4, 0x17, 0xeb, 0x83, 0x05, 0x93, 0x4a, 0xd3, 0xf4, 0xd3, 0xf3,
+	0xd3, 0xf3, 0xc1, 0x1c, 0x30, 0x0b, 0xa2, 0x58, 0x8a, 0x33, 0xb5, 0xa2, 0x04, 0xc2, 0xd4, 0xda,
+	0xc3, 0xc8, 0xc5, 0xef, 0x02, 0x35, 0xd1, 0x09, 0x62, 0xa2, 0x50, 0x10, 0x97, 0x84, 0x8b, 0x63,
+	0x88, 0xa3, 0x93, 0x63, 0xb0, 0x6b, 0xbc, 0x93, 0xa3, 0xb3, 0xb7, 0xab, 0x9f, 0x4b, 0xbc, 0x8f,
+	0x6b, 0x98, 0xab, 0x8f, 0x8b, 0x93, 0x00, 0x83, 0x94, 0x49, 0xd7, 0x5c, 0x05, 0x31, 0x34, 0x2d,
+	0x3e, 0xa9, 0x65, 0xa9, 0x39, 0x2e, 0x4e, 0x97, 0xfa, 0x54, 0x71, 0xc8, 0x08, 0xf9, 0x73, 0x89,
+	0x63, 0x98, 0x19, 0x1c, 0xe8, 0xe3, 0x19, 0xe2, 0x2a, 0xc0, 0x28, 0x65, 0xd4, 0x35, 0x57, 0x41,
+	0x14, 0x4d, 0x63, 0x70, 0xa0, 0x4f, 0x66, 0x49, 0xea, 0xa5, 0x3e, 0x55, 0xec, 0x12, 0x52, 0x2c,
+	0x2b, 0x96, 0xc8, 0x31, 0x38, 0x79, 0x9f, 0x78, 0x28, 0xc7, 0x70, 0xe1, 0xa1, 0x1c, 0xc3, 0x89,
+	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0xb0, 0xe0, 0xb1, 0x1c, 0xe3,
+	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26,
+	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x57, 0xe6, 0x25, 0x97, 0x64, 0x64, 0xe6, 0xa5, 0x23, 0xb1,
+	0x10, 0x21, 0x9b, 0xc4, 0x06, 0x0e, 0x12, 0x63, 0xc0, 0x00, 0x38, 0x0c, 0x48, 0x7d, 0x6e, 0x01,
+	0x00, 0x00,
+}
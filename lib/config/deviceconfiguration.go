@@ -9,6 +9,8 @@ package config
 import (
 	"fmt"
 	"sort"
+
+	"github.com/syncthing/syncthing/lib/protocol"
 )
 
 const defaultNumConnections = 1 // number of connections to use by default; may change in the future.
@@ -21,6 +23,8 @@ func (cfg DeviceConfiguration) Copy() DeviceConfiguration {
 	copy(c.AllowedNetworks, cfg.AllowedNetworks)
 	c.IgnoredFolders = make([]ObservedFolder, len(cfg.IgnoredFolders))
 	copy(c.IgnoredFolders, cfg.IgnoredFolders)
+	c.BandwidthSchedules = make([]BandwidthSchedule, len(cfg.BandwidthSchedules))
+	copy(c.BandwidthSchedules, cfg.BandwidthSchedules)
 	return c
 }
 
@@ -37,6 +41,13 @@ func (cfg *DeviceConfiguration) prepare(sharedFolders []string) {
 
 	cfg.IgnoredFolders = sortedObservedFolderSlice(ignoredFolders)
 
+	if cfg.CompressionLevel < 0 || cfg.CompressionLevel > protocol.MaxZstdLevel {
+		l.Warnf("Device %s (%s) has invalid compression level %d, using the default", cfg.DeviceID.Short(), cfg.Name, cfg.CompressionLevel)
+		cfg.CompressionLevel = 0
+	}
+
+	cfg.BandwidthSchedules = validBandwidthSchedules(cfg.BandwidthSchedules, fmt.Sprintf("device %s (%s)", cfg.DeviceID.Short(), cfg.Name))
+
 	// A device cannot be simultaneously untrusted and an introducer, nor
 	// auto accept folders.
 	if cfg.Untrusted {
@@ -45,6 +45,10 @@ type DeviceConfiguration struct {
 	Untrusted                bool                                                 `protobuf:"varint,17,opt,name=untrusted,proto3" json:"untrusted" xml:"untrusted"`
 	RemoteGUIPort            int                                                  `protobuf:"varint,18,opt,name=remote_gui_port,json=remoteGuiPort,proto3,casttype=int" json:"remoteGUIPort" xml:"remoteGUIPort"`
 	RawNumConnections        int                                                  `protobuf:"varint,19,opt,name=num_connections,json=numConnections,proto3,casttype=int" json:"numConnections" xml:"numConnections"`
+	CompressionAlgorithm     protocol.CompressionAlgorithm                        `protobuf:"varint,20,opt,name=compression_algorithm,json=compressionAlgorithm,proto3,enum=protocol.CompressionAlgorithm" json:"compressionAlgorithm" xml:"compressionAlgorithm"`
+	CompressionLevel         int                                                  `protobuf:"varint,21,opt,name=compression_level,json=compressionLevel,proto3,casttype=int" json:"compressionLevel" xml:"compressionLevel"`
+	BandwidthSchedules       []BandwidthSchedule                                  `protobuf:"bytes,22,rep,name=bandwidth_schedules,json=bandwidthSchedules,proto3" json:"bandwidthSchedules" xml:"bandwidthSchedule"`
+	PauseWhenMetered         bool                                                 `protobuf:"varint,23,opt,name=pause_when_metered,json=pauseWhenMetered,proto3" json:"pauseWhenMetered" xml:"pauseWhenMetered"`
 }
 
 func (m *DeviceConfiguration) Reset()         { *m = DeviceConfiguration{} }
@@ -89,74 +93,84 @@ func init() {
 }
 
 var fileDescriptor_744b782bd13071dd = []byte{
-	// 1057 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xe9, 0x6e, 0xb7, 0x99, 0x6d, 0x9b, 0xc6, 0x65, 0xbb, 0xde, 0x4a, 0x9b, 0x89, 0x42,
-	0x0e, 0x41, 0xec, 0xa6, 0xa8, 0x70, 0xaa, 0x00, 0x89, 0xb4, 0x82, 0xad, 0x2a, 0xba, 0x65, 0x10,
-	0x97, 0xdd, 0x83, 0x71, 0x3c, 0xd3, 0xac, 0xd5, 0x78, 0xc6, 0xd8, 0xe3, 0xb4, 0x95, 0x38, 0x72,
-	0x80, 0x1b, 0xaa, 0xc4, 0x89, 0xcb, 0xc2, 0xdf, 0xe0, 0xc0, 0xb5, 0xb7, 0xe6, 0x08, 0x1c, 0x46,
-	0xda, 0xf4, 0xe6, 0xa3, 0x8f, 0x9c, 0xd0, 0x8c, 0x1d, 0xc7, 0x76, 0x37, 0x2b, 0x24, 0x6e, 0x9e,
-	0xef, 0x7b, 0xf3, 0x7d, 0xf3, 0x9e, 0xdf, 0x9b, 0x01, 0xed, 0xa1, 0xd3, 0xdf, 0xb2, 0x19, 0x3d,
-	0x76, 0x06, 0x5b, 0x98, 0x8c, 0x1c, 0x9b, 0x24, 0x8b, 0xd0, 0xb7, 0xb8, 0xc3, 0x68, 0xd7, 0xf3,
-	0x19, 0x67, 0xfa, 0x62, 0x02, 0x6e, 0x6e, 0xc8, 0x68, 0x05, 0xd9, 0x6c, 0xb8, 0xd5, 0x27, 0x5e,
-	0xc2, 0x6f, 0x3e, 0xc8, 0xa9, 0xb0, 0x7e, 0x40, 0xfc, 0x11, 0xc1, 0x29, 0x55, 0x25, 0x67, 0x3c,
-	0xf9, 0x6c, 0xfd, 0x55, 0x07, 0xeb, 0x7b, 0xca, 0x63, 0x37, 0xef, 0xa1, 0xff, 0xa1, 0x81, 0x6a,
-	0xe2, 0x6d, 0x3a, 0xd8, 0xd0, 0x9a, 0x5a, 0x67, 0xb9, 0xf7, 0xab, 0x76, 0x29, 0x60, 0xe5, 0x6f,
-	0x01, 0x3f, 0x1c, 0x38, 0xfc, 0x45, 0xd8, 0xef, 0xda, 0xcc, 0xdd, 0x0a, 0xce, 0xa9, 0xcd, 0x5f,
-	0x38, 0x74, 0x90, 0xfb, 0xca, 0x9f, 0xa8, 0x9b, 0xa8, 0xef, 0xef, 0x4d, 0x04, 0x5c, 0x9a, 0x7e,
-	0x47, 0x02, 0x2e, 0xe1, 0xf4, 0x3b, 0x16, 0xb0, 0x71, 0xe6, 0x0e, 0x77, 0x5a, 0x0e, 0x7e, 0x64,
-	0x71, 0xee, 0xb7, 0x9a, 0x94, 0x61, 0x72, 0x6c, 0x85, 0x43, 0xbe, 0xd3, 0xe2, 0x7e, 0x48, 0x5a,
-	0xd1, 0x55, 0xfb, 0x4e, 0x4a, 0xc6, 0x57, 0xed, 0x6c, 0xe3, 0x0f, 0xe3, 0xb6, 0x76, 0x31, 0x6e,
-	0x67, 0xa2, 0x2f, 0xc7, 0x6d, 0x0d, 0x4d, 0x59, 0xac, 0x1f, 0x81, 0x5b, 0xd4, 0x72, 0x89, 0xf1,
-	0x56, 0x53, 0xeb, 0x54, 0x7b, 0x1f, 0x45, 0x02, 0xaa, 0x75, 0x2c, 0xe0, 0x03, 0x65, 0x27, 0x17,
-	0x4a, 0xf3, 0x11, 0x73, 0x1d, 0x4e, 0x5c, 0x8f, 0x9f, 0x4b, 0xa7, 0xf5, 0xd7, 0xe0, 0x48, 0xed,
-	0xd4, 0x9f, 0x83, 0xaa, 0x85, 0xb1, 0x4f, 0x82, 0x80, 0x04, 0xc6, 0x42, 0x73, 0xa1, 0x53, 0xed,
-	0x7d, 0x1c, 0x09, 0x38, 0x03, 0x63, 0x01, 0xef, 0x2b, 0xed, 0x14, 0x29, 0x2a, 0xd7, 0x6f, 0xa0,
-	0x68, 0xb6, 0x55, 0x1f, 0x81, 0xbb, 0x36, 0x73, 0x3d, 0xb9, 0x72, 0x18, 0x35, 0x6e, 0x35, 0xb5,
-	0xce, 0xea, 0xf6, 0xbd, 0x6e, 0x56, 0xc6, 0xdd, 0x19, 0xa9, 0x5c, 0xf3, 0xd1, 0xb1, 0x80, 0x1b,
-	0xca, 0x37, 0x87, 0x25, 0xb5, 0x8c, 0xae, 0xda, 0x6b, 0x65, 0x10, 0xe5, 0xb7, 0xea, 0x04, 0x54,
-	0x6d, 0xe2, 0x73, 0x53, 0xd5, 0xea, 0xb6, 0xaa, 0xd5, 0x13, 0xf9, 0x7b, 0x24, 0x78, 0x98, 0xd4,
-	0xeb, 0x61, 0xa2, 0x9d, 0x02, 0xaf, 0xa9, 0xd9, 0xfd, 0x39, 0x1c, 0xca, 0x54, 0xf4, 0x67, 0x00,
-	0x38, 0x94, 0xfb, 0x0c, 0x87, 0x36, 0xf1, 0x8d, 0xc5, 0xa6, 0xd6, 0x59, 0xea, 0xed, 0x44, 0x02,
-	0xe6, 0xd0, 0x58, 0xc0, 0x7b, 0x49, 0x23, 0x64, 0x50, 0x96, 0x44, 0xad, 0x84, 0xa1, 0xdc, 0x3e,
-	0xfd, 0x37, 0x0d, 0x6c, 0x06, 0x27, 0x8e, 0x67, 0x4e, 0x31, 0xd9, 0xc1, 0xa6, 0x4f, 0x5c, 0x36,
-	0xb2, 0x86, 0x81, 0x71, 0x47, 0x99, 0xe1, 0x48, 0x40, 0x43, 0x46, 0xed, 0xe7, 0x82, 0x50, 0x1a,
-	0x13, 0x0b, 0xf8, 0x8e, 0xb2, 0x9e, 0x17, 0x90, 0x1d, 0xe4, 0xe1, 0x1b, 0x23, 0xd0, 0x5c, 0x07,
-	0xfd, 0x77, 0x0d, 0xac, 0x64, 0x67, 0xc6, 0x66, 0xff, 0xdc, 0x58, 0x52, 0x43, 0xf5, 0xf3, 0xff,
-	0x1a, 0xaa, 0x48, 0xc0, 0xe5, 0x99, 0x6a, 0xef, 0x3c, 0x16, 0xb0, 0x53, 0xac, 0x21, 0xee, 0x9d,
-	0xcf, 0x1f, 0xab, 0xfa, 0x8d, 0x30, 0x39, 0x54, 0x6a, 0x90, 0x0a, 0xb2, 0xfa, 0x36, 0x58, 0xf4,
-	0xac, 0x30, 0x20, 0xd8, 0xa8, 0xaa, 0x6a, 0x6e, 0x46, 0x02, 0xa6, 0x48, 0x2c, 0xe0, 0xb2, 0xb2,
-	0x4c, 0x96, 0x2d, 0x94, 0xe2, 0xfa, 0x77, 0x60, 0xcd, 0x1a, 0x0e, 0xd9, 0x29, 0xc1, 0x26, 0x25,
-	0xfc, 0x94, 0xf9, 0x27, 0x81, 0x01, 0xd4, 0xd4, 0x7c, 0x19, 0x09, 0x58, 0x4b, 0xb9, 0xc3, 0x94,
-	0xca, 0xae, 0x81, 0x22, 0x5e, 0x6c, 0x34, 0x63, 0x1e, 0x89, 0xca, 0x72, 0xfa, 0x37, 0x60, 0xdd,
-	0x0a, 0x39, 0x33, 0x2d, 0xdb, 0x26, 0x1e, 0x37, 0x8f, 0xd9, 0x10, 0x13, 0x3f, 0x30, 0xee, 0xaa,
-	0xe3, 0xbf, 0x1f, 0x09, 0x58, 0x97, 0xf4, 0xa7, 0x8a, 0xfd, 0x2c, 0x21, 0x67, 0xe3, 0x5b, 0x66,
-	0x5a, 0xe8, 0x66, 0xb4, 0xfe, 0x14, 0xac, 0xb8, 0xd6, 0x99, 0x19, 0x10, 0x8a, 0xcd, 0x93, 0xbe,
-	0x17, 0x18, 0xcb, 0x4d, 0xad, 0x73, 0xbb, 0xf7, 0x9e, 0x1c, 0x4e, 0xd7, 0x3a, 0xfb, 0x8a, 0x50,
-	0x7c, 0xd0, 0xf7, 0xa4, 0x6a, 0x5d, 0xa9, 0xe6, 0xb0, 0xd6, 0x3f, 0x02, 0x2e, 0x38, 0x94, 0xa3,
-	0x7c, 0xe0, 0x54, 0xd0, 0x27, 0xf6, 0x28, 0x11, 0x5c, 0x29, 0x08, 0x22, 0x62, 0x8f, 0xca, 0x82,
-	0x53, 0xac, 0x20, 0x38, 0x05, 0x75, 0x0a, 0x6a, 0xce, 0x80, 0x32, 0x9f, 0xe0, 0x2c, 0xff, 0xd5,
-	0xe6, 0x42, 0xe7, 0xee, 0xf6, 0x46, 0x37, 0x79, 0x18, 0xba, 0x4f, 0xd3, 0x87, 0x21, 0xc9, 0xa9,
-	0xf7, 0x58, 0xf6, 0x62, 0x24, 0xe0, 0x6a, 0xba, 0x6d, 0x56, 0x98, 0xf5, 0xa4, 0xab, 0xf2, 0x70,
-	0x0b, 0x95, 0xc2, 0xf4, 0x1f, 0x35, 0x50, 0xf3, 0x08, 0xc5, 0x0e, 0x1d, 0x64, 0x86, 0xb5, 0x37,
-	0x1a, 0x3e, 0x91, 0x86, 0x13, 0x01, 0x8d, 0x3d, 0xe2, 0xf9, 0xc4, 0xb6, 0x38, 0xc1, 0x47, 0x89,
-	0x40, 0xaa, 0x19, 0x09, 0xa8, 0x3d, 0xce, 0xee, 0x20, 0x2f, 0xcf, 0xe5, 0x5a, 0xc3, 0xd0, 0xd0,
-	0x6a, 0x81, 0x0b, 0xf4, 0x5f, 0x34, 0x50, 0x4b, 0xaa, 0xf9, 0x6d, 0x48, 0x02, 0x6e, 0x9e, 0x38,
-	0x7d, 0x63, 0x4d, 0xd5, 0x33, 0x98, 0x08, 0xb8, 0xf2, 0x85, 0x2c, 0x93, 0x62, 0x0e, 0x9c, 0x5e,
-	0x24, 0xe0, 0x8a, 0x9b, 0x07, 0xb2, 0x84, 0x0b, 0xe8, 0xb4, 0xc8, 0xd1, 0x55, 0xbb, 0x14, 0x5e,
-	0x06, 0x2e, 0xc6, 0xed, 0xa2, 0x03, 0x2a, 0xf0, 0x7d, 0xfd, 0x13, 0x50, 0x0d, 0x29, 0xf7, 0xc3,
-	0x80, 0x13, 0x6c, 0xd4, 0x55, 0x4f, 0x36, 0xe5, 0x53, 0x92, 0x81, 0xb1, 0x80, 0x35, 0x75, 0x82,
-	0x0c, 0x69, 0xa1, 0x19, 0xab, 0xb2, 0x93, 0x17, 0x1c, 0x27, 0xe6, 0x20, 0x74, 0x4c, 0x8f, 0xf9,
-	0xdc, 0xd0, 0x67, 0xd9, 0x21, 0x45, 0x7d, 0xfe, 0xf5, 0xfe, 0x11, 0xf3, 0xb9, 0xcc, 0xce, 0xcf,
-	0x03, 0x59, 0x76, 0x05, 0x34, 0x9f, 0x5d, 0x31, 0xbc, 0x0c, 0xc8, 0xec, 0x0a, 0x0e, 0x68, 0xca,
-	0x87, 0x8e, 0x5c, 0xea, 0xdf, 0x6b, 0xa0, 0x46, 0x43, 0xd7, 0xb4, 0x19, 0xa5, 0x44, 0x5d, 0x83,
-	0x81, 0xb1, 0xae, 0x4e, 0xf7, 0x7c, 0x22, 0x60, 0x1d, 0x59, 0xa7, 0x87, 0xa1, 0xbb, 0x3b, 0x23,
-	0x65, 0xc7, 0xd1, 0x02, 0x12, 0x0b, 0xf8, 0x76, 0xf2, 0x4a, 0x17, 0xe0, 0xe9, 0x19, 0x2f, 0xc6,
-	0xed, 0x9b, 0x2a, 0xa8, 0xa4, 0xd1, 0x3b, 0xb8, 0x7c, 0xd5, 0xa8, 0x8c, 0x5f, 0x35, 0x2a, 0x97,
-	0x93, 0x86, 0x36, 0x9e, 0x34, 0xb4, 0x9f, 0xae, 0x1b, 0x95, 0x97, 0xd7, 0x0d, 0x6d, 0x7c, 0xdd,
-	0xa8, 0xfc, 0x79, 0xdd, 0xa8, 0x3c, 0x7b, 0xf7, 0x3f, 0xdc, 0xb9, 0x49, 0xe3, 0xf6, 0x17, 0xd5,
-	0xdd, 0xfb, 0xc1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0x19, 0xf5, 0x92, 0x9d, 0x09, 0x00,
-	0x00,
+	// 1230 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x49, 0x9b, 0x66, 0xa7, 0x49, 0xb6, 0x3b, 0x69, 0x52, 0x37, 0x52, 0x77, 0x56, 0x66,
+	0x0f, 0x8b, 0x68, 0x37, 0xa8, 0x7c, 0x1c, 0x2a, 0x40, 0xaa, 0x5b, 0x41, 0x4b, 0xe9, 0x07, 0xae,
+	0x10, 0x52, 0x8b, 0x64, 0x6c, 0xcf, 0x74, 0xd7, 0xca, 0x7a, 0x6c, 0xec, 0xf1, 0x26, 0x91, 0x38,
+	0x72, 0x00, 0x71, 0x41, 0x95, 0xe0, 0xc2, 0xa5, 0xf0, 0x6f, 0x70, 0x40, 0xe2, 0x94, 0x5b, 0xf6,
+	0x88, 0x38, 0x8c, 0xd4, 0xcd, 0xcd, 0x47, 0x1f, 0x39, 0x21, 0x8f, 0x3f, 0xd6, 0xf6, 0x6e, 0x2a,
+	0x24, 0x6e, 0x9e, 0xdf, 0xef, 0xcd, 0xef, 0x7d, 0xec, 0x7b, 0xf3, 0x16, 0x74, 0x47, 0xb6, 0xb9,
+	0x6b, 0xb9, 0xf4, 0x99, 0x3d, 0xd8, 0xc5, 0x64, 0x6c, 0x5b, 0x24, 0x3d, 0x84, 0xbe, 0xc1, 0x6c,
+	0x97, 0xf6, 0x3d, 0xdf, 0x65, 0x2e, 0x5c, 0x49, 0xc1, 0x9d, 0xed, 0xc4, 0x5a, 0x40, 0x96, 0x3b,
+	0xda, 0x35, 0x89, 0x97, 0xf2, 0x3b, 0x97, 0x4b, 0x2a, 0xae, 0x19, 0x10, 0x7f, 0x4c, 0x70, 0x46,
+	0x29, 0x25, 0xca, 0x34, 0x28, 0xde, 0xb7, 0x31, 0x1b, 0x06, 0xd6, 0x90, 0xe0, 0x70, 0x44, 0x32,
+	0x9b, 0x06, 0x39, 0x60, 0xe9, 0xa7, 0xf2, 0xe7, 0x16, 0xd8, 0xbc, 0x2d, 0xe2, 0xb8, 0x55, 0x8e,
+	0x03, 0xfe, 0x21, 0x81, 0x46, 0x1a, 0x9f, 0x6e, 0x63, 0x59, 0xea, 0x48, 0xbd, 0x35, 0xf5, 0x57,
+	0xe9, 0x88, 0xa3, 0xa5, 0xbf, 0x39, 0x7a, 0x67, 0x60, 0xb3, 0x61, 0x68, 0xf6, 0x2d, 0xd7, 0xd9,
+	0x0d, 0x0e, 0xa9, 0xc5, 0x86, 0x36, 0x1d, 0x94, 0xbe, 0xca, 0x51, 0xf7, 0x53, 0xf5, 0xbb, 0xb7,
+	0xa7, 0x1c, 0xad, 0xe6, 0xdf, 0x11, 0x47, 0xab, 0x38, 0xfb, 0x8e, 0x39, 0x6a, 0x1f, 0x38, 0xa3,
+	0x1b, 0x8a, 0x8d, 0xaf, 0x1a, 0x8c, 0xf9, 0x4a, 0x87, 0xba, 0x98, 0x3c, 0x33, 0xc2, 0x11, 0xbb,
+	0xa1, 0x30, 0x3f, 0x24, 0x4a, 0x74, 0xdc, 0x3d, 0x97, 0x91, 0xf1, 0x71, 0xb7, 0xb8, 0xf8, 0xdd,
+	0xa4, 0x2b, 0x3d, 0x9f, 0x74, 0x0b, 0xd1, 0x17, 0x93, 0xae, 0xa4, 0xe5, 0x2c, 0x86, 0x8f, 0xc0,
+	0x19, 0x6a, 0x38, 0x44, 0x7e, 0xad, 0x23, 0xf5, 0x1a, 0xea, 0xfb, 0x11, 0x47, 0xe2, 0x1c, 0x73,
+	0x74, 0x59, 0xb8, 0x4b, 0x0e, 0x42, 0xf3, 0xaa, 0xeb, 0xd8, 0x8c, 0x38, 0x1e, 0x3b, 0x4c, 0x3c,
+	0x6d, 0x2e, 0xc0, 0x35, 0x71, 0x13, 0x3e, 0x05, 0x0d, 0x03, 0x63, 0x9f, 0x04, 0x01, 0x09, 0xe4,
+	0xe5, 0xce, 0x72, 0xaf, 0xa1, 0x7e, 0x10, 0x71, 0x34, 0x03, 0x63, 0x8e, 0x2e, 0x09, 0xed, 0x0c,
+	0xa9, 0x2a, 0xb7, 0xe6, 0x50, 0x6d, 0x76, 0x15, 0x8e, 0xc1, 0x79, 0xcb, 0x75, 0xbc, 0xe4, 0x64,
+	0xbb, 0x54, 0x3e, 0xd3, 0x91, 0x7a, 0x1b, 0xd7, 0xb7, 0xfa, 0x45, 0x19, 0x6f, 0xcd, 0x48, 0xe1,
+	0xb5, 0x6c, 0x1d, 0x73, 0xb4, 0x2d, 0xfc, 0x96, 0xb0, 0xb4, 0x96, 0xd1, 0x71, 0xf7, 0x42, 0x1d,
+	0xd4, 0xca, 0x57, 0x21, 0x01, 0x0d, 0x8b, 0xf8, 0x4c, 0x17, 0xb5, 0x3a, 0x2b, 0x6a, 0x75, 0x27,
+	0xf9, 0x79, 0x12, 0xf0, 0x41, 0x5a, 0xaf, 0x2b, 0xa9, 0x76, 0x06, 0x2c, 0xa8, 0xd9, 0xa5, 0x53,
+	0x38, 0xad, 0x50, 0x81, 0x4f, 0x00, 0xb0, 0x29, 0xf3, 0x5d, 0x1c, 0x5a, 0xc4, 0x97, 0x57, 0x3a,
+	0x52, 0x6f, 0x55, 0xbd, 0x11, 0x71, 0x54, 0x42, 0x63, 0x8e, 0xb6, 0xd2, 0x46, 0x28, 0xa0, 0x22,
+	0x89, 0x66, 0x0d, 0xd3, 0x4a, 0xf7, 0xe0, 0x6f, 0x12, 0xd8, 0x09, 0xf6, 0x6c, 0x4f, 0xcf, 0xb1,
+	0xa4, 0x83, 0x75, 0x9f, 0x38, 0xee, 0xd8, 0x18, 0x05, 0xf2, 0x39, 0xe1, 0x0c, 0x47, 0x1c, 0xc9,
+	0x89, 0xd5, 0xdd, 0x92, 0x91, 0x96, 0xd9, 0xc4, 0x1c, 0xbd, 0x2e, 0x5c, 0x9f, 0x66, 0x50, 0x04,
+	0x72, 0xe5, 0x95, 0x16, 0xda, 0xa9, 0x1e, 0xe0, 0xef, 0x12, 0x58, 0x2f, 0x62, 0xc6, 0xba, 0x79,
+	0x28, 0xaf, 0x8a, 0xa1, 0xfa, 0xe9, 0x7f, 0x0d, 0x55, 0xc4, 0xd1, 0xda, 0x4c, 0x55, 0x3d, 0x8c,
+	0x39, 0xea, 0x55, 0x6b, 0x88, 0xd5, 0xc3, 0xd3, 0xc7, 0xaa, 0x35, 0x67, 0x96, 0x0c, 0x95, 0x18,
+	0xa4, 0x8a, 0x2c, 0xbc, 0x0e, 0x56, 0x3c, 0x23, 0x0c, 0x08, 0x96, 0x1b, 0xa2, 0x9a, 0x3b, 0x11,
+	0x47, 0x19, 0x12, 0x73, 0xb4, 0x26, 0x5c, 0xa6, 0x47, 0x45, 0xcb, 0x70, 0xf8, 0x0d, 0xb8, 0x60,
+	0x8c, 0x46, 0xee, 0x3e, 0xc1, 0x3a, 0x25, 0x6c, 0xdf, 0xf5, 0xf7, 0x02, 0x19, 0x88, 0xa9, 0xf9,
+	0x2c, 0xe2, 0xa8, 0x99, 0x71, 0x0f, 0x32, 0xaa, 0x78, 0x06, 0xaa, 0x78, 0xb5, 0xd1, 0xe4, 0xd3,
+	0x48, 0xad, 0x2e, 0x07, 0xbf, 0x02, 0x9b, 0x46, 0xc8, 0x5c, 0xdd, 0xb0, 0x2c, 0xe2, 0x31, 0xfd,
+	0x99, 0x3b, 0xc2, 0xc4, 0x0f, 0xe4, 0xf3, 0x22, 0xfc, 0xb7, 0x22, 0x8e, 0x5a, 0x09, 0x7d, 0x53,
+	0xb0, 0x1f, 0xa5, 0xe4, 0x6c, 0x7c, 0xeb, 0x8c, 0xa2, 0xcd, 0x5b, 0xc3, 0x87, 0x60, 0xdd, 0x31,
+	0x0e, 0xf4, 0x80, 0x50, 0xac, 0xef, 0x99, 0x5e, 0x20, 0xaf, 0x75, 0xa4, 0xde, 0x59, 0xf5, 0xcd,
+	0x64, 0x38, 0x1d, 0xe3, 0xe0, 0x31, 0xa1, 0xf8, 0x9e, 0xe9, 0x25, 0xaa, 0x2d, 0xa1, 0x5a, 0xc2,
+	0x94, 0x7f, 0x38, 0x5a, 0xb6, 0x29, 0xd3, 0xca, 0x86, 0xb9, 0xa0, 0x4f, 0xac, 0x71, 0x2a, 0xb8,
+	0x5e, 0x11, 0xd4, 0x88, 0x35, 0xae, 0x0b, 0xe6, 0x58, 0x45, 0x30, 0x07, 0x21, 0x05, 0x4d, 0x7b,
+	0x40, 0x5d, 0x9f, 0xe0, 0x22, 0xff, 0x8d, 0xce, 0x72, 0xef, 0xfc, 0xf5, 0xed, 0x7e, 0xba, 0x21,
+	0xfa, 0x0f, 0xb3, 0xe5, 0x91, 0xe6, 0xa4, 0x5e, 0x4b, 0x7a, 0x31, 0xe2, 0x68, 0x23, 0xbb, 0x36,
+	0x2b, 0xcc, 0x66, 0xda, 0x55, 0x65, 0x58, 0xd1, 0x6a, 0x66, 0xf0, 0x7b, 0x09, 0x34, 0x3d, 0x42,
+	0xb1, 0x4d, 0x07, 0x85, 0xc3, 0xe6, 0x2b, 0x1d, 0xde, 0x49, 0x1c, 0x4e, 0x39, 0x92, 0x6f, 0x13,
+	0xcf, 0x27, 0x96, 0xc1, 0x08, 0x7e, 0x94, 0x0a, 0x64, 0x9a, 0x11, 0x47, 0xd2, 0xb5, 0xe2, 0x0d,
+	0xf2, 0xca, 0x5c, 0xa9, 0x35, 0x64, 0x49, 0xdb, 0xa8, 0x70, 0x01, 0xfc, 0x45, 0x02, 0xcd, 0xb4,
+	0x9a, 0x5f, 0x87, 0x24, 0x60, 0xfa, 0x9e, 0x6d, 0xca, 0x17, 0x44, 0x3d, 0x83, 0x29, 0x47, 0xeb,
+	0xf7, 0x93, 0x32, 0x09, 0xe6, 0x9e, 0xad, 0x46, 0x1c, 0xad, 0x3b, 0x65, 0xa0, 0x48, 0xb8, 0x82,
+	0xe6, 0x45, 0x8e, 0x8e, 0xbb, 0x35, 0xf3, 0x3a, 0xf0, 0x7c, 0xd2, 0xad, 0x7a, 0xd0, 0x2a, 0xbc,
+	0x09, 0x3f, 0x04, 0x8d, 0x90, 0x32, 0x3f, 0x0c, 0x18, 0xc1, 0x72, 0x4b, 0xf4, 0x64, 0x27, 0x59,
+	0x25, 0x05, 0x18, 0x73, 0xd4, 0x14, 0x11, 0x14, 0x88, 0xa2, 0xcd, 0x58, 0x91, 0x5d, 0xf2, 0xc0,
+	0x31, 0xa2, 0x0f, 0x42, 0x5b, 0xf7, 0x5c, 0x9f, 0xc9, 0x70, 0x96, 0x9d, 0x26, 0xa8, 0x8f, 0x3f,
+	0xbf, 0xfb, 0xc8, 0xf5, 0x59, 0x92, 0x9d, 0x5f, 0x06, 0x8a, 0xec, 0x2a, 0x68, 0x39, 0xbb, 0xaa,
+	0x79, 0x1d, 0x48, 0xb2, 0xab, 0x78, 0xd0, 0x72, 0x3e, 0xb4, 0x93, 0x23, 0xfc, 0x56, 0x02, 0x4d,
+	0x1a, 0x3a, 0xba, 0xe5, 0x52, 0x4a, 0xc4, 0x33, 0x18, 0xc8, 0x9b, 0x22, 0xba, 0xa7, 0x53, 0x8e,
+	0x5a, 0x9a, 0xb1, 0xff, 0x20, 0x74, 0x6e, 0xcd, 0xc8, 0xa4, 0xe3, 0x68, 0x05, 0x89, 0x39, 0xba,
+	0x98, 0x6e, 0xe9, 0x0a, 0x9c, 0xc7, 0xf8, 0x7c, 0xd2, 0x9d, 0x57, 0xd1, 0x6a, 0x1a, 0xf0, 0x07,
+	0x09, 0x6c, 0x95, 0x56, 0x9d, 0x6e, 0x8c, 0x06, 0xae, 0x6f, 0xb3, 0xa1, 0x23, 0x5f, 0x14, 0xdb,
+	0xb5, 0xbd, 0x70, 0xbb, 0xde, 0xcc, 0xad, 0xd4, 0xf7, 0x22, 0x8e, 0x2e, 0x5a, 0x0b, 0x98, 0x98,
+	0xa3, 0x9d, 0xfa, 0xbe, 0x2d, 0x48, 0x45, 0x5b, 0x78, 0x07, 0x9a, 0xa0, 0x55, 0x0e, 0x66, 0x44,
+	0xc6, 0x64, 0x24, 0x6f, 0x89, 0xaa, 0xbc, 0x1b, 0x71, 0x54, 0x5e, 0xd3, 0x9f, 0x26, 0xdc, 0xa2,
+	0xa5, 0x2e, 0x88, 0x62, 0xd6, 0xe7, 0xae, 0xc0, 0x9f, 0x25, 0xb0, 0x59, 0xfc, 0xe9, 0xd3, 0xf3,
+	0x7f, 0x7d, 0x81, 0xbc, 0x2d, 0x86, 0xf0, 0x72, 0x3e, 0x84, 0x6a, 0x6e, 0xf2, 0x38, 0xb3, 0x50,
+	0x3f, 0xc9, 0x06, 0x1f, 0x9a, 0x75, 0x6a, 0xf6, 0x2a, 0xce, 0x51, 0x62, 0x83, 0xcc, 0xa1, 0xda,
+	0x02, 0x0d, 0xf8, 0x25, 0x80, 0x62, 0x2b, 0xe8, 0xfb, 0x43, 0x42, 0x75, 0x87, 0x30, 0xe2, 0x13,
+	0x2c, 0x5f, 0x12, 0x8d, 0xdf, 0x4f, 0xb2, 0x17, 0xec, 0x17, 0x43, 0x42, 0xef, 0xa7, 0x5c, 0x91,
+	0x7d, 0x9d, 0x50, 0xb4, 0x39, 0x5b, 0xf5, 0xde, 0xd1, 0xcb, 0xf6, 0xd2, 0xe4, 0x65, 0x7b, 0xe9,
+	0x68, 0xda, 0x96, 0x26, 0xd3, 0xb6, 0xf4, 0xe3, 0x49, 0x7b, 0xe9, 0xc5, 0x49, 0x5b, 0x9a, 0x9c,
+	0xb4, 0x97, 0xfe, 0x3a, 0x69, 0x2f, 0x3d, 0x79, 0xe3, 0x3f, 0x2c, 0xd7, 0xb4, 0x38, 0xe6, 0x8a,
+	0x68, 0x8a, 0xb7, 0xff, 0x1d, 0x00, 0x43, 0x5e, 0xb1, 0x8a, 0xaa, 0x0b, 0x00, 0x00,
 }
 
 func (m *DeviceConfiguration) Marshal() (dAtA []byte, err error) {
@@ -179,6 +193,48 @@ func (m *DeviceConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PauseWhenMetered {
+		i--
+		if m.PauseWhenMetered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.BandwidthSchedules) > 0 {
+		for iNdEx := len(m.BandwidthSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BandwidthSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDeviceconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.CompressionLevel != 0 {
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.CompressionLevel))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.CompressionAlgorithm != 0 {
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.CompressionAlgorithm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.RawNumConnections != 0 {
 		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.RawNumConnections))
 		i--
@@ -436,6 +492,21 @@ func (m *DeviceConfiguration) ProtoSize() (n int) {
 	if m.RawNumConnections != 0 {
 		n += 2 + sovDeviceconfiguration(uint64(m.RawNumConnections))
 	}
+	if m.CompressionAlgorithm != 0 {
+		n += 2 + sovDeviceconfiguration(uint64(m.CompressionAlgorithm))
+	}
+	if m.CompressionLevel != 0 {
+		n += 2 + sovDeviceconfiguration(uint64(m.CompressionLevel))
+	}
+	if len(m.BandwidthSchedules) > 0 {
+		for _, e := range m.BandwidthSchedules {
+			l = e.ProtoSize()
+			n += 2 + l + sovDeviceconfiguration(uint64(l))
+		}
+	}
+	if m.PauseWhenMetered {
+		n += 3
+	}
 	return n
 }
 
@@ -950,6 +1021,98 @@ func (m *DeviceConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionAlgorithm", wireType)
+			}
+			m.CompressionAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionAlgorithm |= protocol.CompressionAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionLevel", wireType)
+			}
+			m.CompressionLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionLevel |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandwidthSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BandwidthSchedules = append(m.BandwidthSchedules, BandwidthSchedule{})
+			if err := m.BandwidthSchedules[len(m.BandwidthSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseWhenMetered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseWhenMetered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDeviceconfiguration(dAtA[iNdEx:])
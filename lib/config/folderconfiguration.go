@@ -41,6 +41,15 @@ func (f FolderConfiguration) Copy() FolderConfiguration {
 	c.Devices = make([]FolderDeviceConfiguration, len(f.Devices))
 	copy(c.Devices, f.Devices)
 	c.Versioning = f.Versioning.Copy()
+	c.SyncWindows = make([]SyncWindow, len(f.SyncWindows))
+	copy(c.SyncWindows, f.SyncWindows)
+	c.Merge.Patterns = make([]string, len(f.Merge.Patterns))
+	copy(c.Merge.Patterns, f.Merge.Patterns)
+	c.Hooks = make([]FolderHook, len(f.Hooks))
+	for i, h := range f.Hooks {
+		c.Hooks[i] = h
+		c.Hooks[i].Patterns = append([]string(nil), h.Patterns...)
+	}
 	return c
 }
 
@@ -54,6 +63,15 @@ func (f FolderConfiguration) Filesystem(fset *db.FileSet) fs.Filesystem {
 	if f.FilesystemType == fs.FilesystemTypeBasic && f.JunctionsAsDirs {
 		opts = append(opts, new(fs.OptionJunctionsAsDirs))
 	}
+	if f.FilesystemType == fs.FilesystemTypeSFTP {
+		opts = append(opts, &fs.OptionSFTP{
+			PrivateKeyFile: f.SFTP.PrivateKeyFile,
+			Password:       f.SFTP.Password,
+			HostKey:        f.SFTP.HostKey,
+			KnownHostsFile: f.SFTP.KnownHostsFile,
+			PollInterval:   time.Duration(f.SFTP.PollIntervalS) * time.Second,
+		})
+	}
 	if !f.CaseSensitiveFS {
 		opts = append(opts, new(fs.OptionDetectCaseConflicts))
 	}
@@ -214,6 +232,11 @@ func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices ma
 		f.Versioning.CleanupIntervalS = 0
 	}
 
+	if maxSize := f.Versioning.Retention.MaxTotalSize; maxSize.BaseValue() < 0 || maxSize.Percentage() && maxSize.BaseValue() > 100 {
+		l.Warnf("Folder %s: invalid maximum total size %v of versions, ignoring it", f.Description(), maxSize)
+		f.Versioning.Retention.MaxTotalSize = Size{}
+	}
+
 	if f.WeakHashThresholdPct == 0 {
 		f.WeakHashThresholdPct = 25
 	}
@@ -228,6 +251,13 @@ func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices ma
 		f.MaxConcurrentWrites = maxConcurrentWritesLimit
 	}
 
+	if f.Priority <= 0 {
+		f.Priority = 1
+	}
+
+	f.SyncWindows = validSyncWindows(f.SyncWindows, f.Description())
+	f.Hooks = validFolderHooks(f.Hooks, f.Description())
+
 	if f.Type == FolderTypeReceiveEncrypted {
 		f.DisableTempIndexes = true
 		f.IgnorePerms = true
@@ -302,3 +332,37 @@ func (f XattrFilter) GetMaxSingleEntrySize() int {
 func (f XattrFilter) GetMaxTotalSize() int {
 	return f.MaxTotalSize
 }
+
+// Matches returns whether conflicting changes to the file with the given
+// name should be merged.
+func (m MergeConfiguration) Matches(name string) bool {
+	return m.Type != "" && matchesAnyPattern(m.Patterns, name)
+}
+
+// matchesAnyPattern returns whether any of the glob patterns matches the
+// file name, either its base name or the whole path.
+func matchesAnyPattern(patterns []string, name string) bool {
+	name = filepath.ToSlash(name)
+	base := path.Base(name)
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, base); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxSize returns the size in bytes of the largest file that should be
+// merged.
+func (m MergeConfiguration) MaxSize() int64 {
+	return int64(m.MaxSizeKiB) * 1024
+}
+
+// Enabled returns whether changes from scans should be checked before
+// they are sent to other devices.
+func (c MassChangeConfiguration) Enabled() bool {
+	return c.MaxChangedPct > 0
+}
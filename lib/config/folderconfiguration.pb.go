@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	fs "github.com/syncthing/syncthing/lib/fs"
 	github_com_syncthing_syncthing_lib_protocol "github.com/syncthing/syncthing/lib/protocol"
+	protocol "github.com/syncthing/syncthing/lib/protocol"
 	_ "github.com/syncthing/syncthing/proto/ext"
 	io "io"
 	math "math"
@@ -105,6 +106,19 @@ type FolderConfiguration struct {
 	SyncXattrs              bool                        `protobuf:"varint,37,opt,name=sync_xattrs,json=syncXattrs,proto3" json:"syncXattrs" xml:"syncXattrs"`
 	SendXattrs              bool                        `protobuf:"varint,38,opt,name=send_xattrs,json=sendXattrs,proto3" json:"sendXattrs" xml:"sendXattrs"`
 	XattrFilter             XattrFilter                 `protobuf:"bytes,39,opt,name=xattr_filter,json=xattrFilter,proto3" json:"xattrFilter" xml:"xattrFilter"`
+	SFTP                    SFTPConfiguration           `protobuf:"bytes,40,opt,name=sftp,proto3" json:"sftp" xml:"sftp"`
+	BlockChunking           protocol.BlockChunking      `protobuf:"varint,41,opt,name=block_chunking,json=blockChunking,proto3,enum=protocol.BlockChunking" json:"blockChunking" xml:"blockChunking"`
+	MaxSendKbps             int                         `protobuf:"varint,42,opt,name=max_send_kbps,json=maxSendKbps,proto3,casttype=int" json:"maxSendKbps" xml:"maxSendKbps"`
+	MaxRecvKbps             int                         `protobuf:"varint,43,opt,name=max_recv_kbps,json=maxRecvKbps,proto3,casttype=int" json:"maxRecvKbps" xml:"maxRecvKbps"`
+	Priority                int                         `protobuf:"varint,44,opt,name=priority,proto3,casttype=int" json:"priority" xml:"priority" default:"1"`
+	SyncWindows             []SyncWindow                `protobuf:"bytes,45,rep,name=sync_windows,json=syncWindows,proto3" json:"syncWindows" xml:"syncWindow" restart:"false"`
+	PauseWhenMetered        bool                        `protobuf:"varint,46,opt,name=pause_when_metered,json=pauseWhenMetered,proto3" json:"pauseWhenMetered" xml:"pauseWhenMetered" restart:"false"`
+	Merge                   MergeConfiguration          `protobuf:"bytes,47,opt,name=merge,proto3" json:"merge" xml:"merge"`
+	MassChange              MassChangeConfiguration     `protobuf:"bytes,48,opt,name=mass_change,json=massChange,proto3" json:"massChange" xml:"massChange"`
+	MaxDeletes              int                         `protobuf:"varint,49,opt,name=max_deletes,json=maxDeletes,proto3,casttype=int" json:"maxDeletes" xml:"maxDeletes"`
+	MaxDeletesPct           int                         `protobuf:"varint,50,opt,name=max_deletes_pct,json=maxDeletesPct,proto3,casttype=int" json:"maxDeletesPct" xml:"maxDeletesPct"`
+	Snapshot                SnapshotConfiguration       `protobuf:"bytes,51,opt,name=snapshot,proto3" json:"snapshot" xml:"snapshot"`
+	Hooks                   []FolderHook                `protobuf:"bytes,52,rep,name=hooks,proto3" json:"hooks" xml:"hook" restart:"false"`
 	// Legacy deprecated
 	DeprecatedReadOnly       bool    `protobuf:"varint,9000,opt,name=read_only,json=readOnly,proto3" json:"-" xml:"ro,attr,omitempty"`                       // Deprecated: Do not use.
 	DeprecatedMinDiskFreePct float64 `protobuf:"fixed64,9001,opt,name=min_disk_free_pct,json=minDiskFreePct,proto3" json:"-" xml:"minDiskFreePct,omitempty"` // Deprecated: Do not use.
@@ -145,6 +159,51 @@ func (m *FolderConfiguration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FolderConfiguration proto.InternalMessageInfo
 
+// Connection options for folders using the "sftp" filesystem type, where the
+// folder path is an sftp://[user@]host[:port]/path URI. The server host key
+// is verified against host_key (an authorized_keys style public key or a
+// SHA256 fingerprint) if set, otherwise against the known hosts file.
+type SFTPConfiguration struct {
+	PrivateKeyFile string `protobuf:"bytes,1,opt,name=private_key_file,json=privateKeyFile,proto3" json:"privateKeyFile" xml:"privateKeyFile"`
+	Password       string `protobuf:"bytes,2,opt,name=password,proto3" json:"password" xml:"password"`
+	HostKey        string `protobuf:"bytes,3,opt,name=host_key,json=hostKey,proto3" json:"hostKey" xml:"hostKey"`
+	KnownHostsFile string `protobuf:"bytes,4,opt,name=known_hosts_file,json=knownHostsFile,proto3" json:"knownHostsFile" xml:"knownHostsFile"`
+	PollIntervalS  int    `protobuf:"varint,5,opt,name=poll_interval_s,json=pollIntervalS,proto3,casttype=int" json:"pollIntervalS" xml:"pollIntervalS"`
+}
+
+func (m *SFTPConfiguration) Reset()         { *m = SFTPConfiguration{} }
+func (m *SFTPConfiguration) String() string { return proto.CompactTextString(m) }
+func (*SFTPConfiguration) ProtoMessage()    {}
+func (*SFTPConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a9785876ed3afa, []int{2}
+}
+func (m *SFTPConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SFTPConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SFTPConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SFTPConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SFTPConfiguration.Merge(m, src)
+}
+func (m *SFTPConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SFTPConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_SFTPConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SFTPConfiguration proto.InternalMessageInfo
+
 // Extended attribute filter. This is a list of patterns to match (glob
 // style), each with an action (permit or deny). First match is used. If the
 // filter is empty, all strings are permitted. If the filter is non-empty,
@@ -161,7 +220,7 @@ func (m *XattrFilter) Reset()         { *m = XattrFilter{} }
 func (m *XattrFilter) String() string { return proto.CompactTextString(m) }
 func (*XattrFilter) ProtoMessage()    {}
 func (*XattrFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_44a9785876ed3afa, []int{2}
+	return fileDescriptor_44a9785876ed3afa, []int{3}
 }
 func (m *XattrFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -199,7 +258,7 @@ func (m *XattrFilterEntry) Reset()         { *m = XattrFilterEntry{} }
 func (m *XattrFilterEntry) String() string { return proto.CompactTextString(m) }
 func (*XattrFilterEntry) ProtoMessage()    {}
 func (*XattrFilterEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_44a9785876ed3afa, []int{3}
+	return fileDescriptor_44a9785876ed3afa, []int{4}
 }
 func (m *XattrFilterEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,11 +287,203 @@ func (m *XattrFilterEntry) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_XattrFilterEntry proto.InternalMessageInfo
 
+// Merge configuration. Conflicting changes to files matching any of the
+// patterns (glob style, matched against the file name and the path within
+// the folder) are merged using the merger of the given type, with the last
+// version in common taken from the versioner. Merging is disabled if the
+// type is empty, and files that cannot be merged cleanly result in a
+// conflict copy as usual.
+type MergeConfiguration struct {
+	Type       string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type" xml:"type,attr"`
+	Patterns   []string `protobuf:"bytes,2,rep,name=patterns,proto3" json:"patterns" xml:"pattern"`
+	MaxSizeKiB int      `protobuf:"varint,3,opt,name=max_size_kib,json=maxSizeKib,proto3,casttype=int" json:"maxSizeKiB" xml:"maxSizeKiB" default:"1024"`
+}
+
+func (m *MergeConfiguration) Reset()         { *m = MergeConfiguration{} }
+func (m *MergeConfiguration) String() string { return proto.CompactTextString(m) }
+func (*MergeConfiguration) ProtoMessage()    {}
+func (*MergeConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a9785876ed3afa, []int{5}
+}
+func (m *MergeConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeConfiguration.Merge(m, src)
+}
+func (m *MergeConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MergeConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeConfiguration proto.InternalMessageInfo
+
+// Mass change protection. When a scan modifies or deletes at least
+// max_changed_pct percent of the files in the folder, and at least
+// min_files files, the changes are not sent to other devices until
+// confirmed. With check_entropy set, min_files rewritten files whose
+// new contents look encrypted also trigger it. While the protection is
+// enabled, changes are only sent once each scan completes. Disabled when
+// max_changed_pct is zero.
+type MassChangeConfiguration struct {
+	MaxChangedPct int  `protobuf:"varint,1,opt,name=max_changed_pct,json=maxChangedPct,proto3,casttype=int" json:"maxChangedPct" xml:"maxChangedPct"`
+	MinFiles      int  `protobuf:"varint,2,opt,name=min_files,json=minFiles,proto3,casttype=int" json:"minFiles" xml:"minFiles" default:"100"`
+	CheckEntropy  bool `protobuf:"varint,3,opt,name=check_entropy,json=checkEntropy,proto3" json:"checkEntropy" xml:"checkEntropy"`
+}
+
+func (m *MassChangeConfiguration) Reset()         { *m = MassChangeConfiguration{} }
+func (m *MassChangeConfiguration) String() string { return proto.CompactTextString(m) }
+func (*MassChangeConfiguration) ProtoMessage()    {}
+func (*MassChangeConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a9785876ed3afa, []int{6}
+}
+func (m *MassChangeConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MassChangeConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MassChangeConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MassChangeConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MassChangeConfiguration.Merge(m, src)
+}
+func (m *MassChangeConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MassChangeConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MassChangeConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MassChangeConfiguration proto.InternalMessageInfo
+
+// Snapshot configuration. Scans read a snapshot of the folder, created
+// before and released after each scan, instead of the live files. With the
+// "command" type, create_command is run to create the snapshot and prints
+// its path as the last line of output, and release_command is run
+// afterwards. The placeholders %FOLDER_ID%, %FOLDER_PATH% and, in
+// release_command, %SNAPSHOT_PATH% are replaced in the commands. Commands
+// taking longer than timeout_s seconds are killed. Disabled if the type is
+// empty.
+type SnapshotConfiguration struct {
+	Type           string `protobuf:"bytes,1,opt,name=type,proto3" json:"type" xml:"type,attr"`
+	CreateCommand  string `protobuf:"bytes,2,opt,name=create_command,json=createCommand,proto3" json:"createCommand" xml:"createCommand"`
+	ReleaseCommand string `protobuf:"bytes,3,opt,name=release_command,json=releaseCommand,proto3" json:"releaseCommand" xml:"releaseCommand"`
+	TimeoutS       int    `protobuf:"varint,4,opt,name=timeout_s,json=timeoutS,proto3,casttype=int" json:"timeoutS" xml:"timeoutS" default:"600"`
+}
+
+func (m *SnapshotConfiguration) Reset()         { *m = SnapshotConfiguration{} }
+func (m *SnapshotConfiguration) String() string { return proto.CompactTextString(m) }
+func (*SnapshotConfiguration) ProtoMessage()    {}
+func (*SnapshotConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a9785876ed3afa, []int{7}
+}
+func (m *SnapshotConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotConfiguration.Merge(m, src)
+}
+func (m *SnapshotConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SnapshotConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotConfiguration proto.InternalMessageInfo
+
+// A hook runs a command on a folder event: "pulled" when the folder becomes
+// idle after pulling changes, "before-scan" before each scan, and
+// "file-updated" when the folder becomes idle after pulling changes to files
+// matching any of the patterns (glob style, matched against the file name
+// and the path within the folder). The placeholders %FOLDER_ID% and
+// %FOLDER_PATH% are replaced in the command, and the changed files are
+// listed in its environment. Commands taking longer than timeout_s seconds
+// are killed.
+type FolderHook struct {
+	Event    string   `protobuf:"bytes,1,opt,name=event,proto3" json:"event" xml:"event,attr"`
+	Command  string   `protobuf:"bytes,2,opt,name=command,proto3" json:"command" xml:"command"`
+	Patterns []string `protobuf:"bytes,3,rep,name=patterns,proto3" json:"patterns" xml:"pattern"`
+	TimeoutS int      `protobuf:"varint,4,opt,name=timeout_s,json=timeoutS,proto3,casttype=int" json:"timeoutS" xml:"timeoutS" default:"60"`
+}
+
+func (m *FolderHook) Reset()         { *m = FolderHook{} }
+func (m *FolderHook) String() string { return proto.CompactTextString(m) }
+func (*FolderHook) ProtoMessage()    {}
+func (*FolderHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a9785876ed3afa, []int{8}
+}
+func (m *FolderHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FolderHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FolderHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FolderHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FolderHook.Merge(m, src)
+}
+func (m *FolderHook) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FolderHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_FolderHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FolderHook proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*FolderDeviceConfiguration)(nil), "config.FolderDeviceConfiguration")
 	proto.RegisterType((*FolderConfiguration)(nil), "config.FolderConfiguration")
+	proto.RegisterType((*SFTPConfiguration)(nil), "config.SFTPConfiguration")
 	proto.RegisterType((*XattrFilter)(nil), "config.XattrFilter")
 	proto.RegisterType((*XattrFilterEntry)(nil), "config.XattrFilterEntry")
+	proto.RegisterType((*MergeConfiguration)(nil), "config.MergeConfiguration")
+	proto.RegisterType((*MassChangeConfiguration)(nil), "config.MassChangeConfiguration")
+	proto.RegisterType((*SnapshotConfiguration)(nil), "config.SnapshotConfiguration")
+	proto.RegisterType((*FolderHook)(nil), "config.FolderHook")
 }
 
 func init() {
@@ -240,158 +491,218 @@ func init() {
 }
 
 var fileDescriptor_44a9785876ed3afa = []byte{
-	// 2413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcf, 0x6f, 0x24, 0x47,
-	0xf5, 0x77, 0xdb, 0xbb, 0x6b, 0xbb, 0xfc, 0xbb, 0x6c, 0xef, 0x76, 0x9c, 0xc4, 0x35, 0xe9, 0xcc,
-	0x26, 0x4e, 0xbe, 0x89, 0x77, 0xe3, 0x44, 0x91, 0x12, 0x7d, 0x03, 0x64, 0xec, 0x8c, 0x58, 0x16,
-	0x67, 0xad, 0x1e, 0x43, 0x20, 0x41, 0x6a, 0xda, 0xdd, 0x35, 0x33, 0x1d, 0xf7, 0x8f, 0xa1, 0xab,
-	0xbd, 0xf6, 0xec, 0x21, 0x0a, 0x39, 0x20, 0x24, 0x72, 0x40, 0xe6, 0x80, 0x38, 0x20, 0x45, 0x02,
-	0x21, 0x08, 0x17, 0xce, 0xfc, 0x05, 0xb9, 0x20, 0xfb, 0x84, 0x10, 0x87, 0x92, 0xe2, 0xbd, 0xcd,
-	0x71, 0x8e, 0x7b, 0x42, 0xef, 0xf5, 0x8f, 0xa9, 0x9e, 0x99, 0x48, 0x48, 0xdc, 0xa6, 0x3e, 0x9f,
-	0x57, 0xef, 0x7d, 0xfa, 0x55, 0xd5, 0xab, 0x57, 0x43, 0xaa, 0xbe, 0x77, 0x74, 0xc7, 0x89, 0xc2,
-	0xa6, 0xd7, 0xba, 0xd3, 0x8c, 0x7c, 0x97, 0xc7, 0xe9, 0xe0, 0x24, 0xb6, 0x13, 0x2f, 0x0a, 0xb7,
-	0x3b, 0x71, 0x94, 0x44, 0xf4, 0x46, 0x0a, 0x6e, 0x3c, 0x3d, 0x62, 0x9d, 0x74, 0x3b, 0x3c, 0x35,
-	0xda, 0x58, 0x57, 0x48, 0xe1, 0x3d, 0xca, 0xe1, 0x0d, 0x05, 0xee, 0x9c, 0xf8, 0x7e, 0x14, 0xbb,
-	0x3c, 0xce, 0xb8, 0x2d, 0x85, 0x7b, 0xc8, 0x63, 0xe1, 0x45, 0xa1, 0x17, 0xb6, 0xc6, 0x28, 0xd8,
-	0x60, 0x8a, 0xe5, 0x91, 0x1f, 0x39, 0xc7, 0xc3, 0xae, 0x28, 0x18, 0x34, 0xc5, 0x1d, 0x10, 0x24,
-	0x32, 0xec, 0x99, 0x0c, 0x73, 0xa2, 0x4e, 0x37, 0xb6, 0xc3, 0x16, 0x0f, 0x78, 0xd2, 0x8e, 0xdc,
-	0x8c, 0x9d, 0xe5, 0x67, 0x49, 0xfa, 0xd3, 0xf8, 0xe7, 0x14, 0x79, 0xaa, 0x8e, 0xdf, 0xb3, 0xc7,
-	0x1f, 0x7a, 0x0e, 0xdf, 0x55, 0x15, 0xd0, 0x2f, 0x35, 0x32, 0xeb, 0x22, 0x6e, 0x79, 0xae, 0xae,
-	0x55, 0xb4, 0xad, 0xf9, 0xda, 0xe7, 0xda, 0x57, 0x92, 0x4d, 0xfc, 0x5b, 0xb2, 0x37, 0x5a, 0x5e,
-	0xd2, 0x3e, 0x39, 0xda, 0x76, 0xa2, 0xe0, 0x8e, 0xe8, 0x86, 0x4e, 0xd2, 0xf6, 0xc2, 0x96, 0xf2,
-	0x0b, 0x24, 0x60, 0x10, 0x27, 0xf2, 0xb7, 0x53, 0xef, 0xf7, 0xf6, 0xae, 0x24, 0x9b, 0xc9, 0x7f,
-	0xf7, 0x24, 0x9b, 0x71, 0xb3, 0xdf, 0x7d, 0xc9, 0x16, 0xce, 0x02, 0xff, 0x6d, 0xc3, 0x73, 0x5f,
-	0xb1, 0x93, 0x24, 0x36, 0x7a, 0x17, 0xd5, 0xe9, 0xec, 0x77, 0xff, 0xa2, 0x5a, 0xd8, 0xfd, 0xf2,
-	0xb2, 0xaa, 0x9d, 0x5f, 0x56, 0x0b, 0x1f, 0x66, 0xce, 0xb8, 0xf4, 0x4f, 0x1a, 0x59, 0xf0, 0xc2,
-	0x24, 0x8e, 0xdc, 0x13, 0x87, 0xbb, 0xd6, 0x51, 0x57, 0x9f, 0x44, 0xc1, 0x9f, 0xfe, 0x4f, 0x82,
-	0x7b, 0x92, 0xcd, 0x0f, 0xbc, 0xd6, 0xba, 0x7d, 0xc9, 0x6e, 0xa5, 0x42, 0x15, 0xb0, 0x90, 0xbc,
-	0x32, 0x82, 0x82, 0x60, 0xb3, 0xe4, 0x81, 0x3a, 0x64, 0x95, 0x87, 0x4e, 0xdc, 0xed, 0x40, 0x8e,
-	0xad, 0x8e, 0x2d, 0xc4, 0x69, 0x14, 0xbb, 0xfa, 0x54, 0x45, 0xdb, 0x9a, 0xad, 0xed, 0xf4, 0x24,
-	0xa3, 0x03, 0xfa, 0x20, 0x63, 0xfb, 0x92, 0xe9, 0x18, 0x76, 0x94, 0x32, 0xcc, 0x31, 0xf6, 0xc6,
-	0x79, 0x95, 0xac, 0xa6, 0x0b, 0x5b, 0x5e, 0xd2, 0x06, 0x99, 0xcc, 0x96, 0x72, 0xb6, 0xb6, 0x7b,
-	0x25, 0xd9, 0x24, 0x7e, 0xe2, 0xa4, 0x07, 0x11, 0x36, 0x4b, 0x2b, 0x50, 0x09, 0x23, 0x97, 0x37,
-	0xed, 0x13, 0x3f, 0x79, 0xdb, 0x48, 0xe2, 0x13, 0xae, 0x2e, 0xc9, 0xf9, 0x65, 0x75, 0xf2, 0xde,
-	0xde, 0x17, 0xf0, 0x6d, 0x93, 0x9e, 0x4b, 0x7f, 0x40, 0xae, 0xfb, 0xf6, 0x11, 0xf7, 0x31, 0xe3,
-	0xb3, 0xb5, 0x6f, 0xf7, 0x24, 0x4b, 0x81, 0xbe, 0x64, 0x15, 0x74, 0x8a, 0xa3, 0xcc, 0x6f, 0xcc,
-	0x45, 0x62, 0xc7, 0xc9, 0xdb, 0x46, 0xd3, 0xf6, 0x05, 0xba, 0x25, 0x03, 0xfa, 0xd3, 0xcb, 0xea,
-	0x84, 0x99, 0x4e, 0xa6, 0x2d, 0xb2, 0xd4, 0xf4, 0x7c, 0x2e, 0xba, 0x22, 0xe1, 0x81, 0x05, 0xfb,
-	0x1b, 0x93, 0xb4, 0xb8, 0x43, 0xb7, 0x9b, 0x62, 0xbb, 0x5e, 0x50, 0x87, 0xdd, 0x0e, 0xaf, 0xbd,
-	0xdc, 0x93, 0x6c, 0xb1, 0x59, 0xc2, 0xfa, 0x92, 0xad, 0x61, 0xf4, 0x32, 0x6c, 0x98, 0x43, 0x76,
-	0x74, 0x9f, 0x5c, 0xeb, 0xd8, 0x49, 0x5b, 0xbf, 0x86, 0xf2, 0xdf, 0xea, 0x49, 0x86, 0xe3, 0xbe,
-	0x64, 0x4f, 0xe3, 0x7c, 0x18, 0x64, 0xe2, 0x8b, 0x94, 0x7c, 0x02, 0xc2, 0x67, 0x0b, 0xe6, 0xc9,
-	0x45, 0x55, 0xfb, 0xc4, 0xc4, 0x69, 0xf4, 0x80, 0x5c, 0x43, 0xb1, 0xd7, 0x33, 0xb1, 0xe9, 0xe9,
-	0xdd, 0x4e, 0x97, 0x03, 0xc5, 0x6e, 0x41, 0x88, 0x24, 0x95, 0xb8, 0x84, 0x21, 0x60, 0x50, 0x6c,
-	0xa3, 0xd9, 0x62, 0x64, 0xa2, 0x15, 0xfd, 0x09, 0x99, 0x4e, 0xf7, 0xb9, 0xd0, 0x6f, 0x54, 0xa6,
-	0xb6, 0xe6, 0x76, 0x9e, 0x2b, 0x3b, 0x1d, 0x73, 0x78, 0x6b, 0x0c, 0xb6, 0x7d, 0x4f, 0xb2, 0x7c,
-	0x66, 0x5f, 0xb2, 0x79, 0x0c, 0x95, 0x8e, 0x0d, 0x33, 0x27, 0xe8, 0x6f, 0x34, 0xb2, 0x12, 0x73,
-	0xe1, 0xd8, 0xa1, 0xe5, 0x85, 0x09, 0x8f, 0x1f, 0xda, 0xbe, 0x25, 0xf4, 0xe9, 0x8a, 0xb6, 0x75,
-	0xbd, 0xd6, 0xea, 0x49, 0xb6, 0x94, 0x92, 0xf7, 0x32, 0xae, 0xd1, 0x97, 0xec, 0x25, 0xf4, 0x34,
-	0x84, 0x0f, 0xa7, 0xe8, 0xf5, 0x37, 0xef, 0xde, 0x35, 0x9e, 0x48, 0x36, 0xe5, 0x85, 0x49, 0xef,
-	0xa2, 0xba, 0x36, 0xce, 0xfc, 0xc9, 0x45, 0xf5, 0x1a, 0xd8, 0x99, 0xc3, 0x41, 0xe8, 0xdf, 0x35,
-	0x42, 0x9b, 0xc2, 0x3a, 0xb5, 0x13, 0xa7, 0xcd, 0x63, 0x8b, 0x87, 0xf6, 0x91, 0xcf, 0x5d, 0x7d,
-	0xa6, 0xa2, 0x6d, 0xcd, 0xd4, 0x7e, 0xa5, 0x5d, 0x49, 0xb6, 0x5c, 0x6f, 0x7c, 0x90, 0xb2, 0xef,
-	0xa5, 0x64, 0x4f, 0xb2, 0xe5, 0xa6, 0x28, 0x63, 0x7d, 0xc9, 0x5e, 0x4e, 0x37, 0xc1, 0x10, 0x31,
-	0xac, 0x36, 0xdf, 0xe3, 0xeb, 0x63, 0x0d, 0x41, 0x27, 0x58, 0x9c, 0x5f, 0x56, 0x47, 0xc2, 0x9a,
-	0x23, 0x41, 0xe9, 0xdf, 0xca, 0xe2, 0x5d, 0xee, 0xdb, 0x5d, 0x4b, 0xe8, 0xb3, 0x15, 0x6d, 0x4b,
-	0xab, 0x7d, 0x06, 0xe2, 0x97, 0x0a, 0x2f, 0x7b, 0x40, 0x36, 0x20, 0xcf, 0x85, 0x9b, 0x14, 0xea,
-	0x4b, 0xf6, 0x62, 0x59, 0x7a, 0x8a, 0x0f, 0x2b, 0x7f, 0xed, 0x2e, 0xe8, 0x5e, 0x1b, 0x67, 0xf5,
-	0xe4, 0xa2, 0x3a, 0xf9, 0xda, 0xdd, 0xf3, 0xcb, 0xea, 0x70, 0x38, 0x73, 0x38, 0x18, 0xfd, 0x29,
-	0x99, 0xf7, 0x5a, 0x61, 0x14, 0x73, 0xab, 0xc3, 0xe3, 0x40, 0xe8, 0x04, 0x13, 0xfd, 0x4e, 0x4f,
-	0xb2, 0xb9, 0x14, 0x3f, 0x00, 0xb8, 0x2f, 0xd9, 0xcd, 0xb4, 0x4c, 0x0c, 0xb0, 0x62, 0xdf, 0x2e,
-	0x0f, 0x83, 0xa6, 0x3a, 0x95, 0xfe, 0x5c, 0x23, 0x8b, 0xf6, 0x49, 0x12, 0x59, 0x61, 0x14, 0x07,
-	0xb6, 0xef, 0x3d, 0xe2, 0xfa, 0x1c, 0x06, 0xf9, 0xb0, 0x27, 0xd9, 0x02, 0x30, 0xef, 0xe7, 0x44,
-	0xf1, 0xe9, 0x25, 0xf4, 0x9b, 0x96, 0x8c, 0x8e, 0x5a, 0xe5, 0xeb, 0x65, 0x96, 0xfd, 0xd2, 0x88,
-	0x2c, 0x04, 0x5e, 0x68, 0xb9, 0x9e, 0x38, 0xb6, 0x9a, 0x31, 0xe7, 0xfa, 0x7c, 0x45, 0xdb, 0x9a,
-	0xdb, 0x99, 0xcf, 0xcf, 0x53, 0xc3, 0x7b, 0xc4, 0x6b, 0xef, 0x64, 0x47, 0x67, 0x2e, 0xf0, 0xc2,
-	0x3d, 0x4f, 0x1c, 0xd7, 0x63, 0x0e, 0x8a, 0x18, 0x2a, 0x52, 0x30, 0x75, 0x0d, 0x2a, 0xb7, 0x8d,
-	0x27, 0x17, 0xd5, 0xa9, 0xd7, 0x2a, 0xb7, 0x4d, 0x75, 0x1a, 0x6d, 0x11, 0x32, 0xb8, 0xe0, 0xf5,
-	0x05, 0x8c, 0xc6, 0xf2, 0x68, 0x3f, 0x2c, 0x98, 0xf2, 0xd9, 0x7d, 0x21, 0x13, 0xa0, 0x4c, 0xed,
-	0x4b, 0xb6, 0x8c, 0xf1, 0x07, 0x90, 0x61, 0x2a, 0x3c, 0x7d, 0x87, 0x4c, 0x3b, 0x51, 0xc7, 0xe3,
-	0xb1, 0xd0, 0x17, 0xf1, 0xe8, 0x3e, 0x0f, 0x87, 0x3f, 0x83, 0x8a, 0xfb, 0x35, 0x1b, 0xe7, 0xc7,
-	0xd2, 0xcc, 0x0d, 0xe8, 0x3f, 0x34, 0x72, 0x13, 0x5a, 0x0b, 0x1e, 0x5b, 0x81, 0x7d, 0x66, 0x75,
-	0x78, 0xe8, 0x7a, 0x61, 0xcb, 0x3a, 0xf6, 0x8e, 0xf4, 0x25, 0x74, 0xf7, 0x5b, 0xd8, 0xb5, 0xab,
-	0x07, 0x68, 0xb2, 0x6f, 0x9f, 0x1d, 0xa4, 0x06, 0xf7, 0xbd, 0x5a, 0x4f, 0xb2, 0xd5, 0xce, 0x28,
-	0xdc, 0x97, 0xec, 0xa9, 0xb4, 0x7a, 0x8e, 0x72, 0x4a, 0x55, 0x18, 0x3b, 0x75, 0x3c, 0x7c, 0x7e,
-	0x59, 0x1d, 0x17, 0xdf, 0x1c, 0x63, 0x7b, 0x04, 0xe9, 0x68, 0xdb, 0xa2, 0x0d, 0xe9, 0x58, 0x1e,
-	0xa4, 0x23, 0x83, 0x8a, 0x74, 0x64, 0xe3, 0x41, 0x3a, 0x32, 0x80, 0xbe, 0x4b, 0xae, 0x63, 0x93,
-	0xa5, 0xaf, 0x60, 0x11, 0x5f, 0xc9, 0x57, 0x0c, 0xe2, 0x3f, 0x00, 0xa2, 0xa6, 0xc3, 0x2d, 0x87,
-	0x36, 0x7d, 0xc9, 0xe6, 0xd0, 0x1b, 0x8e, 0x0c, 0x33, 0x45, 0xe9, 0x7d, 0xb2, 0x90, 0x1d, 0x28,
-	0x97, 0xfb, 0x3c, 0xe1, 0x3a, 0xc5, 0xcd, 0xfe, 0x02, 0xb6, 0x14, 0x48, 0xec, 0x21, 0xde, 0x97,
-	0x8c, 0x2a, 0x47, 0x2a, 0x05, 0x0d, 0xb3, 0x64, 0x43, 0xcf, 0x88, 0x8e, 0x05, 0xba, 0x13, 0x47,
-	0xad, 0x98, 0x0b, 0xa1, 0x56, 0xea, 0x55, 0xfc, 0x3e, 0xb8, 0x75, 0xd7, 0xc1, 0xe6, 0x20, 0x33,
-	0x51, 0xeb, 0x75, 0x7a, 0x8f, 0x8d, 0x65, 0x8b, 0x6f, 0x1f, 0x3f, 0x99, 0x36, 0xc8, 0x62, 0xb6,
-	0x2f, 0x3a, 0xf6, 0x89, 0xe0, 0x96, 0xd0, 0xd7, 0x30, 0xde, 0xab, 0xf0, 0x1d, 0x29, 0x73, 0x00,
-	0x44, 0xa3, 0xf8, 0x0e, 0x15, 0x2c, 0xbc, 0x97, 0x4c, 0x29, 0x27, 0x0b, 0xb0, 0xcb, 0x20, 0xa9,
-	0xbe, 0xe7, 0x24, 0x42, 0x5f, 0x47, 0x9f, 0xdf, 0x01, 0x9f, 0x81, 0x7d, 0xb6, 0x9b, 0xe3, 0x83,
-	0x53, 0xa7, 0x80, 0xe5, 0xd2, 0x97, 0x05, 0x48, 0x2b, 0x9d, 0x59, 0x9a, 0x4d, 0x5d, 0xb2, 0xe6,
-	0x7a, 0x02, 0x4a, 0xb2, 0x25, 0x3a, 0x76, 0x2c, 0xb8, 0x85, 0x37, 0xbf, 0x7e, 0x13, 0x57, 0x02,
-	0x7b, 0xad, 0x8c, 0x6f, 0x20, 0x8d, 0x3d, 0x45, 0xd1, 0x6b, 0x8d, 0x52, 0x86, 0x39, 0xc6, 0x5e,
-	0x8d, 0x92, 0xf0, 0xa0, 0x63, 0x79, 0xa1, 0xcb, 0xcf, 0xb8, 0xd0, 0x6f, 0x8d, 0x44, 0x39, 0xe4,
-	0x41, 0xe7, 0x5e, 0xca, 0x0e, 0x47, 0x51, 0xa8, 0x41, 0x14, 0x05, 0xa4, 0x3b, 0xe4, 0x06, 0x2e,
-	0x80, 0xab, 0xeb, 0xe8, 0x77, 0xa3, 0x27, 0x59, 0x86, 0x14, 0x57, 0x7b, 0x3a, 0x34, 0xcc, 0x0c,
-	0xa7, 0x09, 0xb9, 0x75, 0xca, 0xed, 0x63, 0x0b, 0x76, 0xb5, 0x95, 0xb4, 0x63, 0x2e, 0xda, 0x91,
-	0xef, 0x5a, 0x1d, 0x27, 0xd1, 0x9f, 0xc2, 0x84, 0x43, 0x79, 0x5f, 0x03, 0x93, 0xef, 0xda, 0xa2,
-	0x7d, 0x98, 0x1b, 0x1c, 0x38, 0x49, 0x5f, 0xb2, 0x0d, 0x74, 0x39, 0x8e, 0x2c, 0x16, 0x75, 0xec,
-	0x54, 0xba, 0x4b, 0xe6, 0x02, 0x3b, 0x3e, 0xe6, 0xb1, 0x15, 0xda, 0x01, 0xd7, 0x37, 0xb0, 0xab,
-	0x32, 0xa0, 0x9c, 0xa5, 0xf0, 0xfb, 0x76, 0xc0, 0x8b, 0x72, 0x36, 0x80, 0x0c, 0x53, 0xe1, 0x69,
-	0x97, 0x6c, 0xc0, 0xeb, 0xc5, 0x8a, 0x4e, 0x43, 0x1e, 0x8b, 0xb6, 0xd7, 0xb1, 0x9a, 0x71, 0x14,
-	0x58, 0x1d, 0x3b, 0xe6, 0x61, 0xa2, 0x3f, 0x8d, 0x29, 0xf8, 0xff, 0x9e, 0x64, 0xb7, 0xc0, 0xea,
-	0x41, 0x6e, 0x54, 0x8f, 0xa3, 0xe0, 0x00, 0x4d, 0xfa, 0x92, 0x3d, 0x9b, 0x57, 0xbc, 0x71, 0xbc,
-	0x61, 0x7e, 0xd3, 0x4c, 0xfa, 0x0b, 0x8d, 0xac, 0x04, 0x91, 0x6b, 0x25, 0x5e, 0xc0, 0xad, 0x53,
-	0x2f, 0x74, 0xa3, 0x53, 0x4b, 0xe8, 0xcf, 0x60, 0xc2, 0x3e, 0xba, 0x92, 0x6c, 0xc5, 0xb4, 0x4f,
-	0xf7, 0x23, 0xf7, 0xd0, 0x0b, 0xf8, 0x07, 0xc8, 0xc2, 0xe5, 0xbd, 0x18, 0x94, 0x90, 0xa2, 0xf7,
-	0x2c, 0xc3, 0x79, 0xe6, 0xce, 0x2f, 0xab, 0xa3, 0x5e, 0xcc, 0x21, 0x1f, 0xf4, 0x53, 0x8d, 0xac,
-	0x67, 0xc7, 0xc4, 0x39, 0x89, 0x41, 0x9b, 0x75, 0x1a, 0x7b, 0x09, 0x17, 0xfa, 0xb3, 0x28, 0xe6,
-	0xfb, 0x50, 0x7a, 0xd3, 0x0d, 0x9f, 0xf1, 0x1f, 0x20, 0xdd, 0x97, 0xec, 0xb6, 0x72, 0x6a, 0x4a,
-	0x9c, 0x72, 0x78, 0x76, 0x94, 0xb3, 0xa3, 0xed, 0x98, 0xe3, 0x3c, 0x41, 0x11, 0xcb, 0xf7, 0x76,
-	0x13, 0x9e, 0x4a, 0xfa, 0xe6, 0xa0, 0x88, 0x65, 0x44, 0x1d, 0xf0, 0xe2, 0xf0, 0xab, 0xa0, 0x61,
-	0x96, 0x6c, 0xa8, 0x4f, 0x96, 0xf1, 0x09, 0x6b, 0x41, 0x2d, 0xb0, 0xd2, 0xfa, 0xca, 0xb0, 0xbe,
-	0xde, 0xcc, 0xeb, 0x6b, 0x0d, 0xf8, 0x41, 0x91, 0xc5, 0xae, 0xfe, 0xa8, 0x84, 0x15, 0x99, 0x2d,
-	0xc3, 0x86, 0x39, 0x64, 0x47, 0x3f, 0xd7, 0xc8, 0x0a, 0x6e, 0x21, 0x7c, 0x01, 0x5b, 0xe9, 0x13,
-	0x58, 0xaf, 0x60, 0xbc, 0x55, 0x78, 0x41, 0xec, 0x46, 0x9d, 0xae, 0x09, 0xdc, 0x3e, 0x52, 0xb5,
-	0xfb, 0xd0, 0x83, 0x39, 0x65, 0xb0, 0x2f, 0xd9, 0x56, 0xb1, 0x8d, 0x14, 0x5c, 0x49, 0xa3, 0x48,
-	0xec, 0xd0, 0xb5, 0x63, 0x17, 0xee, 0xff, 0x99, 0x7c, 0x60, 0x0e, 0x3b, 0xa2, 0x7f, 0x04, 0x39,
-	0x36, 0x14, 0x50, 0x1e, 0x0a, 0x2f, 0xf1, 0x1e, 0x42, 0x46, 0xf5, 0xe7, 0x30, 0x9d, 0x67, 0xd0,
-	0x10, 0xee, 0xda, 0x82, 0x37, 0x72, 0xae, 0x8e, 0x0d, 0xa1, 0x53, 0x86, 0xfa, 0x92, 0xad, 0xa7,
-	0x62, 0xca, 0x38, 0xf4, 0x40, 0x23, 0xb6, 0xa3, 0x10, 0xb4, 0x81, 0x43, 0x41, 0xcc, 0x21, 0x1b,
-	0x41, 0xff, 0xa0, 0x91, 0xe5, 0x66, 0xe4, 0xfb, 0xd1, 0xa9, 0xf5, 0xf1, 0x49, 0xe8, 0x40, 0x3b,
-	0x22, 0x74, 0x63, 0xa0, 0xf2, 0x7b, 0x39, 0xf8, 0xae, 0xd8, 0xf3, 0x62, 0x01, 0x2a, 0x3f, 0x2e,
-	0x43, 0x85, 0xca, 0x21, 0x1c, 0x55, 0x0e, 0xdb, 0x8e, 0x42, 0xa0, 0x72, 0x28, 0x88, 0xb9, 0x94,
-	0x2a, 0x2a, 0x60, 0xfa, 0x80, 0x2c, 0xc2, 0x8e, 0x1a, 0x54, 0x07, 0xfd, 0x79, 0x94, 0x08, 0x0f,
-	0xab, 0x05, 0x60, 0x8a, 0x73, 0xdd, 0x97, 0x6c, 0x35, 0xbd, 0xfc, 0x54, 0xd4, 0x30, 0xcb, 0x56,
-	0xe8, 0x90, 0x87, 0xae, 0xe2, 0xb0, 0xaa, 0x38, 0xe4, 0xa1, 0x3b, 0xc6, 0xa1, 0x8a, 0x82, 0x43,
-	0x75, 0x0c, 0x45, 0x10, 0x15, 0x9e, 0x41, 0x37, 0x2a, 0xf4, 0xdb, 0xe8, 0x0d, 0x8b, 0x20, 0xc0,
-	0x3f, 0x42, 0xb4, 0x28, 0x82, 0x03, 0xc8, 0x30, 0x15, 0x1e, 0x9d, 0x80, 0xaa, 0xcc, 0xc9, 0x0b,
-	0x8a, 0x13, 0x1e, 0xba, 0xc3, 0x4e, 0x0a, 0x08, 0x9c, 0x14, 0x03, 0x68, 0xec, 0x71, 0x3e, 0xdc,
-	0x7d, 0x09, 0x8f, 0xf5, 0x17, 0xb1, 0x07, 0x5d, 0xcd, 0x4f, 0x1c, 0x5a, 0xd5, 0x91, 0xaa, 0x6d,
-	0xe5, 0x8d, 0xef, 0xd9, 0x00, 0xec, 0x4b, 0xb6, 0x82, 0xfe, 0x15, 0xcc, 0x30, 0x55, 0x0b, 0x7a,
-	0x4c, 0x66, 0x63, 0x6e, 0xbb, 0x56, 0x14, 0xfa, 0x5d, 0xfd, 0xcf, 0x75, 0x54, 0xb9, 0x7f, 0x25,
-	0x19, 0xdd, 0xe3, 0x9d, 0x98, 0x3b, 0x76, 0xc2, 0x5d, 0x93, 0xdb, 0xee, 0x83, 0xd0, 0xef, 0xf6,
-	0x24, 0xd3, 0x5e, 0x2d, 0xfe, 0x44, 0x89, 0x23, 0x6c, 0xd6, 0x5f, 0x89, 0x02, 0x0f, 0x6e, 0xce,
-	0xa4, 0x8b, 0x7f, 0xa2, 0x8c, 0xa0, 0xba, 0x66, 0xce, 0xc4, 0x99, 0x03, 0xfa, 0x33, 0xb2, 0x52,
-	0xea, 0xe0, 0xf1, 0x36, 0xfb, 0x4b, 0x1d, 0x5f, 0x56, 0xef, 0x5d, 0x49, 0xa6, 0x0f, 0x82, 0xee,
-	0x0f, 0xfa, 0xf0, 0x03, 0x27, 0xc9, 0x43, 0x6f, 0x0e, 0xb7, 0xf1, 0x07, 0x4e, 0xa2, 0x28, 0xd0,
-	0x35, 0x73, 0xb1, 0x4c, 0xd2, 0x1f, 0x93, 0xe9, 0xb4, 0x7b, 0x11, 0xfa, 0x97, 0x75, 0xac, 0xbc,
-	0xdf, 0x82, 0x6b, 0x60, 0x10, 0x28, 0xed, 0x4a, 0x45, 0xf9, 0xe3, 0xb2, 0x29, 0x8a, 0xeb, 0xac,
-	0xdc, 0xea, 0x9a, 0x99, 0xfb, 0xa3, 0xc7, 0x64, 0x11, 0xfb, 0xba, 0xc1, 0xbe, 0xfb, 0x6b, 0x9a,
-	0xbf, 0xdd, 0x2b, 0xc9, 0x6e, 0x0d, 0x22, 0x34, 0x1c, 0x3b, 0x2c, 0x36, 0x57, 0x1e, 0xe7, 0xd9,
-	0xa2, 0xab, 0x2b, 0xa8, 0xf2, 0x87, 0x2c, 0x94, 0x38, 0xe3, 0xb3, 0x29, 0x32, 0xa7, 0x2c, 0x37,
-	0xfd, 0x88, 0x4c, 0xf3, 0x30, 0x89, 0x3d, 0x2e, 0x74, 0x0d, 0xff, 0x56, 0xd0, 0xc7, 0x6c, 0x8a,
-	0xf7, 0xc2, 0x24, 0xee, 0xd6, 0x5e, 0xcc, 0xff, 0x4d, 0xc8, 0x26, 0x14, 0x3d, 0x2f, 0x8c, 0x71,
-	0xd9, 0xae, 0xe3, 0x2f, 0x33, 0x37, 0xa0, 0xbf, 0xcb, 0x2e, 0x2f, 0xe1, 0x85, 0x2d, 0x9f, 0x5b,
-	0xc8, 0x5a, 0x02, 0x1e, 0x7d, 0x93, 0x98, 0xc2, 0x26, 0xf4, 0x45, 0x81, 0x7d, 0xd6, 0x40, 0x1e,
-	0xa3, 0x34, 0xd4, 0x97, 0xdf, 0x28, 0x55, 0xea, 0xfb, 0x76, 0xde, 0x50, 0x1e, 0x11, 0x63, 0xfc,
-	0xc0, 0x03, 0x10, 0xac, 0xcc, 0x31, 0x1c, 0x7d, 0x44, 0x16, 0x41, 0x5a, 0x12, 0x25, 0xd0, 0x40,
-	0x83, 0xa6, 0x29, 0xd4, 0x74, 0x98, 0xf5, 0x9f, 0x87, 0x40, 0x64, 0x6a, 0x9e, 0xcb, 0xd5, 0x14,
-	0xa0, 0xa2, 0xe3, 0x8d, 0xbb, 0x6f, 0xbd, 0xa9, 0xe8, 0x28, 0xcd, 0x05, 0x05, 0xc0, 0x9b, 0x25,
-	0xd4, 0xf8, 0xbd, 0x46, 0x96, 0x87, 0xd3, 0x0b, 0xcf, 0x8d, 0x00, 0x5e, 0xe3, 0xd9, 0x3f, 0x73,
-	0xff, 0x07, 0x6f, 0x0b, 0x04, 0x94, 0x3e, 0x29, 0x71, 0xda, 0xc5, 0x4b, 0x9b, 0x0c, 0x86, 0x66,
-	0x6a, 0x48, 0xeb, 0xe4, 0x06, 0x3c, 0xdc, 0xbd, 0x04, 0xf3, 0x3b, 0x53, 0xdb, 0xc6, 0xfe, 0x10,
-	0x91, 0xe2, 0x08, 0xa7, 0xc3, 0xc2, 0xcb, 0x9c, 0x32, 0x36, 0x33, 0xdb, 0xda, 0xfd, 0xaf, 0xbe,
-	0xde, 0x9c, 0xb8, 0xfc, 0x7a, 0x73, 0xe2, 0xab, 0xab, 0x4d, 0xed, 0xf2, 0x6a, 0x53, 0xfb, 0xf5,
-	0xe3, 0xcd, 0x89, 0x2f, 0x1e, 0x6f, 0x6a, 0x97, 0x8f, 0x37, 0x27, 0xfe, 0xf5, 0x78, 0x73, 0xe2,
-	0xc3, 0x97, 0xfe, 0x8b, 0x3f, 0x52, 0xd3, 0x7d, 0x74, 0x74, 0x03, 0xff, 0x50, 0x7d, 0xfd, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x79, 0xd7, 0x43, 0x6e, 0x17, 0x00, 0x00,
+	// 3374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0x4d, 0x6c, 0x1c, 0x47,
+	0x76, 0x56, 0xf3, 0x47, 0x22, 0x8b, 0xff, 0x45, 0xfd, 0xb4, 0x28, 0x89, 0x4d, 0xb7, 0x47, 0x32,
+	0x25, 0x5b, 0x14, 0x45, 0x0b, 0x72, 0x2c, 0xd8, 0x49, 0x3c, 0xa4, 0x08, 0xcb, 0x0a, 0x2d, 0xa2,
+	0x49, 0x47, 0xb1, 0x9d, 0xa0, 0xd3, 0xec, 0xae, 0xe1, 0xb4, 0x39, 0xd3, 0xdd, 0xe9, 0x6a, 0xfe,
+	0x8c, 0x0e, 0x86, 0xe3, 0x43, 0x10, 0x20, 0x0e, 0x10, 0x30, 0x87, 0x20, 0x01, 0x02, 0x18, 0x88,
+	0x11, 0x24, 0xce, 0x25, 0x97, 0xbd, 0xec, 0x7d, 0x01, 0x5f, 0x16, 0xe2, 0x61, 0xb1, 0x58, 0xec,
+	0xa1, 0x01, 0x53, 0xb7, 0x39, 0xce, 0x51, 0xa7, 0xc5, 0x7b, 0xd5, 0x5d, 0x5d, 0x3d, 0x33, 0x02,
+	0x76, 0xd7, 0xb7, 0xa9, 0xef, 0x7b, 0xf5, 0xea, 0xd5, 0xab, 0xaa, 0x57, 0xaf, 0x5e, 0x0f, 0xa9,
+	0x34, 0xfc, 0x9d, 0x3b, 0x6e, 0x18, 0xd4, 0xfc, 0xdd, 0x3b, 0xb5, 0xb0, 0xe1, 0xb1, 0x58, 0x34,
+	0xf6, 0x63, 0x27, 0xf1, 0xc3, 0x60, 0x29, 0x8a, 0xc3, 0x24, 0xa4, 0x67, 0x05, 0x38, 0x77, 0xa5,
+	0x47, 0x3a, 0x69, 0x45, 0x4c, 0x08, 0xcd, 0x5d, 0x50, 0x48, 0xee, 0x3f, 0xcb, 0xe1, 0x39, 0x05,
+	0x8e, 0xf6, 0x1b, 0x8d, 0x30, 0xf6, 0x58, 0x9c, 0x71, 0x8b, 0x0a, 0x77, 0xc0, 0x62, 0xee, 0x87,
+	0x81, 0x1f, 0xec, 0xf6, 0xb1, 0x60, 0xce, 0x50, 0x24, 0x77, 0x1a, 0xa1, 0xbb, 0xd7, 0xad, 0x4a,
+	0x35, 0x8d, 0xb7, 0x02, 0xf7, 0xd0, 0x0f, 0xbc, 0xf0, 0x30, 0x23, 0x2f, 0x02, 0x89, 0x3f, 0xdd,
+	0xb0, 0x71, 0x67, 0x87, 0x45, 0x19, 0x4e, 0x01, 0xaf, 0xf1, 0x3b, 0x30, 0x0b, 0x9e, 0x61, 0x57,
+	0x33, 0xcc, 0x0d, 0xa3, 0x56, 0xec, 0x04, 0xbb, 0xac, 0xc9, 0x92, 0x7a, 0xe8, 0x65, 0xec, 0x28,
+	0x3b, 0x4a, 0xc4, 0x4f, 0xf3, 0xd7, 0x83, 0xe4, 0xf2, 0x3a, 0x3a, 0x61, 0x8d, 0x1d, 0xf8, 0x2e,
+	0x5b, 0x55, 0xcd, 0xa6, 0xdf, 0x6b, 0x64, 0xd4, 0x43, 0xdc, 0xf6, 0x3d, 0x5d, 0x5b, 0xd0, 0x16,
+	0xc7, 0xab, 0xdf, 0x68, 0x3f, 0xa4, 0xc6, 0x99, 0xdf, 0xa6, 0xc6, 0xbd, 0x5d, 0x3f, 0xa9, 0xef,
+	0xef, 0x2c, 0xb9, 0x61, 0x13, 0x8d, 0x4d, 0xea, 0x7e, 0xb0, 0xab, 0xfc, 0x52, 0xcd, 0x5d, 0x12,
+	0xda, 0x1f, 0xad, 0x9d, 0xa6, 0xc6, 0x48, 0xfe, 0xbb, 0x9d, 0x1a, 0x23, 0x5e, 0xf6, 0xbb, 0x93,
+	0x1a, 0x13, 0x47, 0xcd, 0xc6, 0x03, 0xd3, 0xf7, 0xde, 0x72, 0x92, 0x24, 0x36, 0xdb, 0xcf, 0x2b,
+	0xe7, 0xb2, 0xdf, 0x9d, 0xe7, 0x15, 0x29, 0xf7, 0x8f, 0x27, 0x15, 0xed, 0xf8, 0xa4, 0x22, 0x75,
+	0x58, 0x39, 0xe3, 0xd1, 0xff, 0xd6, 0xc8, 0x84, 0x1f, 0x24, 0x71, 0xe8, 0xed, 0xbb, 0xcc, 0xb3,
+	0x77, 0x5a, 0xfa, 0x00, 0x1a, 0xfc, 0xd5, 0x4f, 0x32, 0xb8, 0x9d, 0x1a, 0xe3, 0x85, 0xd6, 0x6a,
+	0xab, 0x93, 0x1a, 0x97, 0x84, 0xa1, 0x0a, 0x28, 0x4d, 0x9e, 0xe9, 0x41, 0xc1, 0x60, 0xab, 0xa4,
+	0x81, 0xba, 0x64, 0x96, 0x05, 0x6e, 0xdc, 0x8a, 0xc0, 0xc7, 0x76, 0xe4, 0x70, 0x7e, 0x18, 0xc6,
+	0x9e, 0x3e, 0xb8, 0xa0, 0x2d, 0x8e, 0x56, 0x57, 0xda, 0xa9, 0x41, 0x0b, 0x7a, 0x33, 0x63, 0x3b,
+	0xa9, 0xa1, 0xe3, 0xb0, 0xbd, 0x94, 0x69, 0xf5, 0x91, 0x37, 0x5f, 0xdc, 0x26, 0xb3, 0x62, 0x61,
+	0xcb, 0x4b, 0xba, 0x45, 0x06, 0xb2, 0xa5, 0x1c, 0xad, 0xae, 0x9e, 0xa6, 0xc6, 0x00, 0x4e, 0x71,
+	0xc0, 0x87, 0x11, 0xe6, 0x4b, 0x2b, 0xb0, 0x10, 0x84, 0x1e, 0xab, 0x39, 0xfb, 0x8d, 0xe4, 0x81,
+	0x99, 0xc4, 0xfb, 0x4c, 0x5d, 0x92, 0xe3, 0x93, 0xca, 0xc0, 0xa3, 0xb5, 0x6f, 0x61, 0x6e, 0x03,
+	0xbe, 0x47, 0x3f, 0x21, 0xc3, 0x0d, 0x67, 0x87, 0x35, 0xd0, 0xe3, 0xa3, 0xd5, 0x3f, 0x6b, 0xa7,
+	0x86, 0x00, 0x3a, 0xa9, 0xb1, 0x80, 0x4a, 0xb1, 0x95, 0xe9, 0x8d, 0x19, 0x4f, 0x9c, 0x38, 0x79,
+	0x60, 0xd6, 0x9c, 0x06, 0x47, 0xb5, 0xa4, 0xa0, 0xbf, 0x3a, 0xa9, 0x9c, 0xb1, 0x44, 0x67, 0xba,
+	0x4b, 0xa6, 0x6a, 0x7e, 0x83, 0xf1, 0x16, 0x4f, 0x58, 0xd3, 0x86, 0xfd, 0x8d, 0x4e, 0x9a, 0x5c,
+	0xa1, 0x4b, 0x35, 0xbe, 0xb4, 0x2e, 0xa9, 0xed, 0x56, 0xc4, 0xaa, 0xb7, 0xda, 0xa9, 0x31, 0x59,
+	0x2b, 0x61, 0x9d, 0xd4, 0x38, 0x8f, 0xa3, 0x97, 0x61, 0xd3, 0xea, 0x92, 0xa3, 0x1b, 0x64, 0x28,
+	0x72, 0x92, 0xba, 0x3e, 0x84, 0xe6, 0xbf, 0xdb, 0x4e, 0x0d, 0x6c, 0x77, 0x52, 0xe3, 0x0a, 0xf6,
+	0x87, 0x46, 0x66, 0xbc, 0x74, 0xc9, 0x97, 0x60, 0xf8, 0xa8, 0x64, 0x5e, 0x3e, 0xaf, 0x68, 0x5f,
+	0x5a, 0xd8, 0x8d, 0x6e, 0x92, 0x21, 0x34, 0x76, 0x38, 0x33, 0x56, 0x9c, 0xe8, 0x25, 0xb1, 0x1c,
+	0x68, 0xec, 0x22, 0x0c, 0x91, 0x08, 0x13, 0xa7, 0x70, 0x08, 0x68, 0xc8, 0x6d, 0x34, 0x2a, 0x5b,
+	0x16, 0x4a, 0xd1, 0xbf, 0x26, 0xe7, 0xc4, 0x3e, 0xe7, 0xfa, 0xd9, 0x85, 0xc1, 0xc5, 0xb1, 0x95,
+	0xd7, 0xca, 0x4a, 0xfb, 0x1c, 0xde, 0xaa, 0x01, 0xdb, 0xbe, 0x9d, 0x1a, 0x79, 0xcf, 0x4e, 0x6a,
+	0x8c, 0xe3, 0x50, 0xa2, 0x6d, 0x5a, 0x39, 0x41, 0xff, 0x55, 0x23, 0x33, 0x31, 0xe3, 0xae, 0x13,
+	0xd8, 0x7e, 0x90, 0xb0, 0xf8, 0xc0, 0x69, 0xd8, 0x5c, 0x3f, 0xb7, 0xa0, 0x2d, 0x0e, 0x57, 0x77,
+	0xdb, 0xa9, 0x31, 0x25, 0xc8, 0x47, 0x19, 0xb7, 0xd5, 0x49, 0x8d, 0x9b, 0xa8, 0xa9, 0x0b, 0xef,
+	0x76, 0xd1, 0xdb, 0xf7, 0x97, 0x97, 0xcd, 0x97, 0xa9, 0x31, 0xe8, 0x07, 0x49, 0xfb, 0x79, 0xe5,
+	0x7c, 0x3f, 0xf1, 0x97, 0xcf, 0x2b, 0x43, 0x20, 0x67, 0x75, 0x0f, 0x42, 0x7f, 0xae, 0x11, 0x5a,
+	0xe3, 0xf6, 0xa1, 0x93, 0xb8, 0x75, 0x16, 0xdb, 0x2c, 0x70, 0x76, 0x1a, 0xcc, 0xd3, 0x47, 0x16,
+	0xb4, 0xc5, 0x91, 0xea, 0x3f, 0x69, 0xa7, 0xa9, 0x31, 0xbd, 0xbe, 0xf5, 0x54, 0xb0, 0x0f, 0x05,
+	0xd9, 0x4e, 0x8d, 0xe9, 0x1a, 0x2f, 0x63, 0x9d, 0xd4, 0xb8, 0x25, 0x36, 0x41, 0x17, 0xd1, 0x6d,
+	0x6d, 0xbe, 0xc7, 0x2f, 0xf4, 0x15, 0x04, 0x3b, 0x41, 0xe2, 0xf8, 0xa4, 0xd2, 0x33, 0xac, 0xd5,
+	0x33, 0x28, 0xfd, 0xff, 0xb2, 0xf1, 0x1e, 0x6b, 0x38, 0x2d, 0x9b, 0xeb, 0xa3, 0x0b, 0xda, 0xa2,
+	0x56, 0xfd, 0x1a, 0x8c, 0x9f, 0x92, 0x5a, 0xd6, 0x80, 0xdc, 0x02, 0x3f, 0xd7, 0x78, 0x09, 0xea,
+	0xa4, 0xc6, 0x1b, 0x65, 0xd3, 0x05, 0xde, 0x6d, 0xf9, 0xdd, 0x65, 0xb0, 0xfb, 0x7c, 0x3f, 0xa9,
+	0x97, 0xcf, 0x2b, 0x03, 0x77, 0x97, 0x8f, 0x4f, 0x2a, 0xdd, 0xc3, 0x59, 0xdd, 0x83, 0xd1, 0xbf,
+	0x25, 0xe3, 0xfe, 0x6e, 0x10, 0xc6, 0xcc, 0x8e, 0x58, 0xdc, 0xe4, 0x3a, 0x41, 0x47, 0xbf, 0xdf,
+	0x4e, 0x8d, 0x31, 0x81, 0x6f, 0x02, 0xdc, 0x49, 0x8d, 0x8b, 0x22, 0x4c, 0x14, 0x98, 0xdc, 0xb7,
+	0xd3, 0xdd, 0xa0, 0xa5, 0x76, 0xa5, 0x7f, 0xaf, 0x91, 0x49, 0x67, 0x3f, 0x09, 0xed, 0x20, 0x8c,
+	0x9b, 0x4e, 0xc3, 0x7f, 0xc6, 0xf4, 0x31, 0x1c, 0xe4, 0xb3, 0x76, 0x6a, 0x4c, 0x00, 0xf3, 0x71,
+	0x4e, 0xc8, 0xa9, 0x97, 0xd0, 0x57, 0x2d, 0x19, 0xed, 0x95, 0xca, 0xd7, 0xcb, 0x2a, 0xeb, 0xa5,
+	0x21, 0x99, 0x68, 0xfa, 0x81, 0xed, 0xf9, 0x7c, 0xcf, 0xae, 0xc5, 0x8c, 0xe9, 0xe3, 0x0b, 0xda,
+	0xe2, 0xd8, 0xca, 0x78, 0x7e, 0x9e, 0xb6, 0xfc, 0x67, 0xac, 0xfa, 0x7e, 0x76, 0x74, 0xc6, 0x9a,
+	0x7e, 0xb0, 0xe6, 0xf3, 0xbd, 0xf5, 0x98, 0x81, 0x45, 0x06, 0x5a, 0xa4, 0x60, 0xea, 0x1a, 0x2c,
+	0x5c, 0x37, 0x5f, 0x3e, 0xaf, 0x0c, 0xde, 0x5d, 0xb8, 0x6e, 0xa9, 0xdd, 0xe8, 0x2e, 0x21, 0x45,
+	0x56, 0xa0, 0x4f, 0xe0, 0x68, 0x46, 0x3e, 0xda, 0x5f, 0x4a, 0xa6, 0x7c, 0x76, 0x6f, 0x64, 0x06,
+	0x28, 0x5d, 0x3b, 0xa9, 0x31, 0x8d, 0xe3, 0x17, 0x90, 0x69, 0x29, 0x3c, 0x7d, 0x9f, 0x9c, 0x73,
+	0xc3, 0xc8, 0x67, 0x31, 0xd7, 0x27, 0xf1, 0xe8, 0xbe, 0x0e, 0x87, 0x3f, 0x83, 0xe4, 0xfd, 0x9a,
+	0xb5, 0xf3, 0x63, 0x69, 0xe5, 0x02, 0xf4, 0x97, 0x1a, 0xb9, 0x08, 0xf9, 0x08, 0x8b, 0xed, 0xa6,
+	0x73, 0x64, 0x47, 0x2c, 0xf0, 0xfc, 0x60, 0xd7, 0xde, 0xf3, 0x77, 0xf4, 0x29, 0x54, 0xf7, 0x6f,
+	0xb0, 0x6b, 0x67, 0x37, 0x51, 0x64, 0xc3, 0x39, 0xda, 0x14, 0x02, 0x8f, 0xfd, 0x6a, 0x3b, 0x35,
+	0x66, 0xa3, 0x5e, 0xb8, 0x93, 0x1a, 0x97, 0x45, 0xf4, 0xec, 0xe5, 0x94, 0xa8, 0xd0, 0xb7, 0x6b,
+	0x7f, 0xf8, 0xf8, 0xa4, 0xd2, 0x6f, 0x7c, 0xab, 0x8f, 0xec, 0x0e, 0xb8, 0xa3, 0xee, 0xf0, 0x3a,
+	0xb8, 0x63, 0xba, 0x70, 0x47, 0x06, 0x49, 0x77, 0x64, 0xed, 0xc2, 0x1d, 0x19, 0x40, 0x3f, 0x20,
+	0xc3, 0x98, 0x99, 0xe9, 0x33, 0x18, 0xc4, 0x67, 0xf2, 0x15, 0x83, 0xf1, 0x9f, 0x00, 0x51, 0xd5,
+	0xe1, 0x96, 0x43, 0x99, 0x4e, 0x6a, 0x8c, 0xa1, 0x36, 0x6c, 0x99, 0x96, 0x40, 0xe9, 0x63, 0x32,
+	0x91, 0x1d, 0x28, 0x8f, 0x35, 0x58, 0xc2, 0x74, 0x8a, 0x9b, 0xfd, 0x06, 0xa6, 0x14, 0x48, 0xac,
+	0x21, 0xde, 0x49, 0x0d, 0xaa, 0x1c, 0x29, 0x01, 0x9a, 0x56, 0x49, 0x86, 0x1e, 0x11, 0x1d, 0x03,
+	0x74, 0x14, 0x87, 0xbb, 0x31, 0xe3, 0x5c, 0x8d, 0xd4, 0xb3, 0x38, 0x3f, 0xb8, 0x75, 0x2f, 0x80,
+	0xcc, 0x66, 0x26, 0xa2, 0xc6, 0x6b, 0x71, 0x8f, 0xf5, 0x65, 0xe5, 0xdc, 0xfb, 0x77, 0xa6, 0x5b,
+	0x64, 0x32, 0xdb, 0x17, 0x91, 0xb3, 0xcf, 0x99, 0xcd, 0xf5, 0xf3, 0x38, 0xde, 0x6d, 0x98, 0x87,
+	0x60, 0x36, 0x81, 0xd8, 0x92, 0xf3, 0x50, 0x41, 0xa9, 0xbd, 0x24, 0x4a, 0x19, 0x99, 0x80, 0x5d,
+	0x06, 0x4e, 0x6d, 0xf8, 0x6e, 0xc2, 0xf5, 0x0b, 0xa8, 0xf3, 0xcf, 0x41, 0x67, 0xd3, 0x39, 0x5a,
+	0xcd, 0xf1, 0xe2, 0xd4, 0x29, 0x60, 0x39, 0xf4, 0x65, 0x03, 0x88, 0x48, 0x67, 0x95, 0x7a, 0x53,
+	0x8f, 0x9c, 0xf7, 0x7c, 0x0e, 0x21, 0xd9, 0xe6, 0x91, 0x13, 0x73, 0x66, 0xe3, 0xcd, 0xaf, 0x5f,
+	0xc4, 0x95, 0xc0, 0x5c, 0x2b, 0xe3, 0xb7, 0x90, 0xc6, 0x9c, 0x42, 0xe6, 0x5a, 0xbd, 0x94, 0x69,
+	0xf5, 0x91, 0x57, 0x47, 0x49, 0x58, 0x33, 0xb2, 0xfd, 0xc0, 0x63, 0x47, 0x8c, 0xeb, 0x97, 0x7a,
+	0x46, 0xd9, 0x66, 0xcd, 0xe8, 0x91, 0x60, 0xbb, 0x47, 0x51, 0xa8, 0x62, 0x14, 0x05, 0xa4, 0x2b,
+	0xe4, 0x2c, 0x2e, 0x80, 0xa7, 0xeb, 0xa8, 0x77, 0xae, 0x9d, 0x1a, 0x19, 0x22, 0xaf, 0x76, 0xd1,
+	0x34, 0xad, 0x0c, 0xa7, 0x09, 0xb9, 0x74, 0xc8, 0x9c, 0x3d, 0x1b, 0x76, 0xb5, 0x9d, 0xd4, 0x63,
+	0xc6, 0xeb, 0x61, 0xc3, 0xb3, 0x23, 0x37, 0xd1, 0x2f, 0xa3, 0xc3, 0x21, 0xbc, 0x9f, 0x07, 0x91,
+	0x0f, 0x1d, 0x5e, 0xdf, 0xce, 0x05, 0x36, 0xdd, 0xa4, 0x93, 0x1a, 0x73, 0xa8, 0xb2, 0x1f, 0x29,
+	0x17, 0xb5, 0x6f, 0x57, 0xba, 0x4a, 0xc6, 0x9a, 0x4e, 0xbc, 0xc7, 0x62, 0x3b, 0x70, 0x9a, 0x4c,
+	0x9f, 0xc3, 0xac, 0xca, 0x84, 0x70, 0x26, 0xe0, 0x8f, 0x9d, 0x26, 0x93, 0xe1, 0xac, 0x80, 0x4c,
+	0x4b, 0xe1, 0x69, 0x8b, 0xcc, 0xc1, 0xeb, 0xc5, 0x0e, 0x0f, 0x03, 0x16, 0xf3, 0xba, 0x1f, 0xd9,
+	0xb5, 0x38, 0x6c, 0xda, 0x91, 0x13, 0xb3, 0x20, 0xd1, 0xaf, 0xa0, 0x0b, 0xde, 0x6b, 0xa7, 0xc6,
+	0x25, 0x90, 0x7a, 0x92, 0x0b, 0xad, 0xc7, 0x61, 0x73, 0x13, 0x45, 0x3a, 0xa9, 0x71, 0x2d, 0x8f,
+	0x78, 0xfd, 0x78, 0xd3, 0x7a, 0x55, 0x4f, 0xfa, 0x0f, 0x1a, 0x99, 0x69, 0x86, 0x9e, 0x9d, 0xf8,
+	0x4d, 0x66, 0x8b, 0x37, 0x98, 0xcd, 0xf5, 0xab, 0xe8, 0xb0, 0xcf, 0x4f, 0x53, 0x63, 0xc6, 0x72,
+	0x0e, 0x37, 0x42, 0x6f, 0xdb, 0x6f, 0xb2, 0xa7, 0xc8, 0xc2, 0xe5, 0x3d, 0xd9, 0x2c, 0x21, 0x32,
+	0xf7, 0x2c, 0xc3, 0xb9, 0xe7, 0x8e, 0x4f, 0x2a, 0xbd, 0x5a, 0xac, 0x2e, 0x1d, 0xf4, 0x2b, 0x8d,
+	0x5c, 0xc8, 0x8e, 0x89, 0xbb, 0x1f, 0x83, 0x6d, 0xf6, 0x61, 0xec, 0x27, 0x8c, 0xeb, 0xd7, 0xd0,
+	0x98, 0xbf, 0x80, 0xd0, 0x2b, 0x36, 0x7c, 0xc6, 0x3f, 0x45, 0xba, 0x93, 0x1a, 0xd7, 0x95, 0x53,
+	0x53, 0xe2, 0x94, 0xc3, 0xb3, 0xa2, 0x9c, 0x1d, 0x6d, 0xc5, 0xea, 0xa7, 0x09, 0x82, 0x58, 0xbe,
+	0xb7, 0x6b, 0xf0, 0x54, 0xd2, 0xe7, 0x8b, 0x20, 0x96, 0x11, 0xeb, 0x80, 0xcb, 0xc3, 0xaf, 0x82,
+	0xa6, 0x55, 0x92, 0xa1, 0x0d, 0x32, 0x8d, 0xef, 0x5e, 0x1b, 0x62, 0x81, 0x2d, 0xe2, 0xab, 0x81,
+	0xf1, 0xf5, 0x62, 0x1e, 0x5f, 0xab, 0xc0, 0x17, 0x41, 0x16, 0xb3, 0xfa, 0x9d, 0x12, 0x26, 0x3d,
+	0x5b, 0x86, 0x4d, 0xab, 0x4b, 0x8e, 0x7e, 0xa3, 0x91, 0x19, 0xdc, 0x42, 0xf8, 0x02, 0xb6, 0xc5,
+	0x13, 0x58, 0x5f, 0xc0, 0xf1, 0x66, 0xe1, 0x05, 0xb1, 0x1a, 0x46, 0x2d, 0x0b, 0xb8, 0x0d, 0xa4,
+	0xaa, 0x8f, 0x21, 0x07, 0x73, 0xcb, 0x60, 0x27, 0x35, 0x16, 0xe5, 0x36, 0x52, 0x70, 0xc5, 0x8d,
+	0x3c, 0x71, 0x02, 0xcf, 0x89, 0x3d, 0xb8, 0xff, 0x47, 0xf2, 0x86, 0xd5, 0xad, 0x88, 0x7e, 0x07,
+	0xe6, 0x38, 0x10, 0x40, 0x59, 0xc0, 0xfd, 0xc4, 0x3f, 0x00, 0x8f, 0xea, 0xaf, 0xa1, 0x3b, 0x8f,
+	0x20, 0x21, 0x5c, 0x75, 0x38, 0xdb, 0xca, 0xb9, 0x75, 0x4c, 0x08, 0xdd, 0x32, 0xd4, 0x49, 0x8d,
+	0x0b, 0xc2, 0x98, 0x32, 0x0e, 0x39, 0x50, 0x8f, 0x6c, 0x2f, 0x04, 0x69, 0x60, 0xd7, 0x20, 0x56,
+	0x97, 0x0c, 0xa7, 0xff, 0xa5, 0x91, 0xe9, 0x5a, 0xd8, 0x68, 0x84, 0x87, 0xf6, 0x17, 0xfb, 0x81,
+	0x0b, 0xe9, 0x08, 0xd7, 0xcd, 0xc2, 0xca, 0x8f, 0x72, 0xf0, 0x03, 0xbe, 0xe6, 0xc7, 0x1c, 0xac,
+	0xfc, 0xa2, 0x0c, 0x49, 0x2b, 0xbb, 0x70, 0xb4, 0xb2, 0x5b, 0xb6, 0x17, 0x02, 0x2b, 0xbb, 0x06,
+	0xb1, 0xa6, 0x84, 0x45, 0x12, 0xa6, 0x4f, 0xc8, 0x24, 0xec, 0xa8, 0x22, 0x3a, 0xe8, 0xaf, 0xa3,
+	0x89, 0xf0, 0xb0, 0x9a, 0x00, 0x46, 0x9e, 0xeb, 0x4e, 0x6a, 0xcc, 0x8a, 0xcb, 0x4f, 0x45, 0x4d,
+	0xab, 0x2c, 0x85, 0x0a, 0x59, 0xe0, 0x29, 0x0a, 0x2b, 0x8a, 0x42, 0x16, 0x78, 0x7d, 0x14, 0xaa,
+	0x28, 0x28, 0x54, 0xdb, 0x10, 0x04, 0xd1, 0xc2, 0x23, 0xc8, 0x46, 0xb9, 0x7e, 0x1d, 0xb5, 0x61,
+	0x10, 0x04, 0xf8, 0xaf, 0x10, 0x95, 0x41, 0xb0, 0x80, 0x4c, 0x4b, 0xe1, 0x51, 0x09, 0x58, 0x95,
+	0x29, 0xb9, 0xa1, 0x28, 0x61, 0x81, 0xd7, 0xad, 0x44, 0x42, 0xa0, 0x44, 0x36, 0x20, 0xb1, 0xc7,
+	0xfe, 0x70, 0xf7, 0x25, 0x2c, 0xd6, 0xdf, 0xc0, 0x1c, 0x74, 0x36, 0x3f, 0x71, 0x28, 0xb5, 0x8e,
+	0x54, 0x75, 0x31, 0x4f, 0x7c, 0x8f, 0x0a, 0xb0, 0x93, 0x1a, 0x33, 0xa8, 0x5f, 0xc1, 0x4c, 0x4b,
+	0x95, 0xa0, 0x9f, 0x92, 0x21, 0x5e, 0x4b, 0x22, 0x7d, 0x11, 0x35, 0x5f, 0x96, 0xb9, 0xf4, 0xfa,
+	0xf6, 0x66, 0x39, 0xaf, 0xbd, 0x05, 0xfa, 0x4f, 0x53, 0x63, 0x08, 0x28, 0x78, 0x03, 0x43, 0xb7,
+	0x4e, 0x6a, 0x10, 0x31, 0x81, 0x5a, 0x12, 0x99, 0xc7, 0x27, 0x15, 0x64, 0x2d, 0xe4, 0x68, 0x9d,
+	0x88, 0x63, 0x6d, 0xbb, 0xf5, 0xfd, 0x60, 0x0f, 0x52, 0xe8, 0x9b, 0x78, 0x80, 0x2f, 0x2d, 0xc9,
+	0x52, 0x0d, 0x86, 0x8c, 0xd5, 0x8c, 0x16, 0x0b, 0xb6, 0xa3, 0x42, 0x72, 0xc1, 0x4a, 0xa8, 0x69,
+	0x95, 0xa5, 0xe8, 0x13, 0x91, 0x92, 0xa0, 0xbf, 0xf7, 0x76, 0x22, 0xae, 0xdf, 0xc2, 0x18, 0xfb,
+	0x26, 0xbe, 0x03, 0x9c, 0xa3, 0x2d, 0x16, 0x78, 0x8f, 0x77, 0x22, 0x2e, 0xdd, 0xa1, 0x60, 0xf2,
+	0x3e, 0x54, 0x05, 0x73, 0x85, 0x31, 0x73, 0x0f, 0x84, 0xc2, 0x37, 0x4b, 0x0a, 0x2d, 0xe6, 0x1e,
+	0x74, 0x2b, 0xcc, 0xb1, 0x92, 0xc2, 0x1c, 0xa4, 0x16, 0x19, 0x89, 0x62, 0x3f, 0x8c, 0xfd, 0xa4,
+	0xa5, 0xbf, 0x85, 0xba, 0xee, 0x43, 0x0d, 0x2d, 0xc7, 0xe4, 0x95, 0x9d, 0x03, 0x6a, 0x9e, 0xa4,
+	0x86, 0xfa, 0xbb, 0x96, 0xec, 0x03, 0x57, 0xcc, 0x38, 0xee, 0x53, 0x71, 0xcf, 0x71, 0xfd, 0x36,
+	0xd6, 0x17, 0x64, 0xd1, 0x62, 0xab, 0x15, 0xb8, 0xe2, 0x3a, 0xaa, 0x3e, 0xca, 0x37, 0x07, 0x97,
+	0x18, 0x97, 0x05, 0x9e, 0x02, 0xeb, 0x5b, 0xe0, 0x29, 0x68, 0x2c, 0xf0, 0xa8, 0x2a, 0x68, 0x44,
+	0xa8, 0xc8, 0x2c, 0x0f, 0xeb, 0x2c, 0x80, 0x30, 0xcd, 0x62, 0xe6, 0xe9, 0x4b, 0xb8, 0xd7, 0xe1,
+	0x71, 0x31, 0x8d, 0xec, 0xd3, 0x3a, 0x0b, 0x36, 0x04, 0xd7, 0x49, 0x8d, 0x1b, 0x45, 0xba, 0xa3,
+	0x10, 0x3d, 0x43, 0xe3, 0x60, 0x3d, 0xfd, 0xe9, 0xc7, 0x64, 0xb8, 0xc9, 0xe2, 0x5d, 0xa6, 0xdf,
+	0xc1, 0x0d, 0x3b, 0x97, 0x4f, 0x76, 0x03, 0xc0, 0xf2, 0x8e, 0xbd, 0x9a, 0x4d, 0x5a, 0x74, 0x90,
+	0x99, 0x3e, 0xb6, 0x4c, 0x4b, 0xa0, 0xb4, 0x0e, 0x09, 0x0f, 0xe7, 0xb6, 0x5b, 0x87, 0x78, 0xaf,
+	0x2f, 0x97, 0x1f, 0x79, 0x1b, 0x0e, 0xe7, 0xab, 0xc8, 0xbc, 0xe2, 0x91, 0xd7, 0x94, 0x02, 0x4a,
+	0x56, 0x94, 0x43, 0x98, 0x15, 0xe5, 0x0d, 0xfa, 0x11, 0x8c, 0x74, 0x94, 0x3d, 0x28, 0xb8, 0x7e,
+	0x17, 0x77, 0xc1, 0x4d, 0xa1, 0xe4, 0x48, 0x3c, 0x15, 0xb8, 0xa2, 0x24, 0x87, 0xe4, 0x7e, 0x52,
+	0xc4, 0xe8, 0x53, 0x32, 0xa5, 0xe8, 0xc2, 0xa4, 0x70, 0x05, 0xf5, 0xdd, 0x81, 0x23, 0x54, 0x08,
+	0x8a, 0x6c, 0x70, 0xb6, 0x4b, 0xa5, 0x9a, 0x06, 0x96, 0x85, 0xe9, 0xdf, 0x90, 0x11, 0x1e, 0x38,
+	0x11, 0xaf, 0x87, 0x89, 0xfe, 0x36, 0xfa, 0xe2, 0x9a, 0xdc, 0x4e, 0x19, 0x5e, 0xf6, 0x84, 0x99,
+	0x79, 0x42, 0x76, 0xeb, 0xa4, 0xc6, 0xa4, 0xd8, 0x56, 0x19, 0x60, 0x5a, 0x92, 0xa3, 0x0e, 0x19,
+	0xae, 0x87, 0xe1, 0x1e, 0xd7, 0xef, 0x95, 0xb7, 0xaa, 0x28, 0x85, 0x7d, 0x18, 0x86, 0x7b, 0xd5,
+	0x77, 0xf2, 0x55, 0x43, 0x41, 0x79, 0x30, 0xa0, 0xd5, 0x6f, 0x7b, 0x0e, 0x01, 0x21, 0x2a, 0x8f,
+	0xd8, 0x81, 0xee, 0x91, 0xd1, 0x98, 0x39, 0x9e, 0x1d, 0x06, 0x8d, 0x96, 0xfe, 0x3f, 0xeb, 0xb8,
+	0x15, 0x37, 0x4e, 0x53, 0x83, 0xae, 0xb1, 0x28, 0x66, 0xae, 0x93, 0x30, 0xcf, 0x62, 0x8e, 0xf7,
+	0x24, 0x68, 0xb4, 0xda, 0xa9, 0xa1, 0xdd, 0x96, 0x55, 0xe1, 0x38, 0xc4, 0xea, 0xc3, 0x5b, 0x61,
+	0xd3, 0x87, 0xa7, 0x40, 0xd2, 0xc2, 0xaa, 0x70, 0x0f, 0xaa, 0x6b, 0xd6, 0x48, 0x9c, 0x29, 0xa0,
+	0x7f, 0x47, 0x66, 0x4a, 0x25, 0x09, 0x5c, 0x89, 0xff, 0x5d, 0xc7, 0x52, 0xd1, 0xc3, 0xd3, 0xd4,
+	0xd0, 0x8b, 0x41, 0x37, 0x8a, 0xc2, 0xc2, 0xa6, 0x9b, 0xe4, 0x43, 0xcf, 0x77, 0xd7, 0x25, 0x36,
+	0xdd, 0x44, 0xb1, 0x40, 0xd7, 0xac, 0xc9, 0x32, 0x49, 0x3f, 0x25, 0xe7, 0xc4, 0x73, 0x8c, 0xeb,
+	0xdf, 0xaf, 0xe3, 0x9a, 0xff, 0x29, 0xe4, 0xb5, 0xc5, 0x40, 0xe2, 0x99, 0xcd, 0xcb, 0x93, 0xcb,
+	0xba, 0x28, 0xaa, 0xb3, 0x0d, 0xa0, 0x6b, 0x56, 0xae, 0x8f, 0xee, 0x91, 0x49, 0x7c, 0xa8, 0x16,
+	0x17, 0xe9, 0xff, 0x09, 0xff, 0x41, 0xb5, 0xf9, 0x52, 0x31, 0xc2, 0x96, 0xeb, 0x04, 0xf2, 0xb6,
+	0xcc, 0xc7, 0xb9, 0x26, 0x9f, 0xa9, 0x92, 0x2a, 0x4f, 0x64, 0xa2, 0xc4, 0x99, 0xff, 0x3c, 0x48,
+	0x66, 0x7a, 0x6e, 0x19, 0xba, 0x4d, 0xa6, 0xa3, 0xd8, 0x3f, 0x70, 0x12, 0x66, 0xef, 0xb1, 0x16,
+	0x3e, 0xf9, 0xb2, 0x8a, 0x37, 0xa6, 0x93, 0x19, 0xf7, 0x98, 0xb5, 0xe0, 0xf9, 0x26, 0xd3, 0xc9,
+	0x32, 0x6c, 0x5a, 0x5d, 0x72, 0xf4, 0x01, 0x19, 0x91, 0xb5, 0x7a, 0x51, 0xe7, 0x9e, 0xc7, 0xe8,
+	0x5b, 0x54, 0xe8, 0x27, 0xb3, 0xa0, 0x94, 0xd7, 0xe5, 0x25, 0x47, 0xdf, 0x21, 0x23, 0xf5, 0x90,
+	0x27, 0x60, 0x4e, 0x56, 0xe7, 0xbf, 0x8a, 0xd5, 0x88, 0x90, 0x27, 0x8f, 0x59, 0xab, 0xa8, 0x46,
+	0x88, 0xb6, 0x69, 0xe5, 0x0c, 0x4c, 0x65, 0x2f, 0x08, 0x0f, 0x03, 0x1b, 0x00, 0x2e, 0xa6, 0x32,
+	0x54, 0x4c, 0x05, 0xb9, 0x0f, 0x81, 0x2a, 0x4d, 0xa5, 0x0c, 0x9b, 0x56, 0x97, 0x1c, 0x9c, 0xfc,
+	0x28, 0x6c, 0x34, 0xd4, 0x1a, 0xc2, 0x70, 0x71, 0xf2, 0x81, 0x52, 0x6b, 0x07, 0xe2, 0xe4, 0x97,
+	0xd0, 0xe2, 0xe4, 0x97, 0xe1, 0xaf, 0x07, 0xc9, 0x98, 0x92, 0x4f, 0xd0, 0xcf, 0xc9, 0x39, 0x16,
+	0x24, 0xb1, 0xcf, 0xb8, 0xae, 0xe1, 0x61, 0xd5, 0xfb, 0x64, 0x1d, 0x0f, 0x83, 0x24, 0x6e, 0x55,
+	0xdf, 0xc8, 0xcb, 0xd5, 0x59, 0x07, 0x19, 0x6a, 0xa1, 0x8d, 0xc7, 0x68, 0x18, 0x7f, 0x59, 0xb9,
+	0x00, 0xfd, 0xf7, 0xec, 0x75, 0xc4, 0xfd, 0x60, 0xb7, 0xc1, 0x6c, 0x64, 0x6d, 0xf8, 0x68, 0x87,
+	0xcb, 0x33, 0x5c, 0xad, 0xc1, 0xc3, 0x1b, 0x2e, 0x64, 0xe4, 0x71, 0x94, 0x2d, 0xb5, 0xb4, 0xd8,
+	0x4b, 0x95, 0x0a, 0x0b, 0x2b, 0xf7, 0x94, 0x2a, 0x55, 0x1f, 0x3d, 0x50, 0x61, 0x04, 0x29, 0xab,
+	0x0f, 0x47, 0x9f, 0x91, 0x49, 0x30, 0x2d, 0x09, 0x13, 0xa7, 0x21, 0x6c, 0x1a, 0x44, 0x9b, 0xb6,
+	0xb3, 0x02, 0xc7, 0x36, 0x10, 0x99, 0x35, 0xaf, 0xe5, 0xd6, 0x48, 0x50, 0xb1, 0xe3, 0xde, 0xf2,
+	0xbb, 0xf7, 0x15, 0x3b, 0x4a, 0x7d, 0xc1, 0x02, 0xe0, 0xad, 0x12, 0x6a, 0xfe, 0xa7, 0x46, 0xa6,
+	0xbb, 0xdd, 0x0b, 0xf5, 0xac, 0x26, 0x94, 0x7b, 0xb3, 0x83, 0xf0, 0x26, 0x5e, 0x69, 0x00, 0x28,
+	0xb7, 0x45, 0xe2, 0xd6, 0x65, 0x29, 0x97, 0x14, 0x4d, 0x4b, 0x08, 0xd2, 0x75, 0x72, 0x16, 0x2a,
+	0xc3, 0x7e, 0x82, 0xfe, 0x1d, 0xa9, 0x2e, 0x61, 0x01, 0x02, 0x11, 0x99, 0xc3, 0x88, 0xa6, 0xd4,
+	0x32, 0xa6, 0xb4, 0xad, 0x4c, 0xd6, 0xfc, 0xd9, 0x00, 0xa1, 0xbd, 0x37, 0x2d, 0x7d, 0x2f, 0xfb,
+	0x6a, 0x22, 0x0c, 0xfc, 0x43, 0xbf, 0x90, 0xac, 0xc1, 0xe9, 0x4c, 0x12, 0x16, 0x07, 0x5c, 0x1f,
+	0x58, 0x18, 0xcc, 0x34, 0x48, 0x4c, 0x1e, 0xb1, 0x0c, 0xc0, 0x8f, 0x59, 0xd9, 0x6f, 0x4b, 0x4a,
+	0xd1, 0xff, 0xd0, 0xc8, 0xb8, 0xd8, 0x52, 0xcf, 0x18, 0x96, 0x3e, 0xc5, 0xaa, 0xc1, 0xc3, 0x87,
+	0x6c, 0xc0, 0x2a, 0x3f, 0x63, 0xa2, 0xe0, 0x49, 0x9a, 0xb2, 0xa5, 0x96, 0xa8, 0x32, 0xe8, 0xd5,
+	0xfb, 0x48, 0xed, 0x57, 0x6a, 0xe5, 0xbb, 0xe9, 0xf8, 0xa4, 0xa2, 0x8c, 0x64, 0x15, 0x12, 0x3b,
+	0xe6, 0x2f, 0x06, 0xc8, 0xa5, 0x57, 0xe4, 0x12, 0xb4, 0x2e, 0xee, 0x72, 0x91, 0x80, 0x88, 0x02,
+	0x8f, 0x26, 0x2b, 0x6a, 0x70, 0x3d, 0x8b, 0x4e, 0x5e, 0xd7, 0x5d, 0x5e, 0xa0, 0x8a, 0x8d, 0x65,
+	0x71, 0xab, 0xdc, 0xa4, 0x2e, 0x19, 0x85, 0xdb, 0x4a, 0xd4, 0xd1, 0xc4, 0x41, 0x5b, 0x07, 0x4f,
+	0x37, 0xfd, 0x20, 0xaf, 0x9e, 0x5d, 0xcd, 0xef, 0x23, 0x04, 0x4a, 0xce, 0x50, 0xbf, 0x07, 0xc9,
+	0x2e, 0x58, 0x37, 0x5f, 0x5e, 0xb6, 0x24, 0x40, 0x3f, 0x27, 0x13, 0x6e, 0x9d, 0xb9, 0x7b, 0x78,
+	0xa8, 0xc3, 0x48, 0x04, 0xcd, 0x11, 0x4c, 0x77, 0xc7, 0x91, 0x78, 0x28, 0x70, 0x59, 0x75, 0x50,
+	0x41, 0x58, 0xdb, 0x92, 0x94, 0x55, 0x6a, 0x99, 0xbf, 0x1a, 0x20, 0x17, 0xfa, 0xe6, 0x21, 0x3f,
+	0x71, 0x0b, 0x3e, 0x21, 0x93, 0x6e, 0xcc, 0xe0, 0xd6, 0x71, 0xc3, 0x66, 0xd3, 0x09, 0xf2, 0x6b,
+	0x02, 0x5f, 0x24, 0x82, 0x59, 0x15, 0x84, 0x5c, 0x82, 0x12, 0x6a, 0x5a, 0x65, 0x29, 0xba, 0x45,
+	0xa6, 0x62, 0xd6, 0x60, 0x0e, 0x2f, 0x34, 0x0e, 0x16, 0xb1, 0x3f, 0xa3, 0x0a, 0x95, 0xe7, 0xb3,
+	0x6f, 0x72, 0x2a, 0x6c, 0x5a, 0x5d, 0x72, 0xf4, 0x29, 0x19, 0x4d, 0xfc, 0x26, 0x0b, 0xf7, 0x13,
+	0x9b, 0xe3, 0x55, 0x32, 0x5c, 0x7d, 0x00, 0xeb, 0x97, 0x81, 0x5b, 0x72, 0xfd, 0x72, 0x40, 0x59,
+	0x3f, 0xe5, 0x7b, 0x1e, 0xac, 0x19, 0x7c, 0xb6, 0x93, 0xfd, 0xcc, 0xef, 0x06, 0x08, 0x29, 0x52,
+	0x30, 0x08, 0x38, 0xec, 0x00, 0x4a, 0x75, 0x4a, 0xc0, 0x41, 0x40, 0x06, 0x1c, 0x6c, 0x15, 0x01,
+	0xa7, 0x68, 0x5a, 0x42, 0x90, 0xde, 0x87, 0x2f, 0x1a, 0xaa, 0x27, 0xaf, 0x8a, 0x2f, 0x1a, 0xf9,
+	0x84, 0xf3, 0x2f, 0x1a, 0xd9, 0x4c, 0x73, 0xa6, 0x14, 0x0b, 0x06, 0xff, 0xe8, 0x58, 0xf0, 0x49,
+	0xaf, 0xa3, 0xfe, 0xa4, 0xcb, 0x51, 0x57, 0x5e, 0xed, 0x28, 0xb5, 0x2c, 0x7d, 0x5f, 0x71, 0x53,
+	0xf5, 0xf1, 0x0f, 0x3f, 0xce, 0x9f, 0x39, 0xf9, 0x71, 0xfe, 0xcc, 0x0f, 0xa7, 0xf3, 0xda, 0xc9,
+	0xe9, 0xbc, 0xf6, 0x2f, 0x2f, 0xe6, 0xcf, 0x7c, 0xfb, 0x62, 0x5e, 0x3b, 0x79, 0x31, 0x7f, 0xe6,
+	0x37, 0x2f, 0xe6, 0xcf, 0x7c, 0x76, 0xf3, 0xf7, 0xf8, 0x9f, 0x82, 0xb8, 0x45, 0x77, 0xce, 0xe2,
+	0x23, 0xf8, 0xed, 0xdf, 0x0d, 0x00, 0x5b, 0x10, 0x3a, 0xb6, 0x02, 0x23, 0x00, 0x00,
 }
 
 func (m *FolderDeviceConfiguration) Marshal() (dAtA []byte, err error) {
@@ -511,6 +822,140 @@ func (m *FolderConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x9a
+	if m.MaxDeletesPct != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxDeletesPct))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxDeletes != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxDeletes))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size, err := m.MassChange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.Merge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xfa
+	if m.PauseWhenMetered {
+		i--
+		if m.PauseWhenMetered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.SyncWindows) > 0 {
+		for iNdEx := len(m.SyncWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyncWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.Priority != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxRecvKbps != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxRecvKbps))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MaxSendKbps != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxSendKbps))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.BlockChunking != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.BlockChunking))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
+	{
+		size, err := m.SFTP.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xc2
 	{
 		size, err := m.XattrFilter.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -865,6 +1310,62 @@ func (m *FolderConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SFTPConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SFTPConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SFTPConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PollIntervalS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.PollIntervalS))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.KnownHostsFile) > 0 {
+		i -= len(m.KnownHostsFile)
+		copy(dAtA[i:], m.KnownHostsFile)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.KnownHostsFile)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HostKey) > 0 {
+		i -= len(m.HostKey)
+		copy(dAtA[i:], m.HostKey)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.HostKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PrivateKeyFile) > 0 {
+		i -= len(m.PrivateKeyFile)
+		copy(dAtA[i:], m.PrivateKeyFile)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.PrivateKeyFile)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *XattrFilter) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -952,16 +1453,203 @@ func (m *XattrFilterEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFolderconfiguration(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFolderconfiguration(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MergeConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *MergeConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSizeKiB != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxSizeKiB))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Patterns) > 0 {
+		for iNdEx := len(m.Patterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Patterns[iNdEx])
+			copy(dAtA[i:], m.Patterns[iNdEx])
+			i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Patterns[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MassChangeConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MassChangeConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MassChangeConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckEntropy {
+		i--
+		if m.CheckEntropy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinFiles != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MinFiles))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxChangedPct != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxChangedPct))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.TimeoutS))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReleaseCommand) > 0 {
+		i -= len(m.ReleaseCommand)
+		copy(dAtA[i:], m.ReleaseCommand)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.ReleaseCommand)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CreateCommand) > 0 {
+		i -= len(m.CreateCommand)
+		copy(dAtA[i:], m.CreateCommand)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.CreateCommand)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FolderHook) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FolderHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FolderHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.TimeoutS))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Patterns) > 0 {
+		for iNdEx := len(m.Patterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Patterns[iNdEx])
+			copy(dAtA[i:], m.Patterns[iNdEx])
+			i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Patterns[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Command) > 0 {
+		i -= len(m.Command)
+		copy(dAtA[i:], m.Command)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Command)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFolderconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFolderconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *FolderDeviceConfiguration) ProtoSize() (n int) {
 	if m == nil {
@@ -1107,6 +1795,47 @@ func (m *FolderConfiguration) ProtoSize() (n int) {
 	}
 	l = m.XattrFilter.ProtoSize()
 	n += 2 + l + sovFolderconfiguration(uint64(l))
+	l = m.SFTP.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	if m.BlockChunking != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.BlockChunking))
+	}
+	if m.MaxSendKbps != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxSendKbps))
+	}
+	if m.MaxRecvKbps != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxRecvKbps))
+	}
+	if m.Priority != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.Priority))
+	}
+	if len(m.SyncWindows) > 0 {
+		for _, e := range m.SyncWindows {
+			l = e.ProtoSize()
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.PauseWhenMetered {
+		n += 3
+	}
+	l = m.Merge.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	l = m.MassChange.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	if m.MaxDeletes != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxDeletes))
+	}
+	if m.MaxDeletesPct != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxDeletesPct))
+	}
+	l = m.Snapshot.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.ProtoSize()
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
 	if m.DeprecatedReadOnly {
 		n += 4
 	}
@@ -1122,6 +1851,34 @@ func (m *FolderConfiguration) ProtoSize() (n int) {
 	return n
 }
 
+func (m *SFTPConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrivateKeyFile)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.HostKey)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.KnownHostsFile)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.PollIntervalS != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.PollIntervalS))
+	}
+	return n
+}
+
 func (m *XattrFilter) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -1159,6 +1916,96 @@ func (m *XattrFilterEntry) ProtoSize() (n int) {
 	return n
 }
 
+func (m *MergeConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	if len(m.Patterns) > 0 {
+		for _, s := range m.Patterns {
+			l = len(s)
+			n += 1 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.MaxSizeKiB != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.MaxSizeKiB))
+	}
+	return n
+}
+
+func (m *MassChangeConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxChangedPct != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.MaxChangedPct))
+	}
+	if m.MinFiles != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.MinFiles))
+	}
+	if m.CheckEntropy {
+		n += 2
+	}
+	return n
+}
+
+func (m *SnapshotConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.CreateCommand)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.ReleaseCommand)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.TimeoutS != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.TimeoutS))
+	}
+	return n
+}
+
+func (m *FolderHook) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	l = len(m.Command)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	if len(m.Patterns) > 0 {
+		for _, s := range m.Patterns {
+			l = len(s)
+			n += 1 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.TimeoutS != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.TimeoutS))
+	}
+	return n
+}
+
 func sovFolderconfiguration(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2199,11 +3046,11 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9000:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedReadOnly", wireType)
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SFTP", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2213,28 +3060,904 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeprecatedReadOnly = bool(v != 0)
-		case 9001:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMinDiskFreePct", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.DeprecatedMinDiskFreePct = float64(math.Float64frombits(v))
-		case 9002:
-			if wireType != 0 {
+			if err := m.SFTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockChunking", wireType)
+			}
+			m.BlockChunking = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockChunking |= protocol.BlockChunking(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSendKbps", wireType)
+			}
+			m.MaxSendKbps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSendKbps |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecvKbps", wireType)
+			}
+			m.MaxRecvKbps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecvKbps |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncWindows = append(m.SyncWindows, SyncWindow{})
+			if err := m.SyncWindows[len(m.SyncWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseWhenMetered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseWhenMetered = bool(v != 0)
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MassChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MassChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeletes", wireType)
+			}
+			m.MaxDeletes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeletes |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeletesPct", wireType)
+			}
+			m.MaxDeletesPct = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeletesPct |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, FolderHook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9000:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeprecatedReadOnly = bool(v != 0)
+		case 9001:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMinDiskFreePct", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DeprecatedMinDiskFreePct = float64(math.Float64frombits(v))
+		case 9002:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedPullers", wireType)
 			}
-			m.DeprecatedPullers = 0
+			m.DeprecatedPullers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeprecatedPullers |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9003:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedScanOwnership", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeprecatedScanOwnership = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SFTPConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SFTPConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SFTPConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKeyFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrivateKeyFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownHostsFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KnownHostsFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollIntervalS", wireType)
+			}
+			m.PollIntervalS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollIntervalS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XattrFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XattrFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XattrFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, XattrFilterEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSingleEntrySize", wireType)
+			}
+			m.MaxSingleEntrySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSingleEntrySize |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSize", wireType)
+			}
+			m.MaxTotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalSize |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XattrFilterEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XattrFilterEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Match = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patterns", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2244,14 +3967,134 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeprecatedPullers |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9003:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patterns = append(m.Patterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedScanOwnership", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSizeKiB", wireType)
+			}
+			m.MaxSizeKiB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSizeKiB |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MassChangeConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MassChangeConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MassChangeConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangedPct", wireType)
+			}
+			m.MaxChangedPct = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChangedPct |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFiles", wireType)
+			}
+			m.MinFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFiles |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckEntropy", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2268,7 +4111,7 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DeprecatedScanOwnership = bool(v != 0)
+			m.CheckEntropy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
@@ -2290,7 +4133,7 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *XattrFilter) Unmarshal(dAtA []byte) error {
+func (m *SnapshotConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2313,17 +4156,17 @@ func (m *XattrFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: XattrFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: XattrFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2333,31 +4176,29 @@ func (m *XattrFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFolderconfiguration
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFolderconfiguration
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, XattrFilterEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSingleEntrySize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateCommand", wireType)
 			}
-			m.MaxSingleEntrySize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2367,16 +4208,61 @@ func (m *XattrFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSingleEntrySize |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateCommand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseCommand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseCommand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutS", wireType)
 			}
-			m.MaxTotalSize = 0
+			m.TimeoutS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2386,7 +4272,7 @@ func (m *XattrFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTotalSize |= int(b&0x7F) << shift
+				m.TimeoutS |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2412,7 +4298,7 @@ func (m *XattrFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
+func (m *FolderHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2435,15 +4321,15 @@ func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: XattrFilterEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: FolderHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: XattrFilterEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FolderHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2471,13 +4357,77 @@ func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Match = string(dAtA[iNdEx:postIndex])
+			m.Event = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patterns = append(m.Patterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutS", wireType)
 			}
-			var v int
+			m.TimeoutS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -2487,12 +4437,11 @@ func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TimeoutS |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Permit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
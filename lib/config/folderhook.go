@@ -0,0 +1,86 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"path"
+
+	"github.com/syncthing/syncthing/lib/structutil"
+)
+
+// The events that folder hooks run on.
+const (
+	HookPulled      = "pulled"
+	HookBeforeScan  = "before-scan"
+	HookFileUpdated = "file-updated"
+)
+
+func (h *FolderHook) UnmarshalJSON(data []byte) error {
+	structutil.SetDefaults(h)
+	type noCustomUnmarshal FolderHook
+	ptr := (*noCustomUnmarshal)(h)
+	return json.Unmarshal(data, ptr)
+}
+
+func (h *FolderHook) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	structutil.SetDefaults(h)
+	type noCustomUnmarshal FolderHook
+	ptr := (*noCustomUnmarshal)(h)
+	return d.DecodeElement(ptr, &start)
+}
+
+// Matches returns whether a change to the file with the given name
+// triggers the hook. Hooks without patterns match all files.
+func (h FolderHook) Matches(name string) bool {
+	return len(h.Patterns) == 0 || matchesAnyPattern(h.Patterns, name)
+}
+
+// Validate returns an error describing what is wrong with the hook, if
+// anything.
+func (h FolderHook) Validate() error {
+	switch h.Event {
+	case HookPulled, HookBeforeScan, HookFileUpdated:
+	default:
+		return fmt.Errorf("unknown event %q", h.Event)
+	}
+	if h.Command == "" {
+		return fmt.Errorf("%s hook has no command", h.Event)
+	}
+	for _, pattern := range h.Patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// HooksFor returns the folder's hooks for the given event.
+func (f FolderConfiguration) HooksFor(event string) []FolderHook {
+	var hooks []FolderHook
+	for _, h := range f.Hooks {
+		if h.Event == event {
+			hooks = append(hooks, h)
+		}
+	}
+	return hooks
+}
+
+// validFolderHooks returns the hooks, less those that are invalid.
+func validFolderHooks(hooks []FolderHook, what string) []FolderHook {
+	valid := hooks[:0]
+	for _, h := range hooks {
+		if err := h.Validate(); err != nil {
+			l.Warnf("Ignoring invalid hook for %s: %v", what, err)
+			continue
+		}
+		valid = append(valid, h)
+	}
+	return valid
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+)
+
+func TestFolderHookMatches(t *testing.T) {
+	hook := FolderHook{Patterns: []string{"*.conf", "etc/*"}}
+	cases := map[string]bool{
+		"app.conf":     true,
+		"dir/app.conf": true,
+		"etc/hosts":    true,
+		"etc/sub/file": false,
+		"app.txt":      false,
+	}
+	for name, expected := range cases {
+		if hook.Matches(name) != expected {
+			t.Errorf("Matches(%q) != %v", name, expected)
+		}
+	}
+}
+
+func TestFolderHookDefaults(t *testing.T) {
+	var fromXML FolderConfiguration
+	if err := xml.Unmarshal([]byte(`<folder><hook event="pulled"><command>true</command></hook></folder>`), &fromXML); err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON FolderConfiguration
+	if err := json.Unmarshal([]byte(`{"hooks": [{"event": "pulled", "command": "true"}]}`), &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	for _, cfg := range []FolderConfiguration{fromXML, fromJSON} {
+		if len(cfg.Hooks) != 1 || cfg.Hooks[0].TimeoutS != 60 {
+			t.Errorf("unexpected hooks %+v", cfg.Hooks)
+		}
+	}
+}
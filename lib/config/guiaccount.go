@@ -0,0 +1,100 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// The roles of GUI users.
+const (
+	GUIRoleViewer   = "viewer"
+	GUIRoleOperator = "operator"
+	GUIRoleAdmin    = "admin"
+)
+
+func validGUIRole(role string) error {
+	switch role {
+	case GUIRoleViewer, GUIRoleOperator, GUIRoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("unknown role %q", role)
+	}
+}
+
+func (a GUIAccount) Copy() GUIAccount {
+	c := a
+	c.Folders = append([]string(nil), a.Folders...)
+	return c
+}
+
+// Validate returns an error describing what is wrong with the account, if
+// anything.
+func (a GUIAccount) Validate() error {
+	if a.Name == "" {
+		return errors.New("account has no name")
+	}
+	if a.Password == "" {
+		return errors.New("account has no password")
+	}
+	return validGUIRole(a.Role)
+}
+
+// CompareHashedPassword returns nil when the given plaintext password
+// matches the stored hash.
+func (a GUIAccount) CompareHashedPassword(password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(a.Password), []byte(password))
+}
+
+// Account returns the account with the given name.
+func (c GUIConfiguration) Account(name string) (GUIAccount, bool) {
+	for _, a := range c.Accounts {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return GUIAccount{}, false
+}
+
+// HashAccountPasswords hashes the passwords of the accounts that are given
+// in plaintext.
+func (c *GUIConfiguration) HashAccountPasswords() error {
+	for i := range c.Accounts {
+		hash, err := hashPassword(c.Accounts[i].Password)
+		if err != nil {
+			return err
+		}
+		c.Accounts[i].Password = hash
+	}
+	return nil
+}
+
+// validGUIAccounts returns the accounts, less those that are invalid or
+// have the same name as an earlier one or the GUI user.
+func validGUIAccounts(accounts []GUIAccount, user string) []GUIAccount {
+	valid := accounts[:0]
+	names := make(map[string]struct{}, len(accounts)+1)
+	if user != "" {
+		names[user] = struct{}{}
+	}
+	for _, a := range accounts {
+		if err := a.Validate(); err != nil {
+			l.Warnf("Ignoring invalid GUI account %q: %v", a.Name, err)
+			continue
+		}
+		if _, ok := names[a.Name]; ok {
+			l.Warnf("Ignoring GUI account %q with the same name as another", a.Name)
+			continue
+		}
+		names[a.Name] = struct{}{}
+		valid = append(valid, a)
+	}
+	return valid
+}
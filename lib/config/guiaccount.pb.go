@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/guiaccount.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A GUIAccount is a user of the GUI besides the one of the GUI
+// configuration, who is always an admin. The role is one of "viewer"
+// (looks, but changes nothing), "operator" (may also scan, pause, override
+// and so on, but not change the configuration) or "admin". If folders are
+// listed, a viewer or operator sees only those.
+type GUIAccount struct {
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name,attr"`
+	Password string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password" xml:"password,omitempty"`
+	Role     string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role" xml:"role,attr"`
+	Folders  []string `protobuf:"bytes,4,rep,name=folders,proto3" json:"folders" xml:"folder"`
+}
+
+func (m *GUIAccount) Reset()         { *m = GUIAccount{} }
+func (m *GUIAccount) String() string { return proto.CompactTextString(m) }
+func (*GUIAccount) ProtoMessage()    {}
+func (*GUIAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5e4740a5b2e86e6, []int{0}
+}
+func (m *GUIAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GUIAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GUIAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GUIAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GUIAccount.Merge(m, src)
+}
+func (m *GUIAccount) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GUIAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_GUIAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GUIAccount proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GUIAccount)(nil), "config.GUIAccount")
+}
+
+func init() { proto.RegisterFile("lib/config/guiaccount.proto", fileDescriptor_a5e4740a5b2e86e6) }
+
+var fileDescriptor_a5e4740a5b2e86e6 = []byte{
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0xc9, 0x4c, 0xd2,
+	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x4f, 0x2f, 0xcd, 0x4c, 0x4c, 0x4e, 0xce, 0x2f, 0xcd,
+	0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0x48, 0x48, 0x71, 0xa6, 0x56, 0x40,
+	0x85, 0x94, 0x96, 0x32, 0x71, 0x71, 0xb9, 0x87, 0x7a, 0x3a, 0x42, 0xd4, 0x09, 0xd9, 0x70, 0xb1,
+	0xe4, 0x25, 0xe6, 0xa6, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x69, 0xbc, 0xba, 0x27, 0x0f,
+	0xe6, 0x7f, 0xba, 0x27, 0xcf, 0x5f, 0x91, 0x9b, 0x63, 0xa5, 0x04, 0xe2, 0xe8, 0x24, 0x96, 0x94,
+	0x14, 0x29, 0xbd, 0x3a, 0xaf, 0xc2, 0x09, 0xe7, 0x05, 0x81, 0x55, 0x09, 0x45, 0x71, 0x71, 0x14,
+	0x24, 0x16, 0x17, 0x97, 0xe7, 0x17, 0xa5, 0x48, 0x30, 0x81, 0x4d, 0xb0, 0x7b, 0x75, 0x4f, 0x1e,
+	0x2e, 0xf6, 0xe9, 0x9e, 0xbc, 0x04, 0xd8, 0x14, 0x98, 0x80, 0x4e, 0x7e, 0x6e, 0x66, 0x49, 0x6a,
+	0x6e, 0x41, 0x49, 0x25, 0xc8, 0x38, 0x21, 0x4c, 0xe1, 0x20, 0xb8, 0x5e, 0x90, 0xcb, 0x8a, 0xf2,
+	0x73, 0x52, 0x25, 0x98, 0x11, 0x2e, 0x03, 0xf1, 0xe1, 0x2e, 0x03, 0x71, 0x10, 0x2e, 0x83, 0xf3,
+	0x82, 0xc0, 0xaa, 0x84, 0xec, 0xb9, 0xd8, 0xd3, 0xf2, 0x73, 0x52, 0x52, 0x8b, 0x8a, 0x25, 0x58,
+	0x14, 0x98, 0x35, 0x38, 0x9d, 0x54, 0x5f, 0xdd, 0x93, 0x87, 0x09, 0x7d, 0xba, 0x27, 0xcf, 0x03,
+	0x36, 0x03, 0xc2, 0x07, 0x19, 0xc0, 0x06, 0x61, 0x06, 0xc1, 0x94, 0x38, 0x79, 0x9f, 0x78, 0x28,
+	0xc7, 0x70, 0xe1, 0xa1, 0x1c, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
+	0x2c, 0xc7, 0xb0, 0xe0, 0xb1, 0x1c, 0xe3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
+	0x69, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0x57, 0xe6, 0x25,
+	0x97, 0x64, 0x64, 0xe6, 0xa5, 0x23, 0xb1, 0x10, 0x11, 0x93, 0xc4, 0x06, 0x0e, 0x7b, 0x63, 0xc0,
+	0x00, 0x82, 0xa6, 0x60, 0xc8, 0xad, 0x01, 0x00, 0x00,
+}
+
+func (m *GUIAccount) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GUIAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GUIAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintGuiaccount(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintGuiaccount(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintGuiaccount(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGuiaccount(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGuiaccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGuiaccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GUIAccount) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGuiaccount(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovGuiaccount(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovGuiaccount(uint64(l))
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovGuiaccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGuiaccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGuiaccount(x uint64) (n int) {
+	return sovGuiaccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GUIAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuiaccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GUIAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GUIAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiaccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiaccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiaccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiaccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuiaccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuiaccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGuiaccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGuiaccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuiaccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuiaccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGuiaccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGuiaccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGuiaccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGuiaccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGuiaccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGuiaccount = fmt.Errorf("proto: unexpected end of group")
+)
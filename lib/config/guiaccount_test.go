@@ -0,0 +1,86 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"testing"
+)
+
+func TestValidGUIAccounts(t *testing.T) {
+	accounts := validGUIAccounts([]GUIAccount{
+		{Name: "helpdesk", Password: "secret", Role: GUIRoleViewer},
+		{Name: "helpdesk", Password: "secret", Role: GUIRoleAdmin},
+		{Name: "admin", Password: "secret", Role: GUIRoleAdmin},
+		{Name: "nopassword", Role: GUIRoleViewer},
+		{Name: "norole", Password: "secret"},
+		{Name: "ops", Password: "secret", Role: GUIRoleOperator},
+	}, "admin")
+
+	if len(accounts) != 2 || accounts[0].Name != "helpdesk" || accounts[0].Role != GUIRoleViewer || accounts[1].Name != "ops" {
+		t.Errorf("unexpected accounts %v", accounts)
+	}
+}
+
+func TestHashAccountPasswords(t *testing.T) {
+	gui := GUIConfiguration{Accounts: []GUIAccount{{Name: "helpdesk", Password: "secret", Role: GUIRoleViewer}}}
+	if err := gui.HashAccountPasswords(); err != nil {
+		t.Fatal(err)
+	}
+	hash := gui.Accounts[0].Password
+	if err := gui.Accounts[0].CompareHashedPassword("secret"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Hashes are kept as they are
+	if err := gui.HashAccountPasswords(); err != nil {
+		t.Fatal(err)
+	}
+	if gui.Accounts[0].Password != hash {
+		t.Error("hashed password was hashed again")
+	}
+}
+
+func TestLDAPGroupRole(t *testing.T) {
+	cfg := LDAPConfiguration{
+		GroupRoles: []LDAPGroupRole{
+			{Group: "cn=admins,dc=example,dc=com", Role: GUIRoleAdmin},
+			{Group: "cn=helpdesk,dc=example,dc=com", Role: GUIRoleViewer, Folders: []string{"default"}},
+		},
+	}
+
+	gr, ok := cfg.UserRole([]string{"cn=other,dc=example,dc=com", "CN=Helpdesk,DC=example,DC=com"})
+	if !ok || gr.Role != GUIRoleViewer {
+		t.Errorf("unexpected group role %v", gr)
+	}
+	gr, ok = cfg.UserRole([]string{"cn=helpdesk,dc=example,dc=com", "cn=admins,dc=example,dc=com"})
+	if !ok || gr.Role != GUIRoleAdmin {
+		t.Errorf("unexpected group role %v", gr)
+	}
+	if _, ok := cfg.UserRole([]string{"cn=other,dc=example,dc=com"}); ok {
+		t.Error("unexpected group role for other group")
+	}
+
+	cfg.DefaultRole = GUIRoleViewer
+	gr, ok = cfg.UserRole([]string{"cn=other,dc=example,dc=com"})
+	if !ok || gr.Role != GUIRoleViewer || len(gr.Folders) != 0 {
+		t.Errorf("unexpected default role %v", gr)
+	}
+
+	// An invalid role refuses its members rather than giving them the
+	// default role.
+	cfg.GroupRoles[0].Role = "superuser"
+	cfg.prepare()
+	if len(cfg.GroupRoles) != 2 {
+		t.Fatal("invalid group role was dropped")
+	}
+	if cfg.Validate() == nil {
+		t.Error("invalid group role was not reported")
+	}
+	if _, ok := cfg.UserRole([]string{"cn=admins,dc=example,dc=com"}); ok {
+		t.Error("unexpected role for group with invalid role")
+	}
+}
@@ -20,7 +20,7 @@ import (
 
 func (c GUIConfiguration) IsAuthEnabled() bool {
 	// This function should match isAuthEnabled() in syncthingController.js
-	return c.AuthMode == AuthModeLDAP || (len(c.User) > 0 && len(c.Password) > 0)
+	return c.AuthMode == AuthModeLDAP || (len(c.User) > 0 && len(c.Password) > 0) || len(c.Accounts) > 0 || c.OIDC.IsEnabled()
 }
 
 func (GUIConfiguration) IsOverridden() bool {
@@ -120,17 +120,26 @@ var bcryptExpr = regexp.MustCompile(`^\$2[aby]\$\d+\$.{50,}`)
 // Plaintext passwords are hashed. Returns an error if the password is not
 // valid.
 func (c *GUIConfiguration) SetPassword(password string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	c.Password = hash
+	return nil
+}
+
+// hashPassword returns the bcrypt hash of a plaintext password, or the
+// password as is if it's already hashed.
+func hashPassword(password string) (string, error) {
 	if bcryptExpr.MatchString(password) {
 		// Already hashed
-		c.Password = password
-		return nil
+		return password, nil
 	}
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
-		return err
+		return "", err
 	}
-	c.Password = string(hash)
-	return nil
+	return string(hash), nil
 }
 
 // CompareHashedPassword returns nil when the given plaintext password matches the stored hash.
@@ -141,7 +150,7 @@ func (c GUIConfiguration) CompareHashedPassword(password string) error {
 }
 
 // IsValidAPIKey returns true when the given API key is valid, including both
-// the value in config and any overrides
+// the value in config and any overrides, and the keys of API tokens
 func (c GUIConfiguration) IsValidAPIKey(apiKey string) bool {
 	switch apiKey {
 	case "":
@@ -151,7 +160,8 @@ func (c GUIConfiguration) IsValidAPIKey(apiKey string) bool {
 		return true
 
 	default:
-		return false
+		_, ok := c.APIToken(apiKey)
+		return ok
 	}
 }
 
@@ -159,8 +169,20 @@ func (c *GUIConfiguration) prepare() {
 	if c.APIKey == "" {
 		c.APIKey = rand.String(32)
 	}
+	c.APITokens = validAPITokens(c.APITokens)
+	c.Accounts = validGUIAccounts(c.Accounts, c.User)
+	c.OIDC.prepare()
 }
 
 func (c GUIConfiguration) Copy() GUIConfiguration {
+	c.APITokens = append([]APIToken(nil), c.APITokens...)
+	for i, t := range c.APITokens {
+		c.APITokens[i] = t.Copy()
+	}
+	c.Accounts = append([]GUIAccount(nil), c.Accounts...)
+	for i, a := range c.Accounts {
+		c.Accounts[i] = a.Copy()
+	}
+	c.OIDC = c.OIDC.Copy()
 	return c
 }
@@ -24,20 +24,23 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GUIConfiguration struct {
-	Enabled                   bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled" xml:"enabled,attr" default:"true"`
-	RawAddress                string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address" xml:"address" default:"127.0.0.1:8384"`
-	RawUnixSocketPermissions  string   `protobuf:"bytes,3,opt,name=unix_socket_permissions,json=unixSocketPermissions,proto3" json:"unixSocketPermissions" xml:"unixSocketPermissions,omitempty"`
-	User                      string   `protobuf:"bytes,4,opt,name=user,proto3" json:"user" xml:"user,omitempty"`
-	Password                  string   `protobuf:"bytes,5,opt,name=password,proto3" json:"password" xml:"password,omitempty"`
-	AuthMode                  AuthMode `protobuf:"varint,6,opt,name=auth_mode,json=authMode,proto3,enum=config.AuthMode" json:"authMode" xml:"authMode,omitempty"`
-	RawUseTLS                 bool     `protobuf:"varint,7,opt,name=use_tls,json=useTls,proto3" json:"useTLS" xml:"tls,attr"`
-	APIKey                    string   `protobuf:"bytes,8,opt,name=api_key,json=apiKey,proto3" json:"apiKey" xml:"apikey,omitempty"`
-	InsecureAdminAccess       bool     `protobuf:"varint,9,opt,name=insecure_admin_access,json=insecureAdminAccess,proto3" json:"insecureAdminAccess" xml:"insecureAdminAccess,omitempty"`
-	Theme                     string   `protobuf:"bytes,10,opt,name=theme,proto3" json:"theme" xml:"theme" default:"default"`
-	Debugging                 bool     `protobuf:"varint,11,opt,name=debugging,proto3" json:"debugging" xml:"debugging,attr"`
-	InsecureSkipHostCheck     bool     `protobuf:"varint,12,opt,name=insecure_skip_host_check,json=insecureSkipHostCheck,proto3" json:"insecureSkipHostcheck" xml:"insecureSkipHostcheck,omitempty"`
-	InsecureAllowFrameLoading bool     `protobuf:"varint,13,opt,name=insecure_allow_frame_loading,json=insecureAllowFrameLoading,proto3" json:"insecureAllowFrameLoading" xml:"insecureAllowFrameLoading,omitempty"`
-	SendBasicAuthPrompt       bool     `protobuf:"varint,14,opt,name=send_basic_auth_prompt,json=sendBasicAuthPrompt,proto3" json:"sendBasicAuthPrompt" xml:"sendBasicAuthPrompt,attr"`
+	Enabled                   bool              `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled" xml:"enabled,attr" default:"true"`
+	RawAddress                string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address" xml:"address" default:"127.0.0.1:8384"`
+	RawUnixSocketPermissions  string            `protobuf:"bytes,3,opt,name=unix_socket_permissions,json=unixSocketPermissions,proto3" json:"unixSocketPermissions" xml:"unixSocketPermissions,omitempty"`
+	User                      string            `protobuf:"bytes,4,opt,name=user,proto3" json:"user" xml:"user,omitempty"`
+	Password                  string            `protobuf:"bytes,5,opt,name=password,proto3" json:"password" xml:"password,omitempty"`
+	AuthMode                  AuthMode          `protobuf:"varint,6,opt,name=auth_mode,json=authMode,proto3,enum=config.AuthMode" json:"authMode" xml:"authMode,omitempty"`
+	RawUseTLS                 bool              `protobuf:"varint,7,opt,name=use_tls,json=useTls,proto3" json:"useTLS" xml:"tls,attr"`
+	APIKey                    string            `protobuf:"bytes,8,opt,name=api_key,json=apiKey,proto3" json:"apiKey" xml:"apikey,omitempty"`
+	InsecureAdminAccess       bool              `protobuf:"varint,9,opt,name=insecure_admin_access,json=insecureAdminAccess,proto3" json:"insecureAdminAccess" xml:"insecureAdminAccess,omitempty"`
+	Theme                     string            `protobuf:"bytes,10,opt,name=theme,proto3" json:"theme" xml:"theme" default:"default"`
+	Debugging                 bool              `protobuf:"varint,11,opt,name=debugging,proto3" json:"debugging" xml:"debugging,attr"`
+	InsecureSkipHostCheck     bool              `protobuf:"varint,12,opt,name=insecure_skip_host_check,json=insecureSkipHostCheck,proto3" json:"insecureSkipHostcheck" xml:"insecureSkipHostcheck,omitempty"`
+	InsecureAllowFrameLoading bool              `protobuf:"varint,13,opt,name=insecure_allow_frame_loading,json=insecureAllowFrameLoading,proto3" json:"insecureAllowFrameLoading" xml:"insecureAllowFrameLoading,omitempty"`
+	SendBasicAuthPrompt       bool              `protobuf:"varint,14,opt,name=send_basic_auth_prompt,json=sendBasicAuthPrompt,proto3" json:"sendBasicAuthPrompt" xml:"sendBasicAuthPrompt,attr"`
+	APITokens                 []APIToken        `protobuf:"bytes,15,rep,name=api_tokens,json=apiTokens,proto3" json:"apiTokens" xml:"apiToken"`
+	Accounts                  []GUIAccount      `protobuf:"bytes,16,rep,name=accounts,proto3" json:"accounts" xml:"account"`
+	OIDC                      OIDCConfiguration `protobuf:"bytes,17,opt,name=oidc,proto3" json:"oidc" xml:"oidc"`
 }
 
 func (m *GUIConfiguration) Reset()         { *m = GUIConfiguration{} }
@@ -80,63 +83,74 @@ func init() {
 func init() { proto.RegisterFile("lib/config/guiconfiguration.proto", fileDescriptor_2a9586d611855d64) }
 
 var fileDescriptor_2a9586d611855d64 = []byte{
-	// 888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0x5b, 0x47, 0xb2, 0xb6, 0x89, 0x60, 0xb0, 0x4d, 0xca, 0x04, 0x0d, 0xd7, 0x51, 0xd8,
-	0xc2, 0x01, 0x02, 0x39, 0x71, 0x5a, 0x24, 0xf0, 0xa1, 0x80, 0x1c, 0x20, 0x4d, 0x60, 0x17, 0x30,
-	0xe8, 0xfa, 0x92, 0x0b, 0xb1, 0x22, 0xd7, 0xd2, 0x42, 0xfc, 0x2b, 0x77, 0x09, 0x5b, 0x87, 0xf6,
-	0x01, 0x7a, 0x2a, 0xdc, 0x73, 0x81, 0x3e, 0x43, 0x2f, 0x7d, 0x85, 0xdc, 0xa4, 0x53, 0xd1, 0xd3,
-	0x02, 0x91, 0xd1, 0x0b, 0x8f, 0x3c, 0xe6, 0x54, 0xec, 0xf2, 0x47, 0xa2, 0x4c, 0x37, 0xb9, 0xed,
-	0x7c, 0xf3, 0xcd, 0x7c, 0x33, 0xc3, 0x19, 0x10, 0xdc, 0x73, 0xc9, 0x60, 0xdb, 0x0e, 0xfc, 0x13,
-	0x32, 0xdc, 0x1e, 0xc6, 0x24, 0x7b, 0xc5, 0x11, 0x62, 0x24, 0xf0, 0x7b, 0x61, 0x14, 0xb0, 0x40,
-	0x6d, 0x66, 0xe0, 0x9d, 0xdb, 0x4b, 0x54, 0x14, 0xb3, 0x91, 0x17, 0x38, 0x38, 0xa3, 0xdc, 0x69,
-	0xe3, 0x33, 0x96, 0x3d, 0xbb, 0xff, 0xde, 0x00, 0x1b, 0xdf, 0x1d, 0xbf, 0x7a, 0xbe, 0x9c, 0x48,
-	0x1d, 0x80, 0x16, 0xf6, 0xd1, 0xc0, 0xc5, 0x8e, 0xa6, 0x6c, 0x2a, 0x5b, 0xeb, 0x7b, 0x2f, 0x13,
-	0x0e, 0x0b, 0x28, 0xe5, 0xf0, 0xde, 0x99, 0xe7, 0xee, 0x76, 0x73, 0xfb, 0x21, 0x62, 0x2c, 0xea,
-	0x6e, 0x3a, 0xf8, 0x04, 0xc5, 0x2e, 0xdb, 0xed, 0xb2, 0x28, 0xc6, 0xdd, 0x64, 0x6a, 0x5c, 0x5f,
-	0xf6, 0xbf, 0x9b, 0x1a, 0x6b, 0xc2, 0x61, 0x16, 0x59, 0xd4, 0x9f, 0x40, 0x0b, 0x39, 0x4e, 0x84,
-	0x29, 0xd5, 0x3e, 0xda, 0x54, 0xb6, 0xda, 0x7b, 0xf6, 0x9c, 0x43, 0x60, 0xa2, 0xd3, 0x7e, 0x86,
-	0x0a, 0xc5, 0x9c, 0x90, 0x72, 0xf8, 0x95, 0x54, 0xcc, 0xed, 0x25, 0xb1, 0xc7, 0x3b, 0x4f, 0x7b,
-	0x8f, 0x7a, 0x8f, 0x7a, 0x8f, 0x77, 0x9f, 0x3d, 0x79, 0xf6, 0x75, 0xf7, 0xdd, 0xd4, 0xe8, 0x54,
-	0xa1, 0xf3, 0x99, 0xb1, 0x94, 0xd4, 0x2c, 0x52, 0xaa, 0x7f, 0x2b, 0xe0, 0xf3, 0xd8, 0x27, 0x67,
-	0x16, 0x0d, 0xec, 0x31, 0x66, 0x56, 0x88, 0x23, 0x8f, 0x50, 0x4a, 0x02, 0x9f, 0x6a, 0x1f, 0xcb,
-	0x7a, 0x7e, 0x57, 0xe6, 0x1c, 0x6a, 0x26, 0x3a, 0x3d, 0xf6, 0xc9, 0xd9, 0x91, 0x64, 0x1d, 0x2e,
-	0x48, 0x09, 0x87, 0x37, 0xe3, 0x3a, 0x47, 0xca, 0xe1, 0x97, 0xb2, 0xd8, 0x5a, 0xef, 0xc3, 0xc0,
-	0x23, 0x0c, 0x7b, 0x21, 0x9b, 0x88, 0x11, 0xc1, 0xf7, 0x70, 0xce, 0x67, 0xc6, 0x95, 0x05, 0x98,
-	0xf5, 0xf2, 0xea, 0x0b, 0xb0, 0x16, 0x53, 0x1c, 0x69, 0x6b, 0xb2, 0x89, 0x9d, 0x84, 0x43, 0x69,
-	0xa7, 0x1c, 0x7e, 0x96, 0x95, 0x45, 0x71, 0x54, 0xad, 0xa2, 0x53, 0x85, 0x4c, 0xc9, 0x57, 0x5f,
-	0x83, 0xf5, 0x10, 0x51, 0x7a, 0x1a, 0x44, 0x8e, 0x76, 0x4d, 0xe6, 0xfa, 0x36, 0xe1, 0xb0, 0xc4,
-	0x52, 0x0e, 0x35, 0x99, 0xaf, 0x00, 0xaa, 0x39, 0xd5, 0xcb, 0xb0, 0x59, 0xc6, 0xaa, 0x1e, 0x68,
-	0x8b, 0x8d, 0xb4, 0xc4, 0x4a, 0x6a, 0xcd, 0x4d, 0x65, 0xab, 0xb3, 0xb3, 0xd1, 0xcb, 0x56, 0xb5,
-	0xd7, 0x8f, 0xd9, 0xe8, 0xfb, 0xc0, 0xc1, 0x99, 0x1c, 0xca, 0xad, 0x52, 0xae, 0x00, 0x56, 0xe4,
-	0x2e, 0xc3, 0x66, 0x19, 0xab, 0x62, 0xd0, 0x8a, 0x29, 0xb6, 0x98, 0x4b, 0xb5, 0x96, 0x5c, 0xe7,
-	0x83, 0x39, 0x87, 0x6d, 0x31, 0x58, 0x8a, 0x7f, 0x38, 0x38, 0x4a, 0x38, 0x6c, 0xc6, 0xf2, 0x95,
-	0x72, 0xd8, 0x91, 0x2a, 0xcc, 0xa5, 0xd9, 0x5a, 0x27, 0x53, 0x63, 0xbd, 0x30, 0xd2, 0xa9, 0x91,
-	0xf3, 0xce, 0x67, 0xc6, 0x22, 0xdc, 0x94, 0xa0, 0x4b, 0x85, 0x0c, 0x0a, 0x89, 0x35, 0xc6, 0x13,
-	0x6d, 0x5d, 0x0e, 0x4c, 0xc8, 0x34, 0xfb, 0x87, 0xaf, 0xf6, 0xf1, 0x44, 0x68, 0xa0, 0x90, 0xec,
-	0xe3, 0x49, 0xca, 0xe1, 0xad, 0xac, 0x93, 0x90, 0x8c, 0xf1, 0xa4, 0xda, 0xc7, 0xc6, 0x2a, 0x78,
-	0x3e, 0x33, 0xf2, 0x0c, 0x66, 0x1e, 0xaf, 0xfe, 0xa6, 0x80, 0x9b, 0xc4, 0xa7, 0xd8, 0x8e, 0x23,
-	0x6c, 0x21, 0xc7, 0x23, 0xbe, 0x85, 0x6c, 0x5b, 0xdc, 0x51, 0x5b, 0x36, 0x67, 0x25, 0x1c, 0x7e,
-	0x5a, 0x10, 0xfa, 0xc2, 0xdf, 0x97, 0xee, 0x94, 0xc3, 0xfb, 0x52, 0xb8, 0xc6, 0x57, 0xad, 0xe2,
-	0xee, 0xff, 0x32, 0xcc, 0xba, 0xe4, 0xea, 0x3e, 0xb8, 0xc6, 0x46, 0xd8, 0xc3, 0x1a, 0x90, 0xad,
-	0x7f, 0x93, 0x70, 0x98, 0x01, 0x29, 0x87, 0x77, 0xb3, 0x99, 0x0a, 0x6b, 0xe9, 0x74, 0xf3, 0x87,
-	0xb8, 0xd9, 0x56, 0xfe, 0x36, 0xb3, 0x10, 0xf5, 0x18, 0xb4, 0x1d, 0x3c, 0x88, 0x87, 0x43, 0xe2,
-	0x0f, 0xb5, 0x4f, 0x64, 0x57, 0x4f, 0x13, 0x0e, 0x17, 0x60, 0xb9, 0xcd, 0x25, 0x52, 0x7e, 0xae,
-	0x4e, 0x15, 0x32, 0x17, 0x41, 0xea, 0x5f, 0x0a, 0xd0, 0xca, 0xc9, 0xd1, 0x31, 0x09, 0xad, 0x51,
-	0x40, 0x99, 0x65, 0x8f, 0xb0, 0x3d, 0xd6, 0xae, 0x4b, 0x99, 0x9f, 0xc5, 0x5d, 0x17, 0x9c, 0xa3,
-	0x31, 0x09, 0x5f, 0x06, 0x94, 0x49, 0x42, 0x79, 0xd7, 0xb5, 0xde, 0x95, 0xbb, 0x7e, 0x0f, 0x27,
-	0x9d, 0x1a, 0xf5, 0x22, 0xe6, 0x25, 0xf8, 0xb9, 0x80, 0xd5, 0x3f, 0x15, 0xf0, 0xc5, 0xe2, 0x9b,
-	0xbb, 0x6e, 0x70, 0x6a, 0x9d, 0x44, 0xc8, 0xc3, 0x96, 0x1b, 0x20, 0x47, 0x0c, 0xe9, 0x86, 0xac,
-	0xfe, 0xc7, 0x84, 0xc3, 0xdb, 0xe5, 0xd7, 0x11, 0xb4, 0x17, 0x82, 0x75, 0x90, 0x91, 0x52, 0x0e,
-	0x1f, 0x54, 0x17, 0x60, 0x95, 0x51, 0xed, 0xe2, 0xfe, 0x07, 0xf0, 0xcc, 0xab, 0xe5, 0xd4, 0x5f,
-	0x14, 0x70, 0x8b, 0x62, 0xdf, 0xb1, 0x06, 0x88, 0x12, 0xdb, 0x92, 0x17, 0x1f, 0x46, 0x81, 0x17,
-	0x32, 0xad, 0x23, 0xcb, 0x3d, 0x16, 0x9b, 0x2a, 0x18, 0x7b, 0x82, 0x20, 0x0e, 0xff, 0x50, 0xba,
-	0x53, 0x0e, 0x75, 0x59, 0x68, 0x8d, 0xaf, 0xfc, 0xce, 0xda, 0x55, 0x4e, 0xb3, 0x2e, 0xe5, 0xde,
-	0xfe, 0x9b, 0xb7, 0x7a, 0x63, 0xf6, 0x56, 0x6f, 0xbc, 0x99, 0xeb, 0xca, 0x6c, 0xae, 0x2b, 0xbf,
-	0x5e, 0xe8, 0x8d, 0x3f, 0x2e, 0x74, 0x65, 0x76, 0xa1, 0x37, 0xfe, 0xb9, 0xd0, 0x1b, 0xaf, 0x1f,
-	0x0c, 0x09, 0x1b, 0xc5, 0x83, 0x9e, 0x1d, 0x78, 0xdb, 0x74, 0xe2, 0xdb, 0x6c, 0x44, 0xfc, 0xe1,
-	0xd2, 0x6b, 0xf1, 0x3b, 0x1d, 0x34, 0xe5, 0xbf, 0xf3, 0xc9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xfe, 0x19, 0xb2, 0x3c, 0x8e, 0x07, 0x00, 0x00,
+	// 1062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0xdb, 0xb6,
+	0x1b, 0xb6, 0x7e, 0x4d, 0xe3, 0x98, 0x69, 0xfd, 0xcb, 0xb4, 0xb5, 0x53, 0xb2, 0xd5, 0x74, 0x5d,
+	0x6d, 0x48, 0x81, 0xc0, 0x69, 0xd3, 0x0d, 0x2d, 0x72, 0x18, 0x60, 0x67, 0x68, 0x1b, 0x24, 0xc3,
+	0x02, 0xa5, 0xd9, 0xa1, 0x18, 0x20, 0xc8, 0x12, 0x63, 0x13, 0xd6, 0xbf, 0x89, 0x14, 0x12, 0x1f,
+	0xb6, 0xeb, 0x80, 0x9d, 0x86, 0xec, 0x3c, 0x60, 0x9f, 0x61, 0x97, 0xed, 0x23, 0xe4, 0x66, 0x9f,
+	0x86, 0x9d, 0x08, 0xd4, 0xb9, 0xe9, 0xa8, 0x63, 0x4f, 0x03, 0xa9, 0x3f, 0xb6, 0x12, 0x65, 0xdd,
+	0xc9, 0x7c, 0x9f, 0xe7, 0xe1, 0xfb, 0xbc, 0xa4, 0xf8, 0x92, 0x06, 0xf7, 0x6d, 0xdc, 0xdb, 0x34,
+	0x3d, 0xf7, 0x18, 0xf7, 0x37, 0xfb, 0x21, 0x4e, 0x46, 0x61, 0x60, 0x50, 0xec, 0xb9, 0x6d, 0x3f,
+	0xf0, 0xa8, 0x27, 0x2f, 0x26, 0xe0, 0xda, 0xea, 0x9c, 0xd4, 0x08, 0xe9, 0xc0, 0xf1, 0x2c, 0x94,
+	0x48, 0x8a, 0x94, 0x8f, 0xa9, 0x37, 0x44, 0xe9, 0xec, 0xb5, 0x8f, 0x8a, 0x06, 0x86, 0x69, 0x7a,
+	0xa1, 0x4b, 0x53, 0xb2, 0x35, 0x47, 0x7a, 0xd8, 0x32, 0x4b, 0xec, 0xd7, 0x6a, 0xe8, 0x34, 0x95,
+	0xb7, 0xfe, 0x5c, 0x01, 0x2b, 0x2f, 0x8e, 0x76, 0x77, 0xe6, 0x55, 0x72, 0x0f, 0x54, 0x91, 0x6b,
+	0xf4, 0x6c, 0x64, 0x29, 0x52, 0x53, 0x5a, 0x5f, 0xea, 0xbe, 0x8c, 0x18, 0xcc, 0xa0, 0x98, 0xc1,
+	0xfb, 0xa7, 0x8e, 0xbd, 0xdd, 0x4a, 0xe3, 0x0d, 0x83, 0xd2, 0xa0, 0xd5, 0xb4, 0xd0, 0xb1, 0x11,
+	0xda, 0x74, 0xbb, 0x45, 0x83, 0x10, 0xb5, 0xa2, 0xb1, 0x7a, 0x6b, 0x9e, 0x7f, 0x3b, 0x56, 0x17,
+	0x38, 0xa1, 0x65, 0x59, 0xe4, 0xef, 0x41, 0xd5, 0xb0, 0xac, 0x00, 0x11, 0xa2, 0xfc, 0xaf, 0x29,
+	0xad, 0xd7, 0xba, 0xe6, 0x94, 0x41, 0xa0, 0x19, 0x27, 0x9d, 0x04, 0xe5, 0x8e, 0xa9, 0x20, 0x66,
+	0xf0, 0x53, 0xe1, 0x98, 0xc6, 0x73, 0x66, 0x8f, 0xb7, 0x9e, 0xb6, 0x1f, 0xb5, 0x1f, 0xb5, 0x1f,
+	0x6f, 0x3f, 0x7b, 0xf2, 0xec, 0xb3, 0xd6, 0xdb, 0xb1, 0x5a, 0x2f, 0x42, 0x67, 0x13, 0x75, 0x2e,
+	0xa9, 0x96, 0xa5, 0x94, 0xff, 0x92, 0xc0, 0x87, 0xa1, 0x8b, 0x4f, 0x75, 0xe2, 0x99, 0x43, 0x44,
+	0x75, 0x1f, 0x05, 0x0e, 0x26, 0x04, 0x7b, 0x2e, 0x51, 0x6e, 0x88, 0x7a, 0x7e, 0x95, 0xa6, 0x0c,
+	0x2a, 0x9a, 0x71, 0x72, 0xe4, 0xe2, 0xd3, 0x43, 0xa1, 0x3a, 0x98, 0x89, 0x22, 0x06, 0xef, 0x84,
+	0x65, 0x44, 0xcc, 0xe0, 0x27, 0xa2, 0xd8, 0x52, 0x76, 0xc3, 0x73, 0x30, 0x45, 0x8e, 0x4f, 0x47,
+	0x7c, 0x8b, 0xe0, 0x3b, 0x34, 0x67, 0x13, 0xf5, 0xda, 0x02, 0xb4, 0x72, 0x7b, 0xf9, 0x39, 0x58,
+	0x08, 0x09, 0x0a, 0x94, 0x05, 0xb1, 0x88, 0xad, 0x88, 0x41, 0x11, 0xc7, 0x0c, 0x7e, 0x90, 0x94,
+	0x45, 0x50, 0x50, 0xac, 0xa2, 0x5e, 0x84, 0x34, 0xa1, 0x97, 0x5f, 0x83, 0x25, 0xdf, 0x20, 0xe4,
+	0xc4, 0x0b, 0x2c, 0xe5, 0xa6, 0xc8, 0xf5, 0x45, 0xc4, 0x60, 0x8e, 0xc5, 0x0c, 0x2a, 0x22, 0x5f,
+	0x06, 0x14, 0x73, 0xca, 0x57, 0x61, 0x2d, 0x9f, 0x2b, 0x3b, 0xa0, 0xc6, 0x4f, 0xbb, 0xce, 0x8f,
+	0xbb, 0xb2, 0xd8, 0x94, 0xd6, 0xeb, 0x5b, 0x2b, 0xed, 0xe4, 0xa0, 0xb6, 0x3b, 0x21, 0x1d, 0x7c,
+	0xe5, 0x59, 0x28, 0xb1, 0x33, 0xd2, 0x28, 0xb7, 0xcb, 0x80, 0x4b, 0x76, 0x57, 0x61, 0x2d, 0x9f,
+	0x2b, 0x23, 0x50, 0x0d, 0x09, 0xd2, 0xa9, 0x4d, 0x94, 0xaa, 0x38, 0xce, 0xfb, 0x53, 0x06, 0x6b,
+	0x7c, 0x63, 0x09, 0x7a, 0xb5, 0x7f, 0x18, 0x31, 0xb8, 0x18, 0x8a, 0x51, 0xcc, 0x60, 0x5d, 0xb8,
+	0x50, 0x9b, 0x24, 0xc7, 0x3a, 0x1a, 0xab, 0x4b, 0x59, 0x10, 0x8f, 0xd5, 0x54, 0x77, 0x36, 0x51,
+	0x67, 0xd3, 0x35, 0x01, 0xda, 0x84, 0xdb, 0x18, 0x3e, 0xd6, 0x87, 0x68, 0xa4, 0x2c, 0x89, 0x0d,
+	0xe3, 0x36, 0x8b, 0x9d, 0x83, 0xdd, 0x3d, 0x34, 0xe2, 0x1e, 0x86, 0x8f, 0xf7, 0xd0, 0x28, 0x66,
+	0xf0, 0x6e, 0xb2, 0x12, 0x1f, 0x0f, 0xd1, 0xa8, 0xb8, 0x8e, 0x95, 0xcb, 0xe0, 0xd9, 0x44, 0x4d,
+	0x33, 0x68, 0xe9, 0x7c, 0xf9, 0x17, 0x09, 0xdc, 0xc1, 0x2e, 0x41, 0x66, 0x18, 0x20, 0xdd, 0xb0,
+	0x1c, 0xec, 0xea, 0x86, 0x69, 0xf2, 0x3e, 0xaa, 0x89, 0xc5, 0xe9, 0x11, 0x83, 0xef, 0x67, 0x82,
+	0x0e, 0xe7, 0x3b, 0x82, 0x8e, 0x19, 0x7c, 0x20, 0x8c, 0x4b, 0xb8, 0x62, 0x15, 0xf7, 0xfe, 0x55,
+	0xa1, 0x95, 0x25, 0x97, 0xf7, 0xc0, 0x4d, 0x3a, 0x40, 0x0e, 0x52, 0x80, 0x58, 0xfa, 0xe7, 0x11,
+	0x83, 0x09, 0x10, 0x33, 0x78, 0x2f, 0xd9, 0x53, 0x1e, 0xcd, 0xb5, 0x6e, 0x3a, 0xe0, 0x3d, 0x5b,
+	0x4d, 0xc7, 0x5a, 0x32, 0x45, 0x3e, 0x02, 0x35, 0x0b, 0xf5, 0xc2, 0x7e, 0x1f, 0xbb, 0x7d, 0x65,
+	0x59, 0xac, 0xea, 0x69, 0xc4, 0xe0, 0x0c, 0xcc, 0x4f, 0x73, 0x8e, 0xe4, 0x9f, 0xab, 0x5e, 0x84,
+	0xb4, 0xd9, 0x24, 0xf9, 0x0f, 0x09, 0x28, 0xf9, 0xce, 0x91, 0x21, 0xf6, 0xf5, 0x81, 0x47, 0xa8,
+	0x6e, 0x0e, 0x90, 0x39, 0x54, 0x6e, 0x09, 0x9b, 0x1f, 0x78, 0x5f, 0x67, 0x9a, 0xc3, 0x21, 0xf6,
+	0x5f, 0x7a, 0x84, 0x0a, 0x41, 0xde, 0xd7, 0xa5, 0xec, 0xa5, 0xbe, 0x7e, 0x87, 0x26, 0x1e, 0xab,
+	0xe5, 0x26, 0xda, 0x15, 0x78, 0x87, 0xc3, 0xf2, 0xef, 0x12, 0xf8, 0x78, 0xf6, 0xcd, 0x6d, 0xdb,
+	0x3b, 0xd1, 0x8f, 0x03, 0xc3, 0x41, 0xba, 0xed, 0x19, 0x16, 0xdf, 0xa4, 0xdb, 0xa2, 0xfa, 0xef,
+	0x22, 0x06, 0x57, 0xf3, 0xaf, 0xc3, 0x65, 0xcf, 0xb9, 0x6a, 0x3f, 0x11, 0xc5, 0x0c, 0x3e, 0x2c,
+	0x1e, 0x80, 0xcb, 0x8a, 0xe2, 0x2a, 0x1e, 0xfc, 0x07, 0x9d, 0x76, 0xbd, 0x9d, 0xfc, 0x93, 0x04,
+	0xee, 0x12, 0xe4, 0x5a, 0x7a, 0xcf, 0x20, 0xd8, 0xd4, 0x45, 0xc7, 0xfb, 0x81, 0xe7, 0xf8, 0x54,
+	0xa9, 0x8b, 0x72, 0x8f, 0xf8, 0x49, 0xe5, 0x8a, 0x2e, 0x17, 0xf0, 0xc6, 0x3f, 0x10, 0x74, 0xcc,
+	0x60, 0x43, 0x14, 0x5a, 0xc2, 0xe5, 0xdf, 0x59, 0xb9, 0x8e, 0xd4, 0xca, 0x52, 0xca, 0x3f, 0x4a,
+	0x00, 0xf0, 0xee, 0x14, 0xef, 0x28, 0x51, 0xfe, 0xdf, 0xbc, 0xb1, 0xbe, 0x3c, 0x77, 0xe9, 0x1c,
+	0xec, 0xbe, 0xe2, 0x44, 0xf7, 0x9b, 0x73, 0x06, 0x2b, 0xfc, 0x76, 0xc8, 0x10, 0x7e, 0xd1, 0xd7,
+	0x0c, 0x1f, 0x27, 0x41, 0x7e, 0x41, 0x64, 0x88, 0xb8, 0x20, 0xb2, 0x20, 0x1e, 0xab, 0x33, 0x29,
+	0xbf, 0x23, 0xf2, 0x24, 0xda, 0x0c, 0x97, 0xbf, 0x05, 0x4b, 0xe9, 0x8b, 0x4d, 0x94, 0x15, 0x51,
+	0x86, 0x9c, 0x95, 0xf1, 0xe2, 0x68, 0xb7, 0x93, 0x50, 0xdd, 0x0d, 0x5e, 0x88, 0xb8, 0x01, 0x53,
+	0x6d, 0xcc, 0xe0, 0xed, 0xc4, 0x3a, 0x01, 0xb8, 0x73, 0x35, 0x1d, 0x6b, 0xb9, 0x4a, 0xc6, 0x60,
+	0x81, 0xbf, 0xfa, 0xca, 0x7b, 0x4d, 0x69, 0x7d, 0x79, 0x6b, 0x35, 0xcb, 0xfc, 0xf5, 0xee, 0x97,
+	0x3b, 0x85, 0x37, 0xbe, 0xbb, 0x9d, 0xae, 0x74, 0x81, 0x53, 0xfc, 0x95, 0xe0, 0xd3, 0x62, 0x06,
+	0x81, 0x30, 0xe1, 0x01, 0x77, 0x48, 0xd0, 0xf4, 0xf7, 0x6c, 0xa2, 0x0a, 0xb5, 0x26, 0xa2, 0xee,
+	0xde, 0xf9, 0x9b, 0x46, 0x65, 0xf2, 0xa6, 0x51, 0x39, 0x9f, 0x36, 0xa4, 0xc9, 0xb4, 0x21, 0xfd,
+	0x7c, 0xd1, 0xa8, 0xfc, 0x76, 0xd1, 0x90, 0x26, 0x17, 0x8d, 0xca, 0xdf, 0x17, 0x8d, 0xca, 0xeb,
+	0x87, 0x7d, 0x4c, 0x07, 0x61, 0xaf, 0x6d, 0x7a, 0xce, 0x26, 0x19, 0xb9, 0x26, 0x1d, 0x60, 0xb7,
+	0x3f, 0x37, 0x9a, 0xfd, 0x55, 0xe9, 0x2d, 0x8a, 0xbf, 0x23, 0x4f, 0xfe, 0x19, 0x00, 0xd0, 0xc8,
+	0x6e, 0x01, 0x3d, 0x09, 0x00, 0x00,
 }
 
 func (m *GUIConfiguration) Marshal() (dAtA []byte, err error) {
@@ -159,6 +173,48 @@ func (m *GUIConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.OIDC.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.APITokens) > 0 {
+		for iNdEx := len(m.APITokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.APITokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if m.SendBasicAuthPrompt {
 		i--
 		if m.SendBasicAuthPrompt {
@@ -344,6 +400,20 @@ func (m *GUIConfiguration) ProtoSize() (n int) {
 	if m.SendBasicAuthPrompt {
 		n += 2
 	}
+	if len(m.APITokens) > 0 {
+		for _, e := range m.APITokens {
+			l = e.ProtoSize()
+			n += 1 + l + sovGuiconfiguration(uint64(l))
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.ProtoSize()
+			n += 2 + l + sovGuiconfiguration(uint64(l))
+		}
+	}
+	l = m.OIDC.ProtoSize()
+	n += 2 + l + sovGuiconfiguration(uint64(l))
 	return n
 }
 
@@ -733,6 +803,107 @@ func (m *GUIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SendBasicAuthPrompt = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APITokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APITokens = append(m.APITokens, APIToken{})
+			if err := m.APITokens[len(m.APITokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, GUIAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OIDC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGuiconfiguration(dAtA[iNdEx:])
@@ -6,6 +6,74 @@
 
 package config
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 func (c LDAPConfiguration) Copy() LDAPConfiguration {
+	c.GroupRoles = append([]LDAPGroupRole(nil), c.GroupRoles...)
+	for i, gr := range c.GroupRoles {
+		c.GroupRoles[i].Folders = append([]string(nil), gr.Folders...)
+	}
 	return c
 }
+
+func (c *LDAPConfiguration) prepare() {
+	// Invalid roles are kept rather than dropped, so that their users are
+	// refused at login instead of falling through to another role.
+	if err := c.Validate(); err != nil {
+		l.Warnf("Invalid LDAP configuration, affected users cannot log in: %v", err)
+	}
+}
+
+// Validate returns an error describing what is wrong with the group roles
+// or the default role, if anything.
+func (c LDAPConfiguration) Validate() error {
+	for _, gr := range c.GroupRoles {
+		if err := gr.Validate(); err != nil {
+			return fmt.Errorf("group role for %q: %w", gr.Group, err)
+		}
+	}
+	if c.DefaultRole != "" {
+		if err := validGUIRole(c.DefaultRole); err != nil {
+			return fmt.Errorf("default role: %w", err)
+		}
+	}
+	return nil
+}
+
+// UserRole returns the first group role for any of the given groups, in
+// the order they are configured, or else the default role. It returns
+// false when there is neither or the role is invalid.
+func (c LDAPConfiguration) UserRole(groups []string) (LDAPGroupRole, bool) {
+	gr, ok := c.groupRole(groups)
+	if !ok {
+		gr = LDAPGroupRole{Role: c.DefaultRole}
+	}
+	if validGUIRole(gr.Role) != nil {
+		return LDAPGroupRole{}, false
+	}
+	return gr, true
+}
+
+func (c LDAPConfiguration) groupRole(groups []string) (LDAPGroupRole, bool) {
+	for _, gr := range c.GroupRoles {
+		for _, group := range groups {
+			if strings.EqualFold(gr.Group, group) {
+				return gr, true
+			}
+		}
+	}
+	return LDAPGroupRole{}, false
+}
+
+// Validate returns an error describing what is wrong with the group role,
+// if anything.
+func (gr LDAPGroupRole) Validate() error {
+	if gr.Group == "" {
+		return errors.New("no group")
+	}
+	return validGUIRole(gr.Role)
+}
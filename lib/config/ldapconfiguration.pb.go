@@ -24,12 +24,15 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type LDAPConfiguration struct {
-	Address            string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address" xml:"address,omitempty"`
-	BindDN             string        `protobuf:"bytes,2,opt,name=bind_dn,json=bindDn,proto3" json:"bindDN" xml:"bindDN,omitempty"`
-	Transport          LDAPTransport `protobuf:"varint,3,opt,name=transport,proto3,enum=config.LDAPTransport" json:"transport" xml:"transport,omitempty"`
-	InsecureSkipVerify bool          `protobuf:"varint,4,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecureSkipVerify" xml:"insecureSkipVerify,omitempty" default:"false"`
-	SearchBaseDN       string        `protobuf:"bytes,5,opt,name=search_base_dn,json=searchBaseDn,proto3" json:"searchBaseDN" xml:"searchBaseDN,omitempty"`
-	SearchFilter       string        `protobuf:"bytes,6,opt,name=search_filter,json=searchFilter,proto3" json:"searchFilter" xml:"searchFilter,omitempty"`
+	Address            string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address" xml:"address,omitempty"`
+	BindDN             string          `protobuf:"bytes,2,opt,name=bind_dn,json=bindDn,proto3" json:"bindDN" xml:"bindDN,omitempty"`
+	Transport          LDAPTransport   `protobuf:"varint,3,opt,name=transport,proto3,enum=config.LDAPTransport" json:"transport" xml:"transport,omitempty"`
+	InsecureSkipVerify bool            `protobuf:"varint,4,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecureSkipVerify" xml:"insecureSkipVerify,omitempty" default:"false"`
+	SearchBaseDN       string          `protobuf:"bytes,5,opt,name=search_base_dn,json=searchBaseDn,proto3" json:"searchBaseDN" xml:"searchBaseDN,omitempty"`
+	SearchFilter       string          `protobuf:"bytes,6,opt,name=search_filter,json=searchFilter,proto3" json:"searchFilter" xml:"searchFilter,omitempty"`
+	GroupAttribute     string          `protobuf:"bytes,7,opt,name=group_attribute,json=groupAttribute,proto3" json:"groupAttribute" xml:"groupAttribute,omitempty" default:"memberOf"`
+	GroupRoles         []LDAPGroupRole `protobuf:"bytes,8,rep,name=group_roles,json=groupRoles,proto3" json:"groupRoles" xml:"groupRole"`
+	DefaultRole        string          `protobuf:"bytes,9,opt,name=default_role,json=defaultRole,proto3" json:"defaultRole" xml:"defaultRole,omitempty"`
 }
 
 func (m *LDAPConfiguration) Reset()         { *m = LDAPConfiguration{} }
@@ -65,8 +68,51 @@ func (m *LDAPConfiguration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LDAPConfiguration proto.InternalMessageInfo
 
+// An LDAPGroupRole gives the members of an LDAP group, as listed in the
+// group attribute of the user found by the search, a role and optionally
+// the folders they see, as for a GUIAccount.
+type LDAPGroupRole struct {
+	Group   string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group" xml:"group,attr"`
+	Role    string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role" xml:"role,attr"`
+	Folders []string `protobuf:"bytes,3,rep,name=folders,proto3" json:"folders" xml:"folder"`
+}
+
+func (m *LDAPGroupRole) Reset()         { *m = LDAPGroupRole{} }
+func (m *LDAPGroupRole) String() string { return proto.CompactTextString(m) }
+func (*LDAPGroupRole) ProtoMessage()    {}
+func (*LDAPGroupRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9681ad7e41c73956, []int{1}
+}
+func (m *LDAPGroupRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LDAPGroupRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LDAPGroupRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LDAPGroupRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LDAPGroupRole.Merge(m, src)
+}
+func (m *LDAPGroupRole) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LDAPGroupRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_LDAPGroupRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LDAPGroupRole proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*LDAPConfiguration)(nil), "config.LDAPConfiguration")
+	proto.RegisterType((*LDAPGroupRole)(nil), "config.LDAPGroupRole")
 }
 
 func init() {
@@ -74,39 +120,53 @@ func init() {
 }
 
 var fileDescriptor_9681ad7e41c73956 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x81, 0xba, 0xc4, 0x2a, 0x15, 0x35, 0x50, 0x4c, 0x55, 0xf9, 0x22, 0xcb, 0x43, 0x90,
-	0x50, 0x22, 0x95, 0xad, 0x4c, 0x35, 0x15, 0x03, 0x20, 0x84, 0x5c, 0xe8, 0xc0, 0x12, 0xf9, 0xc7,
-	0x39, 0x39, 0xd5, 0x39, 0x5b, 0x77, 0xe7, 0xaa, 0xe1, 0xaf, 0x80, 0xfe, 0x05, 0xdd, 0xf8, 0x57,
-	0xba, 0xc5, 0x23, 0xd3, 0x49, 0x4d, 0x36, 0x8f, 0x1e, 0x99, 0x50, 0xce, 0x4e, 0x63, 0xa7, 0x51,
-	0xb7, 0xf7, 0xbe, 0xef, 0xbd, 0xef, 0x7d, 0x77, 0x4f, 0x4f, 0x35, 0x23, 0xe4, 0xf5, 0xfc, 0x18,
-	0x87, 0x68, 0xd0, 0x8b, 0x02, 0x37, 0x29, 0xc3, 0x94, 0xb8, 0x0c, 0xc5, 0xb8, 0x9b, 0x90, 0x98,
-	0xc5, 0x9a, 0x52, 0x82, 0x7b, 0xc6, 0x4a, 0x2d, 0x23, 0x2e, 0xa6, 0x49, 0x4c, 0x58, 0x59, 0xb7,
-	0xd7, 0x82, 0x17, 0x55, 0x68, 0xfe, 0x56, 0xd4, 0x9d, 0xcf, 0xc7, 0x47, 0x5f, 0xdf, 0xd7, 0xe5,
-	0xb4, 0xef, 0xea, 0xa6, 0x1b, 0x04, 0x04, 0x52, 0xaa, 0xcb, 0x6d, 0xb9, 0xd3, 0xb2, 0xdf, 0xe5,
-	0x1c, 0x2c, 0xa0, 0x82, 0x83, 0x97, 0x17, 0xa3, 0xe8, 0xd0, 0xac, 0xf2, 0x37, 0xf1, 0x08, 0x31,
-	0x38, 0x4a, 0xd8, 0xd8, 0xcc, 0x27, 0xd6, 0xce, 0x1d, 0xd4, 0x59, 0x34, 0x6a, 0xb1, 0xba, 0xe9,
-	0x21, 0x1c, 0xf4, 0x03, 0xac, 0x3f, 0x10, 0xb2, 0xa7, 0x53, 0x0e, 0x14, 0x1b, 0xe1, 0xe0, 0xf8,
-	0x4b, 0xce, 0x81, 0xe2, 0x89, 0xa8, 0xe0, 0x60, 0x57, 0xe8, 0x97, 0x69, 0x53, 0xfe, 0xe9, 0x2a,
-	0x58, 0x4c, 0xac, 0xaa, 0xef, 0x32, 0xb3, 0x2a, 0x2d, 0xa7, 0x44, 0xb0, 0x76, 0xae, 0xb6, 0x6e,
-	0xdf, 0xae, 0x3f, 0x6c, 0xcb, 0x9d, 0xed, 0x83, 0x17, 0xdd, 0xf2, 0x63, 0xba, 0xf3, 0x57, 0x7f,
-	0x5b, 0x90, 0xf6, 0x51, 0xce, 0xc1, 0xb2, 0xb6, 0xe0, 0xe0, 0x95, 0xb0, 0x70, 0x8b, 0x34, 0x5d,
-	0x3c, 0x5b, 0x83, 0x3b, 0xcb, 0x76, 0xed, 0x8f, 0xac, 0x3e, 0x47, 0x98, 0x42, 0x3f, 0x25, 0xb0,
-	0x4f, 0xcf, 0x50, 0xd2, 0x3f, 0x87, 0x04, 0x85, 0x63, 0xfd, 0x51, 0x5b, 0xee, 0x3c, 0xb6, 0xd3,
-	0x9c, 0x03, 0x6d, 0xc1, 0x9f, 0x9c, 0xa1, 0xe4, 0x54, 0xb0, 0x05, 0x07, 0x07, 0x62, 0xea, 0x5d,
-	0xaa, 0x36, 0xbe, 0x1d, 0xc0, 0xd0, 0x4d, 0x23, 0x76, 0x68, 0x86, 0x6e, 0x44, 0xe1, 0xdc, 0xce,
-	0xfe, 0x7d, 0x0d, 0xff, 0x26, 0xd6, 0x86, 0xa8, 0x74, 0xd6, 0x8c, 0xd4, 0xae, 0x64, 0x75, 0x9b,
-	0x42, 0x97, 0xf8, 0xc3, 0xbe, 0xe7, 0x52, 0x38, 0x5f, 0xcd, 0x86, 0x58, 0xcd, 0xcf, 0x29, 0x07,
-	0x5b, 0x27, 0x82, 0xb1, 0x5d, 0x0a, 0xc5, 0x82, 0xb6, 0x68, 0x2d, 0x2f, 0x38, 0xd8, 0x17, 0x6e,
-	0xeb, 0x60, 0xf3, 0x9b, 0x76, 0xd7, 0x53, 0xc5, 0xc4, 0x6a, 0x28, 0x5d, 0x66, 0x56, 0x63, 0x92,
-	0x53, 0x67, 0xb1, 0x16, 0xab, 0x4f, 0x2a, 0x87, 0x21, 0x8a, 0x18, 0x24, 0xba, 0x22, 0x0c, 0x7e,
-	0x5c, 0x1a, 0xfa, 0x20, 0xf0, 0x15, 0x43, 0x25, 0xb8, 0xd6, 0xd0, 0x2a, 0xe5, 0x34, 0x74, 0xec,
-	0x4f, 0xd7, 0x37, 0x86, 0x94, 0xdd, 0x18, 0xd2, 0xf5, 0xd4, 0x90, 0xb3, 0xa9, 0x21, 0xff, 0x9a,
-	0x19, 0xd2, 0xd5, 0xcc, 0x90, 0xb3, 0x99, 0x21, 0xfd, 0x9d, 0x19, 0xd2, 0x8f, 0xd7, 0x03, 0xc4,
-	0x86, 0xa9, 0xd7, 0xf5, 0xe3, 0x51, 0x8f, 0x8e, 0xb1, 0xcf, 0x86, 0x08, 0x0f, 0x6a, 0xd1, 0xf2,
-	0xfe, 0x3c, 0x45, 0xdc, 0xd9, 0xdb, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x64, 0x4b, 0x05,
-	0xc0, 0x03, 0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8e, 0x7f, 0x69, 0x93, 0xfa, 0x9a, 0xfe, 0xf3, 0x8f, 0x16, 0x53, 0x2a, 0x5f, 0x14, 0x05,
+	0x29, 0x88, 0x2a, 0x15, 0x65, 0x2b, 0x48, 0x28, 0xa6, 0xa2, 0x12, 0x20, 0x40, 0x2e, 0x74, 0x60,
+	0x89, 0xec, 0xf8, 0x92, 0xba, 0x75, 0x7c, 0xd1, 0xd9, 0xa9, 0x5a, 0x66, 0x3e, 0x00, 0xea, 0xc4,
+	0xd8, 0x8d, 0xaf, 0xd2, 0x2d, 0x61, 0x63, 0x3a, 0xa9, 0xc9, 0xe6, 0xd1, 0x63, 0x27, 0x74, 0x67,
+	0x3b, 0xb6, 0xd3, 0xc0, 0x76, 0xef, 0xf3, 0x3c, 0xf7, 0xbc, 0x8f, 0xef, 0x4d, 0x5e, 0x50, 0xb1,
+	0x2d, 0x63, 0xa7, 0x85, 0x9d, 0xb6, 0xd5, 0xd9, 0xb1, 0x4d, 0xbd, 0x17, 0x1e, 0xfb, 0x44, 0xf7,
+	0x2c, 0xec, 0xd4, 0x7b, 0x04, 0x7b, 0x58, 0x2a, 0x84, 0xe0, 0xa6, 0x32, 0xa5, 0xf5, 0x88, 0xee,
+	0xb8, 0x3d, 0x4c, 0xbc, 0x50, 0xb7, 0x29, 0xa2, 0xf3, 0xe8, 0x58, 0xf9, 0x26, 0x82, 0xb5, 0x77,
+	0xfb, 0x8d, 0x8f, 0xaf, 0xd2, 0x76, 0xd2, 0x67, 0x50, 0xd4, 0x4d, 0x93, 0x20, 0xd7, 0x95, 0x85,
+	0xb2, 0x50, 0x13, 0xd5, 0xe7, 0x3e, 0x85, 0x31, 0x14, 0x50, 0x78, 0xff, 0xbc, 0x6b, 0xef, 0x55,
+	0xa2, 0x7a, 0x1b, 0x77, 0x2d, 0x0f, 0x75, 0x7b, 0xde, 0x45, 0xc5, 0x1f, 0x54, 0xd7, 0xee, 0xa0,
+	0x5a, 0x7c, 0x51, 0xc2, 0xa0, 0x68, 0x58, 0x8e, 0xd9, 0x34, 0x1d, 0xf9, 0x3f, 0x6e, 0x7b, 0x34,
+	0xa2, 0xb0, 0xa0, 0x5a, 0x8e, 0xb9, 0xff, 0xde, 0xa7, 0xb0, 0x60, 0xf0, 0x53, 0x40, 0xe1, 0x06,
+	0xf7, 0x0f, 0xcb, 0xac, 0xfd, 0xea, 0x34, 0x18, 0x0c, 0xaa, 0xd1, 0xbd, 0xcb, 0x61, 0x35, 0xf2,
+	0xd2, 0x42, 0xc4, 0x91, 0xce, 0x80, 0x38, 0xf9, 0x76, 0x39, 0x5f, 0x16, 0x6a, 0xcb, 0xbb, 0xeb,
+	0xf5, 0xf0, 0x61, 0xea, 0xec, 0xab, 0x3f, 0xc5, 0xa4, 0xda, 0xf0, 0x29, 0x4c, 0xb4, 0x01, 0x85,
+	0x0f, 0x78, 0x84, 0x09, 0x92, 0x4d, 0xf1, 0xff, 0x0c, 0x5c, 0x4b, 0xae, 0x4b, 0x3f, 0x05, 0x70,
+	0xcf, 0x72, 0x5c, 0xd4, 0xea, 0x13, 0xd4, 0x74, 0x4f, 0xad, 0x5e, 0xf3, 0x0c, 0x11, 0xab, 0x7d,
+	0x21, 0xcf, 0x95, 0x85, 0xda, 0x82, 0xda, 0xf7, 0x29, 0x94, 0x62, 0xfe, 0xf0, 0xd4, 0xea, 0x1d,
+	0x71, 0x36, 0xa0, 0x70, 0x97, 0x77, 0xbd, 0x4b, 0xa5, 0xda, 0x97, 0x4d, 0xd4, 0xd6, 0xfb, 0xb6,
+	0xb7, 0x57, 0x69, 0xeb, 0xb6, 0x8b, 0x58, 0x9c, 0xad, 0x7f, 0x5d, 0xb8, 0x1d, 0x54, 0xe7, 0xb9,
+	0x52, 0x9b, 0xd1, 0x52, 0xba, 0x12, 0xc0, 0xb2, 0x8b, 0x74, 0xd2, 0x3a, 0x6e, 0x1a, 0xba, 0x8b,
+	0xd8, 0x68, 0xe6, 0xf9, 0x68, 0xbe, 0x8e, 0x28, 0x2c, 0x1d, 0x72, 0x46, 0xd5, 0x5d, 0xc4, 0x07,
+	0x54, 0x72, 0x53, 0x75, 0x40, 0xe1, 0x16, 0x4f, 0x9b, 0x06, 0xb3, 0xcf, 0xb4, 0x31, 0x9b, 0x0a,
+	0x06, 0xd5, 0x8c, 0xd3, 0xe5, 0xb0, 0x9a, 0xe9, 0xa4, 0xa5, 0x59, 0x47, 0xc2, 0x60, 0x29, 0x4a,
+	0xd8, 0xb6, 0x6c, 0x0f, 0x11, 0xb9, 0xc0, 0x03, 0xbe, 0x49, 0x02, 0xbd, 0xe6, 0xf8, 0x54, 0xa0,
+	0x10, 0x9c, 0x19, 0x68, 0x9a, 0xd2, 0x32, 0x3e, 0xd2, 0x0f, 0x01, 0xac, 0x74, 0x08, 0xee, 0xf7,
+	0x9a, 0xba, 0xe7, 0x11, 0xcb, 0xe8, 0x7b, 0x48, 0x2e, 0xf2, 0x9e, 0xd8, 0xa7, 0x70, 0x99, 0x53,
+	0x8d, 0x98, 0x09, 0x28, 0x7c, 0xca, 0xbb, 0x66, 0xe1, 0x99, 0x03, 0xeb, 0xa2, 0xae, 0x81, 0xc8,
+	0x87, 0x36, 0x8b, 0x22, 0xff, 0x4d, 0x7f, 0x3b, 0xa8, 0x2e, 0xc4, 0x42, 0x6d, 0xaa, 0x99, 0x74,
+	0x02, 0x16, 0xc3, 0x64, 0x04, 0xdb, 0xc8, 0x95, 0x17, 0xca, 0xf9, 0xda, 0x62, 0xf6, 0x27, 0x7d,
+	0xc0, 0x68, 0x0d, 0xdb, 0x48, 0xdd, 0xbd, 0xa6, 0x30, 0xe7, 0x53, 0x08, 0x3a, 0x31, 0xc4, 0xfe,
+	0xba, 0x2b, 0x49, 0x58, 0x06, 0xb1, 0x28, 0xe2, 0xa4, 0xd2, 0x52, 0x5a, 0xe9, 0x04, 0x94, 0xa2,
+	0xe4, 0xbc, 0x9b, 0x2c, 0xf2, 0x27, 0x38, 0xf0, 0x29, 0x5c, 0x8c, 0x70, 0xa6, 0x0b, 0x28, 0x7c,
+	0xc8, 0x2d, 0x53, 0x58, 0xf6, 0xd1, 0xd7, 0x67, 0x32, 0x5a, 0xda, 0xa4, 0xf2, 0x4b, 0x00, 0x4b,
+	0x99, 0xf4, 0x52, 0x03, 0xcc, 0xf3, 0x2c, 0xd1, 0x02, 0x7a, 0xe2, 0x53, 0x18, 0x02, 0x01, 0x85,
+	0xab, 0xc9, 0x37, 0x6c, 0xb3, 0x11, 0xb1, 0x2e, 0x20, 0x29, 0xb5, 0x50, 0x28, 0xbd, 0x00, 0x73,
+	0x3c, 0x78, 0xb8, 0x6b, 0x6a, 0x3e, 0x85, 0xbc, 0x9e, 0x3c, 0x02, 0x2b, 0x26, 0xf7, 0xc5, 0x49,
+	0xa5, 0x71, 0x95, 0xf4, 0x12, 0x14, 0xdb, 0xd8, 0x36, 0x11, 0x71, 0xe5, 0x7c, 0x39, 0x5f, 0x13,
+	0xd5, 0x47, 0x6c, 0x07, 0x46, 0x50, 0x40, 0x61, 0x89, 0x7b, 0x84, 0x35, 0x33, 0x28, 0x84, 0x47,
+	0x2d, 0x96, 0xa8, 0x6f, 0xaf, 0x6f, 0x94, 0xdc, 0xf0, 0x46, 0xc9, 0x5d, 0x8f, 0x14, 0x61, 0x38,
+	0x52, 0x84, 0xef, 0x63, 0x25, 0x77, 0x35, 0x56, 0x84, 0xe1, 0x58, 0xc9, 0xfd, 0x1e, 0x2b, 0xb9,
+	0x2f, 0x8f, 0x3b, 0x96, 0x77, 0xdc, 0x37, 0xea, 0x2d, 0xdc, 0xdd, 0x71, 0x2f, 0x9c, 0x96, 0x77,
+	0x6c, 0x39, 0x9d, 0xd4, 0x29, 0x59, 0xe3, 0x46, 0x81, 0xaf, 0xeb, 0x67, 0x7f, 0x06, 0x00, 0x7e,
+	0x05, 0xaa, 0x38, 0x07, 0x06, 0x00, 0x00,
 }
 
 func (m *LDAPConfiguration) Marshal() (dAtA []byte, err error) {
@@ -129,6 +189,34 @@ func (m *LDAPConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DefaultRole) > 0 {
+		i -= len(m.DefaultRole)
+		copy(dAtA[i:], m.DefaultRole)
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.DefaultRole)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.GroupRoles) > 0 {
+		for iNdEx := len(m.GroupRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLdapconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.GroupAttribute) > 0 {
+		i -= len(m.GroupAttribute)
+		copy(dAtA[i:], m.GroupAttribute)
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.GroupAttribute)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.SearchFilter) > 0 {
 		i -= len(m.SearchFilter)
 		copy(dAtA[i:], m.SearchFilter)
@@ -175,6 +263,52 @@ func (m *LDAPConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LDAPGroupRole) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LDAPGroupRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LDAPGroupRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLdapconfiguration(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLdapconfiguration(v)
 	base := offset
@@ -214,6 +348,43 @@ func (m *LDAPConfiguration) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLdapconfiguration(uint64(l))
 	}
+	l = len(m.GroupAttribute)
+	if l > 0 {
+		n += 1 + l + sovLdapconfiguration(uint64(l))
+	}
+	if len(m.GroupRoles) > 0 {
+		for _, e := range m.GroupRoles {
+			l = e.ProtoSize()
+			n += 1 + l + sovLdapconfiguration(uint64(l))
+		}
+	}
+	l = len(m.DefaultRole)
+	if l > 0 {
+		n += 1 + l + sovLdapconfiguration(uint64(l))
+	}
+	return n
+}
+
+func (m *LDAPGroupRole) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovLdapconfiguration(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovLdapconfiguration(uint64(l))
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovLdapconfiguration(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -419,6 +590,250 @@ func (m *LDAPConfiguration) Unmarshal(dAtA []byte) error {
 			}
 			m.SearchFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAttribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAttribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupRoles = append(m.GroupRoles, LDAPGroupRole{})
+			if err := m.GroupRoles[len(m.GroupRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRole", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultRole = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLdapconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LDAPGroupRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLdapconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LDAPGroupRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LDAPGroupRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLdapconfiguration(dAtA[iNdEx:])
@@ -27,6 +27,7 @@ import (
 // put the newest on top for readability.
 var (
 	migrations = migrationSet{
+		{38, migrateToConfigV38},
 		{37, migrateToConfigV37},
 		{36, migrateToConfigV36},
 		{35, migrateToConfigV35},
@@ -96,6 +97,13 @@ func (m migration) apply(cfg *Configuration) {
 	cfg.Version = m.targetVersion
 }
 
+func migrateToConfigV38(cfg *Configuration) {
+	// LDAP users used to be admins; now they need a role
+	if cfg.GUI.AuthMode == AuthModeLDAP && len(cfg.LDAP.GroupRoles) == 0 && cfg.LDAP.DefaultRole == "" {
+		cfg.LDAP.DefaultRole = GUIRoleAdmin
+	}
+}
+
 func migrateToConfigV37(cfg *Configuration) {
 	// "scan ownership" changed name to "send ownership"
 	for i := range cfg.Folders {
@@ -34,3 +34,22 @@ func TestMigrateCrashReporting(t *testing.T) {
 		}
 	}
 }
+
+func TestMigrateLDAPDefaultRole(t *testing.T) {
+	// LDAP users, who used to be admins, stay admins after migration.
+	cfg := Configuration{Version: 37, GUI: GUIConfiguration{AuthMode: AuthModeLDAP}}
+	migrationsMut.Lock()
+	migrations.apply(&cfg)
+	migrationsMut.Unlock()
+	if cfg.LDAP.DefaultRole != GUIRoleAdmin {
+		t.Errorf("unexpected default role %q", cfg.LDAP.DefaultRole)
+	}
+
+	cfg = Configuration{Version: 37}
+	migrationsMut.Lock()
+	migrations.apply(&cfg)
+	migrationsMut.Unlock()
+	if cfg.LDAP.DefaultRole != "" {
+		t.Errorf("unexpected default role %q", cfg.LDAP.DefaultRole)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+func (c OIDCConfiguration) Copy() OIDCConfiguration {
+	c.ClaimRoles = append([]OIDCClaimRole(nil), c.ClaimRoles...)
+	for i, cr := range c.ClaimRoles {
+		c.ClaimRoles[i].Folders = append([]string(nil), cr.Folders...)
+	}
+	return c
+}
+
+// IsEnabled returns whether OIDC login is configured.
+func (c OIDCConfiguration) IsEnabled() bool {
+	return c.Issuer != "" && c.ClientID != "" && c.RedirectURL != ""
+}
+
+func (c *OIDCConfiguration) prepare() {
+	if c.Issuer != "" && c.ClientID != "" && c.RedirectURL == "" {
+		l.Warnln("OIDC login is disabled, as no redirect URL is set")
+	}
+	// Invalid roles are kept rather than dropped, so that their users are
+	// refused at login instead of falling through to another role.
+	if err := c.Validate(); err != nil {
+		l.Warnf("Invalid OIDC configuration, affected users cannot log in: %v", err)
+	}
+}
+
+// Validate returns an error describing what is wrong with the claim roles
+// or the default role, if anything.
+func (c OIDCConfiguration) Validate() error {
+	for _, cr := range c.ClaimRoles {
+		if err := cr.Validate(); err != nil {
+			return fmt.Errorf("claim role for %q: %w", cr.Value, err)
+		}
+	}
+	if c.DefaultRole != "" {
+		if err := validGUIRole(c.DefaultRole); err != nil {
+			return fmt.Errorf("default role: %w", err)
+		}
+	}
+	return nil
+}
+
+// UserRole returns the first claim role for any of the given values, in
+// the order they are configured, or else the default role. It returns
+// false when there is neither or the role is invalid.
+func (c OIDCConfiguration) UserRole(values []string) (OIDCClaimRole, bool) {
+	cr, ok := c.claimRole(values)
+	if !ok {
+		cr = OIDCClaimRole{Role: c.DefaultRole}
+	}
+	if validGUIRole(cr.Role) != nil {
+		return OIDCClaimRole{}, false
+	}
+	return cr, true
+}
+
+func (c OIDCConfiguration) claimRole(values []string) (OIDCClaimRole, bool) {
+	for _, cr := range c.ClaimRoles {
+		for _, value := range values {
+			if cr.Value == value {
+				return cr, true
+			}
+		}
+	}
+	return OIDCClaimRole{}, false
+}
+
+// Validate returns an error describing what is wrong with the claim role,
+// if anything.
+func (cr OIDCClaimRole) Validate() error {
+	if cr.Value == "" {
+		return errors.New("no value")
+	}
+	return validGUIRole(cr.Role)
+}
@@ -0,0 +1,972 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/oidcconfiguration.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OIDC login to the GUI is enabled when the issuer, client ID and redirect
+// URL are set. The redirect URL is the callback path on the address the
+// GUI is reached at, as registered with the provider. The user claim of
+// the ID token names the user. The role claim, a string or a list of
+// strings, gives the role and folders of the first claim role with a
+// matching value, or else the default role; users with neither may not
+// log in.
+type OIDCConfiguration struct {
+	Issuer       string          `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer" xml:"issuer,omitempty"`
+	ClientID     string          `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"clientID" xml:"clientID,omitempty"`
+	ClientSecret string          `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"clientSecret" xml:"clientSecret,omitempty"`
+	RedirectURL  string          `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirectURL" xml:"redirectURL,omitempty"`
+	Scopes       string          `protobuf:"bytes,5,opt,name=scopes,proto3" json:"scopes" xml:"scopes,omitempty" default:"openid profile email"`
+	UserClaim    string          `protobuf:"bytes,6,opt,name=user_claim,json=userClaim,proto3" json:"userClaim" xml:"userClaim,omitempty" default:"preferred_username"`
+	RoleClaim    string          `protobuf:"bytes,7,opt,name=role_claim,json=roleClaim,proto3" json:"roleClaim" xml:"roleClaim,omitempty" default:"groups"`
+	ClaimRoles   []OIDCClaimRole `protobuf:"bytes,8,rep,name=claim_roles,json=claimRoles,proto3" json:"claimRoles" xml:"claimRole"`
+	DefaultRole  string          `protobuf:"bytes,9,opt,name=default_role,json=defaultRole,proto3" json:"defaultRole" xml:"defaultRole,omitempty"`
+}
+
+func (m *OIDCConfiguration) Reset()         { *m = OIDCConfiguration{} }
+func (m *OIDCConfiguration) String() string { return proto.CompactTextString(m) }
+func (*OIDCConfiguration) ProtoMessage()    {}
+func (*OIDCConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee763e3bef38c648, []int{0}
+}
+func (m *OIDCConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OIDCConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OIDCConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OIDCConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OIDCConfiguration.Merge(m, src)
+}
+func (m *OIDCConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *OIDCConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_OIDCConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OIDCConfiguration proto.InternalMessageInfo
+
+// An OIDCClaimRole gives users with the value in their role claim a role
+// and optionally the folders they see, as for a GUIAccount.
+type OIDCClaimRole struct {
+	Value   string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value" xml:"value,attr"`
+	Role    string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role" xml:"role,attr"`
+	Folders []string `protobuf:"bytes,3,rep,name=folders,proto3" json:"folders" xml:"folder"`
+}
+
+func (m *OIDCClaimRole) Reset()         { *m = OIDCClaimRole{} }
+func (m *OIDCClaimRole) String() string { return proto.CompactTextString(m) }
+func (*OIDCClaimRole) ProtoMessage()    {}
+func (*OIDCClaimRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee763e3bef38c648, []int{1}
+}
+func (m *OIDCClaimRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OIDCClaimRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OIDCClaimRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OIDCClaimRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OIDCClaimRole.Merge(m, src)
+}
+func (m *OIDCClaimRole) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *OIDCClaimRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_OIDCClaimRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OIDCClaimRole proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*OIDCConfiguration)(nil), "config.OIDCConfiguration")
+	proto.RegisterType((*OIDCClaimRole)(nil), "config.OIDCClaimRole")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/oidcconfiguration.proto", fileDescriptor_ee763e3bef38c648)
+}
+
+var fileDescriptor_ee763e3bef38c648 = []byte{
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xc1, 0x6b, 0x13, 0x4b,
+	0x1c, 0xc7, 0xb3, 0x2f, 0x6d, 0x9a, 0x9d, 0xa4, 0xbc, 0xbe, 0x7d, 0xaf, 0x65, 0x79, 0xca, 0x4e,
+	0x08, 0x2b, 0x44, 0x2d, 0x29, 0x54, 0x14, 0x29, 0x82, 0x98, 0x14, 0xa4, 0x2a, 0x28, 0x23, 0x5e,
+	0x44, 0x08, 0xc9, 0xee, 0x24, 0x9d, 0xb2, 0xc9, 0x84, 0xd9, 0x5d, 0x69, 0xf1, 0xe0, 0xd1, 0xab,
+	0xd4, 0x83, 0x57, 0xff, 0x9c, 0xde, 0x12, 0x6f, 0x9e, 0x06, 0x9a, 0x80, 0x87, 0x3d, 0xee, 0xb1,
+	0x27, 0x99, 0x99, 0xcd, 0x66, 0xd3, 0xee, 0x6d, 0x7e, 0x9f, 0xdf, 0x77, 0x7e, 0xdf, 0x6f, 0x32,
+	0xb3, 0x03, 0xea, 0x1e, 0xe9, 0xed, 0x39, 0x74, 0xd4, 0x27, 0x83, 0x3d, 0x4a, 0x5c, 0x47, 0x2d,
+	0x43, 0xd6, 0x0d, 0x08, 0x1d, 0x35, 0xc7, 0x8c, 0x06, 0xd4, 0x28, 0x29, 0xf8, 0xbf, 0x8e, 0x4f,
+	0x03, 0x85, 0xea, 0xbf, 0xcb, 0xe0, 0x9f, 0xd7, 0x47, 0x87, 0xed, 0x76, 0x56, 0x6e, 0xbc, 0x01,
+	0x25, 0xe2, 0xfb, 0x21, 0x66, 0xa6, 0x56, 0xd3, 0x1a, 0x7a, 0xeb, 0x71, 0xc4, 0x61, 0x42, 0x62,
+	0x0e, 0x77, 0x4e, 0x87, 0xde, 0x41, 0x5d, 0x95, 0xbb, 0x74, 0x48, 0x02, 0x3c, 0x1c, 0x07, 0x67,
+	0xf5, 0x68, 0x62, 0x6f, 0x5d, 0x87, 0x28, 0xd9, 0x65, 0x7c, 0x06, 0xba, 0xe3, 0x11, 0x3c, 0x0a,
+	0x3a, 0xc4, 0x35, 0xff, 0x92, 0x43, 0x7b, 0x33, 0x0e, 0xcb, 0x6d, 0x09, 0x8f, 0x0e, 0x23, 0x0e,
+	0xcb, 0x4e, 0xb2, 0x8e, 0x39, 0x34, 0xa5, 0xc5, 0x02, 0xac, 0x9a, 0x18, 0x37, 0x71, 0x3c, 0xb1,
+	0xd3, 0xdd, 0xe7, 0x53, 0x3b, 0x9d, 0x8a, 0x16, 0xd4, 0x35, 0x28, 0xd8, 0x4c, 0x02, 0xf8, 0xd8,
+	0x61, 0x38, 0x30, 0x8b, 0x32, 0xc4, 0x8b, 0x88, 0xc3, 0xaa, 0x6a, 0xbc, 0x95, 0x3c, 0xe6, 0xf0,
+	0x76, 0xc6, 0x5c, 0xc1, 0xd5, 0x00, 0x3b, 0xf9, 0x2d, 0xb4, 0x32, 0xc7, 0xf8, 0xae, 0x81, 0x2a,
+	0xc3, 0x2e, 0x61, 0xd8, 0x09, 0x3a, 0x21, 0xf3, 0xcc, 0x35, 0x69, 0x18, 0xcc, 0x38, 0xac, 0xa0,
+	0x84, 0xbf, 0x43, 0xaf, 0x22, 0x0e, 0x2b, 0x6c, 0x59, 0xc6, 0x1c, 0xde, 0x92, 0xf6, 0x19, 0xb6,
+	0xea, 0xbe, 0x9d, 0xdb, 0x89, 0x27, 0x76, 0x76, 0xcc, 0xf9, 0xd4, 0xce, 0x9a, 0xa0, 0x65, 0x8f,
+	0x79, 0xc6, 0x17, 0x0d, 0x94, 0x7c, 0x87, 0x8e, 0xb1, 0x6f, 0xae, 0xcb, 0x4c, 0x54, 0x1c, 0xaf,
+	0x22, 0x31, 0x87, 0x0f, 0xa5, 0xbf, 0x2a, 0x33, 0xd6, 0x35, 0x17, 0xf7, 0xbb, 0xa1, 0x17, 0x1c,
+	0xd4, 0xe9, 0x18, 0x8f, 0x88, 0x5b, 0x1b, 0x33, 0xda, 0x27, 0x1e, 0xae, 0xe1, 0x61, 0x97, 0x78,
+	0xf2, 0xf4, 0xaf, 0xef, 0xb9, 0x9a, 0xd8, 0xff, 0xe5, 0x89, 0x51, 0x62, 0x66, 0x7c, 0xd3, 0x00,
+	0x08, 0x7d, 0xcc, 0x3a, 0x8e, 0xd7, 0x25, 0x43, 0xb3, 0xa4, 0xfe, 0xa1, 0x88, 0x43, 0x5d, 0xd0,
+	0xb6, 0x80, 0x31, 0x87, 0x8f, 0x64, 0xa0, 0x94, 0xe4, 0x66, 0x1a, 0x33, 0xdc, 0xc7, 0x8c, 0x61,
+	0xb7, 0x23, 0x74, 0xa3, 0xee, 0x10, 0x8b, 0x44, 0xff, 0xe6, 0x6c, 0xba, 0x9a, 0xd8, 0xc6, 0x4d,
+	0x35, 0x5a, 0x3a, 0x1a, 0x9f, 0x00, 0x60, 0xd4, 0xc3, 0x49, 0xa8, 0x0d, 0x19, 0xea, 0x83, 0x08,
+	0x25, 0xe8, 0x22, 0xd4, 0x3d, 0x75, 0x4a, 0x0b, 0x92, 0x1b, 0x6a, 0xc0, 0x68, 0x38, 0xf6, 0x65,
+	0x90, 0x1c, 0xe1, 0xd5, 0xc4, 0x2e, 0x29, 0x05, 0x5a, 0x4e, 0x36, 0x4e, 0x40, 0x45, 0xfa, 0x76,
+	0x04, 0xf2, 0xcd, 0x72, 0xad, 0xd8, 0xa8, 0xec, 0x6f, 0x37, 0xd5, 0x97, 0xdb, 0x94, 0x9f, 0xaa,
+	0x68, 0x23, 0xea, 0xe1, 0xd6, 0xfe, 0x05, 0x87, 0x85, 0x88, 0x43, 0xe0, 0x2c, 0x90, 0x38, 0xbf,
+	0xbf, 0x93, 0xeb, 0x9b, 0x20, 0x61, 0xaf, 0xa7, 0x15, 0xca, 0x68, 0x8d, 0x13, 0x50, 0x4d, 0xb2,
+	0x4a, 0x37, 0x53, 0x97, 0x3f, 0xf5, 0xb9, 0xb8, 0x92, 0x09, 0x17, 0xba, 0xf4, 0x4a, 0x66, 0xd8,
+	0xb5, 0x2b, 0x99, 0xdb, 0x41, 0xd9, 0x21, 0xf5, 0x9f, 0x1a, 0xd8, 0x5c, 0x49, 0x6f, 0x3c, 0x03,
+	0xeb, 0x1f, 0xbb, 0x5e, 0x88, 0x93, 0x37, 0xe6, 0x7e, 0xc4, 0xa1, 0x02, 0x31, 0x87, 0x5b, 0xd2,
+	0x50, 0x56, 0xbb, 0xdd, 0x20, 0x60, 0xc2, 0x05, 0x2c, 0x4b, 0xa4, 0x84, 0xc6, 0x13, 0xb0, 0x26,
+	0x83, 0xab, 0x07, 0xa5, 0x11, 0x71, 0x28, 0xeb, 0xf4, 0x4f, 0x10, 0x45, 0xba, 0x5f, 0x4f, 0x2b,
+	0x24, 0x55, 0xc6, 0x53, 0xb0, 0xd1, 0xa7, 0x9e, 0x8b, 0x99, 0x6f, 0x16, 0x6b, 0xc5, 0x86, 0xde,
+	0xba, 0x13, 0x71, 0xb8, 0x40, 0x31, 0x87, 0x55, 0x39, 0x43, 0xd5, 0x62, 0x40, 0x49, 0x2d, 0xd1,
+	0x42, 0xd2, 0x7a, 0x79, 0x71, 0x69, 0x15, 0xa6, 0x97, 0x56, 0xe1, 0x62, 0x66, 0x69, 0xd3, 0x99,
+	0xa5, 0x7d, 0x9d, 0x5b, 0x85, 0x1f, 0x73, 0x4b, 0x9b, 0xce, 0xad, 0xc2, 0xaf, 0xb9, 0x55, 0x78,
+	0x7f, 0x77, 0x40, 0x82, 0xe3, 0xb0, 0xd7, 0x74, 0xe8, 0x70, 0xcf, 0x3f, 0x1b, 0x39, 0xc1, 0x31,
+	0x19, 0x0d, 0x32, 0xab, 0xe5, 0xa3, 0xdd, 0x2b, 0xc9, 0x07, 0xf9, 0xc1, 0x9f, 0x01, 0x00, 0xed,
+	0x7f, 0x63, 0xe5, 0xc9, 0x05, 0x00, 0x00,
+}
+
+func (m *OIDCConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OIDCConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OIDCConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefaultRole) > 0 {
+		i -= len(m.DefaultRole)
+		copy(dAtA[i:], m.DefaultRole)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.DefaultRole)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ClaimRoles) > 0 {
+		for iNdEx := len(m.ClaimRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOidcconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RoleClaim) > 0 {
+		i -= len(m.RoleClaim)
+		copy(dAtA[i:], m.RoleClaim)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.RoleClaim)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UserClaim) > 0 {
+		i -= len(m.UserClaim)
+		copy(dAtA[i:], m.UserClaim)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.UserClaim)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Scopes) > 0 {
+		i -= len(m.Scopes)
+		copy(dAtA[i:], m.Scopes)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.Scopes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RedirectURL) > 0 {
+		i -= len(m.RedirectURL)
+		copy(dAtA[i:], m.RedirectURL)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.RedirectURL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientSecret) > 0 {
+		i -= len(m.ClientSecret)
+		copy(dAtA[i:], m.ClientSecret)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.ClientSecret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OIDCClaimRole) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OIDCClaimRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OIDCClaimRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOidcconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOidcconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OIDCConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.ClientSecret)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.RedirectURL)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.Scopes)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.UserClaim)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.RoleClaim)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	if len(m.ClaimRoles) > 0 {
+		for _, e := range m.ClaimRoles {
+			l = e.ProtoSize()
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	l = len(m.DefaultRole)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	return n
+}
+
+func (m *OIDCClaimRole) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOidcconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOidcconfiguration(x uint64) (n int) {
+	return sovOidcconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OIDCConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOidcconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OIDCConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OIDCConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserClaim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleClaim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRoles = append(m.ClaimRoles, OIDCClaimRole{})
+			if err := m.ClaimRoles[len(m.ClaimRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRole", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultRole = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOidcconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OIDCClaimRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOidcconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OIDCClaimRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OIDCClaimRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOidcconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOidcconfiguration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOidcconfiguration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOidcconfiguration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOidcconfiguration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOidcconfiguration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOidcconfiguration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOidcconfiguration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOidcconfiguration = fmt.Errorf("proto: unexpected end of group")
+)
@@ -26,6 +26,16 @@ func (opts OptionsConfiguration) Copy() OptionsConfiguration {
 	copy(optsCopy.AlwaysLocalNets, opts.AlwaysLocalNets)
 	optsCopy.UnackedNotificationIDs = make([]string, len(opts.UnackedNotificationIDs))
 	copy(optsCopy.UnackedNotificationIDs, opts.UnackedNotificationIDs)
+	optsCopy.BandwidthSchedules = make([]BandwidthSchedule, len(opts.BandwidthSchedules))
+	copy(optsCopy.BandwidthSchedules, opts.BandwidthSchedules)
+	optsCopy.MeteredInterfaces = make([]string, len(opts.MeteredInterfaces))
+	copy(optsCopy.MeteredInterfaces, opts.MeteredInterfaces)
+	optsCopy.MeteredSubnets = make([]string, len(opts.MeteredSubnets))
+	copy(optsCopy.MeteredSubnets, opts.MeteredSubnets)
+	optsCopy.Webhooks = make([]Webhook, len(opts.Webhooks))
+	for i, w := range opts.Webhooks {
+		optsCopy.Webhooks[i] = w.Copy()
+	}
 	return optsCopy
 }
 
@@ -65,6 +75,9 @@ func (opts *OptionsConfiguration) prepare(guiPWIsSet bool) {
 		l.Warnln("Connection priority number for TCP over WAN must be worse (higher) than TCP over LAN. Correcting.")
 		opts.ConnectionPriorityTCPWAN = opts.ConnectionPriorityTCPLAN + 1
 	}
+
+	opts.BandwidthSchedules = validBandwidthSchedules(opts.BandwidthSchedules, "overall")
+	opts.Webhooks = validWebhooks(opts.Webhooks)
 }
 
 // RequiresRestartOnly returns a copy with only the attributes that require
@@ -89,6 +89,27 @@ type OptionsConfiguration struct {
 	ConnectionPriorityQUICWAN          int  `protobuf:"varint,57,opt,name=connection_priority_quic_wan,json=connectionPriorityQuicWan,proto3,casttype=int" json:"connectionPriorityQuicWan" xml:"connectionPriorityQuicWan" default:"40"`
 	ConnectionPriorityRelay            int  `protobuf:"varint,58,opt,name=connection_priority_relay,json=connectionPriorityRelay,proto3,casttype=int" json:"connectionPriorityRelay" xml:"connectionPriorityRelay" default:"50"`
 	ConnectionPriorityUpgradeThreshold int  `protobuf:"varint,59,opt,name=connection_priority_upgrade_threshold,json=connectionPriorityUpgradeThreshold,proto3,casttype=int" json:"connectionPriorityUpgradeThreshold" xml:"connectionPriorityUpgradeThreshold" default:"0"`
+	// Schedules overriding max_send_kbps and max_recv_kbps during certain
+	// times. The first schedule active at any given time applies.
+	BandwidthSchedules []BandwidthSchedule `protobuf:"bytes,60,rep,name=bandwidth_schedules,json=bandwidthSchedules,proto3" json:"bandwidthSchedules" xml:"bandwidthSchedule"`
+	// The network is considered metered when the flag is set, when any of
+	// the named interfaces (glob patterns such as "usb*") is up, or when we
+	// have an address in any of the subnets. Devices and folders may then
+	// be set to pause syncing.
+	Metered           bool     `protobuf:"varint,61,opt,name=metered,proto3" json:"metered" xml:"metered"`
+	MeteredInterfaces []string `protobuf:"bytes,62,rep,name=metered_interfaces,json=meteredInterfaces,proto3" json:"meteredInterfaces" xml:"meteredInterface"`
+	MeteredSubnets    []string `protobuf:"bytes,63,rep,name=metered_subnets,json=meteredSubnets,proto3" json:"meteredSubnets" xml:"meteredSubnet"`
+	// The database used for the index. An existing LevelDB index can be
+	// copied with "syncthing cli debug index migrate" before switching.
+	DatabaseBackend DatabaseBackend `protobuf:"varint,64,opt,name=database_backend,json=databaseBackend,proto3,enum=config.DatabaseBackend" json:"databaseBackend" xml:"databaseBackend" restart:"true"`
+	// Webhooks posting events to other services.
+	Webhooks []Webhook `protobuf:"bytes,65,rep,name=webhooks,proto3" json:"webhooks" xml:"webhook"`
+	// The audit trail records who changed what through the GUI and REST
+	// API, in hash chained files of at most the given size. The given
+	// number of rotated files are kept, or all of them when zero.
+	AuditTrailEnabled    bool `protobuf:"varint,66,opt,name=audit_trail_enabled,json=auditTrailEnabled,proto3" json:"auditTrailEnabled" xml:"auditTrailEnabled"`
+	AuditTrailMaxSizeMiB int  `protobuf:"varint,67,opt,name=audit_trail_max_size_mib,json=auditTrailMaxSizeMib,proto3,casttype=int" json:"auditTrailMaxSizeMiB" xml:"auditTrailMaxSizeMiB" default:"10"`
+	AuditTrailMaxFiles   int  `protobuf:"varint,68,opt,name=audit_trail_max_files,json=auditTrailMaxFiles,proto3,casttype=int" json:"auditTrailMaxFiles" xml:"auditTrailMaxFiles"`
 	// Legacy deprecated
 	DeprecatedUPnPEnabled        bool     `protobuf:"varint,9000,opt,name=upnp_enabled,json=upnpEnabled,proto3" json:"-" xml:"upnpEnabled,omitempty"`                                    // Deprecated: Do not use.
 	DeprecatedUPnPLeaseM         int      `protobuf:"varint,9001,opt,name=upnp_lease_m,json=upnpLeaseM,proto3,casttype=int" json:"-" xml:"upnpLeaseMinutes,omitempty"`                   // Deprecated: Do not use.
@@ -141,228 +162,251 @@ func init() {
 }
 
 var fileDescriptor_d09882599506ca03 = []byte{
-	// 3521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x5d, 0x6c, 0x1d, 0x47,
-	0x15, 0xce, 0x26, 0x4d, 0xda, 0x6c, 0x1c, 0x27, 0x1e, 0x3b, 0xf6, 0x26, 0x4e, 0xbd, 0xee, 0xcd,
-	0x4d, 0xeb, 0xb6, 0x69, 0x62, 0x3b, 0x3f, 0x4d, 0x8d, 0x50, 0xf1, 0x4f, 0x4d, 0xdd, 0xd8, 0x8e,
-	0x3b, 0xb6, 0x6b, 0x54, 0x84, 0x56, 0xe3, 0xbd, 0x73, 0xed, 0xad, 0xf7, 0xee, 0xde, 0xec, 0xce,
-	0xfa, 0xa7, 0x45, 0x50, 0x15, 0x41, 0x79, 0xa3, 0x5c, 0x15, 0x90, 0x40, 0xaa, 0x8a, 0x00, 0x89,
-	0x52, 0x8a, 0x90, 0x90, 0x90, 0x40, 0x42, 0x54, 0x48, 0x48, 0x15, 0x3c, 0xf8, 0x3e, 0x21, 0x24,
-	0xca, 0xa2, 0x3a, 0x7d, 0xba, 0x0f, 0x3c, 0xdc, 0x47, 0xf3, 0x82, 0x66, 0xf6, 0x6f, 0x76, 0x77,
-	0xd6, 0xce, 0xdb, 0xdd, 0xf3, 0x9d, 0x39, 0x73, 0xbe, 0xf9, 0x39, 0x73, 0xce, 0xcc, 0x95, 0x2f,
-	0x9b, 0xc6, 0xea, 0x35, 0xdd, 0xb6, 0xaa, 0xc6, 0xda, 0x35, 0xbb, 0x4e, 0x0c, 0xdb, 0x72, 0x83,
-	0x2f, 0xcf, 0x41, 0xf4, 0xeb, 0x6a, 0xdd, 0xb1, 0x89, 0x0d, 0x4e, 0x04, 0xc2, 0x0b, 0x7d, 0x9c,
-	0x3a, 0xf1, 0x2c, 0xc3, 0x5a, 0x0b, 0x14, 0x2e, 0x9c, 0xe3, 0x00, 0xd7, 0x78, 0x1d, 0x87, 0xe2,
-	0x93, 0x78, 0x9b, 0x04, 0x3f, 0x4b, 0xef, 0x2d, 0xca, 0x3d, 0x77, 0x83, 0x1e, 0x26, 0xf9, 0x1e,
-	0xc0, 0x7b, 0x92, 0x7c, 0xd6, 0x34, 0x5c, 0x82, 0x2d, 0x0d, 0x55, 0x2a, 0x0e, 0x76, 0x5d, 0xec,
-	0x2a, 0xd2, 0xe0, 0xb1, 0xa1, 0x93, 0x13, 0xee, 0x9e, 0xaf, 0x02, 0x88, 0xb6, 0x66, 0x19, 0x3c,
-	0x1e, 0xa1, 0x2d, 0x5f, 0x3d, 0x63, 0xa6, 0x45, 0x6d, 0x5f, 0xbd, 0xbc, 0x5d, 0x33, 0xc7, 0x4a,
-	0x29, 0x79, 0x69, 0xb0, 0x82, 0xab, 0xc8, 0x33, 0xc9, 0x58, 0x29, 0xfc, 0x51, 0xda, 0xdf, 0x2d,
-	0x3f, 0x1c, 0xfe, 0x6e, 0x34, 0xcb, 0x02, 0xe3, 0x30, 0x6b, 0x1a, 0xfc, 0x57, 0x92, 0x95, 0x35,
-	0xd3, 0x5e, 0x45, 0xa6, 0x56, 0x31, 0x5c, 0xdd, 0xde, 0xc4, 0xce, 0x8e, 0xe6, 0x62, 0x67, 0x13,
-	0x3b, 0xae, 0x72, 0x94, 0x39, 0xfa, 0x3b, 0x69, 0xcf, 0x57, 0xbb, 0x21, 0xda, 0xfa, 0x32, 0xd3,
-	0x1b, 0xb7, 0xac, 0xc5, 0x00, 0x6f, 0xf9, 0xea, 0xb9, 0xb5, 0x48, 0x66, 0x7b, 0x96, 0x8e, 0x43,
-	0xa0, 0xed, 0xab, 0x57, 0x98, 0xc3, 0x22, 0x54, 0xe0, 0x77, 0x6b, 0xb7, 0xdc, 0x23, 0x52, 0x6d,
-	0xef, 0x96, 0xc5, 0x1d, 0xa4, 0x89, 0x8a, 0x7c, 0x83, 0xbd, 0x41, 0xc3, 0xa9, 0x88, 0x54, 0x28,
-	0x07, 0x9f, 0x8b, 0x08, 0x63, 0x0b, 0xad, 0x9a, 0xb8, 0xa2, 0x1c, 0x1b, 0x94, 0x86, 0x1e, 0x99,
-	0xf8, 0x80, 0x12, 0x3e, 0x1b, 0x5b, 0x7c, 0x21, 0x00, 0xf3, 0x6c, 0x43, 0xa0, 0xed, 0xab, 0x4f,
-	0x09, 0xd8, 0x86, 0x28, 0x47, 0x97, 0x38, 0x1e, 0xa6, 0x5c, 0x0b, 0xcc, 0x14, 0x01, 0xfb, 0xbb,
-	0xe5, 0x87, 0x68, 0xd3, 0x46, 0xb3, 0x9c, 0x73, 0x2a, 0x47, 0x33, 0x94, 0x83, 0x4f, 0x25, 0xb9,
-	0xcf, 0xb4, 0x75, 0x21, 0xcb, 0x87, 0x18, 0xcb, 0x9f, 0x51, 0x96, 0x67, 0x66, 0xa9, 0x4e, 0x8a,
-	0x64, 0x8f, 0x19, 0x8a, 0x32, 0x1c, 0x9f, 0x0c, 0x96, 0xa0, 0x00, 0x14, 0x50, 0x14, 0x1b, 0x29,
-	0x90, 0x73, 0x04, 0xb3, 0xfe, 0xc0, 0x73, 0xac, 0x41, 0x8e, 0xde, 0xdf, 0x25, 0xb9, 0x3b, 0xa0,
-	0x87, 0x42, 0x5b, 0x5a, 0xdd, 0x76, 0x88, 0x72, 0x7c, 0x50, 0x1a, 0x3a, 0x3e, 0xf1, 0x63, 0x4a,
-	0xad, 0x23, 0x32, 0xb5, 0x60, 0x3b, 0xa4, 0xe5, 0xab, 0x5d, 0xa9, 0xae, 0xa9, 0xb0, 0xed, 0xab,
-	0x4f, 0xe4, 0x49, 0x51, 0x84, 0x63, 0x34, 0x3a, 0x32, 0x3c, 0xfa, 0x6c, 0x69, 0xdf, 0x57, 0x8f,
-	0x19, 0x16, 0x69, 0xed, 0x96, 0x05, 0x66, 0x44, 0xc2, 0xfd, 0xdd, 0xf2, 0x71, 0xd6, 0xb4, 0xd1,
-	0x2c, 0xa7, 0x3c, 0x81, 0x79, 0x5d, 0xf0, 0xad, 0xa3, 0xf2, 0x60, 0x86, 0x4d, 0xcd, 0x33, 0x89,
-	0xa1, 0x23, 0x97, 0x44, 0x71, 0x43, 0x39, 0x31, 0x28, 0x0d, 0x9d, 0x9c, 0xf8, 0x03, 0xa5, 0xd6,
-	0x19, 0x19, 0x9c, 0x9b, 0xa4, 0x3b, 0xb9, 0xe5, 0xab, 0xdd, 0x29, 0xa3, 0x81, 0xb8, 0xed, 0xab,
-	0xb7, 0xf2, 0xf4, 0x02, 0x8c, 0x23, 0xf8, 0xd5, 0x6a, 0x75, 0x64, 0x74, 0x6c, 0xec, 0xf6, 0xf5,
-	0xdb, 0x37, 0xbe, 0x36, 0x16, 0xb0, 0x6d, 0xed, 0x96, 0x85, 0x06, 0xc5, 0xe2, 0xfd, 0xdd, 0x32,
-	0xc8, 0x1b, 0x69, 0x34, 0xcb, 0x19, 0x37, 0xe1, 0xa3, 0xe9, 0xc6, 0x11, 0xc3, 0x30, 0x18, 0x81,
-	0xbb, 0xf2, 0xe9, 0x1a, 0xda, 0xd6, 0x5c, 0x6c, 0x55, 0xb4, 0x8d, 0xd5, 0xba, 0xab, 0x3c, 0xcc,
-	0x26, 0xf3, 0xe9, 0x96, 0xaf, 0x9e, 0xaa, 0xa1, 0xed, 0x45, 0x6c, 0x55, 0xee, 0xac, 0xd6, 0x69,
-	0x70, 0xe9, 0x62, 0xb4, 0x38, 0x59, 0x34, 0x3f, 0x90, 0x57, 0x8c, 0x0c, 0x3a, 0x58, 0xdf, 0x0c,
-	0x0c, 0x3e, 0x92, 0x32, 0x08, 0xb1, 0xbe, 0x99, 0x35, 0x18, 0xc9, 0x52, 0x06, 0x23, 0x21, 0xf8,
-	0xbd, 0x24, 0xf7, 0x39, 0x58, 0xb7, 0x2d, 0x0b, 0xeb, 0x34, 0xbc, 0x6b, 0x86, 0x45, 0xb0, 0xb3,
-	0x89, 0x4c, 0xcd, 0x55, 0x4e, 0x32, 0xdb, 0xdf, 0x60, 0x41, 0x3d, 0x52, 0x99, 0x09, 0xe1, 0x45,
-	0x1a, 0x3b, 0xf8, 0x86, 0x31, 0xd0, 0xf6, 0xd5, 0x21, 0xd6, 0xb7, 0x10, 0xe5, 0x66, 0xe9, 0xd6,
-	0x70, 0xe4, 0xd2, 0xfe, 0x6e, 0xf9, 0xe8, 0xad, 0x61, 0x16, 0xdf, 0x73, 0xfd, 0x40, 0x71, 0x2f,
-	0xa0, 0x2a, 0x77, 0x3a, 0xd8, 0x44, 0x3b, 0x6e, 0x1c, 0x03, 0x64, 0x16, 0x03, 0x9e, 0x6f, 0xf9,
-	0xea, 0xe9, 0x00, 0x49, 0x36, 0x7a, 0x29, 0x74, 0x88, 0x93, 0x66, 0x77, 0x78, 0xb4, 0x63, 0x61,
-	0xba, 0x31, 0x78, 0xeb, 0xa8, 0xdc, 0x1f, 0x76, 0x14, 0x3b, 0x92, 0x0c, 0x52, 0x4d, 0x39, 0xc5,
-	0x06, 0xe9, 0x2f, 0x74, 0x0d, 0xf7, 0x41, 0xaa, 0x97, 0xa3, 0x30, 0xd7, 0xf2, 0xd5, 0x3e, 0x47,
-	0x0c, 0xc5, 0x81, 0xb6, 0x00, 0xe7, 0xbc, 0x1c, 0x19, 0xe6, 0xb6, 0x6c, 0xa1, 0xbd, 0x62, 0x88,
-	0x0e, 0xf2, 0x08, 0x1d, 0xe4, 0x22, 0x37, 0xa1, 0x12, 0xf0, 0xcc, 0x23, 0x60, 0x55, 0x3e, 0xed,
-	0x12, 0xe4, 0x10, 0x6d, 0xd5, 0xb1, 0xb7, 0x5c, 0xec, 0x28, 0x1d, 0x6c, 0xac, 0xbf, 0xd8, 0xf2,
-	0xd5, 0x0e, 0x06, 0x4c, 0x04, 0xf2, 0xb6, 0xaf, 0x3e, 0xc6, 0xe8, 0xf0, 0xc2, 0xc2, 0x91, 0x4e,
-	0x35, 0x05, 0xbf, 0x90, 0xe4, 0x73, 0x16, 0x22, 0x1a, 0x71, 0x10, 0x3d, 0xd5, 0x90, 0x19, 0x4f,
-	0x6c, 0x27, 0xeb, 0xec, 0xde, 0x9e, 0xaf, 0xca, 0xf3, 0xe3, 0x4b, 0x49, 0x58, 0x97, 0x2d, 0x44,
-	0x92, 0x39, 0x56, 0x59, 0xc7, 0x89, 0x48, 0x10, 0xc2, 0xf9, 0x06, 0xa9, 0x2f, 0x2e, 0x5c, 0x73,
-	0x5d, 0xc0, 0x6e, 0x0b, 0x91, 0xa5, 0xc8, 0x9d, 0x68, 0x41, 0xfc, 0x31, 0xe7, 0xa7, 0x89, 0x91,
-	0x8b, 0xb5, 0x9a, 0x72, 0x86, 0x2d, 0x85, 0xef, 0xd0, 0xa5, 0x70, 0x72, 0x7e, 0x7c, 0x69, 0x96,
-	0x8a, 0xe9, 0xe4, 0x9f, 0xb1, 0x10, 0x09, 0x3e, 0x0c, 0xcb, 0x23, 0x2c, 0xf9, 0x29, 0x45, 0xce,
-	0xf2, 0x72, 0xe1, 0xde, 0x68, 0xed, 0x96, 0x73, 0xed, 0xf3, 0xa2, 0x78, 0x07, 0x25, 0x1d, 0x43,
-	0xc0, 0x7b, 0x1f, 0xc8, 0xc0, 0xdf, 0x24, 0xb9, 0x2f, 0xed, 0xbc, 0x83, 0x2d, 0xbc, 0xc5, 0x56,
-	0xf2, 0x59, 0xe6, 0x7e, 0x83, 0xba, 0x7f, 0x6a, 0x7e, 0x7c, 0x09, 0x06, 0x00, 0x25, 0xd0, 0x65,
-	0x21, 0x12, 0x7d, 0xc6, 0x14, 0xca, 0x11, 0x85, 0x34, 0xc2, 0x91, 0xb8, 0xce, 0x93, 0x10, 0xd8,
-	0x10, 0x09, 0x29, 0x91, 0xeb, 0x94, 0x08, 0xef, 0x02, 0xec, 0xe1, 0xa9, 0x44, 0x52, 0x01, 0x19,
-	0x62, 0xd4, 0xb0, 0xed, 0x11, 0xcd, 0x55, 0xba, 0xd2, 0x64, 0x96, 0x02, 0x60, 0x31, 0x24, 0x13,
-	0x7d, 0xd2, 0x95, 0x5e, 0x49, 0x91, 0x49, 0x23, 0x45, 0xdb, 0x4f, 0x60, 0x43, 0x24, 0x8c, 0xb7,
-	0x1c, 0xef, 0x42, 0x9a, 0x4c, 0x24, 0x05, 0x3f, 0x91, 0x64, 0xc5, 0x73, 0xd1, 0x1a, 0xd6, 0x1c,
-	0x4c, 0xcf, 0x7d, 0xc3, 0x5a, 0xd3, 0x90, 0xae, 0xe3, 0x3a, 0xc1, 0x15, 0x05, 0x30, 0x36, 0x88,
-	0xee, 0x80, 0x65, 0x38, 0x1e, 0x4a, 0xe9, 0x0e, 0xf0, 0x9c, 0xe8, 0xab, 0xed, 0xab, 0x67, 0x19,
-	0x89, 0x44, 0xc4, 0x39, 0xcc, 0x2b, 0xa6, 0xbe, 0xe8, 0x8a, 0x4f, 0x4c, 0xc2, 0x5e, 0xe6, 0x02,
-	0x8c, 0x3c, 0x88, 0xe4, 0xe0, 0x0d, 0xb9, 0x27, 0xeb, 0x9c, 0x8b, 0xb1, 0xa5, 0x74, 0x33, 0xc7,
-	0x66, 0xf6, 0x7c, 0xf5, 0xc4, 0x32, 0x5c, 0xc4, 0xd8, 0x6a, 0xf9, 0xea, 0x09, 0xcf, 0xa1, 0xbf,
-	0xda, 0xbe, 0xda, 0x11, 0x3a, 0x44, 0x3f, 0x39, 0x67, 0x22, 0x85, 0xf8, 0x57, 0xa3, 0x59, 0x0e,
-	0x9b, 0x43, 0x90, 0x76, 0x80, 0xca, 0xc0, 0x0f, 0x24, 0xf9, 0x7c, 0xb6, 0x77, 0xcf, 0x32, 0xee,
-	0x79, 0x58, 0x33, 0x2a, 0x4a, 0x0f, 0x4b, 0x22, 0x5e, 0x0d, 0xc6, 0x66, 0x99, 0x89, 0x67, 0xa6,
-	0x82, 0xb1, 0x09, 0xbf, 0xf8, 0xb1, 0x89, 0x14, 0x4a, 0xc1, 0xa0, 0x44, 0x9f, 0x6d, 0xfe, 0x2b,
-	0x1c, 0x94, 0x08, 0xcb, 0x0e, 0x4a, 0xa4, 0x05, 0x3e, 0x96, 0xe4, 0xee, 0x9c, 0x5f, 0x8e, 0xa9,
-	0x9c, 0x63, 0x1e, 0x7d, 0x8f, 0xae, 0xbd, 0xe3, 0xcb, 0x70, 0x19, 0xce, 0xb6, 0x7c, 0xf5, 0xb8,
-	0xe7, 0x2c, 0xc3, 0xd9, 0xb6, 0xaf, 0xde, 0x8e, 0x1c, 0x81, 0xb3, 0xdc, 0xea, 0x5a, 0x27, 0xa4,
-	0xee, 0x8e, 0x5d, 0xbb, 0x56, 0x41, 0x04, 0x5d, 0x75, 0x77, 0x2c, 0x9d, 0xac, 0xd3, 0x62, 0xcd,
-	0xc2, 0xe4, 0x9a, 0x85, 0xb7, 0xa8, 0x94, 0x3a, 0x1c, 0x1a, 0x89, 0x7e, 0xec, 0xef, 0x96, 0x1f,
-	0xa0, 0x61, 0xa3, 0x59, 0x0e, 0xbc, 0x80, 0x5d, 0x19, 0x1e, 0x8e, 0x09, 0xfe, 0x23, 0xc9, 0x6a,
-	0x96, 0x42, 0xdd, 0x76, 0xe9, 0x09, 0xe7, 0x62, 0xdd, 0x73, 0xb0, 0xb9, 0xa3, 0xf4, 0xb2, 0xf0,
-	0xfb, 0x23, 0x56, 0x41, 0x2c, 0xc3, 0x05, 0xdb, 0x25, 0x33, 0x31, 0xd8, 0xf2, 0xd5, 0xb3, 0x9e,
-	0x93, 0x96, 0xb5, 0x7d, 0xf5, 0xf1, 0x90, 0x64, 0x1a, 0xe0, 0xf8, 0x56, 0x91, 0xe9, 0xb2, 0x90,
-	0x9c, 0x6f, 0x2d, 0x90, 0xd1, 0xcc, 0x93, 0xb5, 0xa0, 0xf5, 0x42, 0xd6, 0x05, 0x78, 0x31, 0x4d,
-	0x2b, 0x8d, 0x82, 0x7f, 0x0b, 0x18, 0x1a, 0x96, 0x41, 0x0c, 0x5a, 0x47, 0xd0, 0xf3, 0x4e, 0x73,
-	0x95, 0x3e, 0xb6, 0x8a, 0x7f, 0xc8, 0xaa, 0x87, 0x65, 0x38, 0x13, 0xa0, 0x53, 0x14, 0xa4, 0x01,
-	0xe3, 0x8c, 0xe7, 0xa4, 0x44, 0x71, 0xb8, 0xc8, 0xc8, 0xf9, 0x60, 0x71, 0x7b, 0x38, 0x15, 0xc0,
-	0xb3, 0x16, 0xf2, 0x22, 0x7a, 0x02, 0xd1, 0x56, 0xb4, 0x60, 0xc8, 0xb8, 0x00, 0xfb, 0xd3, 0x04,
-	0x53, 0x20, 0x78, 0x5b, 0x92, 0xfb, 0x90, 0x47, 0x6c, 0xcd, 0xab, 0xaf, 0x39, 0xa8, 0x82, 0x93,
-	0xdc, 0x64, 0x5d, 0x39, 0xcf, 0x78, 0x2d, 0xd0, 0x0a, 0x88, 0xaa, 0x2c, 0x07, 0x1a, 0xd1, 0xb1,
-	0xfe, 0x62, 0x5c, 0x2c, 0x88, 0x40, 0x9e, 0xcd, 0x28, 0x9f, 0xa8, 0x8d, 0x8c, 0x42, 0xa1, 0x35,
-	0x50, 0x93, 0xfb, 0x22, 0x1f, 0x88, 0xad, 0xd5, 0x1d, 0x3a, 0xe2, 0xec, 0x68, 0x74, 0x95, 0x0b,
-	0x6c, 0x09, 0xdd, 0xa2, 0x8e, 0x84, 0x2a, 0x4b, 0xf6, 0x82, 0x83, 0x61, 0x88, 0xb7, 0x7d, 0xf5,
-	0x42, 0x30, 0xa2, 0x02, 0xb0, 0x04, 0x85, 0x6d, 0xc0, 0xa6, 0x0c, 0x36, 0x30, 0xae, 0x6b, 0x04,
-	0xd7, 0xea, 0xb6, 0x83, 0x1c, 0x03, 0xbb, 0xda, 0xba, 0xd2, 0xcf, 0x28, 0xbf, 0x48, 0xd7, 0x25,
-	0x45, 0x97, 0x12, 0x90, 0xd2, 0xbd, 0xc4, 0x7a, 0xc9, 0x02, 0x7c, 0x69, 0x74, 0x83, 0xa7, 0x3a,
-	0x7a, 0x03, 0xe6, 0xac, 0x80, 0x1d, 0xb9, 0x5b, 0x47, 0xfa, 0x3a, 0xd6, 0x8c, 0x35, 0xcb, 0x76,
-	0x70, 0x45, 0xab, 0x1a, 0x26, 0x76, 0x95, 0x8b, 0x8c, 0xe2, 0x0c, 0x3d, 0x60, 0x18, 0x3c, 0x13,
-	0xa0, 0xd3, 0x14, 0x8c, 0x07, 0x3a, 0x87, 0xe4, 0xb6, 0x44, 0xbc, 0xd4, 0x61, 0xde, 0x0c, 0xf8,
-	0xbe, 0x24, 0x5f, 0xa8, 0x3b, 0xf6, 0x1a, 0xad, 0x2d, 0x34, 0xaf, 0x5e, 0x41, 0x04, 0xf3, 0xf9,
-	0xfa, 0xa3, 0x8c, 0xfb, 0x12, 0x4d, 0x37, 0x23, 0xad, 0x65, 0xa6, 0xc4, 0xe7, 0xe6, 0x41, 0xcd,
-	0x5b, 0x80, 0x73, 0xee, 0xdc, 0xe4, 0x06, 0x42, 0xba, 0x09, 0x8b, 0x2c, 0x82, 0xb7, 0x24, 0xb9,
-	0xd7, 0x34, 0x6a, 0x06, 0xd1, 0x56, 0x91, 0x55, 0xd9, 0x32, 0x2a, 0x64, 0x5d, 0x33, 0x2c, 0xcd,
-	0x44, 0x96, 0x32, 0xc0, 0x86, 0x64, 0x8e, 0xd5, 0x72, 0x54, 0x63, 0x22, 0x52, 0x98, 0xb1, 0x66,
-	0x91, 0x95, 0xd4, 0xdf, 0x79, 0xec, 0x80, 0x61, 0x11, 0x99, 0x02, 0x6f, 0x4a, 0x32, 0xa8, 0x19,
-	0x96, 0xb6, 0x6e, 0xd7, 0xb0, 0x56, 0x31, 0xdc, 0x0d, 0xad, 0xea, 0x60, 0xac, 0xa8, 0x83, 0xd2,
-	0xd0, 0xa9, 0xd1, 0x8e, 0xab, 0xc1, 0x45, 0xd7, 0xd5, 0x45, 0xe3, 0x75, 0x3c, 0xf1, 0xc2, 0x27,
-	0xbe, 0x7a, 0x84, 0xee, 0xea, 0x9a, 0x61, 0xbd, 0x68, 0xd7, 0xf0, 0x94, 0xe1, 0x6e, 0x4c, 0x3b,
-	0x18, 0xc7, 0xab, 0x23, 0x23, 0xe7, 0xf7, 0xc1, 0xe0, 0x65, 0xea, 0xc8, 0xb1, 0x91, 0xc1, 0xcb,
-	0x30, 0xdb, 0x1c, 0xdc, 0x97, 0xe4, 0x8e, 0x68, 0xbd, 0xb3, 0x53, 0x60, 0x90, 0x9d, 0x02, 0x7f,
-	0x66, 0x19, 0x48, 0xb4, 0x68, 0x83, 0xb3, 0xe0, 0x94, 0x93, 0x7c, 0xb6, 0x7d, 0x75, 0x2a, 0x2a,
-	0x00, 0x22, 0x99, 0xe0, 0x5c, 0x08, 0x77, 0x80, 0x9b, 0x09, 0xf1, 0x35, 0x4c, 0xd0, 0xd5, 0xd7,
-	0x5c, 0xdb, 0xa2, 0xa1, 0x34, 0x65, 0x36, 0xfd, 0xb9, 0xbf, 0x5b, 0x1e, 0x7a, 0x50, 0x53, 0x34,
-	0x5d, 0xe1, 0xfc, 0x85, 0x89, 0x1d, 0xc7, 0x04, 0x2b, 0x72, 0x17, 0x32, 0xb7, 0x68, 0x31, 0x14,
-	0x14, 0xf7, 0x16, 0x26, 0xae, 0xf2, 0x18, 0xbb, 0x53, 0xa3, 0x35, 0xe8, 0x99, 0x00, 0x64, 0x45,
-	0xf2, 0x3c, 0x26, 0x74, 0xe1, 0xf7, 0x04, 0x11, 0x26, 0x25, 0x2f, 0xc1, 0xac, 0x22, 0xf8, 0x9f,
-	0x24, 0x0f, 0xd9, 0x9b, 0xd8, 0xd9, 0x72, 0x0c, 0x42, 0x03, 0x47, 0xcd, 0x26, 0x58, 0xab, 0xe0,
-	0x4d, 0x43, 0xc7, 0x9a, 0x85, 0x6a, 0xd8, 0xd5, 0x6c, 0x4b, 0x0b, 0xeb, 0x12, 0xa5, 0x94, 0xdc,
-	0xf6, 0xf4, 0xdd, 0x8d, 0x1a, 0x41, 0xd6, 0x66, 0x0a, 0x6f, 0xce, 0x53, 0xf5, 0x96, 0xaf, 0x5e,
-	0xb2, 0x73, 0x90, 0xa1, 0x63, 0x86, 0xde, 0xb5, 0x26, 0x03, 0x53, 0x6d, 0x5f, 0x7d, 0x8e, 0x39,
-	0xf8, 0x00, 0xba, 0xc5, 0x8b, 0x92, 0x16, 0x55, 0x05, 0x7e, 0xc0, 0x07, 0xf1, 0x02, 0x7c, 0x53,
-	0x3e, 0x47, 0xc3, 0x98, 0x66, 0x58, 0x15, 0xbc, 0xad, 0xd1, 0x95, 0xbc, 0x6a, 0xda, 0xfa, 0x86,
-	0xab, 0x5c, 0x62, 0x5b, 0x9a, 0x2e, 0x1a, 0x40, 0x15, 0x66, 0x28, 0x3e, 0x67, 0x58, 0x13, 0x0c,
-	0x8d, 0x2f, 0x51, 0xf3, 0x90, 0x30, 0x71, 0x0d, 0xd2, 0x51, 0x28, 0xb0, 0x04, 0xfe, 0x45, 0xb3,
-	0x4f, 0x0b, 0xe9, 0x1b, 0xb8, 0xa2, 0x59, 0x36, 0x31, 0xaa, 0x86, 0x8e, 0x82, 0xeb, 0x80, 0x8a,
-	0xab, 0x94, 0xd9, 0xfc, 0xbe, 0x4f, 0x87, 0xbb, 0x77, 0x39, 0x50, 0x9a, 0xe7, 0x74, 0x66, 0xa6,
-	0xe8, 0x68, 0xf7, 0x7a, 0x42, 0xa4, 0xed, 0xab, 0xfd, 0x41, 0x68, 0x17, 0xc1, 0xec, 0xea, 0x50,
-	0x88, 0xb4, 0x77, 0xcb, 0x05, 0x16, 0x1b, 0xcd, 0x72, 0x81, 0x17, 0x50, 0xd8, 0xa2, 0xe2, 0x02,
-	0x28, 0x9f, 0x26, 0x0e, 0xaa, 0x56, 0x0d, 0x5d, 0xd3, 0x4d, 0xe4, 0xba, 0xca, 0x65, 0x36, 0xac,
-	0xcf, 0xd0, 0xf2, 0x35, 0x04, 0x26, 0xa9, 0xbc, 0xed, 0xab, 0x20, 0x18, 0x50, 0x4e, 0x18, 0xdf,
-	0x9b, 0xa4, 0x54, 0xc1, 0x1b, 0x72, 0x77, 0x38, 0xc4, 0x5a, 0xd5, 0x36, 0x2b, 0xd8, 0xd1, 0xea,
-	0x88, 0xac, 0x2b, 0x8f, 0xb3, 0x5d, 0x7f, 0x67, 0xcf, 0x57, 0xfb, 0xa7, 0x70, 0xdd, 0xc1, 0x3a,
-	0x22, 0xb8, 0x32, 0x15, 0x28, 0x4e, 0x33, 0xbd, 0x05, 0x44, 0xd6, 0x5b, 0xbe, 0x2a, 0x3d, 0x13,
-	0x17, 0xcb, 0x95, 0x2c, 0x7c, 0xc5, 0xae, 0x19, 0x74, 0x92, 0xc8, 0x4e, 0x49, 0x91, 0x60, 0x57,
-	0x0e, 0x07, 0x1b, 0xf2, 0x59, 0x17, 0x13, 0xcd, 0xb4, 0xb7, 0xb4, 0xba, 0x63, 0xd8, 0x8e, 0x41,
-	0x76, 0x94, 0x27, 0xd8, 0xa6, 0x18, 0x6f, 0xf9, 0x6a, 0xa7, 0x8b, 0xc9, 0xac, 0xbd, 0xb5, 0x10,
-	0x22, 0x71, 0x64, 0x4b, 0x8b, 0x0b, 0xcb, 0xf2, 0x4c, 0x73, 0xf0, 0x81, 0x24, 0xf7, 0xd6, 0xd0,
-	0x76, 0x44, 0x53, 0xb7, 0x2d, 0xdd, 0x73, 0x1c, 0x6c, 0xe9, 0x3b, 0xca, 0x10, 0x1b, 0x47, 0x97,
-	0xdd, 0x7d, 0xa0, 0xad, 0x39, 0xb4, 0x1d, 0xf8, 0x38, 0x99, 0xa8, 0xd0, 0x23, 0xbf, 0x26, 0x90,
-	0xc7, 0x47, 0xbe, 0x08, 0x8c, 0x86, 0x9c, 0x5d, 0x56, 0x88, 0xed, 0x42, 0xa1, 0x55, 0xf0, 0xa9,
-	0x24, 0x77, 0xeb, 0x0e, 0x72, 0xd7, 0x33, 0x29, 0xf9, 0x93, 0x6c, 0x5a, 0x3e, 0x64, 0x29, 0xf9,
-	0x64, 0x94, 0x92, 0xeb, 0x61, 0x4a, 0x3e, 0x1d, 0x9c, 0xcd, 0xb4, 0x59, 0x92, 0x1c, 0x0b, 0xc3,
-	0x30, 0xd3, 0xc9, 0xa7, 0xd9, 0x4c, 0x4c, 0xd7, 0x72, 0x57, 0xce, 0x08, 0x4d, 0xd6, 0xf5, 0x30,
-	0x59, 0x2f, 0x3f, 0x88, 0x19, 0x9a, 0xae, 0x4f, 0x06, 0xe9, 0x7a, 0xc6, 0x98, 0x63, 0x82, 0x9f,
-	0x4a, 0x72, 0x5f, 0x96, 0x5e, 0x74, 0x4b, 0xf2, 0x14, 0x9b, 0x7f, 0x63, 0xcf, 0x57, 0x4f, 0x4e,
-	0x42, 0xee, 0x82, 0x3f, 0x6d, 0x25, 0x7b, 0xc1, 0x2f, 0x44, 0x8b, 0x96, 0x46, 0xa3, 0x59, 0x4e,
-	0x6c, 0x43, 0xb1, 0x65, 0xf0, 0x6d, 0x49, 0xee, 0x75, 0x89, 0x67, 0x69, 0x34, 0x73, 0x42, 0xa6,
-	0xb1, 0x89, 0xb5, 0xe0, 0xee, 0xc8, 0x55, 0x9e, 0x8e, 0xf3, 0xd1, 0x6e, 0xaa, 0x71, 0x27, 0x52,
-	0x58, 0xa4, 0xf8, 0x62, 0x9c, 0x25, 0x09, 0xb0, 0x74, 0x6e, 0xcd, 0x05, 0xb4, 0x63, 0x23, 0xb7,
-	0x87, 0xa1, 0xc8, 0x1a, 0x2d, 0x59, 0x33, 0x6e, 0xd0, 0xb8, 0xea, 0x2a, 0x57, 0x98, 0x13, 0x2f,
-	0xd1, 0x44, 0x2d, 0xd5, 0x6c, 0xce, 0xb0, 0x92, 0xd4, 0x3e, 0x87, 0xf0, 0x39, 0x62, 0x2a, 0xa0,
-	0x8e, 0x0e, 0xc3, 0xbc, 0x1d, 0x9a, 0x95, 0x77, 0xb0, 0xde, 0xa3, 0x77, 0xa7, 0x67, 0x58, 0x0c,
-	0xad, 0xec, 0xf9, 0x6a, 0x27, 0x44, 0x5b, 0x8b, 0xc4, 0xe3, 0x5e, 0x9c, 0x4e, 0xb9, 0xc9, 0x67,
-	0x7c, 0x37, 0x94, 0xc8, 0x0e, 0x7d, 0x15, 0xcb, 0x58, 0x84, 0xbc, 0x3d, 0xb0, 0x29, 0x9f, 0xa1,
-	0x55, 0xe0, 0x2a, 0x72, 0xb1, 0x16, 0x3c, 0x01, 0x2a, 0x57, 0x07, 0xa5, 0xa1, 0xce, 0xd1, 0xce,
-	0x28, 0x2d, 0x5a, 0x62, 0x52, 0x76, 0x99, 0xd7, 0x19, 0xa9, 0x06, 0xb2, 0x38, 0x72, 0xa4, 0xc5,
-	0xa5, 0x41, 0x07, 0xb3, 0x29, 0x0d, 0x97, 0xc7, 0x9b, 0xcd, 0xb2, 0x04, 0x33, 0x4d, 0xc1, 0xbb,
-	0x47, 0xe5, 0x4b, 0x34, 0x6a, 0xc4, 0xe1, 0x82, 0xd6, 0x94, 0xba, 0x5d, 0xa3, 0x4b, 0xd6, 0xc1,
-	0xf7, 0x3c, 0xec, 0x12, 0x6d, 0xc3, 0x58, 0x55, 0xae, 0xb1, 0xe9, 0xf8, 0xab, 0x14, 0x3e, 0x1d,
-	0xce, 0xa1, 0xed, 0xc9, 0x19, 0x18, 0xe0, 0x77, 0x8c, 0x89, 0x96, 0xaf, 0xaa, 0x35, 0xb4, 0x1d,
-	0x6f, 0x71, 0x32, 0x13, 0xda, 0x48, 0x54, 0xe2, 0x53, 0xf0, 0x10, 0x3d, 0xae, 0x1e, 0x3b, 0xd4,
-	0xe4, 0xe1, 0x2a, 0xe1, 0x63, 0x64, 0xc6, 0x5d, 0x78, 0x48, 0xb3, 0x55, 0xf0, 0xb9, 0x24, 0xf7,
-	0xc6, 0x2f, 0x22, 0x26, 0xe2, 0xdf, 0x50, 0x87, 0xd9, 0x06, 0xfe, 0x88, 0x8e, 0x44, 0x4f, 0xf4,
-	0xa2, 0x30, 0x3b, 0x3e, 0xcf, 0x3f, 0xa3, 0xf6, 0x20, 0x81, 0x3c, 0x4e, 0xa4, 0x45, 0xa0, 0xe8,
-	0x21, 0x4b, 0x68, 0xa4, 0x40, 0xce, 0x6d, 0x7d, 0xa1, 0x53, 0x30, 0x69, 0x85, 0xb8, 0x37, 0xd8,
-	0x4d, 0xf9, 0x02, 0x7b, 0xf4, 0xa8, 0x7a, 0xa6, 0x19, 0x66, 0x35, 0xb6, 0x15, 0x95, 0xa8, 0xca,
-	0x08, 0x63, 0x3a, 0x46, 0xb3, 0x06, 0xaa, 0x35, 0xed, 0x99, 0x26, 0xcb, 0x47, 0xee, 0x5a, 0x61,
-	0x51, 0xd9, 0xf6, 0xd5, 0x8b, 0xe1, 0x91, 0x25, 0x82, 0x4b, 0xb0, 0xa0, 0x1d, 0x78, 0x49, 0x3e,
-	0x5d, 0xc5, 0x88, 0x78, 0x0e, 0xd6, 0xaa, 0x26, 0x5a, 0x73, 0x95, 0x51, 0xb6, 0xef, 0x2e, 0xd3,
-	0x93, 0x3e, 0x04, 0xa6, 0xa9, 0x3c, 0x7e, 0x20, 0xe1, 0x84, 0x25, 0x98, 0x52, 0x01, 0x5b, 0x72,
-	0x1f, 0xf7, 0x2e, 0x12, 0xd4, 0x38, 0xd8, 0xb2, 0xbd, 0xb5, 0x75, 0xe5, 0x3a, 0x5b, 0xb4, 0xcf,
-	0xb3, 0xf0, 0x1a, 0xab, 0xcc, 0x52, 0x8d, 0x17, 0x98, 0x42, 0x9c, 0xf5, 0x08, 0xd1, 0x38, 0xa3,
-	0x10, 0x37, 0x06, 0x1b, 0x72, 0x4f, 0xae, 0xe3, 0x1a, 0xda, 0x56, 0x6e, 0xb0, 0x5e, 0x9f, 0xa3,
-	0xc9, 0x60, 0xa6, 0xe1, 0x1c, 0xda, 0x6e, 0xfb, 0xaa, 0x22, 0xea, 0x72, 0x0e, 0x6d, 0xc7, 0xfd,
-	0x09, 0x9a, 0x81, 0xb7, 0x8f, 0xca, 0x6a, 0x74, 0xd9, 0xa3, 0x21, 0x93, 0xa6, 0x14, 0xb6, 0x59,
-	0xd1, 0x88, 0xe9, 0x6a, 0x34, 0x7e, 0x18, 0xb6, 0xe5, 0x2a, 0x37, 0xd9, 0x7c, 0x7d, 0x4c, 0x57,
-	0x66, 0x7f, 0x74, 0xb5, 0x32, 0x4e, 0x55, 0xef, 0x9a, 0x95, 0xa5, 0xd9, 0xc5, 0x57, 0x42, 0xbd,
-	0x96, 0xaf, 0xf6, 0x1b, 0xc5, 0x70, 0x9c, 0xef, 0x1c, 0xa0, 0x43, 0xd7, 0xe7, 0x81, 0x36, 0x0e,
-	0x86, 0x1b, 0xcd, 0xf2, 0x41, 0x0e, 0xc2, 0x7c, 0x5b, 0xd3, 0x8d, 0x40, 0xd0, 0x94, 0xe4, 0x7e,
-	0x6e, 0xdc, 0xa3, 0xc4, 0x4a, 0x23, 0x7a, 0x9d, 0x95, 0xb3, 0xb7, 0xd8, 0xf0, 0xbf, 0x43, 0x47,
-	0x41, 0x99, 0x8c, 0xf5, 0xa2, 0x34, 0x69, 0x69, 0x72, 0x61, 0x76, 0x7c, 0xbe, 0xe5, 0xab, 0x8a,
-	0x9e, 0xc7, 0xf4, 0x7a, 0x50, 0xf0, 0x3e, 0x9d, 0x99, 0xa1, 0xb4, 0xc2, 0x01, 0x49, 0x7b, 0xa3,
-	0x59, 0x2e, 0xec, 0x13, 0x16, 0xf6, 0x08, 0xfe, 0x21, 0xc9, 0x17, 0x45, 0x94, 0xee, 0x79, 0x86,
-	0xce, 0x38, 0x3d, 0xcb, 0x38, 0xbd, 0x4b, 0x39, 0x9d, 0xcf, 0xdb, 0x7f, 0x79, 0x79, 0x66, 0x32,
-	0x20, 0x75, 0x3e, 0xdf, 0xc5, 0xcb, 0x9e, 0xa1, 0x07, 0xac, 0xae, 0x14, 0xb0, 0x0a, 0x35, 0x0e,
-	0x38, 0x3a, 0x1b, 0xcd, 0x72, 0x71, 0xb7, 0xb0, 0xb8, 0xd3, 0x03, 0xe7, 0x6a, 0x0b, 0x59, 0xca,
-	0xed, 0xc3, 0xe6, 0x6a, 0xe5, 0x80, 0xb9, 0x5a, 0x39, 0x6c, 0xae, 0x56, 0x52, 0xa4, 0xae, 0xa7,
-	0x48, 0x5d, 0x2f, 0x9e, 0xab, 0x95, 0xc2, 0xb9, 0x5a, 0x39, 0x6c, 0xae, 0x28, 0xa7, 0xe7, 0x0e,
-	0x9d, 0xab, 0x95, 0x83, 0xe6, 0x6a, 0xe5, 0xd0, 0xb9, 0x4a, 0xd3, 0xba, 0x91, 0xa2, 0x75, 0xe3,
-	0x80, 0xb9, 0x5a, 0x29, 0x9e, 0x2b, 0x4a, 0xac, 0x21, 0xc9, 0xe7, 0x45, 0xc4, 0xd8, 0x6b, 0xa3,
-	0x32, 0xc6, 0x58, 0xbd, 0xd2, 0xf2, 0xd5, 0xbe, 0xbc, 0x09, 0xf6, 0x52, 0x99, 0xe4, 0xaa, 0x62,
-	0x9c, 0xbf, 0xb4, 0x4a, 0xf9, 0x7c, 0x73, 0x18, 0x16, 0xd9, 0x04, 0x7f, 0x92, 0xe4, 0xcb, 0x22,
-	0xa7, 0xe2, 0x1b, 0xcc, 0x75, 0x07, 0xbb, 0xeb, 0xb6, 0x59, 0x51, 0xbe, 0xc0, 0x1c, 0x7c, 0xad,
-	0xe5, 0xab, 0x02, 0x07, 0xc2, 0x73, 0x67, 0x29, 0xd2, 0x6e, 0xfb, 0xea, 0x8d, 0x02, 0x5f, 0xb3,
-	0xaa, 0x9c, 0xdb, 0xbc, 0xd7, 0xd2, 0x30, 0x7c, 0x80, 0xc6, 0xe0, 0xeb, 0x72, 0x87, 0x57, 0xb7,
-	0xea, 0x71, 0xf6, 0xff, 0xcb, 0x69, 0x16, 0xa3, 0xbf, 0xb2, 0xe7, 0xab, 0xe7, 0x92, 0xc2, 0x73,
-	0x79, 0xc1, 0x5a, 0x48, 0x4a, 0x01, 0x56, 0x72, 0x86, 0xd5, 0x78, 0xdd, 0xaa, 0x87, 0x00, 0x57,
-	0x6c, 0x36, 0x9a, 0x65, 0x71, 0x63, 0x45, 0x82, 0xa7, 0xb8, 0x26, 0xe0, 0xe7, 0x52, 0xd8, 0x7d,
-	0xf4, 0xf4, 0xf9, 0xc1, 0x34, 0x1b, 0xa5, 0x37, 0x59, 0xf2, 0x92, 0x36, 0x11, 0x3f, 0x83, 0xb2,
-	0xee, 0x07, 0xe3, 0xee, 0xf9, 0xe7, 0x4b, 0xce, 0x87, 0x24, 0x4b, 0xbb, 0x50, 0xac, 0x45, 0xb3,
-	0x11, 0x51, 0x2f, 0x8a, 0x04, 0xe5, 0xa4, 0x15, 0xf8, 0xad, 0x24, 0x77, 0x32, 0x37, 0x93, 0x47,
-	0xce, 0x5f, 0x05, 0x8e, 0x7e, 0x97, 0x5d, 0x66, 0xa4, 0x4d, 0x70, 0x0f, 0x9e, 0xcc, 0xd5, 0x52,
-	0xec, 0x6a, 0xfa, 0x89, 0x52, 0xe8, 0xec, 0xc5, 0x83, 0xf4, 0x1a, 0xcd, 0x72, 0x41, 0x5f, 0x8a,
-	0x04, 0x3b, 0xf8, 0x96, 0x89, 0xcb, 0xc9, 0x53, 0xe6, 0x87, 0xc5, 0x2e, 0x73, 0xcf, 0x9a, 0x19,
-	0x97, 0xd3, 0x0f, 0x91, 0xc5, 0x2e, 0x17, 0xe9, 0xe5, 0x5d, 0x8e, 0x34, 0x23, 0x97, 0xe3, 0x97,
-	0xcb, 0xaa, 0x1c, 0xfc, 0x65, 0x22, 0xae, 0x75, 0x7e, 0x3d, 0xcd, 0x92, 0xae, 0x2f, 0xa5, 0xfd,
-	0x65, 0xfb, 0x2e, 0x29, 0x7a, 0xb8, 0xc5, 0xe8, 0x24, 0x48, 0xfa, 0xe6, 0xa3, 0x83, 0x43, 0x5c,
-	0x76, 0xd3, 0x9c, 0xbf, 0xe4, 0xd5, 0xea, 0x3a, 0x51, 0x3e, 0xa2, 0x43, 0x24, 0x4d, 0xcc, 0xed,
-	0xf9, 0xea, 0xc5, 0xa4, 0xc7, 0xb9, 0xf4, 0x15, 0xed, 0x82, 0x4e, 0xd2, 0xe3, 0x54, 0xcb, 0xe1,
-	0xe9, 0xee, 0x41, 0x5e, 0x81, 0x16, 0x76, 0x3d, 0x99, 0xb2, 0xc6, 0xd5, 0x91, 0xe5, 0x2a, 0xbf,
-	0x09, 0x66, 0x69, 0x29, 0xe3, 0x02, 0x5f, 0x0e, 0x2c, 0x52, 0xc5, 0x8c, 0x0b, 0x39, 0x3c, 0x3f,
-	0x55, 0xcc, 0x93, 0x9c, 0xde, 0xc4, 0x9d, 0x4f, 0x3e, 0x1b, 0x38, 0xd2, 0xfc, 0x6c, 0xe0, 0xc8,
-	0x27, 0x7b, 0x03, 0x52, 0x73, 0x6f, 0x40, 0x7a, 0xe7, 0xfe, 0xc0, 0x91, 0xf7, 0xef, 0x0f, 0x48,
-	0xcd, 0xfb, 0x03, 0x47, 0xfe, 0x79, 0x7f, 0xe0, 0xc8, 0xab, 0x4f, 0xae, 0x19, 0x64, 0xdd, 0x5b,
-	0xbd, 0xaa, 0xdb, 0xb5, 0x6b, 0xf1, 0x65, 0x03, 0xf7, 0x2b, 0xf9, 0x0f, 0xe8, 0xea, 0x09, 0xf6,
-	0xa7, 0xcf, 0xeb, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x24, 0x93, 0x46, 0x60, 0x2a, 0x00,
-	0x00,
+	// 3893 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x5d, 0x6c, 0xdd, 0xc8,
+	0x75, 0x36, 0xed, 0xd8, 0xbb, 0xa6, 0x65, 0xc9, 0x1e, 0xc9, 0x12, 0xfd, 0x13, 0x51, 0xb9, 0x7b,
+	0x9d, 0x68, 0xb3, 0xfe, 0x91, 0x65, 0xaf, 0xe3, 0x75, 0x9b, 0x6e, 0xf4, 0xb3, 0xea, 0x6a, 0x2d,
+	0xd9, 0xca, 0x48, 0x8a, 0xdb, 0x04, 0x05, 0x3b, 0x97, 0x9c, 0x2b, 0x31, 0xe2, 0x25, 0xaf, 0xf9,
+	0x23, 0xc9, 0x9b, 0xa2, 0x5d, 0x24, 0x68, 0xd3, 0xb7, 0xa6, 0x42, 0x9a, 0x02, 0x2d, 0x50, 0xa4,
+	0x68, 0x0b, 0x74, 0x9b, 0xa6, 0x08, 0x50, 0xa0, 0x40, 0x0b, 0x14, 0x0d, 0x0a, 0x14, 0x58, 0xb4,
+	0x0f, 0xd2, 0x53, 0x51, 0xa0, 0x2d, 0x8b, 0x95, 0xdb, 0x87, 0xde, 0x87, 0x3e, 0xdc, 0x47, 0xf7,
+	0x25, 0x38, 0x43, 0x0e, 0x39, 0x24, 0x87, 0x92, 0xdf, 0xc8, 0xf3, 0x9d, 0x73, 0xe6, 0x7c, 0x33,
+	0xc3, 0x99, 0x33, 0x67, 0xa8, 0x5e, 0x77, 0xec, 0xd6, 0x6d, 0xd3, 0x73, 0xdb, 0xf6, 0xc6, 0x6d,
+	0xaf, 0x1b, 0xda, 0x9e, 0x1b, 0x24, 0x6f, 0x91, 0x4f, 0xe0, 0xed, 0x56, 0xd7, 0xf7, 0x42, 0x0f,
+	0x9d, 0x49, 0x84, 0x57, 0xc6, 0x04, 0xf5, 0x30, 0x72, 0x6d, 0x77, 0x23, 0x51, 0xb8, 0x32, 0x21,
+	0x00, 0x16, 0x09, 0x49, 0x8b, 0x04, 0xb4, 0x45, 0xcc, 0x2d, 0xea, 0x5a, 0xa9, 0xc6, 0x25, 0x41,
+	0x23, 0xb0, 0x3f, 0xa4, 0xa9, 0xb8, 0x21, 0x88, 0x5b, 0xc4, 0xb5, 0x76, 0x6c, 0x2b, 0xdc, 0x0c,
+	0xcc, 0x4d, 0x6a, 0x45, 0x0e, 0xd7, 0xd1, 0x04, 0x9d, 0x1d, 0xda, 0xda, 0xf4, 0xbc, 0xad, 0x14,
+	0x39, 0x4b, 0x77, 0xc3, 0xe4, 0xb1, 0xf1, 0x3f, 0xdf, 0x50, 0x47, 0x9e, 0x24, 0x0c, 0xe6, 0x44,
+	0x06, 0xe8, 0x8f, 0x14, 0xf5, 0x82, 0x63, 0x07, 0x21, 0x75, 0x0d, 0x62, 0x59, 0x3e, 0x0d, 0x02,
+	0x1a, 0x68, 0xca, 0xc4, 0xa9, 0xc9, 0xb3, 0xb3, 0xc1, 0x61, 0xac, 0x23, 0x4c, 0x76, 0x96, 0x18,
+	0x3c, 0xc3, 0xd1, 0x5e, 0xac, 0x0f, 0x39, 0x45, 0x51, 0x3f, 0xd6, 0xaf, 0xef, 0x76, 0x9c, 0x87,
+	0x8d, 0x82, 0xbc, 0x31, 0x61, 0xd1, 0x36, 0x89, 0x9c, 0xf0, 0x61, 0x23, 0x7d, 0x68, 0xbc, 0xdc,
+	0x6f, 0xbe, 0x96, 0x3e, 0xef, 0x1d, 0x34, 0x25, 0xce, 0x71, 0xd9, 0x35, 0xfa, 0x3f, 0x45, 0xd5,
+	0x36, 0x1c, 0xaf, 0x45, 0x1c, 0xc3, 0xb2, 0x03, 0xd3, 0xdb, 0xa6, 0xfe, 0x73, 0x23, 0xa0, 0xfe,
+	0x36, 0xf5, 0x03, 0xed, 0x24, 0x0b, 0xf4, 0xaf, 0x95, 0xc3, 0x58, 0x1f, 0xc6, 0x64, 0xe7, 0x17,
+	0x99, 0xde, 0x8c, 0xeb, 0xae, 0x26, 0x78, 0x2f, 0xd6, 0x2f, 0x6d, 0x70, 0x99, 0x17, 0xb9, 0x26,
+	0x4d, 0x81, 0x7e, 0xac, 0xdf, 0x60, 0x01, 0xcb, 0x50, 0x49, 0xdc, 0xbd, 0xfd, 0xe6, 0x88, 0x4c,
+	0xb5, 0xbf, 0xdf, 0x94, 0x37, 0x50, 0x24, 0x2a, 0x8b, 0x0d, 0x8f, 0x26, 0x86, 0xf3, 0x9c, 0x54,
+	0x2a, 0x47, 0xff, 0x2d, 0x23, 0x4c, 0x5d, 0xd2, 0x72, 0xa8, 0xa5, 0x9d, 0x9a, 0x50, 0x26, 0x5f,
+	0x9f, 0xfd, 0x18, 0x08, 0x5f, 0xc8, 0x3c, 0xbe, 0x97, 0x80, 0x55, 0xb6, 0x29, 0xd0, 0x8f, 0xf5,
+	0x2f, 0x4a, 0xd8, 0xa6, 0xa8, 0x40, 0x37, 0xf4, 0x23, 0x0a, 0x5c, 0x6b, 0xdc, 0xd4, 0x01, 0x2f,
+	0xf7, 0x9b, 0x9f, 0x01, 0xd3, 0xbd, 0x83, 0x66, 0x25, 0xa8, 0x0a, 0xcd, 0x54, 0x8e, 0xfe, 0x43,
+	0x51, 0xc7, 0x1c, 0xcf, 0x94, 0xb2, 0xfc, 0x0c, 0x63, 0xf9, 0x27, 0xc0, 0x72, 0x68, 0xc9, 0x33,
+	0x45, 0x7f, 0xbd, 0x58, 0x1f, 0x71, 0x3c, 0xb3, 0x12, 0x43, 0x3f, 0xd6, 0xdf, 0x4c, 0xa6, 0xa0,
+	0x67, 0xbe, 0x0a, 0x45, 0xb9, 0x93, 0x1a, 0xb9, 0x40, 0xb0, 0x1c, 0x0f, 0xbe, 0xc4, 0x0c, 0x2a,
+	0xf4, 0xfe, 0x45, 0x51, 0x87, 0x13, 0x7a, 0x24, 0xf5, 0x65, 0x74, 0x3d, 0x3f, 0xd4, 0x4e, 0x4f,
+	0x28, 0x93, 0xa7, 0x67, 0xff, 0x00, 0xa8, 0x0d, 0x70, 0x57, 0x2b, 0x9e, 0x1f, 0xf6, 0x62, 0xfd,
+	0x62, 0xa1, 0x69, 0x10, 0xf6, 0x63, 0xfd, 0x0b, 0x55, 0x52, 0x80, 0x08, 0x8c, 0xa6, 0xef, 0x4c,
+	0x4d, 0x7f, 0xa9, 0xf1, 0x32, 0xd6, 0x4f, 0xd9, 0x6e, 0xd8, 0xdb, 0x6f, 0x4a, 0xdc, 0xc8, 0x84,
+	0x2f, 0xf7, 0x9b, 0xa7, 0x99, 0xe9, 0xde, 0x41, 0xb3, 0x10, 0x09, 0xae, 0xea, 0xa2, 0xef, 0x9c,
+	0x54, 0x27, 0x4a, 0x6c, 0x3a, 0x91, 0x13, 0xda, 0x26, 0x09, 0x42, 0xbe, 0x6e, 0x68, 0x67, 0x26,
+	0x94, 0xc9, 0xb3, 0xb3, 0x7f, 0x0b, 0xd4, 0x06, 0xb9, 0xc3, 0xe5, 0x39, 0xf8, 0x92, 0x7b, 0xb1,
+	0x3e, 0x5c, 0x70, 0x9a, 0x88, 0xfb, 0xb1, 0x7e, 0xbf, 0x4a, 0x2f, 0xc1, 0x04, 0x82, 0xdf, 0x68,
+	0xb7, 0xef, 0x4c, 0x3f, 0x7c, 0xf8, 0xe0, 0xee, 0x83, 0x7b, 0xbf, 0xf2, 0x30, 0x61, 0xdb, 0xdb,
+	0x6f, 0x4a, 0x1d, 0xca, 0xc5, 0x2f, 0xf7, 0x9b, 0xa8, 0xea, 0x64, 0xef, 0xa0, 0x59, 0x0a, 0x13,
+	0x7f, 0xb6, 0x68, 0xcc, 0x19, 0xa6, 0x8b, 0x11, 0x7a, 0xa2, 0x9e, 0xef, 0x90, 0x5d, 0x23, 0xa0,
+	0xae, 0x65, 0x6c, 0xb5, 0xba, 0x81, 0xf6, 0x1a, 0x1b, 0xcc, 0xb7, 0x7a, 0xb1, 0x7e, 0xae, 0x43,
+	0x76, 0x57, 0xa9, 0x6b, 0x3d, 0x6a, 0x75, 0x61, 0x71, 0xb9, 0xc8, 0x68, 0x09, 0x32, 0x3e, 0x3e,
+	0x58, 0x54, 0xe4, 0x0e, 0x7d, 0x6a, 0x6e, 0x27, 0x0e, 0x5f, 0x2f, 0x38, 0xc4, 0xd4, 0xdc, 0x2e,
+	0x3b, 0xe4, 0xb2, 0x82, 0x43, 0x2e, 0x44, 0x7f, 0xa3, 0xa8, 0x63, 0x3e, 0x35, 0x3d, 0xd7, 0xa5,
+	0x26, 0x2c, 0xef, 0x86, 0xed, 0x86, 0xd4, 0xdf, 0x26, 0x8e, 0x11, 0x68, 0x67, 0x99, 0xef, 0x5f,
+	0x67, 0x8b, 0x3a, 0x57, 0x59, 0x4c, 0xe1, 0x55, 0x58, 0x3b, 0x44, 0xc3, 0x0c, 0xe8, 0xc7, 0xfa,
+	0x24, 0x6b, 0x5b, 0x8a, 0x0a, 0xa3, 0x74, 0x7f, 0x8a, 0x87, 0xf4, 0x72, 0xbf, 0x79, 0xf2, 0xfe,
+	0x14, 0x5b, 0xdf, 0x2b, 0xed, 0x60, 0x79, 0x2b, 0xa8, 0xad, 0x0e, 0xfa, 0xd4, 0x21, 0xcf, 0x83,
+	0x6c, 0x0d, 0x50, 0xd9, 0x1a, 0xf0, 0x6e, 0x2f, 0xd6, 0xcf, 0x27, 0x48, 0xfe, 0xa1, 0x37, 0xd2,
+	0x80, 0x04, 0x69, 0xf9, 0x0b, 0xe7, 0x5f, 0x2c, 0x2e, 0x1a, 0xa3, 0x6f, 0x9f, 0x54, 0xaf, 0xa6,
+	0x0d, 0x65, 0x81, 0xe4, 0x9d, 0xd4, 0xd1, 0xce, 0xb1, 0x4e, 0xfa, 0x47, 0x98, 0xc3, 0x63, 0x18,
+	0xf4, 0x2a, 0x14, 0x96, 0x7b, 0xb1, 0x3e, 0xe6, 0xcb, 0xa1, 0x6c, 0xa1, 0xad, 0xc1, 0x85, 0x28,
+	0xef, 0x4c, 0x09, 0x9f, 0x6c, 0xad, 0xbf, 0x7a, 0x08, 0x3a, 0xf9, 0x0e, 0x74, 0x72, 0x5d, 0x98,
+	0x58, 0x4b, 0x78, 0x56, 0x11, 0xd4, 0x52, 0xcf, 0x07, 0x21, 0xf1, 0x43, 0xa3, 0xe5, 0x7b, 0x3b,
+	0x01, 0xf5, 0xb5, 0x01, 0xd6, 0xd7, 0x5f, 0xee, 0xc5, 0xfa, 0x00, 0x03, 0x66, 0x13, 0x79, 0x3f,
+	0xd6, 0x3f, 0xc7, 0xe8, 0x88, 0xc2, 0xda, 0x9e, 0x2e, 0x98, 0xa2, 0x3f, 0x53, 0xd4, 0x4b, 0x2e,
+	0x09, 0x8d, 0xd0, 0x27, 0xb0, 0xab, 0x11, 0x27, 0x1b, 0xd8, 0x41, 0xd6, 0xd8, 0xb3, 0xc3, 0x58,
+	0x57, 0x1f, 0xcf, 0xac, 0xe5, 0xcb, 0xba, 0xea, 0x92, 0x30, 0x1f, 0x63, 0x9d, 0x35, 0x9c, 0x8b,
+	0x24, 0x4b, 0xb8, 0x68, 0x50, 0x78, 0x13, 0x96, 0x6b, 0xa1, 0x09, 0x3c, 0xec, 0x92, 0x70, 0x8d,
+	0x87, 0xc3, 0x27, 0xc4, 0xdf, 0x55, 0xe2, 0x74, 0x28, 0x09, 0xa8, 0xd1, 0xd1, 0x86, 0xd8, 0x54,
+	0xf8, 0x2d, 0x98, 0x0a, 0x67, 0x1f, 0xcf, 0xac, 0x2d, 0x81, 0x18, 0x06, 0x7f, 0xc8, 0x25, 0x61,
+	0xf2, 0x62, 0xbb, 0x51, 0x48, 0x83, 0x6c, 0x42, 0x96, 0xe4, 0xd2, 0x6f, 0xa3, 0xb7, 0xdf, 0xac,
+	0xd8, 0x57, 0x45, 0xd9, 0x17, 0x94, 0x37, 0x8c, 0x91, 0x18, 0x7d, 0x22, 0x43, 0xff, 0xac, 0xa8,
+	0x63, 0xc5, 0xe0, 0x7d, 0xea, 0xd2, 0x1d, 0x36, 0x93, 0x2f, 0xb0, 0xf0, 0xf7, 0x20, 0xfc, 0x73,
+	0x8f, 0x67, 0xd6, 0x70, 0x02, 0x00, 0x81, 0x8b, 0x2e, 0x09, 0xf9, 0x6b, 0x46, 0xa1, 0xc9, 0x29,
+	0x14, 0x11, 0x81, 0xc4, 0x5d, 0x91, 0x84, 0xc4, 0x87, 0x4c, 0x08, 0x44, 0xee, 0x02, 0x11, 0x31,
+	0x04, 0x3c, 0x22, 0x52, 0xe1, 0x52, 0x09, 0x99, 0xd0, 0xee, 0x50, 0x2f, 0x0a, 0x8d, 0x40, 0xbb,
+	0x58, 0x24, 0xb3, 0x96, 0x00, 0xab, 0x29, 0x19, 0xfe, 0x0a, 0x33, 0xdd, 0x2a, 0x90, 0x29, 0x22,
+	0x75, 0x9f, 0x9f, 0xc4, 0x87, 0x4c, 0x98, 0x7d, 0x72, 0x62, 0x08, 0x45, 0x32, 0x5c, 0x8a, 0xfe,
+	0x50, 0x51, 0xb5, 0x28, 0x20, 0x1b, 0xd4, 0xf0, 0x29, 0xec, 0xfb, 0xb6, 0xbb, 0x61, 0x10, 0xd3,
+	0xa4, 0xdd, 0x90, 0x5a, 0x1a, 0x62, 0x6c, 0x08, 0x7c, 0x01, 0xeb, 0x78, 0x26, 0x95, 0xc2, 0x17,
+	0x10, 0xf9, 0xfc, 0xad, 0x1f, 0xeb, 0x17, 0x18, 0x89, 0x5c, 0x24, 0x04, 0x2c, 0x2a, 0x16, 0xde,
+	0x60, 0xc6, 0xe7, 0x2e, 0xf1, 0x28, 0x0b, 0x01, 0xf3, 0x08, 0xb8, 0x1c, 0x7d, 0x4b, 0x1d, 0x29,
+	0x07, 0x17, 0x50, 0xea, 0x6a, 0xc3, 0x2c, 0xb0, 0xc5, 0xc3, 0x58, 0x3f, 0xb3, 0x8e, 0x57, 0x29,
+	0x75, 0x7b, 0xb1, 0x7e, 0x26, 0xf2, 0xe1, 0xa9, 0x1f, 0xeb, 0x03, 0x69, 0x40, 0xf0, 0x2a, 0x04,
+	0xc3, 0x15, 0xb2, 0xa7, 0xbd, 0x83, 0x66, 0x6a, 0x8e, 0x51, 0x31, 0x00, 0x90, 0xa1, 0xdf, 0x53,
+	0xd4, 0xcb, 0xe5, 0xd6, 0x23, 0xd7, 0x7e, 0x16, 0x51, 0xc3, 0xb6, 0xb4, 0x11, 0x96, 0x44, 0x7c,
+	0x3d, 0xe9, 0x9b, 0x75, 0x26, 0x5e, 0x9c, 0x4f, 0xfa, 0x26, 0x7d, 0x13, 0xfb, 0x86, 0x2b, 0x34,
+	0x92, 0x4e, 0xe1, 0xaf, 0x7d, 0xf1, 0x2d, 0xed, 0x14, 0x8e, 0x95, 0x3b, 0x85, 0x6b, 0xa1, 0x9f,
+	0x2a, 0xea, 0x70, 0x25, 0x2e, 0xdf, 0xd1, 0x2e, 0xb1, 0x88, 0x7e, 0x07, 0xe6, 0xde, 0xe9, 0x75,
+	0xbc, 0x8e, 0x97, 0x7a, 0xb1, 0x7e, 0x3a, 0xf2, 0xd7, 0xf1, 0x52, 0x3f, 0xd6, 0x1f, 0xf0, 0x40,
+	0xf0, 0x92, 0x30, 0xbb, 0x36, 0xc3, 0xb0, 0x1b, 0x3c, 0xbc, 0xcd, 0xce, 0x7a, 0xb7, 0x82, 0xe7,
+	0xae, 0x19, 0x6e, 0xc2, 0x61, 0xd0, 0xa5, 0xe1, 0x6d, 0x97, 0xee, 0x80, 0x14, 0x02, 0x4e, 0x9d,
+	0xf0, 0x87, 0x97, 0xfb, 0xcd, 0x57, 0x30, 0xdc, 0x3b, 0x68, 0x26, 0x51, 0xe0, 0x8b, 0x25, 0x1e,
+	0xbe, 0x83, 0xfe, 0x4b, 0x51, 0xf5, 0x32, 0x85, 0xae, 0x17, 0xc0, 0x0e, 0x17, 0x50, 0x33, 0xf2,
+	0xa9, 0xf3, 0x5c, 0x1b, 0x65, 0xcb, 0xef, 0xef, 0xb3, 0x13, 0xc4, 0x3a, 0x5e, 0xf1, 0x82, 0x70,
+	0x31, 0x03, 0x7b, 0xb1, 0x7e, 0x21, 0xf2, 0x8b, 0xb2, 0x7e, 0xac, 0x7f, 0x3e, 0x25, 0x59, 0x04,
+	0x04, 0xbe, 0x6d, 0xe2, 0x04, 0x6c, 0x49, 0xae, 0x5a, 0x4b, 0x64, 0x90, 0x79, 0x32, 0x0b, 0x38,
+	0x2f, 0x94, 0x43, 0xc0, 0xd7, 0x8a, 0xb4, 0x8a, 0x28, 0xfa, 0x4f, 0x09, 0x43, 0xdb, 0xb5, 0x43,
+	0x1b, 0xce, 0x11, 0xb0, 0xdf, 0x19, 0x81, 0x36, 0xc6, 0x66, 0xf1, 0x0f, 0xd8, 0xe9, 0x61, 0x1d,
+	0x2f, 0x26, 0xe8, 0x3c, 0x80, 0xb0, 0x60, 0x0c, 0x45, 0x7e, 0x41, 0x94, 0x2d, 0x17, 0x25, 0xb9,
+	0xb8, 0x58, 0x3c, 0x98, 0x2a, 0x2c, 0xe0, 0x65, 0x0f, 0x55, 0x11, 0xec, 0x40, 0x60, 0x05, 0x07,
+	0x86, 0x52, 0x08, 0xf8, 0x6a, 0x91, 0x60, 0x01, 0x44, 0xdf, 0x55, 0xd4, 0x31, 0x12, 0x85, 0x9e,
+	0x11, 0x75, 0x37, 0x7c, 0x62, 0xd1, 0x3c, 0x37, 0xd9, 0xd4, 0x2e, 0x33, 0x5e, 0x2b, 0x70, 0x02,
+	0x02, 0x95, 0xf5, 0x44, 0x83, 0x6f, 0xeb, 0xef, 0x67, 0x87, 0x05, 0x19, 0x28, 0xb2, 0x99, 0x16,
+	0x13, 0xb5, 0x3b, 0xd3, 0x58, 0xea, 0x0d, 0x75, 0xd4, 0x31, 0x1e, 0x43, 0xe8, 0x19, 0x5d, 0x1f,
+	0x7a, 0x9c, 0x6d, 0x8d, 0x81, 0x76, 0x85, 0x4d, 0xa1, 0xfb, 0x10, 0x48, 0xaa, 0xb2, 0xe6, 0xad,
+	0xf8, 0x14, 0xa7, 0x78, 0x3f, 0xd6, 0xaf, 0x24, 0x3d, 0x2a, 0x01, 0x1b, 0x58, 0x6a, 0x83, 0xb6,
+	0x55, 0xb4, 0x45, 0x69, 0xd7, 0x08, 0x69, 0xa7, 0xeb, 0xf9, 0xc4, 0xb7, 0x69, 0x60, 0x6c, 0x6a,
+	0x57, 0x19, 0xe5, 0xf7, 0x61, 0x5e, 0x02, 0xba, 0x96, 0x83, 0x40, 0xf7, 0x0d, 0xd6, 0x4a, 0x19,
+	0x10, 0x8f, 0x46, 0xf7, 0x44, 0xaa, 0xd3, 0xf7, 0x70, 0xc5, 0x0b, 0x7a, 0xae, 0x0e, 0x9b, 0xc4,
+	0xdc, 0xa4, 0x86, 0xbd, 0xe1, 0x7a, 0x3e, 0xb5, 0x8c, 0xb6, 0xed, 0xd0, 0x40, 0xbb, 0xc6, 0x28,
+	0x2e, 0xc2, 0x06, 0xc3, 0xe0, 0xc5, 0x04, 0x5d, 0x00, 0x30, 0xeb, 0xe8, 0x0a, 0x52, 0xf9, 0x24,
+	0xb2, 0xa9, 0x8e, 0xab, 0x6e, 0xd0, 0xef, 0x2a, 0xea, 0x95, 0xae, 0xef, 0x6d, 0xc0, 0xd9, 0xc2,
+	0x88, 0xba, 0x16, 0x09, 0xa9, 0x98, 0xaf, 0x7f, 0x96, 0x71, 0x5f, 0x83, 0x74, 0x93, 0x6b, 0xad,
+	0x33, 0x25, 0x31, 0x37, 0x4f, 0xce, 0xbc, 0x35, 0xb8, 0x10, 0xce, 0xdb, 0x42, 0x47, 0x28, 0x6f,
+	0xe3, 0x3a, 0x8f, 0xe8, 0xdb, 0x8a, 0x3a, 0xea, 0xd8, 0x1d, 0x3b, 0x34, 0xb2, 0x72, 0x93, 0x61,
+	0xbb, 0x86, 0x43, 0x5c, 0x6d, 0x9c, 0x75, 0xc9, 0x32, 0x3b, 0xcb, 0x81, 0xc6, 0x2c, 0x57, 0x58,
+	0x74, 0x97, 0x88, 0x9b, 0x9f, 0xbf, 0xab, 0xd8, 0x11, 0xdd, 0x22, 0x73, 0x85, 0x3e, 0x52, 0x54,
+	0xd4, 0xb1, 0x5d, 0x63, 0xd3, 0xeb, 0x50, 0xa8, 0x0e, 0x6c, 0x19, 0x6d, 0x9f, 0x52, 0x4d, 0x9f,
+	0x50, 0x26, 0xcf, 0x4d, 0x0f, 0xdc, 0x4a, 0x6a, 0x5d, 0xb7, 0x56, 0xed, 0x0f, 0xe9, 0xec, 0x7b,
+	0x9f, 0xc4, 0xfa, 0x09, 0xf8, 0xaa, 0x3b, 0xb6, 0xfb, 0xbe, 0xd7, 0xa1, 0xf3, 0x76, 0xb0, 0xb5,
+	0xe0, 0x53, 0x9a, 0xcd, 0x8e, 0x92, 0x5c, 0xfc, 0x0e, 0x26, 0xae, 0x43, 0x20, 0xa7, 0xee, 0x4c,
+	0x5c, 0xc7, 0x65, 0x73, 0xf4, 0x42, 0x51, 0x07, 0xf8, 0x7c, 0x67, 0xbb, 0xc0, 0x04, 0xdb, 0x05,
+	0xfe, 0x81, 0x65, 0x20, 0x7c, 0xd2, 0x26, 0x7b, 0xc1, 0x39, 0x3f, 0x7f, 0xed, 0xc7, 0xfa, 0x3c,
+	0x3f, 0x00, 0x70, 0x99, 0x64, 0x5f, 0x48, 0xbf, 0x80, 0xa0, 0xb4, 0xc4, 0x77, 0x68, 0x48, 0x6e,
+	0x7d, 0x33, 0xf0, 0x5c, 0x58, 0x4a, 0x0b, 0x6e, 0x8b, 0xaf, 0x2f, 0xf7, 0x9b, 0x93, 0xaf, 0xea,
+	0x0a, 0xd2, 0x15, 0x21, 0x5e, 0x9c, 0xfb, 0xf1, 0x1d, 0xf4, 0x54, 0xbd, 0x48, 0x9c, 0x1d, 0x38,
+	0x0c, 0x25, 0x87, 0x7b, 0x97, 0x86, 0x81, 0xf6, 0x39, 0x56, 0x53, 0x83, 0x33, 0xe8, 0x50, 0x02,
+	0xb2, 0x43, 0xf2, 0x63, 0x1a, 0xc2, 0xc4, 0x1f, 0x49, 0x56, 0x98, 0x82, 0xbc, 0x81, 0xcb, 0x8a,
+	0xe8, 0xff, 0x15, 0x75, 0x12, 0xca, 0x21, 0x3b, 0xbe, 0x1d, 0xc2, 0xc2, 0xd1, 0xf1, 0x42, 0x6a,
+	0x58, 0x74, 0xdb, 0x36, 0xa9, 0xe1, 0x92, 0x0e, 0x0d, 0x0c, 0xcf, 0x35, 0xd2, 0x73, 0x89, 0xd6,
+	0xc8, 0xab, 0x3d, 0x63, 0x4f, 0xb8, 0x11, 0x66, 0x36, 0xf3, 0x74, 0xfb, 0x31, 0xa8, 0xf7, 0x62,
+	0xfd, 0x0d, 0xaf, 0x02, 0xd9, 0x26, 0x65, 0xe8, 0x13, 0x77, 0x2e, 0x71, 0xd5, 0x8f, 0xf5, 0x77,
+	0x58, 0x80, 0xaf, 0xa0, 0x5b, 0x3f, 0x29, 0xe1, 0x50, 0x55, 0x13, 0x07, 0x7e, 0x95, 0x28, 0xd0,
+	0x6f, 0xa8, 0x97, 0x60, 0x19, 0x33, 0x6c, 0xd7, 0xa2, 0xbb, 0x06, 0xcc, 0xe4, 0x96, 0xe3, 0x99,
+	0x5b, 0x81, 0xf6, 0x06, 0xfb, 0xa4, 0x61, 0xd2, 0x20, 0x50, 0x58, 0x04, 0x7c, 0xd9, 0x76, 0x67,
+	0x19, 0x9a, 0x15, 0x51, 0xab, 0x90, 0x34, 0x71, 0x4d, 0xd2, 0x51, 0x2c, 0xf1, 0x84, 0xfe, 0x1d,
+	0xb2, 0x4f, 0x17, 0x0a, 0xcc, 0x96, 0xe1, 0x7a, 0xa1, 0xdd, 0xb6, 0x4d, 0x92, 0x94, 0x03, 0xac,
+	0x40, 0x6b, 0xb2, 0xf1, 0xfd, 0x21, 0x74, 0xf7, 0xe8, 0x7a, 0xa2, 0xf4, 0x58, 0xd0, 0x59, 0x9c,
+	0x87, 0xde, 0x1e, 0x8d, 0xa4, 0x48, 0x3f, 0xd6, 0xaf, 0x26, 0x4b, 0xbb, 0x0c, 0x66, 0xa5, 0x43,
+	0x29, 0xd2, 0xdf, 0x6f, 0xd6, 0x78, 0xdc, 0x3b, 0x68, 0xd6, 0x44, 0x81, 0xa5, 0x16, 0x56, 0x80,
+	0xb0, 0x7a, 0x3e, 0xf4, 0x49, 0xbb, 0x6d, 0x9b, 0x86, 0xe9, 0x90, 0x20, 0xd0, 0xae, 0xb3, 0x6e,
+	0xbd, 0x09, 0xc7, 0xd7, 0x14, 0x98, 0x03, 0x79, 0x3f, 0xd6, 0x51, 0xd2, 0xa1, 0x82, 0x30, 0xab,
+	0x9b, 0x14, 0x54, 0xd1, 0xb7, 0xd4, 0xe1, 0xb4, 0x8b, 0x8d, 0xb6, 0xe7, 0x58, 0xd4, 0x37, 0xba,
+	0x24, 0xdc, 0xd4, 0x3e, 0xcf, 0xbe, 0xfa, 0x47, 0x87, 0xb1, 0x7e, 0x75, 0x9e, 0x76, 0x7d, 0x6a,
+	0x92, 0x90, 0x5a, 0xf3, 0x89, 0xe2, 0x02, 0xd3, 0x5b, 0x21, 0xe1, 0x66, 0x2f, 0xd6, 0x95, 0x9b,
+	0xd9, 0x61, 0xd9, 0x2a, 0xc3, 0x37, 0xbc, 0x8e, 0x0d, 0x83, 0x14, 0x3e, 0x6f, 0x68, 0x0a, 0xbe,
+	0x58, 0xc1, 0xd1, 0x96, 0x7a, 0x21, 0xa0, 0xa1, 0xe1, 0x78, 0x3b, 0x46, 0xd7, 0xb7, 0x3d, 0xdf,
+	0x0e, 0x9f, 0x6b, 0x5f, 0x60, 0x1f, 0xc5, 0x4c, 0x2f, 0xd6, 0x07, 0x03, 0x1a, 0x2e, 0x79, 0x3b,
+	0x2b, 0x29, 0x92, 0xad, 0x6c, 0x45, 0x71, 0xed, 0xb1, 0xbc, 0x64, 0x8e, 0x3e, 0x56, 0xd4, 0x51,
+	0x28, 0x3a, 0xa5, 0x34, 0x4d, 0xcf, 0x35, 0x23, 0xdf, 0xa7, 0xae, 0xf9, 0x5c, 0x9b, 0x64, 0xfd,
+	0x18, 0xb0, 0xda, 0x07, 0xd9, 0x59, 0x26, 0xbb, 0x49, 0x8c, 0x73, 0xb9, 0x0a, 0x6c, 0xf9, 0x1d,
+	0x89, 0x3c, 0xdb, 0xf2, 0x65, 0x20, 0xef, 0x72, 0x56, 0xac, 0x90, 0xfb, 0xc5, 0x52, 0xaf, 0x50,
+	0x23, 0x1e, 0x36, 0x7d, 0x12, 0x6c, 0x96, 0x52, 0xf2, 0x37, 0xd9, 0xb0, 0xfc, 0x88, 0xa5, 0xe4,
+	0x73, 0x3c, 0x25, 0x37, 0xd3, 0x94, 0x7c, 0x21, 0xd9, 0x9b, 0xc1, 0x2c, 0x4f, 0x8e, 0xa5, 0xcb,
+	0x30, 0xd3, 0xa9, 0xa6, 0xd9, 0x4c, 0x0c, 0x73, 0xf9, 0x62, 0xc5, 0x09, 0x24, 0xeb, 0x66, 0x9a,
+	0xac, 0x37, 0x5f, 0xc5, 0x0d, 0xa4, 0xeb, 0x73, 0x49, 0xba, 0x5e, 0x72, 0xe6, 0x3b, 0xe8, 0x8f,
+	0x15, 0x75, 0xac, 0x4c, 0x8f, 0x57, 0x49, 0xbe, 0xc8, 0xc6, 0xdf, 0x86, 0xe2, 0xc3, 0x1c, 0x16,
+	0x0a, 0xfc, 0x45, 0x2f, 0xe5, 0x02, 0xbf, 0x14, 0xad, 0x9b, 0x1a, 0x50, 0x5f, 0xc8, 0x7c, 0x63,
+	0xb9, 0x67, 0xf4, 0x9b, 0x8a, 0x3a, 0x1a, 0x84, 0x91, 0x6b, 0x40, 0xe6, 0x44, 0x1c, 0x7b, 0x9b,
+	0x1a, 0x49, 0xed, 0x28, 0xd0, 0xde, 0xca, 0xf2, 0xd1, 0x61, 0xd0, 0x78, 0xc4, 0x15, 0x56, 0x01,
+	0x5f, 0xcd, 0xb2, 0x24, 0x09, 0x56, 0xcc, 0xad, 0x85, 0x05, 0xed, 0xd4, 0x9d, 0x07, 0x53, 0x58,
+	0xe6, 0x0d, 0x8e, 0xac, 0xa5, 0x30, 0x60, 0x5d, 0x0d, 0xb4, 0x1b, 0x2c, 0x88, 0x0f, 0x20, 0x51,
+	0x2b, 0x98, 0x2d, 0xdb, 0x6e, 0x9e, 0xda, 0x57, 0x10, 0x31, 0x47, 0x2c, 0x2c, 0xa8, 0xd3, 0x53,
+	0xb8, 0xea, 0x07, 0xb2, 0xf2, 0x01, 0xd6, 0x3a, 0xbf, 0x77, 0xba, 0xc9, 0xd6, 0x50, 0x0b, 0x2a,
+	0xdd, 0x98, 0xec, 0xac, 0x86, 0x91, 0x70, 0xe3, 0x74, 0x2e, 0xc8, 0x5f, 0xb3, 0xda, 0x50, 0x2e,
+	0x3b, 0xf6, 0x56, 0xac, 0xe4, 0x11, 0x8b, 0xfe, 0xd0, 0xb6, 0x3a, 0xc4, 0x2f, 0x10, 0x8d, 0xe4,
+	0x8a, 0x51, 0xbb, 0x35, 0xa1, 0x4c, 0x0e, 0x4e, 0x0f, 0xf2, 0xb4, 0x68, 0x8d, 0x49, 0x59, 0x31,
+	0x6f, 0x90, 0xab, 0x26, 0xb2, 0x6c, 0xe5, 0x28, 0x8a, 0x1b, 0x13, 0x3e, 0x65, 0x43, 0x9a, 0x4e,
+	0x8f, 0x8f, 0x0e, 0x9a, 0x0a, 0x2e, 0x99, 0xa2, 0xef, 0x9f, 0x54, 0xdf, 0x80, 0x55, 0x23, 0x5b,
+	0x2e, 0xe0, 0x4c, 0x69, 0x7a, 0x1d, 0x98, 0xb2, 0x3e, 0x7d, 0x16, 0xd1, 0x20, 0x34, 0xb6, 0xec,
+	0x96, 0x76, 0x9b, 0x0d, 0xc7, 0x3f, 0x29, 0xe9, 0xd5, 0xe1, 0x32, 0xd9, 0x9d, 0x5b, 0xc4, 0x09,
+	0xfe, 0xc8, 0x9e, 0xed, 0xc5, 0xba, 0xde, 0x21, 0xbb, 0xd9, 0x27, 0x1e, 0x2e, 0xa6, 0x3e, 0x72,
+	0x95, 0x6c, 0x17, 0x3c, 0x46, 0x4f, 0x38, 0x8f, 0x1d, 0xeb, 0xf2, 0x78, 0x95, 0xf4, 0x32, 0xb2,
+	0x14, 0x2e, 0x3e, 0xc6, 0xac, 0x05, 0x77, 0x75, 0xa3, 0xd9, 0x8d, 0x88, 0x43, 0xc4, 0x3b, 0xd4,
+	0x29, 0xf6, 0x01, 0xff, 0x18, 0x7a, 0x62, 0x84, 0xdf, 0x28, 0x2c, 0xcd, 0x3c, 0x16, 0xaf, 0x51,
+	0x47, 0x88, 0x44, 0x9e, 0x25, 0xd2, 0x32, 0x50, 0x76, 0x91, 0x25, 0x75, 0x52, 0x23, 0x17, 0x3e,
+	0x7d, 0x69, 0x50, 0x38, 0xb7, 0x22, 0xc2, 0x1d, 0xec, 0xb6, 0x7a, 0x85, 0x5d, 0x7a, 0xb4, 0x23,
+	0xc7, 0x49, 0xb3, 0x1a, 0xcf, 0xe5, 0x47, 0x54, 0xed, 0x0e, 0x63, 0xfa, 0x10, 0xb2, 0x06, 0xd0,
+	0x5a, 0x88, 0x1c, 0x87, 0xe5, 0x23, 0x4f, 0xdc, 0xf4, 0x50, 0xd9, 0x8f, 0xf5, 0x6b, 0xe9, 0x96,
+	0x25, 0x83, 0x1b, 0xb8, 0xc6, 0x0e, 0x7d, 0xa0, 0x9e, 0x6f, 0x53, 0x12, 0x46, 0x3e, 0x35, 0xda,
+	0x0e, 0xd9, 0x08, 0xb4, 0x69, 0xf6, 0xdd, 0x5d, 0x87, 0x9d, 0x3e, 0x05, 0x16, 0x40, 0x9e, 0x5d,
+	0x90, 0x08, 0xc2, 0x06, 0x2e, 0xa8, 0xa0, 0x1d, 0x75, 0x4c, 0xb8, 0x17, 0x49, 0xce, 0x38, 0xd4,
+	0xf5, 0xa2, 0x8d, 0x4d, 0xed, 0x2e, 0x9b, 0xb4, 0xef, 0xb2, 0xe5, 0x35, 0x53, 0x59, 0x02, 0x8d,
+	0xf7, 0x98, 0x42, 0x96, 0xf5, 0x48, 0xd1, 0x2c, 0xa3, 0x90, 0x1b, 0xa3, 0x2d, 0x75, 0xa4, 0xd2,
+	0x70, 0x87, 0xec, 0x6a, 0xf7, 0x58, 0xab, 0xef, 0x40, 0x32, 0x58, 0x32, 0x5c, 0x26, 0xbb, 0xfd,
+	0x58, 0xd7, 0x64, 0x4d, 0x2e, 0x93, 0xdd, 0xac, 0x3d, 0x89, 0x19, 0xfa, 0xee, 0x49, 0x55, 0xe7,
+	0xc5, 0x1e, 0x83, 0x38, 0x90, 0x52, 0x78, 0x8e, 0x65, 0x84, 0x4e, 0x60, 0xc0, 0xfa, 0x61, 0x7b,
+	0x6e, 0xa0, 0xbd, 0xcd, 0xc6, 0xeb, 0xa7, 0x30, 0x33, 0xaf, 0xf2, 0xd2, 0xca, 0x0c, 0xa8, 0x3e,
+	0x71, 0xac, 0xb5, 0xa5, 0xd5, 0xaf, 0xa5, 0x7a, 0xbd, 0x58, 0xbf, 0x6a, 0xd7, 0xc3, 0x59, 0xbe,
+	0x73, 0x84, 0x0e, 0xcc, 0xcf, 0x23, 0x7d, 0x1c, 0x0d, 0xef, 0x1d, 0x34, 0x8f, 0x0a, 0x10, 0x57,
+	0x6d, 0x9d, 0x80, 0x83, 0xe8, 0x40, 0x51, 0xaf, 0x0a, 0xfd, 0xce, 0x13, 0x2b, 0x23, 0x34, 0xbb,
+	0xec, 0x38, 0x7b, 0x9f, 0x75, 0xff, 0xf7, 0xa0, 0x17, 0xb4, 0xb9, 0x4c, 0x8f, 0xa7, 0x49, 0x6b,
+	0x73, 0x2b, 0x4b, 0x33, 0x8f, 0x7b, 0xb1, 0xae, 0x99, 0x55, 0xcc, 0xec, 0x26, 0x07, 0xde, 0xb7,
+	0x4a, 0x23, 0x54, 0x54, 0x38, 0x22, 0x69, 0xdf, 0x3b, 0x68, 0xd6, 0xb6, 0x89, 0x6b, 0x5b, 0x44,
+	0xff, 0xaa, 0xa8, 0xd7, 0x64, 0x94, 0x9e, 0x45, 0xb6, 0xc9, 0x38, 0x7d, 0x89, 0x71, 0xfa, 0x3e,
+	0x70, 0xba, 0x5c, 0xf5, 0xff, 0xd5, 0xf5, 0xc5, 0xb9, 0x84, 0xd4, 0xe5, 0x6a, 0x13, 0x5f, 0x8d,
+	0x6c, 0x33, 0x61, 0x75, 0xa3, 0x86, 0x55, 0xaa, 0x71, 0xc4, 0xd6, 0xb9, 0x77, 0xd0, 0xac, 0x6f,
+	0x16, 0xd7, 0x37, 0x7a, 0xe4, 0x58, 0xed, 0x10, 0x57, 0x7b, 0x70, 0xdc, 0x58, 0x3d, 0x3d, 0x62,
+	0xac, 0x9e, 0x1e, 0x37, 0x56, 0x4f, 0x89, 0x2b, 0xbd, 0xe6, 0xc8, 0x2e, 0x2f, 0x6a, 0xdb, 0xc4,
+	0xb5, 0x2d, 0x1e, 0x3d, 0x56, 0xc0, 0xe9, 0x9d, 0x63, 0xc7, 0xea, 0xe9, 0x51, 0x63, 0xf5, 0xf4,
+	0xd8, 0xb1, 0x2a, 0xd2, 0xba, 0x57, 0xa0, 0x75, 0xef, 0x88, 0xb1, 0x7a, 0x5a, 0x3f, 0x56, 0x40,
+	0x6c, 0x4f, 0x51, 0x2f, 0xcb, 0x88, 0xb1, 0xdb, 0x46, 0xed, 0x21, 0x63, 0xf5, 0x35, 0x28, 0x5a,
+	0x55, 0x5d, 0xb0, 0x9b, 0xca, 0x3c, 0x57, 0x95, 0xe3, 0x62, 0xd1, 0xaa, 0x10, 0xf3, 0xdb, 0x53,
+	0xb8, 0xce, 0x27, 0xfa, 0x7b, 0x45, 0xbd, 0x2e, 0x0b, 0x2a, 0xab, 0x60, 0x6e, 0xfa, 0x34, 0xd8,
+	0xf4, 0x1c, 0x4b, 0xfb, 0x39, 0x16, 0xe0, 0x37, 0x7b, 0xb1, 0x2e, 0x09, 0x20, 0xdd, 0x77, 0xd6,
+	0xb8, 0x76, 0x3f, 0xd6, 0xef, 0xd5, 0xc4, 0x5a, 0x56, 0x15, 0xc2, 0x16, 0xa3, 0x56, 0xa6, 0xf0,
+	0x2b, 0x18, 0xa3, 0x1f, 0x28, 0xea, 0x70, 0x5e, 0x70, 0xe3, 0x3f, 0x78, 0x05, 0xda, 0xcf, 0x4f,
+	0x9c, 0x9a, 0x3c, 0x37, 0x7d, 0x99, 0xe7, 0x76, 0x59, 0x9d, 0x6c, 0x35, 0xd5, 0x98, 0xfd, 0x20,
+	0xad, 0x7f, 0xa1, 0x56, 0x19, 0x82, 0x05, 0x7a, 0x8c, 0x05, 0x5f, 0x81, 0xd8, 0xd9, 0xa6, 0x22,
+	0xc5, 0x12, 0x1f, 0xe8, 0xbe, 0xfa, 0x5a, 0x87, 0x86, 0xd4, 0xa7, 0x96, 0xf6, 0x65, 0xb6, 0x6d,
+	0x5c, 0xeb, 0xc5, 0x3a, 0x17, 0xf5, 0x63, 0xfd, 0x7c, 0x92, 0xab, 0x25, 0xef, 0x0d, 0xcc, 0x11,
+	0xe4, 0xab, 0x28, 0x7d, 0x4c, 0x4a, 0x9a, 0x6d, 0x62, 0xd2, 0x40, 0xfb, 0x05, 0xb6, 0x7d, 0xcf,
+	0x41, 0xb2, 0x9e, 0xa2, 0x8b, 0x19, 0xd8, 0x8f, 0xf5, 0x51, 0xd1, 0x59, 0x86, 0xb0, 0x7b, 0x85,
+	0xb2, 0x10, 0x57, 0x1d, 0x20, 0x53, 0x1d, 0xe2, 0x6d, 0x06, 0x51, 0x8b, 0xd5, 0xb2, 0xde, 0x65,
+	0x0d, 0x42, 0x6a, 0x32, 0x98, 0x42, 0xab, 0x09, 0xd2, 0x8f, 0xf5, 0x61, 0xb1, 0xb5, 0x44, 0x0c,
+	0x4d, 0x9d, 0x2f, 0x48, 0x70, 0xc9, 0x0e, 0x7d, 0x47, 0x51, 0x2f, 0x64, 0x19, 0x78, 0xfa, 0x0f,
+	0x9f, 0xf6, 0x15, 0x96, 0x82, 0x8f, 0xf1, 0x61, 0x9a, 0x4f, 0xf1, 0xd9, 0x04, 0x66, 0x99, 0xc5,
+	0x90, 0x55, 0x14, 0x66, 0x67, 0x93, 0x92, 0x5c, 0x9a, 0x8d, 0x97, 0x8d, 0xd1, 0x2f, 0xab, 0xaf,
+	0xa7, 0xbf, 0xfa, 0x05, 0xda, 0x0c, 0x9b, 0x23, 0x43, 0xbc, 0xf1, 0xa7, 0x89, 0x7c, 0xf6, 0x46,
+	0x3a, 0x33, 0x32, 0xc5, 0x6c, 0xb4, 0x52, 0x01, 0x90, 0x7d, 0x2d, 0x7d, 0xc6, 0x99, 0x16, 0xfa,
+	0x55, 0x75, 0x98, 0x44, 0x96, 0xcd, 0xee, 0x61, 0xed, 0xfc, 0xd6, 0x7e, 0x96, 0x8d, 0xfe, 0x14,
+	0x0c, 0x1d, 0x83, 0xd7, 0x00, 0xcd, 0x8f, 0x9f, 0x63, 0xe9, 0xcd, 0x43, 0x09, 0x69, 0xe0, 0xaa,
+	0x36, 0xfa, 0x5f, 0x45, 0xd5, 0xc4, 0x26, 0xd8, 0x3f, 0x35, 0xf6, 0x87, 0x70, 0xa2, 0x6b, 0x69,
+	0x73, 0xec, 0xfb, 0xfc, 0x49, 0x92, 0x36, 0x67, 0xa6, 0xcb, 0x64, 0x17, 0xca, 0xbd, 0xcb, 0xec,
+	0x08, 0x31, 0x42, 0x24, 0x72, 0xe1, 0xf6, 0xa3, 0x0a, 0xd6, 0x5d, 0xfc, 0xca, 0x3d, 0xd5, 0xc8,
+	0xb3, 0xad, 0x5b, 0x1a, 0x17, 0x96, 0xd9, 0xb4, 0x90, 0xa3, 0x5e, 0x2a, 0x53, 0x4d, 0x2e, 0x18,
+	0xe6, 0xf3, 0xec, 0xaf, 0x60, 0xc8, 0x6f, 0x18, 0xb4, 0x2a, 0x19, 0x06, 0xe5, 0xd9, 0x5f, 0x15,
+	0x43, 0xbf, 0xa6, 0x0e, 0x44, 0x5d, 0xb7, 0x9b, 0x0d, 0xda, 0x9f, 0x2f, 0xb0, 0x51, 0xfb, 0xa5,
+	0xc3, 0x58, 0xbf, 0x94, 0xd7, 0xaf, 0xd6, 0x57, 0xdc, 0x95, 0xbc, 0xa2, 0xa0, 0xdc, 0xcc, 0xd2,
+	0x5b, 0xb0, 0x4d, 0x01, 0xa1, 0x66, 0xb5, 0x77, 0xd0, 0x94, 0x1b, 0x6b, 0x0a, 0x3e, 0x27, 0x98,
+	0xa0, 0x3f, 0x55, 0xd2, 0xe6, 0xf9, 0x1f, 0x14, 0x1f, 0x2f, 0x30, 0x92, 0x1f, 0xb1, 0xc1, 0x2c,
+	0xba, 0xc8, 0xfe, 0xa6, 0x60, 0xcd, 0x4f, 0x64, 0xcd, 0x8b, 0x7f, 0x41, 0x08, 0x31, 0xe4, 0x43,
+	0x76, 0xa5, 0x5e, 0x0b, 0x86, 0x46, 0xd6, 0x8a, 0xa6, 0x60, 0x35, 0xb7, 0x42, 0x3f, 0x51, 0xd4,
+	0x41, 0x16, 0x66, 0xfe, 0xaf, 0xc4, 0x5f, 0x24, 0x81, 0xfe, 0x36, 0xab, 0x89, 0x16, 0x5d, 0x08,
+	0xff, 0x4d, 0x28, 0x37, 0xb3, 0xe3, 0x3c, 0xd8, 0x17, 0xff, 0x74, 0x90, 0x06, 0x7b, 0xed, 0x28,
+	0x3d, 0xa8, 0x7c, 0xca, 0xdb, 0xd2, 0x14, 0x3c, 0x20, 0x5a, 0xe6, 0x21, 0xe7, 0x7f, 0x44, 0xfc,
+	0xa8, 0x3e, 0x64, 0xe1, 0xef, 0x88, 0x52, 0xc8, 0xc5, 0xff, 0x19, 0xea, 0x43, 0xae, 0xd3, 0xab,
+	0x86, 0xcc, 0x35, 0x79, 0xc8, 0xfc, 0x1d, 0xb5, 0xd5, 0xe4, 0xcf, 0xab, 0xac, 0x64, 0xf2, 0x97,
+	0x0b, 0x6c, 0x2d, 0xfe, 0x4a, 0x31, 0x5e, 0xb6, 0x7d, 0xe7, 0xb5, 0x13, 0x61, 0x32, 0xfa, 0x39,
+	0x52, 0x2c, 0xa0, 0x0e, 0x08, 0x48, 0xc0, 0x2e, 0xac, 0xaa, 0x77, 0x45, 0x46, 0xd7, 0x0c, 0xb5,
+	0x1f, 0x43, 0x17, 0x29, 0xb3, 0xcb, 0x87, 0xb1, 0x7e, 0x2d, 0x6f, 0x71, 0xb9, 0x78, 0xd3, 0xb3,
+	0x62, 0x86, 0xc5, 0x7e, 0xea, 0x54, 0xf0, 0x62, 0xf3, 0xa8, 0xaa, 0x00, 0xf5, 0xa1, 0x91, 0x52,
+	0x75, 0x24, 0x30, 0x89, 0x1b, 0x68, 0x7f, 0x95, 0x8c, 0xd2, 0x5a, 0x29, 0x04, 0xb1, 0xaa, 0xb0,
+	0x0a, 0x8a, 0xa5, 0x10, 0x2a, 0x78, 0x75, 0xa8, 0x58, 0x24, 0x15, 0xbd, 0xd9, 0x47, 0x9f, 0x7c,
+	0x3a, 0x7e, 0xe2, 0xe0, 0xd3, 0xf1, 0x13, 0x9f, 0x1c, 0x8e, 0x2b, 0x07, 0x87, 0xe3, 0xca, 0xf7,
+	0x5e, 0x8c, 0x9f, 0xf8, 0xe1, 0x8b, 0x71, 0xe5, 0xe0, 0xc5, 0xf8, 0x89, 0x7f, 0x7b, 0x31, 0x7e,
+	0xe2, 0xeb, 0x6f, 0x6e, 0xd8, 0xe1, 0x66, 0xd4, 0xba, 0x65, 0x7a, 0x9d, 0xdb, 0x59, 0xcd, 0x52,
+	0x78, 0xca, 0xff, 0x26, 0x6f, 0x9d, 0x61, 0xff, 0x8e, 0xdf, 0xfd, 0xd9, 0x00, 0xc9, 0xb1, 0xc0,
+	0xd4, 0x07, 0x2f, 0x00, 0x00,
 }
 
 func (m *OptionsConfiguration) Marshal() (dAtA []byte, err error) {
@@ -458,6 +502,105 @@ func (m *OptionsConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.AuditTrailMaxFiles != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.AuditTrailMaxFiles))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.AuditTrailMaxSizeMiB != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.AuditTrailMaxSizeMiB))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.AuditTrailEnabled {
+		i--
+		if m.AuditTrailEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Webhooks) > 0 {
+		for iNdEx := len(m.Webhooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Webhooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOptionsconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.DatabaseBackend != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.DatabaseBackend))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.MeteredSubnets) > 0 {
+		for iNdEx := len(m.MeteredSubnets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MeteredSubnets[iNdEx])
+			copy(dAtA[i:], m.MeteredSubnets[iNdEx])
+			i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.MeteredSubnets[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.MeteredInterfaces) > 0 {
+		for iNdEx := len(m.MeteredInterfaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MeteredInterfaces[iNdEx])
+			copy(dAtA[i:], m.MeteredInterfaces[iNdEx])
+			i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.MeteredInterfaces[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.Metered {
+		i--
+		if m.Metered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.BandwidthSchedules) > 0 {
+		for iNdEx := len(m.BandwidthSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BandwidthSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOptionsconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
 	if m.ConnectionPriorityUpgradeThreshold != 0 {
 		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.ConnectionPriorityUpgradeThreshold))
 		i--
@@ -1159,6 +1302,45 @@ func (m *OptionsConfiguration) ProtoSize() (n int) {
 	if m.ConnectionPriorityUpgradeThreshold != 0 {
 		n += 2 + sovOptionsconfiguration(uint64(m.ConnectionPriorityUpgradeThreshold))
 	}
+	if len(m.BandwidthSchedules) > 0 {
+		for _, e := range m.BandwidthSchedules {
+			l = e.ProtoSize()
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	if m.Metered {
+		n += 3
+	}
+	if len(m.MeteredInterfaces) > 0 {
+		for _, s := range m.MeteredInterfaces {
+			l = len(s)
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	if len(m.MeteredSubnets) > 0 {
+		for _, s := range m.MeteredSubnets {
+			l = len(s)
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	if m.DatabaseBackend != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.DatabaseBackend))
+	}
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.ProtoSize()
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	if m.AuditTrailEnabled {
+		n += 3
+	}
+	if m.AuditTrailMaxSizeMiB != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.AuditTrailMaxSizeMiB))
+	}
+	if m.AuditTrailMaxFiles != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.AuditTrailMaxFiles))
+	}
 	if m.DeprecatedUPnPEnabled {
 		n += 4
 	}
@@ -2489,6 +2671,235 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandwidthSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BandwidthSchedules = append(m.BandwidthSchedules, BandwidthSchedule{})
+			if err := m.BandwidthSchedules[len(m.BandwidthSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Metered = bool(v != 0)
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeteredInterfaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MeteredInterfaces = append(m.MeteredInterfaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeteredSubnets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MeteredSubnets = append(m.MeteredSubnets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 64:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseBackend", wireType)
+			}
+			m.DatabaseBackend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseBackend |= DatabaseBackend(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 65:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, Webhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 66:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditTrailEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AuditTrailEnabled = bool(v != 0)
+		case 67:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditTrailMaxSizeMiB", wireType)
+			}
+			m.AuditTrailMaxSizeMiB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditTrailMaxSizeMiB |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 68:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditTrailMaxFiles", wireType)
+			}
+			m.AuditTrailMaxFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditTrailMaxFiles |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 9000:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPEnabled", wireType)
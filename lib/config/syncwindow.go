@@ -0,0 +1,49 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import "time"
+
+// ActiveAt returns true if the time falls within the window. Invalid
+// windows are never active.
+func (w SyncWindow) ActiveAt(t time.Time) bool {
+	return windowActiveAt(w.Days, w.Start, w.End, t)
+}
+
+// Validate returns an error describing what is wrong with the window, if
+// anything.
+func (w SyncWindow) Validate() error {
+	_, _, _, err := parseWindow(w.Days, w.Start, w.End)
+	return err
+}
+
+// InSyncWindow returns true if the folder should be syncing at the given
+// time, that is if it has no sync windows or one of them is active.
+func (f FolderConfiguration) InSyncWindow(t time.Time) bool {
+	if len(f.SyncWindows) == 0 {
+		return true
+	}
+	for _, w := range f.SyncWindows {
+		if w.ActiveAt(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// validSyncWindows returns the windows, less those that are invalid.
+func validSyncWindows(windows []SyncWindow, what string) []SyncWindow {
+	valid := windows[:0]
+	for _, w := range windows {
+		if err := w.Validate(); err != nil {
+			l.Warnf("Ignoring invalid sync window for %s: %v", what, err)
+			continue
+		}
+		valid = append(valid, w)
+	}
+	return valid
+}
@@ -0,0 +1,406 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/syncwindow.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A SyncWindow is a window of time on certain days of the week during which
+// a folder is synced. Days, start and end are given as for a
+// BandwidthSchedule.
+type SyncWindow struct {
+	Days  string `protobuf:"bytes,1,opt,name=days,proto3" json:"days" xml:"days,attr,omitempty"`
+	Start string `protobuf:"bytes,2,opt,name=start,proto3" json:"start" xml:"start,attr"`
+	End   string `protobuf:"bytes,3,opt,name=end,proto3" json:"end" xml:"end,attr"`
+}
+
+func (m *SyncWindow) Reset()         { *m = SyncWindow{} }
+func (m *SyncWindow) String() string { return proto.CompactTextString(m) }
+func (*SyncWindow) ProtoMessage()    {}
+func (*SyncWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2d14b1395a54c5b, []int{0}
+}
+func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindow.Merge(m, src)
+}
+func (m *SyncWindow) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SyncWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SyncWindow)(nil), "config.SyncWindow")
+}
+
+func init() { proto.RegisterFile("lib/config/syncwindow.proto", fileDescriptor_b2d14b1395a54c5b) }
+
+var fileDescriptor_b2d14b1395a54c5b = []byte{
+	// 267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0xc9, 0x4c, 0xd2,
+	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x2f, 0xae, 0xcc, 0x4b, 0x2e, 0xcf, 0xcc, 0x4b, 0xc9,
+	0x2f, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0x48, 0x48, 0x71, 0xa6, 0x56, 0x94,
+	0x40, 0x84, 0x94, 0x6e, 0x31, 0x72, 0x71, 0x05, 0x57, 0xe6, 0x25, 0x87, 0x83, 0xd5, 0x09, 0x05,
+	0x70, 0xb1, 0xa4, 0x24, 0x56, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0xd9, 0xbc, 0xba,
+	0x27, 0x0f, 0xe6, 0x7f, 0xba, 0x27, 0x2f, 0x59, 0x91, 0x9b, 0x63, 0xa5, 0x04, 0xe2, 0xe8, 0x24,
+	0x96, 0x94, 0x14, 0xe9, 0xe4, 0xe7, 0x66, 0x96, 0xa4, 0xe6, 0x16, 0x94, 0x54, 0x2a, 0xbd, 0x3a,
+	0xaf, 0x22, 0x8c, 0x45, 0x3c, 0x08, 0xac, 0x53, 0xc8, 0x91, 0x8b, 0xb5, 0xb8, 0x24, 0xb1, 0xa8,
+	0x44, 0x82, 0x09, 0x6c, 0xa4, 0xf6, 0xab, 0x7b, 0xf2, 0x10, 0x81, 0x4f, 0xf7, 0xe4, 0x05, 0xc0,
+	0x66, 0x82, 0x79, 0x60, 0xcd, 0x20, 0xa3, 0xb8, 0x10, 0xdc, 0x20, 0x88, 0x42, 0x21, 0x73, 0x2e,
+	0xe6, 0xd4, 0xbc, 0x14, 0x09, 0x66, 0xb0, 0x01, 0xaa, 0xaf, 0xee, 0xc9, 0x83, 0xb8, 0x9f, 0xee,
+	0xc9, 0xf3, 0x81, 0xb5, 0xa7, 0xe6, 0xa5, 0xc0, 0x35, 0x73, 0xc0, 0x38, 0x41, 0x20, 0x25, 0x4e,
+	0xde, 0x27, 0x1e, 0xca, 0x31, 0x5c, 0x78, 0x28, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
+	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x2c, 0x78, 0x2c, 0xc7, 0x78, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
+	0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xe0,
+	0xe0, 0x2a, 0xc9, 0xc8, 0xcc, 0x4b, 0x47, 0x62, 0x21, 0x42, 0x33, 0x89, 0x0d, 0x1c, 0x60, 0xc6,
+	0x80, 0x01, 0x00, 0x6d, 0x2e, 0xbd, 0xd6, 0x62, 0x01, 0x00, 0x00,
+}
+
+func (m *SyncWindow) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintSyncwindow(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintSyncwindow(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Days) > 0 {
+		i -= len(m.Days)
+		copy(dAtA[i:], m.Days)
+		i = encodeVarintSyncwindow(dAtA, i, uint64(len(m.Days)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSyncwindow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSyncwindow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SyncWindow) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Days)
+	if l > 0 {
+		n += 1 + l + sovSyncwindow(uint64(l))
+	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovSyncwindow(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovSyncwindow(uint64(l))
+	}
+	return n
+}
+
+func sovSyncwindow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSyncwindow(x uint64) (n int) {
+	return sovSyncwindow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SyncWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSyncwindow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyncwindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyncwindow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSyncwindow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Days = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyncwindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyncwindow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSyncwindow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSyncwindow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSyncwindow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSyncwindow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSyncwindow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSyncwindow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSyncwindow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSyncwindow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSyncwindow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSyncwindow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSyncwindow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSyncwindow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSyncwindow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSyncwindow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSyncwindow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSyncwindow = fmt.Errorf("proto: unexpected end of group")
+)
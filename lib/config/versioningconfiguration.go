@@ -22,6 +22,7 @@ type internalVersioningConfiguration struct {
 	CleanupIntervalS int               `xml:"cleanupIntervalS" default:"3600"`
 	FSPath           string            `xml:"fsPath"`
 	FSType           fs.FilesystemType `xml:"fsType"`
+	Retention        RetentionPolicy   `xml:"retention"`
 }
 
 type internalParam struct {
@@ -69,6 +70,7 @@ func (c *VersioningConfiguration) toInternal() internalVersioningConfiguration {
 	tmp.CleanupIntervalS = c.CleanupIntervalS
 	tmp.FSPath = c.FSPath
 	tmp.FSType = c.FSType
+	tmp.Retention = c.Retention
 	for k, v := range c.Params {
 		tmp.Params = append(tmp.Params, internalParam{k, v})
 	}
@@ -83,6 +85,7 @@ func (c *VersioningConfiguration) fromInternal(intCfg internalVersioningConfigur
 	c.CleanupIntervalS = intCfg.CleanupIntervalS
 	c.FSPath = intCfg.FSPath
 	c.FSType = intCfg.FSType
+	c.Retention = intCfg.Retention
 	c.Params = make(map[string]string, len(intCfg.Params))
 	for _, p := range intCfg.Params {
 		c.Params[p.Key] = p.Val
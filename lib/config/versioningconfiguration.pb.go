@@ -31,6 +31,7 @@ type VersioningConfiguration struct {
 	CleanupIntervalS int               `protobuf:"varint,3,opt,name=cleanup_interval_s,json=cleanupIntervalS,proto3,casttype=int" json:"cleanupIntervalS" xml:"cleanupIntervalS" default:"3600"`
 	FSPath           string            `protobuf:"bytes,4,opt,name=fs_path,json=fsPath,proto3" json:"fsPath" xml:"fsPath"`
 	FSType           fs.FilesystemType `protobuf:"varint,5,opt,name=fs_type,json=fsType,proto3,enum=fs.FilesystemType" json:"fsType" xml:"fsType"`
+	Retention        RetentionPolicy   `protobuf:"bytes,6,opt,name=retention,proto3" json:"retention" xml:"retention"`
 }
 
 func (m *VersioningConfiguration) Reset()         { *m = VersioningConfiguration{} }
@@ -66,9 +67,58 @@ func (m *VersioningConfiguration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VersioningConfiguration proto.InternalMessageInfo
 
+// RetentionPolicy decides which versions to keep in place of the
+// versioner's own parameters, when any of its rules are set. A version of
+// a file is kept if any of the rules keeps it: the last keep_last
+// versions, and the newest version of each of the last keep_daily days,
+// keep_weekly weeks and keep_monthly months. On top of that the oldest
+// versions of all files are removed while their total size exceeds
+// max_total_size, if set.
+type RetentionPolicy struct {
+	KeepLast     int  `protobuf:"varint,1,opt,name=keep_last,json=keepLast,proto3,casttype=int" json:"keepLast" xml:"keepLast"`
+	KeepDaily    int  `protobuf:"varint,2,opt,name=keep_daily,json=keepDaily,proto3,casttype=int" json:"keepDaily" xml:"keepDaily"`
+	KeepWeekly   int  `protobuf:"varint,3,opt,name=keep_weekly,json=keepWeekly,proto3,casttype=int" json:"keepWeekly" xml:"keepWeekly"`
+	KeepMonthly  int  `protobuf:"varint,4,opt,name=keep_monthly,json=keepMonthly,proto3,casttype=int" json:"keepMonthly" xml:"keepMonthly"`
+	MaxTotalSize Size `protobuf:"bytes,5,opt,name=max_total_size,json=maxTotalSize,proto3" json:"maxTotalSize" xml:"maxTotalSize"`
+}
+
+func (m *RetentionPolicy) Reset()         { *m = RetentionPolicy{} }
+func (m *RetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetentionPolicy) ProtoMessage()    {}
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ba6bdb22ffea81, []int{1}
+}
+func (m *RetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionPolicy.Merge(m, src)
+}
+func (m *RetentionPolicy) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionPolicy proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*VersioningConfiguration)(nil), "config.VersioningConfiguration")
 	proto.RegisterMapType((map[string]string)(nil), "config.VersioningConfiguration.ParametersEntry")
+	proto.RegisterType((*RetentionPolicy)(nil), "config.RetentionPolicy")
 }
 
 func init() {
@@ -76,40 +126,54 @@ func init() {
 }
 
 var fileDescriptor_95ba6bdb22ffea81 = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4f, 0x6b, 0xdb, 0x4e,
-	0x10, 0x95, 0xfc, 0xef, 0x87, 0x95, 0x1f, 0x4d, 0x59, 0x0a, 0x15, 0x3e, 0x68, 0x8d, 0x70, 0x8b,
-	0x0a, 0x45, 0x0e, 0x09, 0x94, 0x62, 0x0a, 0x05, 0x97, 0xa6, 0x94, 0xf6, 0x10, 0x94, 0xd0, 0x43,
-	0x7b, 0x30, 0x6b, 0x77, 0x65, 0x2f, 0x91, 0x57, 0x42, 0xbb, 0x36, 0x51, 0x3f, 0x45, 0xe8, 0x27,
-	0xe8, 0xc7, 0xf1, 0xcd, 0x3e, 0xf6, 0xb4, 0x10, 0xfb, 0xa6, 0xa3, 0x8e, 0xe9, 0xa5, 0xec, 0xae,
-	0xa2, 0x9a, 0x94, 0xde, 0xe6, 0xcd, 0x7b, 0xf3, 0x66, 0x46, 0xb3, 0xb2, 0xbc, 0x88, 0x8c, 0xfb,
-	0x93, 0x98, 0x86, 0x64, 0xda, 0x5f, 0xe2, 0x94, 0x91, 0x98, 0x12, 0x3a, 0xd5, 0x89, 0x45, 0x8a,
-	0x38, 0x89, 0xa9, 0x9f, 0xa4, 0x31, 0x8f, 0x41, 0x4b, 0x27, 0x3b, 0x40, 0x56, 0x84, 0xac, 0xcf,
-	0xb3, 0x04, 0x33, 0xcd, 0x75, 0xda, 0xf8, 0x8a, 0xeb, 0xd0, 0xfd, 0xd5, 0xb0, 0x1e, 0x7f, 0xaa,
-	0x8c, 0xde, 0xec, 0x1b, 0x81, 0x57, 0x56, 0x43, 0x56, 0xd9, 0x66, 0xd7, 0xf4, 0xda, 0x43, 0x2f,
-	0x17, 0x50, 0xe1, 0x42, 0xc0, 0xc3, 0xab, 0x79, 0x34, 0x70, 0x25, 0x78, 0x8e, 0x38, 0x4f, 0xdd,
-	0x7c, 0xdd, 0x6b, 0x57, 0x28, 0x50, 0x2a, 0x70, 0x6d, 0x5a, 0x56, 0x82, 0x52, 0x34, 0xc7, 0x1c,
-	0xa7, 0xcc, 0xae, 0x75, 0xeb, 0xde, 0xc1, 0x71, 0xdf, 0xd7, 0x63, 0xf9, 0xff, 0xe8, 0xe9, 0x9f,
-	0x55, 0x15, 0x6f, 0x29, 0x4f, 0xb3, 0xe1, 0xeb, 0x95, 0x80, 0xc6, 0x56, 0xc0, 0x96, 0x22, 0x58,
-	0x2e, 0x60, 0x4b, 0x99, 0xb2, 0x6a, 0x8a, 0xaa, 0x87, 0x5b, 0xac, 0x7b, 0x25, 0xf9, 0x7d, 0xd3,
-	0x2b, 0x0b, 0x82, 0xbd, 0x19, 0xc0, 0x37, 0x0b, 0x4c, 0x22, 0x8c, 0xe8, 0x22, 0x19, 0x11, 0xca,
-	0x71, 0xba, 0x44, 0xd1, 0x88, 0xd9, 0xf5, 0xae, 0xe9, 0x35, 0x87, 0x1f, 0x73, 0x01, 0x1f, 0x96,
-	0xec, 0xfb, 0x92, 0x3c, 0x2f, 0x04, 0x7c, 0xa2, 0x9a, 0xdc, 0x27, 0xdc, 0xee, 0x57, 0x1c, 0xa2,
-	0x45, 0xc4, 0x07, 0xee, 0xc9, 0x8b, 0xa3, 0x23, 0xf7, 0x56, 0xc0, 0x3a, 0xa1, 0xfc, 0x76, 0xdd,
-	0x6b, 0x48, 0x1c, 0xfc, 0xe5, 0x04, 0xde, 0x59, 0xff, 0x85, 0x6c, 0x94, 0x20, 0x3e, 0xb3, 0x1b,
-	0xea, 0x7b, 0xfa, 0x72, 0xab, 0xd3, 0xf3, 0x33, 0xc4, 0x67, 0x72, 0xab, 0x90, 0xc9, 0xa8, 0x10,
-	0xf0, 0x7f, 0xd5, 0x50, 0x43, 0x57, 0x2e, 0xa2, 0x35, 0x41, 0xa9, 0x00, 0x5f, 0x94, 0x91, 0x3a,
-	0x4c, 0xb3, 0x6b, 0x7a, 0x0f, 0x8e, 0x81, 0x1f, 0x32, 0xff, 0x94, 0x44, 0x98, 0x65, 0x8c, 0xe3,
-	0xf9, 0x45, 0x96, 0xe0, 0x3b, 0x73, 0x19, 0x6b, 0xf3, 0x0b, 0x7d, 0xb8, 0x3b, 0x73, 0x09, 0x4b,
-	0x73, 0x19, 0x06, 0xa5, 0xa2, 0x33, 0xb7, 0x0e, 0xef, 0x5d, 0x00, 0x3c, 0xb5, 0xea, 0x97, 0x38,
-	0x2b, 0x1f, 0xc1, 0xa3, 0x5c, 0x40, 0x09, 0x0b, 0x01, 0xdb, 0xca, 0xea, 0x12, 0x67, 0x6e, 0x20,
-	0x33, 0xc0, 0xb7, 0x9a, 0x4b, 0x14, 0x2d, 0xb0, 0x5d, 0x53, 0x4a, 0x3b, 0x17, 0x50, 0x27, 0x0a,
-	0x01, 0x0f, 0x94, 0x56, 0x21, 0x37, 0xd0, 0xd9, 0x41, 0xed, 0xa5, 0x39, 0xfc, 0xb0, 0xba, 0x71,
-	0x8c, 0xcd, 0x8d, 0x63, 0xac, 0xb6, 0x8e, 0xb9, 0xd9, 0x3a, 0xe6, 0xf5, 0xce, 0x31, 0x7e, 0xec,
-	0x1c, 0x73, 0xb3, 0x73, 0x8c, 0x9f, 0x3b, 0xc7, 0xf8, 0xfc, 0x6c, 0x4a, 0xf8, 0x6c, 0x31, 0xf6,
-	0x27, 0xf1, 0xbc, 0xcf, 0x32, 0x3a, 0xe1, 0x33, 0x42, 0xa7, 0x7b, 0xd1, 0x9f, 0xff, 0x61, 0xdc,
-	0x52, 0x2f, 0xfa, 0xe4, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x04, 0xd0, 0xd5, 0x24, 0x03,
-	0x00, 0x00,
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6b, 0xdb, 0x48,
+	0x14, 0xc7, 0xad, 0x38, 0xf6, 0xae, 0xc7, 0x26, 0xc9, 0x0e, 0xbb, 0xc4, 0xe4, 0xa0, 0x31, 0xc2,
+	0xd9, 0x75, 0x76, 0x17, 0x39, 0x38, 0x50, 0x4a, 0x28, 0x14, 0xd4, 0x36, 0xa5, 0x6d, 0x5a, 0x82,
+	0x12, 0x5a, 0x68, 0xa0, 0x46, 0x71, 0xc6, 0xb6, 0xb0, 0x7e, 0x18, 0xcd, 0x38, 0xb5, 0xfc, 0x57,
+	0x84, 0xde, 0x7a, 0xeb, 0x9f, 0x93, 0x5b, 0x7c, 0xcc, 0x69, 0x20, 0xf1, 0x4d, 0x47, 0x1d, 0x73,
+	0x2a, 0x33, 0x23, 0x8f, 0xdd, 0x94, 0xde, 0xe6, 0x7d, 0xdf, 0x77, 0x3e, 0x6f, 0x66, 0xde, 0x93,
+	0x40, 0xc3, 0x73, 0xcf, 0x9a, 0x9d, 0x30, 0xe8, 0xba, 0xbd, 0xe6, 0x05, 0x8e, 0x88, 0x1b, 0x06,
+	0x6e, 0xd0, 0x93, 0xc2, 0x28, 0x72, 0xa8, 0x1b, 0x06, 0xe6, 0x30, 0x0a, 0x69, 0x08, 0x8b, 0x52,
+	0xdc, 0xfa, 0x6b, 0x69, 0x07, 0x71, 0x27, 0x58, 0xa6, 0xb7, 0x20, 0x97, 0xbb, 0xa4, 0x49, 0xe3,
+	0x21, 0x26, 0x99, 0x56, 0xc2, 0x63, 0x2a, 0x97, 0xc6, 0x4d, 0x01, 0x6c, 0xbe, 0x57, 0xfc, 0x67,
+	0xcb, 0x7c, 0xf8, 0x04, 0xac, 0xf2, 0x5d, 0x55, 0xad, 0xa6, 0x35, 0x4a, 0x56, 0x23, 0x61, 0x48,
+	0xc4, 0x29, 0x43, 0xeb, 0x63, 0xdf, 0xdb, 0x37, 0x78, 0xf0, 0xbf, 0x43, 0x69, 0x64, 0x24, 0xd7,
+	0xf5, 0x92, 0x8a, 0x6c, 0xe1, 0x82, 0x97, 0x1a, 0x00, 0x43, 0x27, 0x72, 0x7c, 0x4c, 0x71, 0x44,
+	0xaa, 0x2b, 0xb5, 0x7c, 0xa3, 0xdc, 0x6a, 0x9a, 0xf2, 0x84, 0xe6, 0x2f, 0x6a, 0x9a, 0x47, 0x6a,
+	0xc7, 0x8b, 0x80, 0x46, 0xb1, 0xf5, 0xf4, 0x8a, 0xa1, 0xdc, 0x1d, 0x43, 0x45, 0x91, 0x20, 0x09,
+	0x43, 0x45, 0x01, 0x25, 0xea, 0x14, 0xaa, 0x86, 0x91, 0x5e, 0xd7, 0xb3, 0xe4, 0x97, 0x69, 0x3d,
+	0xdb, 0x60, 0x2f, 0x9d, 0x01, 0x4e, 0x00, 0xec, 0x78, 0xd8, 0x09, 0x46, 0xc3, 0xb6, 0x1b, 0x50,
+	0x1c, 0x5d, 0x38, 0x5e, 0x9b, 0x54, 0xf3, 0x35, 0xad, 0x51, 0xb0, 0x0e, 0x13, 0x86, 0x36, 0xb2,
+	0xec, 0xab, 0x2c, 0x79, 0x9c, 0x32, 0xb4, 0x2d, 0x8a, 0x3c, 0x4c, 0x18, 0xb5, 0x73, 0xdc, 0x75,
+	0x46, 0x1e, 0xdd, 0x37, 0xf6, 0x1e, 0xed, 0xee, 0x1a, 0xf7, 0x0c, 0xe5, 0xdd, 0x80, 0xde, 0x5f,
+	0xd7, 0x57, 0x79, 0x6c, 0xff, 0x44, 0x82, 0x2f, 0xc1, 0x6f, 0x5d, 0xd2, 0x1e, 0x3a, 0xb4, 0x5f,
+	0x5d, 0x15, 0xef, 0x69, 0xf2, 0x5b, 0x1d, 0x1c, 0x1f, 0x39, 0xb4, 0xcf, 0x6f, 0xd5, 0x25, 0x7c,
+	0x95, 0x32, 0x54, 0x11, 0x05, 0x65, 0x68, 0xf0, 0x8b, 0x48, 0x8f, 0x9d, 0x39, 0xe0, 0xa9, 0x00,
+	0x89, 0xc6, 0x14, 0x6a, 0x5a, 0x63, 0xad, 0x05, 0xcd, 0x2e, 0x31, 0x0f, 0x5c, 0x0f, 0x93, 0x98,
+	0x50, 0xec, 0x9f, 0xc4, 0x43, 0x3c, 0x87, 0xf3, 0xb5, 0x84, 0x9f, 0xc8, 0xc6, 0xcd, 0xe1, 0x3c,
+	0xcc, 0xe0, 0x7c, 0x69, 0x67, 0x0e, 0x78, 0x0a, 0x4a, 0x11, 0xa6, 0x38, 0xe0, 0xbd, 0xa8, 0x16,
+	0x6b, 0x5a, 0xa3, 0xdc, 0xda, 0x9c, 0xb7, 0xcc, 0x9e, 0x27, 0x8e, 0x42, 0xcf, 0xed, 0xc4, 0x56,
+	0x9d, 0xb7, 0x26, 0x61, 0x68, 0xb1, 0x43, 0xf5, 0x44, 0x29, 0x86, 0xbd, 0xc8, 0x6e, 0xf9, 0x60,
+	0xfd, 0x41, 0x7b, 0xe1, 0xdf, 0x20, 0x3f, 0xc0, 0x71, 0x36, 0x61, 0x7f, 0x26, 0x0c, 0xf1, 0x30,
+	0x65, 0xa8, 0x24, 0x30, 0x03, 0x1c, 0x1b, 0x36, 0x57, 0xa0, 0x09, 0x0a, 0x17, 0x8e, 0x37, 0xc2,
+	0xd5, 0x15, 0xe1, 0xac, 0x26, 0x0c, 0x49, 0x21, 0x65, 0xa8, 0x2c, 0xbc, 0x22, 0x32, 0x6c, 0xa9,
+	0xee, 0xaf, 0x3c, 0xd6, 0x8c, 0xaf, 0x79, 0xb0, 0xfe, 0xe0, 0xcc, 0xd0, 0x02, 0xa5, 0x01, 0xc6,
+	0xc3, 0xb6, 0xe7, 0x10, 0x2a, 0xaa, 0x16, 0xac, 0xed, 0x84, 0xa1, 0xdf, 0xb9, 0x78, 0xe8, 0x10,
+	0x9a, 0x32, 0xb4, 0x96, 0x95, 0x96, 0xc2, 0xbc, 0xb3, 0xb6, 0xb2, 0xc0, 0x03, 0x00, 0x04, 0xe3,
+	0xdc, 0x71, 0xbd, 0x58, 0x1c, 0xa8, 0x60, 0xfd, 0xc3, 0xdf, 0x81, 0xab, 0xcf, 0xb9, 0xa8, 0xde,
+	0x41, 0x29, 0x0a, 0xb3, 0x30, 0xc1, 0xd7, 0xa0, 0x2c, 0x38, 0x9f, 0x31, 0x1e, 0x78, 0x71, 0x36,
+	0x86, 0x3b, 0x09, 0x43, 0x02, 0xff, 0x41, 0xa8, 0x29, 0x43, 0x1b, 0x8a, 0x24, 0x25, 0x85, 0x5a,
+	0xb2, 0xc1, 0x77, 0xa0, 0x22, 0x58, 0x7e, 0x18, 0xd0, 0xbe, 0x17, 0x8b, 0x11, 0x2b, 0x58, 0xff,
+	0x25, 0x0c, 0x89, 0x1a, 0x6f, 0xa5, 0x9c, 0x32, 0xf4, 0x87, 0xa2, 0x65, 0x9a, 0xc2, 0x2d, 0x1b,
+	0xe1, 0x27, 0xb0, 0xe6, 0x3b, 0xe3, 0x36, 0x0d, 0x29, 0xff, 0x44, 0xdc, 0x89, 0x9c, 0xb5, 0x72,
+	0xab, 0x32, 0x1f, 0x86, 0x63, 0x77, 0x82, 0xad, 0x7f, 0xb3, 0x09, 0xa8, 0xf8, 0xce, 0xf8, 0x84,
+	0x5b, 0xb9, 0x9a, 0x32, 0x04, 0x45, 0x91, 0x65, 0xd1, 0xb0, 0x7f, 0xf0, 0x58, 0x6f, 0xae, 0x6e,
+	0xf5, 0xdc, 0xf4, 0x56, 0xcf, 0x5d, 0xdd, 0xe9, 0xda, 0xf4, 0x4e, 0xd7, 0x2e, 0x67, 0x7a, 0xee,
+	0xdb, 0x4c, 0xd7, 0xa6, 0x33, 0x3d, 0x77, 0x33, 0xd3, 0x73, 0x1f, 0x77, 0x7a, 0x2e, 0xed, 0x8f,
+	0xce, 0xcc, 0x4e, 0xe8, 0x37, 0x49, 0x1c, 0x74, 0x68, 0xdf, 0x0d, 0x7a, 0x4b, 0xab, 0xc5, 0xdf,
+	0xee, 0xac, 0x28, 0x7e, 0x65, 0x7b, 0xdf, 0x07, 0x00, 0x6b, 0x04, 0x6e, 0x7e, 0x34, 0x05, 0x00,
+	0x00,
 }
 
 func (m *VersioningConfiguration) Marshal() (dAtA []byte, err error) {
@@ -132,6 +196,16 @@ func (m *VersioningConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVersioningconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.FSType != 0 {
 		i = encodeVarintVersioningconfiguration(dAtA, i, uint64(m.FSType))
 		i--
@@ -178,6 +252,59 @@ func (m *VersioningConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxTotalSize.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVersioningconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.KeepMonthly != 0 {
+		i = encodeVarintVersioningconfiguration(dAtA, i, uint64(m.KeepMonthly))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.KeepWeekly != 0 {
+		i = encodeVarintVersioningconfiguration(dAtA, i, uint64(m.KeepWeekly))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeepDaily != 0 {
+		i = encodeVarintVersioningconfiguration(dAtA, i, uint64(m.KeepDaily))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeepLast != 0 {
+		i = encodeVarintVersioningconfiguration(dAtA, i, uint64(m.KeepLast))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVersioningconfiguration(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVersioningconfiguration(v)
 	base := offset
@@ -217,6 +344,31 @@ func (m *VersioningConfiguration) ProtoSize() (n int) {
 	if m.FSType != 0 {
 		n += 1 + sovVersioningconfiguration(uint64(m.FSType))
 	}
+	l = m.Retention.ProtoSize()
+	n += 1 + l + sovVersioningconfiguration(uint64(l))
+	return n
+}
+
+func (m *RetentionPolicy) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeepLast != 0 {
+		n += 1 + sovVersioningconfiguration(uint64(m.KeepLast))
+	}
+	if m.KeepDaily != 0 {
+		n += 1 + sovVersioningconfiguration(uint64(m.KeepDaily))
+	}
+	if m.KeepWeekly != 0 {
+		n += 1 + sovVersioningconfiguration(uint64(m.KeepWeekly))
+	}
+	if m.KeepMonthly != 0 {
+		n += 1 + sovVersioningconfiguration(uint64(m.KeepMonthly))
+	}
+	l = m.MaxTotalSize.ProtoSize()
+	n += 1 + l + sovVersioningconfiguration(uint64(l))
 	return n
 }
 
@@ -484,6 +636,198 @@ func (m *VersioningConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersioningconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVersioningconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersioningconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVersioningconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVersioningconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVersioningconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepLast", wireType)
+			}
+			m.KeepLast = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersioningconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepLast |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepDaily", wireType)
+			}
+			m.KeepDaily = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersioningconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepDaily |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepWeekly", wireType)
+			}
+			m.KeepWeekly = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersioningconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepWeekly |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepMonthly", wireType)
+			}
+			m.KeepMonthly = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersioningconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepMonthly |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersioningconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVersioningconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersioningconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVersioningconfiguration(dAtA[iNdEx:])
@@ -0,0 +1,68 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/syncthing/syncthing/lib/structutil"
+)
+
+func (w Webhook) Copy() Webhook {
+	c := w
+	c.Events = append([]string(nil), w.Events...)
+	c.Folders = append([]string(nil), w.Folders...)
+	c.Devices = append([]string(nil), w.Devices...)
+	return c
+}
+
+func (w *Webhook) UnmarshalJSON(data []byte) error {
+	structutil.SetDefaults(w)
+	type noCustomUnmarshal Webhook
+	ptr := (*noCustomUnmarshal)(w)
+	return json.Unmarshal(data, ptr)
+}
+
+func (w *Webhook) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	structutil.SetDefaults(w)
+	type noCustomUnmarshal Webhook
+	ptr := (*noCustomUnmarshal)(w)
+	return d.DecodeElement(ptr, &start)
+}
+
+// Validate returns an error describing what is wrong with the webhook, if
+// anything.
+func (w Webhook) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return errors.New("URL has no host")
+	}
+	return nil
+}
+
+// validWebhooks returns the webhooks, less those that are invalid.
+func validWebhooks(webhooks []Webhook) []Webhook {
+	valid := webhooks[:0]
+	for _, w := range webhooks {
+		if err := w.Validate(); err != nil {
+			l.Warnf("Ignoring invalid webhook %q: %v", w.URL, err)
+			continue
+		}
+		valid = append(valid, w)
+	}
+	return valid
+}
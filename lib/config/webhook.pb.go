@@ -0,0 +1,578 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/webhook.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A Webhook posts events as JSON to a URL, one event per request.
+type Webhook struct {
+	URL string `protobuf:"bytes,1,opt,name=url,proto3" json:"url" xml:"url,attr"`
+	// Event types, by name, to post. Empty means all events except the
+	// local and remote change detection ones, as for /rest/events.
+	Events []string `protobuf:"bytes,2,rep,name=events,proto3" json:"events" xml:"event"`
+	// When set, only events concerning one of the folders (by ID) or
+	// devices are posted.
+	Folders []string `protobuf:"bytes,3,rep,name=folders,proto3" json:"folders" xml:"folder"`
+	Devices []string `protobuf:"bytes,4,rep,name=devices,proto3" json:"devices" xml:"device"`
+	// When set, requests carry an X-Syncthing-Signature header with the
+	// HMAC-SHA256 of the body keyed with the secret, as "sha256=<hex>".
+	Secret string `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret" xml:"secret"`
+	// Failed requests are retried up to max_retries times, with the time
+	// between attempts doubling from a second up to a minute.
+	MaxRetries int `protobuf:"varint,6,opt,name=max_retries,json=maxRetries,proto3,casttype=int" json:"maxRetries" xml:"maxRetries" default:"5"`
+	TimeoutS   int `protobuf:"varint,7,opt,name=timeout_s,json=timeoutS,proto3,casttype=int" json:"timeoutS" xml:"timeoutS" default:"10"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bf7b7997c1e330e, []int{0}
+}
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Webhook)(nil), "config.Webhook")
+}
+
+func init() { proto.RegisterFile("lib/config/webhook.proto", fileDescriptor_7bf7b7997c1e330e) }
+
+var fileDescriptor_7bf7b7997c1e330e = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xc1, 0x6a, 0xdb, 0x30,
+	0x1c, 0xc6, 0xed, 0x7a, 0x71, 0x1a, 0x75, 0xec, 0xe0, 0x93, 0xe9, 0x86, 0x14, 0x44, 0x06, 0x19,
+	0x8c, 0xa6, 0xdd, 0x28, 0x0c, 0x5f, 0x06, 0xb9, 0xae, 0x27, 0x8d, 0x32, 0xd8, 0x0e, 0x25, 0x71,
+	0x94, 0x44, 0xcc, 0xb6, 0x86, 0x2c, 0x77, 0xd9, 0x5b, 0x8c, 0x3d, 0xc1, 0x1e, 0xa7, 0xb7, 0xe4,
+	0xb8, 0x93, 0xa0, 0xc9, 0xcd, 0x47, 0x1f, 0x03, 0x83, 0x22, 0xc9, 0x4e, 0x7c, 0xfb, 0xbe, 0x5f,
+	0xfe, 0xdf, 0x8f, 0x60, 0x04, 0xc2, 0x84, 0x4d, 0x47, 0x31, 0xcf, 0xe6, 0x6c, 0x31, 0xfa, 0x49,
+	0xa7, 0x4b, 0xce, 0xbf, 0x5f, 0xfc, 0x10, 0x5c, 0xf2, 0xc0, 0xb7, 0xf4, 0xbc, 0x47, 0x57, 0xd2,
+	0x22, 0xfc, 0xdf, 0x03, 0xdd, 0x2f, 0xf6, 0x28, 0xb8, 0x01, 0x5e, 0x21, 0x92, 0xd0, 0xed, 0xbb,
+	0xc3, 0xde, 0x38, 0xda, 0x2a, 0xe4, 0xdd, 0x92, 0x9b, 0x52, 0x21, 0x4d, 0x2b, 0x85, 0x5e, 0xac,
+	0xd2, 0x24, 0xc2, 0x85, 0x48, 0xde, 0x4e, 0xa4, 0x14, 0xb8, 0x5c, 0x0f, 0x4e, 0x9b, 0x52, 0xad,
+	0x07, 0xfa, 0xe8, 0xcf, 0x66, 0xa0, 0x27, 0x44, 0xe7, 0x20, 0x02, 0x3e, 0xbd, 0xa7, 0x99, 0xcc,
+	0xc3, 0x93, 0xbe, 0x37, 0xec, 0x8d, 0x71, 0xa9, 0x50, 0x4d, 0x2a, 0x85, 0xce, 0x8c, 0xcc, 0x54,
+	0x6d, 0xea, 0x98, 0x44, 0xea, 0xdf, 0x83, 0x8f, 0xa0, 0x3b, 0xe7, 0xc9, 0x8c, 0x8a, 0x3c, 0xf4,
+	0xcc, 0xf8, 0x75, 0xa9, 0x50, 0x83, 0x2a, 0x85, 0x9e, 0x9b, 0xb5, 0xed, 0x7a, 0xee, 0xdb, 0x48,
+	0x9a, 0x13, 0x2d, 0x98, 0xd1, 0x7b, 0x16, 0xd3, 0x3c, 0x7c, 0x76, 0x14, 0xd4, 0xe8, 0x20, 0xb0,
+	0xdd, 0x08, 0x6c, 0x24, 0xcd, 0x49, 0xf0, 0x0e, 0xf8, 0x39, 0x8d, 0x05, 0x95, 0x61, 0xc7, 0x7c,
+	0x8e, 0x73, 0xfd, 0xef, 0x2d, 0x39, 0xcc, 0x6d, 0xc5, 0xa4, 0xe6, 0xc1, 0x37, 0x70, 0x96, 0x4e,
+	0x56, 0x77, 0x82, 0x4a, 0xc1, 0x68, 0x1e, 0xfa, 0x7d, 0x77, 0xd8, 0x19, 0x47, 0xa5, 0x42, 0x20,
+	0x9d, 0xac, 0x88, 0xa5, 0x95, 0x42, 0xaf, 0xcc, 0xf8, 0x88, 0x70, 0x7f, 0x46, 0xe7, 0x93, 0x22,
+	0x91, 0x11, 0xbe, 0xc6, 0x7b, 0x85, 0x3c, 0x96, 0xc9, 0xfd, 0x7a, 0xe0, 0x5e, 0x93, 0xd6, 0x2e,
+	0xb8, 0x05, 0x3d, 0xc9, 0x52, 0xca, 0x0b, 0x79, 0x97, 0x87, 0x5d, 0xa3, 0xfe, 0x50, 0x2a, 0x74,
+	0x5a, 0xc3, 0xcf, 0x95, 0x42, 0x2f, 0x8d, 0xb8, 0x01, 0x2d, 0xed, 0xd5, 0x65, 0xcb, 0x7b, 0x72,
+	0x75, 0x49, 0x0e, 0xab, 0xf1, 0xa7, 0x87, 0x47, 0xe8, 0x6c, 0x1e, 0xa1, 0xf3, 0xb0, 0x85, 0xee,
+	0x66, 0x0b, 0xdd, 0xdf, 0x3b, 0xe8, 0xfc, 0xdd, 0x41, 0x77, 0xb3, 0x83, 0xce, 0xbf, 0x1d, 0x74,
+	0xbe, 0xbe, 0x59, 0x30, 0xb9, 0x2c, 0xa6, 0x17, 0x31, 0x4f, 0x47, 0xf9, 0xaf, 0x2c, 0x96, 0x4b,
+	0x96, 0x2d, 0x5a, 0xe9, 0xf8, 0xda, 0xa6, 0xbe, 0x79, 0x53, 0xef, 0x9f, 0x06, 0x00, 0xcb, 0xba,
+	0x44, 0xc2, 0x82, 0x02, 0x00, 0x00,
+}
+
+func (m *Webhook) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Webhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Webhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutS != 0 {
+		i = encodeVarintWebhook(dAtA, i, uint64(m.TimeoutS))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintWebhook(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintWebhook(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Devices[iNdEx])
+			copy(dAtA[i:], m.Devices[iNdEx])
+			i = encodeVarintWebhook(dAtA, i, uint64(len(m.Devices[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintWebhook(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
+			copy(dAtA[i:], m.Events[iNdEx])
+			i = encodeVarintWebhook(dAtA, i, uint64(len(m.Events[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintWebhook(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWebhook(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWebhook(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Webhook) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovWebhook(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovWebhook(uint64(l))
+		}
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovWebhook(uint64(l))
+		}
+	}
+	if len(m.Devices) > 0 {
+		for _, s := range m.Devices {
+			l = len(s)
+			n += 1 + l + sovWebhook(uint64(l))
+		}
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovWebhook(uint64(l))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovWebhook(uint64(m.MaxRetries))
+	}
+	if m.TimeoutS != 0 {
+		n += 1 + sovWebhook(uint64(m.TimeoutS))
+	}
+	return n
+}
+
+func sovWebhook(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWebhook(x uint64) (n int) {
+	return sovWebhook(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Webhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebhook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Webhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Webhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutS", wireType)
+			}
+			m.TimeoutS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebhook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebhook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWebhook(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWebhook
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWebhook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWebhook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWebhook
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWebhook
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWebhook
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWebhook        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWebhook          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWebhook = fmt.Errorf("proto: unexpected end of group")
+)
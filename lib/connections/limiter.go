@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"sync/atomic"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/protocol"
@@ -23,6 +24,8 @@ import (
 type limiter struct {
 	myID                protocol.DeviceID
 	mu                  sync.Mutex
+	current             config.Configuration // as last committed to the limiter
+	evaluatedAt         time.Time            // when the bandwidth schedules were last evaluated
 	write               *rate.Limiter
 	read                *rate.Limiter
 	limitsLAN           atomic.Bool
@@ -57,20 +60,43 @@ func newLimiter(myId protocol.DeviceID, cfg config.Wrapper) *limiter {
 	return l
 }
 
+// serve re-evaluates the bandwidth schedules as time passes. Schedules
+// have a granularity of one minute, so we look at the start of every
+// minute.
+func (lim *limiter) serve(ctx context.Context) error {
+	for {
+		now := time.Now()
+		timer := time.NewTimer(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+
+		// The configuration last committed rather than that of the
+		// wrapper, which may be ahead of a commit still to come.
+		lim.mu.Lock()
+		lim.applyLocked(lim.current, lim.current, time.Now())
+		lim.mu.Unlock()
+	}
+}
+
 // This function sets limiters according to corresponding DeviceConfiguration
-func (lim *limiter) setLimitsLocked(device config.DeviceConfiguration) bool {
+func (lim *limiter) setLimitsLocked(device config.DeviceConfiguration, now time.Time) bool {
 	readLimiter := lim.getReadLimiterLocked(device.DeviceID)
 	writeLimiter := lim.getWriteLimiterLocked(device.DeviceID)
 
 	// limiters for this device are created so we can store previous rates for logging
+	limits := device.BandwidthLimitsAt(now)
 	previousReadLimit := readLimiter.Limit()
 	previousWriteLimit := writeLimiter.Limit()
-	currentReadLimit := rate.Limit(device.MaxRecvKbps) * 1024
-	currentWriteLimit := rate.Limit(device.MaxSendKbps) * 1024
-	if device.MaxSendKbps <= 0 {
+	currentReadLimit := rate.Limit(limits.MaxRecvKbps) * 1024
+	currentWriteLimit := rate.Limit(limits.MaxSendKbps) * 1024
+	if limits.MaxSendKbps <= 0 {
 		currentWriteLimit = rate.Inf
 	}
-	if device.MaxRecvKbps <= 0 {
+	if limits.MaxRecvKbps <= 0 {
 		currentReadLimit = rate.Inf
 	}
 	// Nothing about this device has changed. Start processing next device
@@ -85,7 +111,7 @@ func (lim *limiter) setLimitsLocked(device config.DeviceConfiguration) bool {
 }
 
 // This function handles removing, adding and updating of device limiters.
-func (lim *limiter) processDevicesConfigurationLocked(from, to config.Configuration) {
+func (lim *limiter) processDevicesConfigurationLocked(from, to config.Configuration, now time.Time) {
 	seen := make(map[protocol.DeviceID]struct{})
 
 	// Mark devices which should not be removed, create new limiters if needed and assign new limiter rate
@@ -96,17 +122,18 @@ func (lim *limiter) processDevicesConfigurationLocked(from, to config.Configurat
 		}
 		seen[dev.DeviceID] = struct{}{}
 
-		if lim.setLimitsLocked(dev) {
+		if lim.setLimitsLocked(dev, now) {
+			limits := dev.BandwidthLimitsAt(now)
 			readLimitStr := "is unlimited"
-			if dev.MaxRecvKbps > 0 {
-				readLimitStr = fmt.Sprintf("limit is %d KiB/s", dev.MaxRecvKbps)
+			if limits.MaxRecvKbps > 0 {
+				readLimitStr = fmt.Sprintf("limit is %d KiB/s", limits.MaxRecvKbps)
 			}
 			writeLimitStr := "is unlimited"
-			if dev.MaxSendKbps > 0 {
-				writeLimitStr = fmt.Sprintf("limit is %d KiB/s", dev.MaxSendKbps)
+			if limits.MaxSendKbps > 0 {
+				writeLimitStr = fmt.Sprintf("limit is %d KiB/s", limits.MaxSendKbps)
 			}
 
-			l.Infof("Device %s send rate %s, receive rate %s", dev.DeviceID, writeLimitStr, readLimitStr)
+			l.Infof("Device %s send rate %s, receive rate %s%s", dev.DeviceID, writeLimitStr, readLimitStr, scheduleStr(limits))
 		}
 	}
 
@@ -126,13 +153,25 @@ func (lim *limiter) CommitConfiguration(from, to config.Configuration) bool {
 	lim.mu.Lock()
 	defer lim.mu.Unlock()
 
+	lim.applyLocked(from, to, time.Now())
+	return true
+}
+
+// applyLocked updates the limiters for the change from one configuration,
+// as evaluated at the previous call, to another as of now.
+func (lim *limiter) applyLocked(from, to config.Configuration, now time.Time) {
+	prevLimits := from.Options.BandwidthLimitsAt(lim.evaluatedAt)
+	limits := to.Options.BandwidthLimitsAt(now)
+	lim.evaluatedAt = now
+	lim.current = to
+
 	// Delete, add or update limiters for devices
-	lim.processDevicesConfigurationLocked(from, to)
+	lim.processDevicesConfigurationLocked(from, to, now)
 
-	if from.Options.MaxRecvKbps == to.Options.MaxRecvKbps &&
-		from.Options.MaxSendKbps == to.Options.MaxSendKbps &&
+	if prevLimits.MaxRecvKbps == limits.MaxRecvKbps &&
+		prevLimits.MaxSendKbps == limits.MaxSendKbps &&
 		from.Options.LimitBandwidthInLan == to.Options.LimitBandwidthInLan {
-		return true
+		return
 	}
 
 	limited := false
@@ -141,25 +180,25 @@ func (lim *limiter) CommitConfiguration(from, to config.Configuration) bool {
 
 	// The rate variables are in KiB/s in the config (despite the camel casing
 	// of the name). We multiply by 1024 to get bytes/s.
-	if to.Options.MaxRecvKbps <= 0 {
+	if limits.MaxRecvKbps <= 0 {
 		lim.read.SetLimit(rate.Inf)
 	} else {
-		lim.read.SetLimit(1024 * rate.Limit(to.Options.MaxRecvKbps))
-		recvLimitStr = fmt.Sprintf("limit is %d KiB/s", to.Options.MaxRecvKbps)
+		lim.read.SetLimit(1024 * rate.Limit(limits.MaxRecvKbps))
+		recvLimitStr = fmt.Sprintf("limit is %d KiB/s", limits.MaxRecvKbps)
 		limited = true
 	}
 
-	if to.Options.MaxSendKbps <= 0 {
+	if limits.MaxSendKbps <= 0 {
 		lim.write.SetLimit(rate.Inf)
 	} else {
-		lim.write.SetLimit(1024 * rate.Limit(to.Options.MaxSendKbps))
-		sendLimitStr = fmt.Sprintf("limit is %d KiB/s", to.Options.MaxSendKbps)
+		lim.write.SetLimit(1024 * rate.Limit(limits.MaxSendKbps))
+		sendLimitStr = fmt.Sprintf("limit is %d KiB/s", limits.MaxSendKbps)
 		limited = true
 	}
 
 	lim.limitsLAN.Store(to.Options.LimitBandwidthInLan)
 
-	l.Infof("Overall send rate %s, receive rate %s", sendLimitStr, recvLimitStr)
+	l.Infof("Overall send rate %s, receive rate %s%s", sendLimitStr, recvLimitStr, scheduleStr(limits))
 
 	if limited {
 		if to.Options.LimitBandwidthInLan {
@@ -168,8 +207,17 @@ func (lim *limiter) CommitConfiguration(from, to config.Configuration) bool {
 			l.Infoln("Rate limits do not apply to LAN connections")
 		}
 	}
+}
 
-	return true
+func scheduleStr(limits config.BandwidthLimits) string {
+	if limits.Schedule == nil {
+		return ""
+	}
+	days := limits.Schedule.Days
+	if days == "" {
+		days = "every day"
+	}
+	return fmt.Sprintf(" (scheduled %s %s-%s)", days, limits.Schedule.Start, limits.Schedule.End)
 }
 
 func (*limiter) String() string {
@@ -14,6 +14,7 @@ import (
 	"math/rand"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/events"
@@ -367,6 +368,56 @@ func TestTotalWaiterLimit(t *testing.T) {
 	}
 }
 
+func TestScheduledLimits(t *testing.T) {
+	wrapper, wrapperCancel := initConfig()
+	defer wrapperCancel()
+	lim := newLimiter(device1, wrapper)
+
+	prev := wrapper.RawCopy()
+	cfg := prev.Copy()
+	cfg.Options.MaxSendKbps = 1000
+	cfg.Options.BandwidthSchedules = []config.BandwidthSchedule{
+		{Start: "08:00", End: "17:00", MaxSendKbps: 100, MaxRecvKbps: 200},
+	}
+	for i, dev := range cfg.Devices {
+		if dev.DeviceID == device3 {
+			cfg.Devices[i].BandwidthSchedules = []config.BandwidthSchedule{
+				{Start: "22:00", End: "06:00", MaxSendKbps: 10},
+			}
+		}
+	}
+
+	night := time.Date(2024, 1, 1, 2, 0, 0, 0, time.Local)
+	day := time.Date(2024, 1, 1, 12, 0, 0, 0, time.Local)
+	evening := time.Date(2024, 1, 1, 20, 0, 0, 0, time.Local)
+
+	cases := []struct {
+		at                     time.Time
+		read, write, dev3Write rate.Limit
+	}{
+		{night, rate.Inf, 1000 * 1024, 10 * 1024},
+		{day, 200 * 1024, 100 * 1024, rate.Inf},
+		{evening, rate.Inf, 1000 * 1024, rate.Inf},
+	}
+
+	for _, tc := range cases {
+		lim.mu.Lock()
+		lim.applyLocked(prev, cfg, tc.at)
+		lim.mu.Unlock()
+		prev = cfg
+
+		if l := lim.read.Limit(); l != tc.read {
+			t.Errorf("at %v: read limit %v, expected %v", tc.at, l, tc.read)
+		}
+		if l := lim.write.Limit(); l != tc.write {
+			t.Errorf("at %v: write limit %v, expected %v", tc.at, l, tc.write)
+		}
+		if l := lim.deviceWriteLimiters[device3].Limit(); l != tc.dev3Write {
+			t.Errorf("at %v: device write limit %v, expected %v", tc.at, l, tc.dev3Write)
+		}
+	}
+}
+
 func checkActualAndExpected(t *testing.T, actualR, actualW, expectedR, expectedW map[protocol.DeviceID]*rate.Limiter) {
 	t.Helper()
 	if len(expectedW) != len(actualW) || len(expectedR) != len(actualR) {
@@ -396,3 +447,23 @@ func (w *countingWriter) Write(data []byte) (int, error) {
 	w.writeCount++
 	return w.w.Write(data)
 }
+
+func TestLimiterReevaluatesCommitted(t *testing.T) {
+	wrapper, wrapperCancel := initConfig()
+	defer wrapperCancel()
+	lim := newLimiter(device1, wrapper)
+
+	// A commit the wrapper doesn't have yet is what the limits are
+	// re-evaluated from, not the older configuration of the wrapper.
+	prev := wrapper.RawCopy()
+	cfg := prev.Copy()
+	cfg.Options.MaxSendKbps = 100
+	lim.CommitConfiguration(prev, cfg)
+
+	lim.mu.Lock()
+	lim.applyLocked(lim.current, lim.current, time.Now())
+	lim.mu.Unlock()
+	if l := lim.write.Limit(); l != 100*1024 {
+		t.Errorf("write limit %v, expected %v", l, 100*1024)
+	}
+}
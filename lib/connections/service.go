@@ -35,6 +35,7 @@ import (
 	"github.com/syncthing/syncthing/lib/connections/registry"
 	"github.com/syncthing/syncthing/lib/discover"
 	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/metered"
 	"github.com/syncthing/syncthing/lib/nat"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
@@ -234,6 +235,7 @@ func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *t
 	service.Add(svcutil.AsService(service.connect, fmt.Sprintf("%s/connect", service)))
 	service.Add(svcutil.AsService(service.handleConns, fmt.Sprintf("%s/handleConns", service)))
 	service.Add(svcutil.AsService(service.handleHellos, fmt.Sprintf("%s/handleHellos", service)))
+	service.Add(svcutil.AsService(service.limiter.serve, fmt.Sprintf("%s/limiter", service)))
 	service.Add(service.natService)
 
 	svcutil.OnSupervisorDone(service.Supervisor, func() {
@@ -311,6 +313,7 @@ func (s *service) helloForDevice(remoteID protocol.DeviceID) protocol.Hello {
 		ClientName:    "syncthing",
 		ClientVersion: build.Version,
 		Timestamp:     time.Now().UnixNano(),
+		Features:      []string{protocol.FeatureDeltaRequest, protocol.FeatureCompressionZstd},
 	}
 	if cfg, ok := s.cfg.Device(remoteID); ok {
 		hello.NumConnections = cfg.NumConnections()
@@ -343,6 +346,10 @@ func (s *service) connectionCheckEarly(remoteID protocol.DeviceID, c internalCon
 		return errDevicePaused
 	}
 
+	if cfg.PauseWhenMetered && s.model.NetworkMetered().Metered {
+		return metered.ErrDevicePaused
+	}
+
 	if len(cfg.AllowedNetworks) > 0 && !IsAllowedNetwork(c.RemoteAddr().String(), cfg.AllowedNetworks) {
 		// The connection is not from an allowed network.
 		return errNetworkNotAllowed
@@ -443,7 +450,14 @@ func (s *service) handleHellos(ctx context.Context) error {
 		// connections are limited.
 		rd, wr := s.limiter.getLimiters(remoteID, c, c.IsLocal())
 
-		protoConn := protocol.NewConnection(remoteID, rd, wr, c, s.model, c, deviceCfg.Compression, s.cfg.FolderPasswords(remoteID), s.keyGen)
+		// Use zstd when we're configured to and the other side can handle
+		// it, otherwise LZ4.
+		compressionAlgo := deviceCfg.CompressionAlgorithm
+		if !hello.HasFeature(protocol.FeatureCompressionZstd) {
+			compressionAlgo = protocol.CompressionAlgorithmLZ4
+		}
+
+		protoConn := protocol.NewConnection(remoteID, rd, wr, c, s.model, c, deviceCfg.Compression, compressionAlgo, deviceCfg.CompressionLevel, s.cfg.FolderPasswords(remoteID), s.keyGen)
 		s.accountAddedConnection(protoConn, hello, s.cfg.Options().ConnectionPriorityUpgradeThreshold)
 		go func() {
 			<-protoConn.Closed()
@@ -560,6 +574,8 @@ func (s *service) dialDevices(ctx context.Context, now time.Time, cfg config.Con
 	// isn't critical, so ignore the potential error.
 	stats, _ := s.model.DeviceStatistics()
 
+	metered := s.model.NetworkMetered().Metered
+
 	queue := make(dialQueue, 0, len(cfg.Devices))
 	for _, deviceCfg := range cfg.Devices {
 		// Don't attempt to connect to ourselves...
@@ -572,6 +588,11 @@ func (s *service) dialDevices(ctx context.Context, now time.Time, cfg config.Con
 			continue
 		}
 
+		// ... or those we shouldn't sync with right now.
+		if deviceCfg.PauseWhenMetered && metered {
+			continue
+		}
+
 		// See if we are already connected and, if so, what our cutoff is
 		// for dialer priority.
 		priorityCutoff := worstDialerPriority
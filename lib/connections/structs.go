@@ -17,6 +17,7 @@ import (
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/metered"
 	"github.com/syncthing/syncthing/lib/nat"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
@@ -226,6 +227,7 @@ type Model interface {
 	AddConnection(conn protocol.Connection, hello protocol.Hello)
 	OnHello(protocol.DeviceID, net.Addr, protocol.Hello) error
 	DeviceStatistics() (map[protocol.DeviceID]stats.DeviceStatistics, error)
+	NetworkMetered() metered.Status
 }
 
 type onAddressesChangedNotifier struct {
@@ -6,7 +6,10 @@
 
 package backend
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 // testBackendBehavior is the generic test suite that must be fulfilled by
 // every backend implementation. It should be called by each implementation
@@ -15,6 +18,7 @@ func testBackendBehavior(t *testing.T, open func() Backend) {
 	t.Run("WriteIsolation", func(t *testing.T) { testWriteIsolation(t, open) })
 	t.Run("DeleteNonexisten", func(t *testing.T) { testDeleteNonexistent(t, open) })
 	t.Run("IteratorClosedDB", func(t *testing.T) { testIteratorClosedDB(t, open) })
+	t.Run("Iterators", func(t *testing.T) { testIterators(t, open) })
 }
 
 func testWriteIsolation(t *testing.T, open func() Backend) {
@@ -53,6 +57,63 @@ func testDeleteNonexistent(t *testing.T, open func() Backend) {
 	}
 }
 
+func testIterators(t *testing.T, open func() Backend) {
+	// Iteration is in key order, prefixes and ranges include the right
+	// keys, and a transaction iterates over its snapshot.
+
+	db := open()
+	defer db.Close()
+
+	for _, k := range []string{"b\xff", "a", "b", "b\x00", "c"} {
+		if err := db.Put([]byte(k), []byte(k)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	keys := func(it Iterator, err error) []string {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer it.Release()
+		var res []string
+		for it.Next() {
+			res = append(res, string(it.Key()))
+		}
+		if err := it.Error(); err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	check := func(name string, got []string, exp ...string) {
+		t.Helper()
+		if strings.Join(got, ",") != strings.Join(exp, ",") {
+			t.Errorf("%s: got %q, expected %q", name, got, exp)
+		}
+	}
+
+	check("all", keys(db.NewPrefixIterator(nil)), "a", "b", "b\x00", "b\xff", "c")
+	check("prefix", keys(db.NewPrefixIterator([]byte("b"))), "b", "b\x00", "b\xff")
+	check("range", keys(db.NewRangeIterator([]byte("a\x00"), []byte("b\xff"))), "b", "b\x00")
+
+	tx, err := db.NewWriteTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Release()
+	if err := tx.Delete([]byte("b")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Put([]byte("bb"), []byte("bb")); err != nil {
+		t.Fatal(err)
+	}
+	check("snapshot", keys(tx.NewPrefixIterator([]byte("b"))), "b", "b\x00", "b\xff")
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	check("committed", keys(db.NewPrefixIterator([]byte("b"))), "b\x00", "bb", "b\xff")
+}
+
 // Either creating the iterator or the .Error() method of the returned iterator
 // should return an error and IsClosed(err) == true.
 func testIteratorClosedDB(t *testing.T, open func() Backend) {
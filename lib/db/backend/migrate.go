@@ -0,0 +1,44 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+// Migrate copies all keys and values from a snapshot of src into dst, and
+// returns the number of entries copied.
+func Migrate(dst, src Backend) (int, error) {
+	snap, err := src.NewReadTransaction()
+	if err != nil {
+		return 0, err
+	}
+	defer snap.Release()
+
+	it, err := snap.NewPrefixIterator(nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Release()
+
+	tx, err := dst.NewWriteTransaction()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Release()
+
+	n := 0
+	for it.Next() {
+		if err := tx.Put(it.Key(), it.Value()); err != nil {
+			return n, err
+		}
+		if err := tx.Checkpoint(); err != nil {
+			return n, err
+		}
+		n++
+	}
+	if err := it.Error(); err != nil {
+		return n, err
+	}
+	return n, tx.Commit()
+}
@@ -0,0 +1,374 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// sqliteBackend implements Backend on top of a SQLite database with a
+// single key/value table. Transactions behave the same as for leveldb: a
+// write transaction reads from a snapshot and collects its writes in a
+// batch, which is written to the database when flushed.
+type sqliteBackend struct {
+	sdb      *sql.DB
+	closeWG  *closeWaitGroup
+	location string
+	tempDir  string   // removed on close, for databases "in memory"
+	lock     *os.File // held while open, unless "in memory"
+	writeMut sync.Mutex
+}
+
+func newSQLiteBackend(sdb *sql.DB, location string) *sqliteBackend {
+	return &sqliteBackend{
+		sdb:      sdb,
+		closeWG:  &closeWaitGroup{},
+		location: location,
+	}
+}
+
+func (b *sqliteBackend) NewReadTransaction() (ReadTransaction, error) {
+	return b.newSnapshot()
+}
+
+func (b *sqliteBackend) newSnapshot() (sqliteSnapshot, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return sqliteSnapshot{}, err
+	}
+	tx, err := b.sdb.Begin()
+	if err != nil {
+		rel.Release()
+		return sqliteSnapshot{}, err
+	}
+	// The snapshot is taken when the transaction first reads from the
+	// database, not when it begins.
+	var discard int
+	if err := tx.QueryRow("SELECT 1 FROM kv LIMIT 1").Scan(&discard); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		rel.Release()
+		return sqliteSnapshot{}, err
+	}
+	return sqliteSnapshot{
+		tx:  tx,
+		rel: rel,
+	}, nil
+}
+
+func (b *sqliteBackend) NewWriteTransaction(hooks ...CommitHook) (WriteTransaction, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := b.newSnapshot()
+	if err != nil {
+		rel.Release()
+		return nil, err
+	}
+	return &sqliteTransaction{
+		sqliteSnapshot: snap,
+		b:              b,
+		rel:            rel,
+		commitHooks:    hooks,
+	}, nil
+}
+
+func (b *sqliteBackend) Close() error {
+	b.closeWG.CloseWait()
+	err := b.sdb.Close()
+	if b.lock != nil {
+		// Closing the file releases the lock.
+		if cerr := b.lock.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if b.tempDir != "" {
+		if rerr := os.RemoveAll(b.tempDir); err == nil {
+			err = rerr
+		}
+	}
+	return err
+}
+
+func (b *sqliteBackend) Get(key []byte) ([]byte, error) {
+	if err := b.closeWG.Add(1); err != nil {
+		return nil, err
+	}
+	defer b.closeWG.Done()
+	return sqliteGet(b.sdb, key)
+}
+
+func (b *sqliteBackend) NewPrefixIterator(prefix []byte) (Iterator, error) {
+	r := util.BytesPrefix(prefix)
+	return b.NewRangeIterator(r.Start, r.Limit)
+}
+
+func (b *sqliteBackend) NewRangeIterator(first, last []byte) (Iterator, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sqliteRange(b.sdb, first, last)
+	if err != nil {
+		rel.Release()
+		return nil, err
+	}
+	return &sqliteIterator{rows: rows, rel: rel}, nil
+}
+
+func (b *sqliteBackend) Put(key, val []byte) error {
+	if err := b.closeWG.Add(1); err != nil {
+		return err
+	}
+	defer b.closeWG.Done()
+	return b.write([]sqliteOp{{key: key, val: val}})
+}
+
+func (b *sqliteBackend) Delete(key []byte) error {
+	if err := b.closeWG.Add(1); err != nil {
+		return err
+	}
+	defer b.closeWG.Done()
+	return b.write([]sqliteOp{{key: key, delete: true}})
+}
+
+// Compact returns free pages to the file system and moves the contents of
+// the write-ahead log into the database.
+func (b *sqliteBackend) Compact() error {
+	if err := b.closeWG.Add(1); err != nil {
+		return err
+	}
+	defer b.closeWG.Done()
+	b.writeMut.Lock()
+	defer b.writeMut.Unlock()
+	if _, err := b.sdb.Exec("PRAGMA incremental_vacuum"); err != nil {
+		return err
+	}
+	_, err := b.sdb.Exec("PRAGMA wal_checkpoint(PASSIVE)")
+	return err
+}
+
+func (b *sqliteBackend) Location() string {
+	return b.location
+}
+
+// write applies the operations in a single database transaction.
+func (b *sqliteBackend) write(ops []sqliteOp) error {
+	b.writeMut.Lock()
+	defer b.writeMut.Unlock()
+
+	tx, err := b.sdb.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	var put, del *sql.Stmt
+	for _, op := range ops {
+		if op.delete {
+			if del == nil {
+				if del, err = tx.Prepare("DELETE FROM kv WHERE key = ?"); err != nil {
+					return err
+				}
+				defer del.Close()
+			}
+			_, err = del.Exec(op.key)
+		} else {
+			if put == nil {
+				if put, err = tx.Prepare("INSERT OR REPLACE INTO kv (key, value) VALUES (?, ?)"); err != nil {
+					return err
+				}
+				defer put.Close()
+			}
+			_, err = put.Exec(op.key, op.val)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// sqliteSnapshot implements backend.ReadTransaction
+type sqliteSnapshot struct {
+	tx  *sql.Tx
+	rel *releaser
+}
+
+func (s sqliteSnapshot) Get(key []byte) ([]byte, error) {
+	return sqliteGet(s.tx, key)
+}
+
+func (s sqliteSnapshot) NewPrefixIterator(prefix []byte) (Iterator, error) {
+	r := util.BytesPrefix(prefix)
+	return s.NewRangeIterator(r.Start, r.Limit)
+}
+
+func (s sqliteSnapshot) NewRangeIterator(first, last []byte) (Iterator, error) {
+	rows, err := sqliteRange(s.tx, first, last)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteIterator{rows: rows}, nil
+}
+
+func (s sqliteSnapshot) Release() {
+	// Rolling back an already finished transaction is harmless.
+	_ = s.tx.Rollback()
+	s.rel.Release()
+}
+
+// sqliteTransaction implements backend.WriteTransaction using a batch of
+// operations which is written in a database transaction when flushed.
+type sqliteTransaction struct {
+	sqliteSnapshot
+	b           *sqliteBackend
+	batch       []sqliteOp
+	size        int
+	rel         *releaser
+	commitHooks []CommitHook
+	inFlush     bool
+}
+
+type sqliteOp struct {
+	key    []byte
+	val    []byte
+	delete bool
+}
+
+func (t *sqliteTransaction) Delete(key []byte) error {
+	t.batch = append(t.batch, sqliteOp{key: append([]byte(nil), key...), delete: true})
+	t.size += len(key)
+	return t.checkFlush(dbFlushBatchMax)
+}
+
+func (t *sqliteTransaction) Put(key, val []byte) error {
+	t.batch = append(t.batch, sqliteOp{key: append([]byte(nil), key...), val: append([]byte(nil), val...)})
+	t.size += len(key) + len(val)
+	return t.checkFlush(dbFlushBatchMax)
+}
+
+func (t *sqliteTransaction) Checkpoint() error {
+	return t.checkFlush(dbFlushBatchMin)
+}
+
+func (t *sqliteTransaction) Commit() error {
+	err := t.flush()
+	t.sqliteSnapshot.Release()
+	t.rel.Release()
+	return err
+}
+
+func (t *sqliteTransaction) Release() {
+	t.sqliteSnapshot.Release()
+	t.rel.Release()
+}
+
+// checkFlush flushes and resets the batch if its size exceeds the given size.
+func (t *sqliteTransaction) checkFlush(size int) error {
+	// Hooks may write to the transaction, don't recurse.
+	if t.inFlush || t.size < size {
+		return nil
+	}
+	return t.flush()
+}
+
+func (t *sqliteTransaction) flush() error {
+	t.inFlush = true
+	defer func() { t.inFlush = false }()
+
+	for _, hook := range t.commitHooks {
+		if err := hook(t); err != nil {
+			return err
+		}
+	}
+	if len(t.batch) == 0 {
+		return nil
+	}
+	if err := t.b.write(t.batch); err != nil {
+		return err
+	}
+	t.batch = t.batch[:0]
+	t.size = 0
+	return nil
+}
+
+type sqliteIterator struct {
+	rows *sql.Rows
+	rel  *releaser // only for iterators outside of a transaction
+	key  []byte
+	val  []byte
+	err  error
+}
+
+func (it *sqliteIterator) Next() bool {
+	if it.err != nil || !it.rows.Next() {
+		return false
+	}
+	if err := it.rows.Scan(&it.key, &it.val); err != nil {
+		it.err = err
+		return false
+	}
+	return true
+}
+
+func (it *sqliteIterator) Key() []byte {
+	return it.key
+}
+
+func (it *sqliteIterator) Value() []byte {
+	return it.val
+}
+
+func (it *sqliteIterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.rows.Err()
+}
+
+func (it *sqliteIterator) Release() {
+	it.rows.Close()
+	if it.rel != nil {
+		it.rel.Release()
+	}
+}
+
+// sqliteQuerier is the part of sql.DB and sql.Tx used for reading.
+type sqliteQuerier interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+func sqliteGet(q sqliteQuerier, key []byte) ([]byte, error) {
+	var val []byte
+	err := q.QueryRow("SELECT value FROM kv WHERE key = ?", key).Scan(&val)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errNotFound
+	}
+	return val, err
+}
+
+// sqliteRange returns the rows with keys from first (inclusive) to last
+// (exclusive), in order. An empty bound means no bound.
+func sqliteRange(q sqliteQuerier, first, last []byte) (*sql.Rows, error) {
+	switch {
+	case len(first) == 0 && len(last) == 0:
+		return q.Query("SELECT key, value FROM kv ORDER BY key")
+	case len(last) == 0:
+		return q.Query("SELECT key, value FROM kv WHERE key >= ? ORDER BY key", first)
+	case len(first) == 0:
+		return q.Query("SELECT key, value FROM kv WHERE key < ? ORDER BY key", last)
+	default:
+		return q.Query("SELECT key, value FROM kv WHERE key >= ? AND key < ? ORDER BY key", first, last)
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build aix || plan9 || js || wasip1
+// +build aix plan9 js wasip1
+
+package backend
+
+import "os"
+
+// lockFile does nothing where file locks aren't available.
+func lockFile(*os.File) error {
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	_ "modernc.org/sqlite" // register the "sqlite" database driver
+)
+
+// Each connection is set up with these pragmas. The busy timeout comes
+// first as the others may need to wait for locks, and auto vacuum must be
+// set before the journal mode, as it only has effect when the database file
+// is created. The write-ahead log lets readers keep their snapshots while
+// others write.
+const sqlitePragmas = "?_pragma=busy_timeout(60000)" +
+	"&_pragma=auto_vacuum(incremental)" +
+	"&_pragma=journal_mode(wal)" +
+	"&_pragma=synchronous(normal)"
+
+const sqliteSchema = `CREATE TABLE IF NOT EXISTS kv (
+	key BLOB NOT NULL PRIMARY KEY,
+	value BLOB
+) WITHOUT ROWID`
+
+// errSQLiteLocked is returned when the database is in use by another
+// process.
+var errSQLiteLocked = errors.New("database is in use by another process (is Syncthing running?)")
+
+// OpenSQLite opens the SQLite database at the given location, creating it
+// if it doesn't exist. The database is locked against use by other
+// processes for as long as it's open.
+func OpenSQLite(location string) (Backend, error) {
+	lock, err := lockSQLite(location)
+	if err != nil {
+		return nil, err
+	}
+	sdb, err := openSQLite(location)
+	if err != nil {
+		lock.Close()
+		return nil, err
+	}
+	b := newSQLiteBackend(sdb, location)
+	b.lock = lock
+	return b, nil
+}
+
+// lockSQLite takes an exclusive lock on a file next to the database, as
+// LevelDB does for its own, since SQLite by itself happily lets several
+// processes use the same database.
+func lockSQLite(location string) (*os.File, error) {
+	fd, err := os.OpenFile(location+".lock", os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(fd); err != nil {
+		fd.Close()
+		return nil, fmt.Errorf("%s: %w", location, err)
+	}
+	return fd, nil
+}
+
+// OpenSQLiteMemory returns a new Backend referencing a SQLite database that
+// doesn't outlive it. SQLite's own in-memory databases don't support
+// snapshots concurrent with writes, so the database is kept in a temporary
+// directory which is removed on close.
+func OpenSQLiteMemory() Backend {
+	dir, err := os.MkdirTemp("", "syncthing-sqlite-")
+	if err != nil {
+		panic(err)
+	}
+	sdb, err := openSQLite(filepath.Join(dir, "index.sqlite"))
+	if err != nil {
+		os.RemoveAll(dir)
+		panic(err)
+	}
+	b := newSQLiteBackend(sdb, "")
+	b.tempDir = dir
+	return b
+}
+
+func openSQLite(location string) (*sql.DB, error) {
+	sdb, err := sql.Open("sqlite", location+sqlitePragmas)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sdb.Exec(sqliteSchema); err != nil {
+		sdb.Close()
+		return nil, err
+	}
+	return sdb, nil
+}
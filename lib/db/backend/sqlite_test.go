@@ -0,0 +1,70 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestSQLiteBackendBehavior(t *testing.T) {
+	testBackendBehavior(t, OpenSQLiteMemory)
+}
+
+func TestMigrate(t *testing.T) {
+	src := OpenLevelDBMemory()
+	defer src.Close()
+	dst := OpenSQLiteMemory()
+	defer dst.Close()
+
+	keys := []string{"a", "b\x00", "b\xff", "c"}
+	for _, k := range keys {
+		if err := src.Put([]byte(k), []byte("v"+k)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	n, err := Migrate(dst, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(keys) {
+		t.Errorf("copied %d entries, expected %d", n, len(keys))
+	}
+	for _, k := range keys {
+		v, err := dst.Get([]byte(k))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(v) != "v"+k {
+			t.Errorf("wrong value %q for %q", v, k)
+		}
+	}
+}
+
+func TestSQLiteLock(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "index.sqlite")
+	db, err := OpenSQLite(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The database can't be opened again while open.
+	if _, err := OpenSQLite(location); !errors.Is(err, errSQLiteLocked) {
+		t.Fatal("expected lock error, got", err)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	db, err = OpenSQLite(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+}
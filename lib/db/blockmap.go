@@ -31,8 +31,10 @@ func (f *BlockFinder) String() string {
 // for the given hash. The iterator function has to return either true (if
 // they are happy with the block) or false to continue iterating for whatever
 // reason. The iterator finally returns the result, whether or not a
-// satisfying block was eventually found.
-func (f *BlockFinder) Iterate(folders []string, hash []byte, iterFn func(string, string, int32) bool) bool {
+// satisfying block was eventually found. The offset passed to the iterator
+// function is only known for files with content defined blocks, otherwise
+// it is -1 and the offset is the index times the block size.
+func (f *BlockFinder) Iterate(folders []string, hash []byte, iterFn func(string, string, int32, int64) bool) bool {
 	t, err := f.db.newReadOnlyTransaction()
 	if err != nil {
 		return false
@@ -52,8 +54,13 @@ func (f *BlockFinder) Iterate(folders []string, hash []byte, iterFn func(string,
 
 		for iter.Next() && iter.Error() == nil {
 			file := string(f.db.keyer.NameFromBlockMapKey(iter.Key()))
-			index := int32(binary.BigEndian.Uint32(iter.Value()))
-			if iterFn(folder, osutil.NativeFilename(file), index) {
+			val := iter.Value()
+			index := int32(binary.BigEndian.Uint32(val))
+			offset := int64(-1)
+			if len(val) >= 12 {
+				offset = int64(binary.BigEndian.Uint64(val[4:]))
+			}
+			if iterFn(folder, osutil.NativeFilename(file), index, offset) {
 				iter.Release()
 				return true
 			}
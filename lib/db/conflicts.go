@@ -0,0 +1,56 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+// AddConflict records the named file as a conflict copy in the folder,
+// replacing any previous record for it.
+func (db *Lowlevel) AddConflict(folder, name string, c Conflict) error {
+	key, err := db.keyer.GenerateConflictKey(nil, []byte(folder), []byte(name))
+	if err != nil {
+		return err
+	}
+	bs, err := c.Marshal()
+	if err != nil {
+		return err
+	}
+	return db.Put(key, bs)
+}
+
+func (db *Lowlevel) RemoveConflict(folder, name string) error {
+	key, err := db.keyer.GenerateConflictKey(nil, []byte(folder), []byte(name))
+	if err != nil {
+		return err
+	}
+	return db.Delete(key)
+}
+
+// Conflicts returns the conflict copies recorded in the folder, by name.
+// Invalid entries are dropped from the database as a side-effect.
+func (db *Lowlevel) Conflicts(folder string) (map[string]Conflict, error) {
+	prefix, err := db.keyer.GenerateConflictKey(nil, []byte(folder), nil)
+	if err != nil {
+		return nil, err
+	}
+	iter, err := db.NewPrefixIterator(prefix.WithoutName())
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+	res := make(map[string]Conflict)
+	for iter.Next() {
+		var c Conflict
+		if err := c.Unmarshal(iter.Value()); err != nil {
+			l.Infof("Invalid conflict entry, deleting from database: %x", iter.Key())
+			if err := db.Delete(iter.Key()); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		res[string(db.keyer.NameFromConflictKey(iter.Key()))] = c
+	}
+	return res, iter.Error()
+}
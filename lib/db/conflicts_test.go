@@ -0,0 +1,60 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConflicts(t *testing.T) {
+	db := newLowlevelMemory(t)
+	defer db.Close()
+
+	c := Conflict{Original: "a.txt", Time: time.Unix(1700000000, 0).UTC(), ModifiedBy: "ABCDEFG", Local: true}
+	for _, name := range []string{"a.sync-conflict-1.txt", "a.sync-conflict-2.txt"} {
+		if err := db.AddConflict("folder1", name, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.AddConflict("folder2", "b.sync-conflict-1", Conflict{Original: "b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := db.Conflicts("folder1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 {
+		t.Fatalf("expected two conflicts, got %v", res)
+	}
+	if got := res["a.sync-conflict-1.txt"]; !got.Time.Equal(c.Time) || got.Original != c.Original || got.ModifiedBy != c.ModifiedBy || !got.Local {
+		t.Errorf("got %v, expected %v", got, c)
+	}
+
+	if err := db.RemoveConflict("folder1", "a.sync-conflict-1.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := db.Conflicts("folder1"); err != nil {
+		t.Fatal(err)
+	} else if _, ok := res["a.sync-conflict-2.txt"]; len(res) != 1 || !ok {
+		t.Errorf("expected only the second conflict, got %v", res)
+	}
+
+	// Dropping the folder drops its conflicts, and only those.
+	DropFolder(db, "folder1")
+	if res, err := db.Conflicts("folder1"); err != nil {
+		t.Fatal(err)
+	} else if len(res) != 0 {
+		t.Errorf("expected no conflicts in dropped folder, got %v", res)
+	}
+	if res, err := db.Conflicts("folder2"); err != nil {
+		t.Fatal(err)
+	} else if len(res) != 1 {
+		t.Errorf("expected one conflict in other folder, got %v", res)
+	}
+}
@@ -204,7 +204,7 @@ func (db *Lowlevel) updateLocalFiles(folder []byte, fs []protocol.FileInfo, meta
 	defer t.close()
 
 	var dk, gk, keyBuf []byte
-	blockBuf := make([]byte, 4)
+	blockBuf := make([]byte, 12)
 	for _, f := range fs {
 		name := []byte(f.Name)
 		dk, err = db.keyer.GenerateDeviceFileKey(dk, folder, protocol.LocalDeviceID[:], name)
@@ -261,12 +261,19 @@ func (db *Lowlevel) updateLocalFiles(folder []byte, fs []protocol.FileInfo, meta
 
 		if len(f.Blocks) != 0 && !f.IsInvalid() && f.Size > 0 {
 			for i, block := range f.Blocks {
-				binary.BigEndian.PutUint32(blockBuf, uint32(i))
+				// The offset of content defined blocks can't be derived
+				// from the index, so it's stored after it.
+				val := blockBuf[:4]
+				binary.BigEndian.PutUint32(val, uint32(i))
+				if f.BlockChunking == protocol.BlockChunkingCDC {
+					val = blockBuf[:12]
+					binary.BigEndian.PutUint64(val[4:], uint64(block.Offset))
+				}
 				keyBuf, err = db.keyer.GenerateBlockMapKey(keyBuf, folder, block.Hash, name)
 				if err != nil {
 					return err
 				}
-				if err := t.Put(keyBuf, blockBuf); err != nil {
+				if err := t.Put(keyBuf, val); err != nil {
 					return err
 				}
 			}
@@ -442,6 +449,15 @@ func (db *Lowlevel) dropFolder(folder []byte) error {
 		return err
 	}
 
+	// Remove the recorded conflicts of the folder
+	k6, err := db.keyer.GenerateConflictKey(k5, folder, nil)
+	if err != nil {
+		return err
+	}
+	if err := t.deleteKeyPrefix(k6.WithoutName()); err != nil {
+		return err
+	}
+
 	return t.Commit()
 }
 
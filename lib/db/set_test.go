@@ -529,7 +529,7 @@ func TestUpdateToInvalid(t *testing.T) {
 		t.Errorf("Have incorrect after invalidation;\n A: %v !=\n E: %v", have, localHave)
 	}
 
-	f.Iterate([]string{folder}, oldBlockHash, func(folder, file string, index int32) bool {
+	f.Iterate([]string{folder}, oldBlockHash, func(folder, file string, index int32, _ int64) bool {
 		if file == localHave[1].Name {
 			t.Errorf("Found unexpected block in blockmap for invalidated file")
 			return true
@@ -537,13 +537,55 @@ func TestUpdateToInvalid(t *testing.T) {
 		return false
 	})
 
-	if !f.Iterate([]string{folder}, localHave[4].Blocks[0].Hash, func(folder, file string, index int32) bool {
+	if !f.Iterate([]string{folder}, localHave[4].Blocks[0].Hash, func(folder, file string, index int32, _ int64) bool {
 		return file == localHave[4].Name
 	}) {
 		t.Errorf("First block of un-invalidated file is missing from blockmap")
 	}
 }
 
+func TestBlockFinderOffsets(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+
+	folder := "test"
+	s := newFileSet(t, folder, ldb)
+	f := db.NewBlockFinder(ldb)
+
+	fixed := genBlocks(3)
+	cdc := genBlocks(6)[3:]
+	var offset int64
+	for i := range cdc {
+		cdc[i].Offset = offset
+		offset += int64(cdc[i].Size)
+	}
+	s.Update(protocol.LocalDeviceID, fileList{
+		protocol.FileInfo{Name: "fixed", Version: protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1000}}}, Blocks: fixed, Size: 1},
+		protocol.FileInfo{Name: "cdc", Version: protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1000}}}, Blocks: cdc, Size: offset, BlockChunking: protocol.BlockChunkingCDC},
+	})
+
+	var gotIndex int32
+	var gotOffset int64
+	iterFn := func(_, _ string, index int32, offset int64) bool {
+		gotIndex, gotOffset = index, offset
+		return true
+	}
+
+	if !f.Iterate([]string{folder}, fixed[2].Hash, iterFn) {
+		t.Fatal("block of fixed file missing from blockmap")
+	}
+	if gotIndex != 2 || gotOffset != -1 {
+		t.Errorf("got index %d offset %d, expected 2 and -1", gotIndex, gotOffset)
+	}
+
+	if !f.Iterate([]string{folder}, cdc[2].Hash, iterFn) {
+		t.Fatal("block of cdc file missing from blockmap")
+	}
+	if gotIndex != 2 || gotOffset != cdc[2].Offset {
+		t.Errorf("got index %d offset %d, expected 2 and %d", gotIndex, gotOffset, cdc[2].Offset)
+	}
+}
+
 func TestInvalidAvailability(t *testing.T) {
 	ldb := newLowlevelMemory(t)
 	defer ldb.Close()
@@ -1881,8 +1923,18 @@ func newLowlevel(t testing.TB, backend backend.Backend) *db.Lowlevel {
 	return ll
 }
 
+// openMemory returns an in-memory database of the backend the tests run
+// against: LevelDB, or SQLite when STTESTBACKEND is "sqlite". The build
+// script runs the tests with each.
+func openMemory() backend.Backend {
+	if os.Getenv("STTESTBACKEND") == "sqlite" {
+		return backend.OpenSQLiteMemory()
+	}
+	return backend.OpenMemory()
+}
+
 func newLowlevelMemory(t testing.TB) *db.Lowlevel {
-	return newLowlevel(t, backend.OpenMemory())
+	return newLowlevel(t, openMemory())
 }
 
 func newFileSet(t testing.TB, folder string, ll *db.Lowlevel) *db.FileSet {
@@ -172,6 +172,7 @@ func (f FileInfoTruncated) copyToFileInfo() protocol.FileInfo {
 		Permissions:   f.Permissions,
 		ModifiedNs:    f.ModifiedNs,
 		RawBlockSize:  f.RawBlockSize,
+		BlockChunking: f.BlockChunking,
 		LocalFlags:    f.LocalFlags,
 		Deleted:       f.Deleted,
 		RawInvalid:    f.RawInvalid,
@@ -115,14 +115,15 @@ type FileInfoTruncated struct {
 	Version    protocol.Vector                                     `protobuf:"bytes,9,opt,name=version,proto3" json:"version" xml:"version"`
 	Sequence   int64                                               `protobuf:"varint,10,opt,name=sequence,proto3" json:"sequence" xml:"sequence"`
 	// repeated BlockInfo Blocks         = 16
-	SymlinkTarget string                `protobuf:"bytes,17,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlinkTarget" xml:"symlinkTarget"`
-	BlocksHash    []byte                `protobuf:"bytes,18,opt,name=blocks_hash,json=blocksHash,proto3" json:"blocksHash" xml:"blocksHash"`
-	Encrypted     []byte                `protobuf:"bytes,19,opt,name=encrypted,proto3" json:"encrypted" xml:"encrypted"`
-	Type          protocol.FileInfoType `protobuf:"varint,2,opt,name=type,proto3,enum=protocol.FileInfoType" json:"type" xml:"type"`
-	Permissions   uint32                `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions" xml:"permissions"`
-	ModifiedNs    int                   `protobuf:"varint,11,opt,name=modified_ns,json=modifiedNs,proto3,casttype=int" json:"modifiedNs" xml:"modifiedNs"`
-	RawBlockSize  int                   `protobuf:"varint,13,opt,name=block_size,json=blockSize,proto3,casttype=int" json:"blockSize" xml:"blockSize"`
-	Platform      protocol.PlatformData `protobuf:"bytes,14,opt,name=platform,proto3" json:"platform" xml:"platform"`
+	SymlinkTarget string                 `protobuf:"bytes,17,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlinkTarget" xml:"symlinkTarget"`
+	BlocksHash    []byte                 `protobuf:"bytes,18,opt,name=blocks_hash,json=blocksHash,proto3" json:"blocksHash" xml:"blocksHash"`
+	Encrypted     []byte                 `protobuf:"bytes,19,opt,name=encrypted,proto3" json:"encrypted" xml:"encrypted"`
+	Type          protocol.FileInfoType  `protobuf:"varint,2,opt,name=type,proto3,enum=protocol.FileInfoType" json:"type" xml:"type"`
+	Permissions   uint32                 `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions" xml:"permissions"`
+	ModifiedNs    int                    `protobuf:"varint,11,opt,name=modified_ns,json=modifiedNs,proto3,casttype=int" json:"modifiedNs" xml:"modifiedNs"`
+	RawBlockSize  int                    `protobuf:"varint,13,opt,name=block_size,json=blockSize,proto3,casttype=int" json:"blockSize" xml:"blockSize"`
+	Platform      protocol.PlatformData  `protobuf:"bytes,14,opt,name=platform,proto3" json:"platform" xml:"platform"`
+	BlockChunking protocol.BlockChunking `protobuf:"varint,20,opt,name=block_chunking,json=blockChunking,proto3,enum=protocol.BlockChunking" json:"blockChunking" xml:"blockChunking"`
 	// see bep.proto
 	LocalFlags    uint32 `protobuf:"varint,1000,opt,name=local_flags,json=localFlags,proto3" json:"localFlags" xml:"localFlags"`
 	VersionHash   []byte `protobuf:"bytes,1001,opt,name=version_hash,json=versionHash,proto3" json:"versionHash" xml:"versionHash"`
@@ -480,6 +481,49 @@ func (m *ObservedDevice) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ObservedDevice proto.InternalMessageInfo
 
+// A conflict copy of a file, as recorded for the folder it's in. Local
+// conflicts were created here, with the previous local version of the
+// original file; others were received from other devices.
+type Conflict struct {
+	Original   string    `protobuf:"bytes,1,opt,name=original,proto3" json:"original" xml:"original"`
+	Time       time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" xml:"time"`
+	ModifiedBy string    `protobuf:"bytes,3,opt,name=modified_by,json=modifiedBy,proto3" json:"modifiedBy" xml:"modifiedBy"`
+	Local      bool      `protobuf:"varint,4,opt,name=local,proto3" json:"local" xml:"local"`
+}
+
+func (m *Conflict) Reset()         { *m = Conflict{} }
+func (m *Conflict) String() string { return proto.CompactTextString(m) }
+func (*Conflict) ProtoMessage()    {}
+func (*Conflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{11}
+}
+func (m *Conflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Conflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Conflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Conflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Conflict.Merge(m, src)
+}
+func (m *Conflict) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Conflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_Conflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Conflict proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*FileVersion)(nil), "db.FileVersion")
 	proto.RegisterType((*VersionList)(nil), "db.VersionList")
@@ -492,109 +536,116 @@ func init() {
 	proto.RegisterType((*VersionListDeprecated)(nil), "db.VersionListDeprecated")
 	proto.RegisterType((*ObservedFolder)(nil), "db.ObservedFolder")
 	proto.RegisterType((*ObservedDevice)(nil), "db.ObservedDevice")
+	proto.RegisterType((*Conflict)(nil), "db.Conflict")
 }
 
 func init() { proto.RegisterFile("lib/db/structs.proto", fileDescriptor_5465d80e8cba02e3) }
 
 var fileDescriptor_5465d80e8cba02e3 = []byte{
-	// 1543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0x2d, 0xd9, 0x92, 0x46, 0xf2, 0x17, 0xb3, 0x36, 0xb4, 0xde, 0x5d, 0x51, 0x3b, 0x71,
-	0x00, 0xed, 0x07, 0x64, 0xc0, 0x41, 0x8c, 0x45, 0x80, 0x6d, 0x10, 0xc6, 0x75, 0xe2, 0x20, 0x75,
-	0xd2, 0x71, 0x90, 0x14, 0xed, 0x41, 0xe0, 0xc7, 0x58, 0x26, 0x42, 0x91, 0x2a, 0x49, 0xdb, 0x51,
-	0x6e, 0xbd, 0x14, 0xe8, 0x2d, 0x08, 0x7a, 0x28, 0x8a, 0xa2, 0x08, 0x50, 0xa0, 0x7f, 0x42, 0xff,
-	0x82, 0xa2, 0xc8, 0xd1, 0xc7, 0xa2, 0x07, 0x16, 0xb1, 0x2f, 0xad, 0x8e, 0x3a, 0xf6, 0x54, 0xcc,
-	0x9b, 0xe1, 0x70, 0x64, 0x23, 0x45, 0x92, 0xfa, 0xc6, 0xf7, 0x7b, 0xbf, 0x79, 0x12, 0xdf, 0xfc,
-	0xde, 0x07, 0xd1, 0x5f, 0x7c, 0xcf, 0x5e, 0x75, 0xed, 0xd5, 0x38, 0x89, 0xf6, 0x9d, 0x24, 0x6e,
-	0xf7, 0xa3, 0x30, 0x09, 0xf5, 0x49, 0xd7, 0x5e, 0xbe, 0x18, 0xd1, 0x7e, 0x18, 0xaf, 0x02, 0x60,
-	0xef, 0xef, 0xae, 0x76, 0xc3, 0x6e, 0x08, 0x06, 0x3c, 0x71, 0xe2, 0xb2, 0xd1, 0x0d, 0xc3, 0xae,
-	0x4f, 0x73, 0x56, 0xe2, 0xf5, 0x68, 0x9c, 0x58, 0xbd, 0xbe, 0x20, 0x2c, 0xb1, 0xf8, 0xf0, 0xe8,
-	0x84, 0xfe, 0xaa, 0x4d, 0x33, 0xbc, 0x42, 0x1f, 0x27, 0xfc, 0x11, 0x7f, 0x3d, 0x89, 0xaa, 0x9b,
-	0x9e, 0x4f, 0x1f, 0xd0, 0x28, 0xf6, 0xc2, 0x40, 0xbf, 0x83, 0x4a, 0x07, 0xfc, 0xb1, 0xae, 0x35,
-	0xb5, 0x56, 0x75, 0x6d, 0xbe, 0x9d, 0x05, 0x68, 0x3f, 0xa0, 0x4e, 0x12, 0x46, 0x66, 0xf3, 0x45,
-	0x6a, 0x4c, 0x0c, 0x53, 0x23, 0x23, 0x8e, 0x52, 0x63, 0xe6, 0x71, 0xcf, 0xbf, 0x8a, 0x85, 0x8d,
-	0x49, 0xe6, 0xd1, 0xd7, 0x51, 0xc9, 0xa5, 0x3e, 0x4d, 0xa8, 0x5b, 0x9f, 0x6c, 0x6a, 0xad, 0xb2,
-	0xf9, 0x77, 0x76, 0x4e, 0x40, 0xf2, 0x9c, 0xb0, 0x31, 0xc9, 0x3c, 0xfa, 0x15, 0x76, 0xee, 0xc0,
-	0x73, 0x68, 0x5c, 0x2f, 0x34, 0x0b, 0xad, 0x9a, 0xf9, 0x37, 0x7e, 0x0e, 0xa0, 0x51, 0x6a, 0xd4,
-	0xc4, 0x39, 0x66, 0xc3, 0x31, 0x70, 0xe8, 0x04, 0xcd, 0x79, 0xc1, 0x81, 0xe5, 0x7b, 0x6e, 0x27,
-	0x3b, 0x5e, 0x84, 0xe3, 0xff, 0x1a, 0xa6, 0xc6, 0xac, 0x70, 0x6d, 0xc8, 0x28, 0x17, 0x20, 0xca,
-	0x18, 0x8c, 0xc9, 0x29, 0x1a, 0xfe, 0x44, 0x43, 0x55, 0x91, 0x9c, 0x3b, 0x5e, 0x9c, 0xe8, 0x3e,
-	0x2a, 0x8b, 0xb7, 0x8b, 0xeb, 0x5a, 0xb3, 0xd0, 0xaa, 0xae, 0xcd, 0xb5, 0x5d, 0xbb, 0xad, 0xe4,
-	0xd0, 0xbc, 0xc6, 0x12, 0x74, 0x9c, 0x1a, 0x55, 0x62, 0x1d, 0x0a, 0x2c, 0x1e, 0xa6, 0x86, 0x3c,
-	0x77, 0x26, 0x61, 0xcf, 0x8e, 0x56, 0x54, 0x2e, 0x91, 0xcc, 0xab, 0xc5, 0x2f, 0x9e, 0x1b, 0x13,
-	0xf8, 0x9b, 0x1a, 0x5a, 0x60, 0x3f, 0xb0, 0x15, 0xec, 0x86, 0xf7, 0xa3, 0xfd, 0xc0, 0xb1, 0x58,
-	0x92, 0xfe, 0x8d, 0x8a, 0x81, 0xd5, 0xa3, 0x70, 0x4f, 0x15, 0x73, 0x69, 0x98, 0x1a, 0x60, 0x8f,
-	0x52, 0x03, 0x41, 0x74, 0x66, 0x60, 0x02, 0x18, 0xe3, 0xc6, 0xde, 0x13, 0x5a, 0x2f, 0x34, 0xb5,
-	0x56, 0x81, 0x73, 0x99, 0x2d, 0xb9, 0xcc, 0xc0, 0x04, 0x30, 0xfd, 0x1a, 0x42, 0xbd, 0xd0, 0xf5,
-	0x76, 0x3d, 0xea, 0x76, 0xe2, 0xfa, 0x14, 0x9c, 0x68, 0x0e, 0x53, 0xa3, 0x92, 0xa1, 0x3b, 0xa3,
-	0xd4, 0x98, 0x83, 0x63, 0x12, 0xc1, 0x24, 0xf7, 0xea, 0xdf, 0x69, 0xa8, 0x2a, 0x23, 0xd8, 0x83,
-	0x7a, 0xad, 0xa9, 0xb5, 0x8a, 0xe6, 0xe7, 0x1a, 0x4b, 0xcb, 0x4f, 0xa9, 0x71, 0xb9, 0xeb, 0x25,
-	0x7b, 0xfb, 0x76, 0xdb, 0x09, 0x7b, 0xab, 0xf1, 0x20, 0x70, 0x92, 0x3d, 0x2f, 0xe8, 0x2a, 0x4f,
-	0xaa, 0x68, 0xdb, 0x3b, 0x7b, 0x61, 0x94, 0x6c, 0x6d, 0x0c, 0x53, 0x43, 0xfe, 0x29, 0x73, 0x30,
-	0x4a, 0x8d, 0xf9, 0xb1, 0xdf, 0x37, 0x07, 0xf8, 0xcb, 0xa3, 0x95, 0xb7, 0x09, 0x4c, 0x94, 0xb0,
-	0xaa, 0xf8, 0x2b, 0x7f, 0x5e, 0xfc, 0x57, 0x51, 0x39, 0xa6, 0x1f, 0xef, 0xd3, 0xc0, 0xa1, 0x75,
-	0x04, 0x59, 0x6c, 0x30, 0x15, 0x64, 0xd8, 0x28, 0x35, 0x66, 0x79, 0xee, 0x05, 0x80, 0x89, 0xf4,
-	0xe9, 0x77, 0xd1, 0x6c, 0x3c, 0xe8, 0xf9, 0x5e, 0xf0, 0xa8, 0x93, 0x58, 0x51, 0x97, 0x26, 0xf5,
-	0x05, 0xb8, 0xe5, 0xd6, 0x30, 0x35, 0x66, 0x84, 0xe7, 0x3e, 0x38, 0xa4, 0x8e, 0xc7, 0x50, 0x4c,
-	0xc6, 0x59, 0xfa, 0x0d, 0x54, 0xb5, 0xfd, 0xd0, 0x79, 0x14, 0x77, 0xf6, 0xac, 0x78, 0xaf, 0xae,
-	0x37, 0xb5, 0x56, 0xcd, 0xc4, 0x2c, 0xad, 0x1c, 0xbe, 0x65, 0xc5, 0x7b, 0x32, 0xad, 0x39, 0x84,
-	0x89, 0xe2, 0xd7, 0xdf, 0x41, 0x15, 0x1a, 0x38, 0xd1, 0xa0, 0xcf, 0x0a, 0xfa, 0x02, 0x84, 0x00,
-	0x61, 0x48, 0x50, 0x0a, 0x43, 0x22, 0x98, 0xe4, 0x5e, 0xdd, 0x44, 0xc5, 0x64, 0xd0, 0xa7, 0xd0,
-	0x0b, 0x66, 0xd7, 0x96, 0xf2, 0xe4, 0x4a, 0x71, 0x0f, 0xfa, 0x94, 0xab, 0x93, 0xf1, 0xa4, 0x3a,
-	0x99, 0x81, 0x09, 0x60, 0xfa, 0x26, 0xaa, 0xf6, 0x69, 0xd4, 0xf3, 0x62, 0x5e, 0x82, 0xc5, 0xa6,
-	0xd6, 0x9a, 0x31, 0x57, 0x86, 0xa9, 0xa1, 0xc2, 0xa3, 0xd4, 0x58, 0x80, 0x93, 0x0a, 0x86, 0x89,
-	0xca, 0xd0, 0x6f, 0x2b, 0x1a, 0x0d, 0xe2, 0x7a, 0xb5, 0xa9, 0xb5, 0xa6, 0xa0, 0x4f, 0x48, 0x41,
-	0x6c, 0xc7, 0x67, 0x74, 0xb6, 0x1d, 0xe3, 0xdf, 0x52, 0xa3, 0xe0, 0x05, 0x09, 0x51, 0x68, 0xfa,
-	0x2e, 0xe2, 0x59, 0xea, 0x40, 0x8d, 0xcd, 0x40, 0xa8, 0x9b, 0xc7, 0xa9, 0x51, 0x23, 0xd6, 0xa1,
-	0xc9, 0x1c, 0x3b, 0xde, 0x13, 0xca, 0x12, 0x65, 0x67, 0x86, 0x4c, 0x94, 0x44, 0xb2, 0xc0, 0xcf,
-	0x8e, 0x56, 0xc6, 0x8e, 0x91, 0xfc, 0x90, 0xfe, 0x00, 0x95, 0xfb, 0xbe, 0x95, 0xec, 0x86, 0x51,
-	0xaf, 0x3e, 0x0b, 0x02, 0x55, 0x72, 0x78, 0x4f, 0x78, 0x36, 0xac, 0xc4, 0x32, 0xb1, 0x90, 0xa9,
-	0xe4, 0x4b, 0xb5, 0x65, 0x00, 0x26, 0xd2, 0xa7, 0x6f, 0xa0, 0xaa, 0x1f, 0x3a, 0x96, 0xdf, 0xd9,
-	0xf5, 0xad, 0x6e, 0x5c, 0xff, 0xa5, 0x04, 0x49, 0x05, 0x75, 0x00, 0xbe, 0xc9, 0x60, 0x99, 0x8c,
-	0x1c, 0xc2, 0x44, 0xf1, 0xeb, 0xb7, 0x50, 0x4d, 0x48, 0x9f, 0x6b, 0xec, 0xd7, 0x12, 0x28, 0x04,
-	0xee, 0x46, 0x38, 0x84, 0xca, 0x16, 0xd4, 0x8a, 0xe1, 0x32, 0x53, 0x19, 0xfa, 0xfb, 0xac, 0x8f,
-	0x87, 0x2e, 0xed, 0x38, 0x7b, 0x56, 0xd0, 0xa5, 0xec, 0x7e, 0x86, 0x25, 0xa8, 0x20, 0xd0, 0x3f,
-	0xf8, 0x6e, 0x80, 0x6b, 0x5b, 0xed, 0xe3, 0x0a, 0x8a, 0xc9, 0x38, 0x4b, 0x9d, 0x44, 0xd3, 0x6f,
-	0x32, 0x89, 0x08, 0x2a, 0x89, 0x81, 0x50, 0x2f, 0xc1, 0xb9, 0xff, 0x1d, 0xa7, 0x06, 0x22, 0xd6,
-	0xe1, 0x16, 0x47, 0x59, 0x14, 0x41, 0x90, 0x51, 0x84, 0xcd, 0xda, 0xba, 0xc2, 0x24, 0x19, 0x8f,
-	0x15, 0x77, 0x10, 0x76, 0x54, 0x15, 0x97, 0x21, 0x34, 0xbc, 0x5c, 0x10, 0xde, 0x1b, 0xd3, 0x31,
-	0x7f, 0xb9, 0x31, 0x14, 0x93, 0x71, 0x96, 0x98, 0x12, 0x0f, 0x51, 0x05, 0x54, 0x03, 0x63, 0xea,
-	0x36, 0x9a, 0xe6, 0x85, 0x2b, 0x86, 0xd4, 0x85, 0x5c, 0x28, 0x40, 0x62, 0xd5, 0x66, 0xfe, 0x43,
-	0xa8, 0x44, 0x50, 0x47, 0xa9, 0x51, 0xcd, 0x45, 0x89, 0x89, 0x80, 0xf1, 0xb7, 0x1a, 0x5a, 0xdc,
-	0x0a, 0x5c, 0x2f, 0xa2, 0x4e, 0x22, 0xae, 0x88, 0xc6, 0x77, 0x03, 0x7f, 0x70, 0x3e, 0x5d, 0xe5,
-	0xdc, 0x74, 0x83, 0xbf, 0x2a, 0xa2, 0xe9, 0x1b, 0xe1, 0x7e, 0x90, 0xc4, 0xfa, 0x15, 0x34, 0xb5,
-	0xeb, 0xf9, 0x34, 0x86, 0xe9, 0x38, 0x65, 0x1a, 0xc3, 0xd4, 0xe0, 0x80, 0x7c, 0x49, 0xb0, 0x64,
-	0x39, 0x73, 0xa7, 0xfe, 0x1e, 0xaa, 0xf2, 0xf7, 0x0c, 0x23, 0x8f, 0xc6, 0xd0, 0xa8, 0xa6, 0xcc,
-	0xff, 0xb0, 0x7f, 0xa2, 0xc0, 0xf2, 0x9f, 0x28, 0x98, 0x0c, 0xa4, 0x12, 0xf5, 0xeb, 0xa8, 0x2c,
-	0xda, 0x70, 0x0c, 0xa3, 0x77, 0xca, 0xbc, 0x04, 0x23, 0x40, 0x60, 0xf9, 0x08, 0x10, 0x80, 0x8c,
-	0x22, 0x29, 0xfa, 0xff, 0x73, 0xe1, 0x16, 0x21, 0xc2, 0xc5, 0x3f, 0x12, 0x6e, 0x76, 0x5e, 0xea,
-	0xb7, 0x8d, 0xa6, 0xec, 0x41, 0x42, 0xb3, 0x39, 0x5e, 0x67, 0x79, 0x00, 0x20, 0xbf, 0x6c, 0x66,
-	0x61, 0xc2, 0xd1, 0xb1, 0xa1, 0x35, 0xfd, 0x86, 0x43, 0x6b, 0x07, 0x55, 0xf8, 0xda, 0xd5, 0xf1,
-	0x5c, 0x98, 0x57, 0x35, 0x73, 0xfd, 0x38, 0x35, 0xca, 0x7c, 0x95, 0x82, 0x21, 0x5e, 0xe6, 0x84,
-	0x2d, 0x57, 0x06, 0xca, 0x00, 0x56, 0x2d, 0x92, 0x49, 0x24, 0x8f, 0x49, 0x4c, 0xed, 0x4d, 0xfa,
-	0xdb, 0xb4, 0x26, 0x51, 0x20, 0x9f, 0x6a, 0xa8, 0xc2, 0xe5, 0xb1, 0x43, 0x13, 0xfd, 0x3a, 0x9a,
-	0x76, 0xc0, 0x10, 0x15, 0x82, 0xd8, 0x1a, 0xc7, 0xdd, 0x79, 0x61, 0x70, 0x86, 0xcc, 0x15, 0x98,
-	0x98, 0x08, 0x98, 0x35, 0x15, 0x27, 0xa2, 0x56, 0xb6, 0xde, 0x16, 0x78, 0x53, 0x11, 0x90, 0xbc,
-	0x1b, 0x61, 0x63, 0x92, 0x79, 0xf0, 0x67, 0x93, 0x68, 0x51, 0x59, 0x18, 0x37, 0x68, 0x3f, 0xa2,
-	0x7c, 0xa7, 0x3b, 0xdf, 0xf5, 0x7b, 0x0d, 0x4d, 0xf3, 0x3c, 0xc2, 0xdf, 0xab, 0x99, 0xcb, 0xec,
-	0x95, 0x38, 0x72, 0x66, 0x89, 0x16, 0x38, 0x7b, 0xa7, 0xac, 0xe1, 0x15, 0xf2, 0x46, 0xf9, 0xaa,
-	0x16, 0x97, 0x37, 0xb5, 0xf5, 0x71, 0x9d, 0xbe, 0x6e, 0x83, 0xc5, 0x87, 0x68, 0x51, 0x59, 0xaf,
-	0x95, 0x54, 0x7c, 0x70, 0x66, 0xd1, 0xfe, 0xeb, 0xa9, 0x45, 0x3b, 0x27, 0x9b, 0xff, 0xcc, 0xe6,
-	0xdd, 0x2b, 0x77, 0xec, 0x33, 0x4b, 0xf5, 0x0f, 0x93, 0x68, 0xf6, 0xae, 0x1d, 0xd3, 0xe8, 0x80,
-	0xba, 0x9b, 0xa1, 0xef, 0xd2, 0x48, 0xdf, 0x46, 0x45, 0xf6, 0x09, 0x25, 0x52, 0xbf, 0xdc, 0xe6,
-	0xdf, 0x57, 0xed, 0xec, 0xfb, 0xaa, 0x7d, 0x3f, 0xfb, 0xbe, 0x32, 0x1b, 0xe2, 0xf7, 0x80, 0x9f,
-	0xef, 0x29, 0x5e, 0x8f, 0xe2, 0xa7, 0x3f, 0x1b, 0x1a, 0x01, 0x9c, 0x15, 0x9f, 0x6f, 0xd9, 0xd4,
-	0x87, 0xf4, 0x57, 0x78, 0xf1, 0x01, 0x20, 0x05, 0x05, 0x16, 0x26, 0x1c, 0xd5, 0x3f, 0x42, 0x0b,
-	0x11, 0x75, 0xa8, 0x77, 0x40, 0x3b, 0xf9, 0x9e, 0xc5, 0x6f, 0xa1, 0x3d, 0x4c, 0x8d, 0x79, 0xe1,
-	0x7c, 0x57, 0x59, 0xb7, 0x96, 0x20, 0xcc, 0x69, 0x07, 0x26, 0x67, 0xb8, 0xfa, 0x43, 0x34, 0x1f,
-	0xd1, 0x5e, 0x98, 0xa8, 0xb1, 0xf9, 0x4d, 0xfd, 0x77, 0x98, 0x1a, 0x73, 0xdc, 0xa7, 0x86, 0x5e,
-	0x14, 0xa1, 0xc7, 0x70, 0x4c, 0x4e, 0x33, 0xf1, 0xf7, 0x5a, 0x9e, 0x48, 0x5e, 0xc0, 0xe7, 0x9e,
-	0xc8, 0xec, 0x53, 0x67, 0xf2, 0x35, 0x3e, 0x75, 0xd6, 0x51, 0xc9, 0x72, 0xdd, 0x88, 0xc6, 0xbc,
-	0xe5, 0x56, 0xb8, 0x10, 0x05, 0x24, 0x65, 0x21, 0x6c, 0x4c, 0x32, 0x8f, 0x79, 0xf3, 0xc5, 0xcb,
-	0xc6, 0xc4, 0xd1, 0xcb, 0xc6, 0xc4, 0x8b, 0xe3, 0x86, 0x76, 0x74, 0xdc, 0xd0, 0x9e, 0x9e, 0x34,
-	0x26, 0x9e, 0x9f, 0x34, 0xb4, 0xa3, 0x93, 0xc6, 0xc4, 0x8f, 0x27, 0x8d, 0x89, 0x0f, 0x2f, 0xbd,
-	0xc6, 0xf7, 0x85, 0x6b, 0xdb, 0xd3, 0xf0, 0x9a, 0x97, 0x7f, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x52,
-	0x5f, 0x14, 0xfe, 0xde, 0x0f, 0x00, 0x00,
+	// 1646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xdb, 0x4e, 0x6c, 0x97, 0x9d, 0xaf, 0x9e, 0x4d, 0x68, 0x02, 0xb8, 0x4d, 0x6d, 0x56,
+	0x32, 0x1f, 0x72, 0xa4, 0xac, 0x36, 0x42, 0x23, 0xc1, 0x6a, 0x3b, 0x21, 0xbb, 0x59, 0x2d, 0x99,
+	0xa5, 0x32, 0x9a, 0x45, 0x70, 0xb0, 0xfa, 0xa3, 0x6c, 0xb7, 0xa6, 0xdd, 0x6d, 0xba, 0x3b, 0xc9,
+	0x7a, 0x6f, 0x5c, 0x90, 0x10, 0x42, 0x5a, 0xad, 0x38, 0x20, 0x84, 0xd0, 0x9e, 0xf8, 0x13, 0xf8,
+	0x0b, 0x10, 0x9a, 0x63, 0x8e, 0x88, 0x43, 0xa3, 0x49, 0x2e, 0xe0, 0xa3, 0x8f, 0x9c, 0x50, 0xbd,
+	0xaa, 0xae, 0xae, 0x4e, 0x34, 0x30, 0x33, 0xe4, 0x14, 0xbf, 0xdf, 0xfb, 0xd5, 0x4b, 0xd7, 0xab,
+	0x5f, 0xbd, 0xf7, 0x0a, 0xbd, 0x11, 0xf8, 0xce, 0x9e, 0xe7, 0xec, 0x25, 0x69, 0x7c, 0xee, 0xa6,
+	0x49, 0x7f, 0x1a, 0x47, 0x69, 0xa4, 0x57, 0x3c, 0x67, 0xe7, 0xcd, 0x98, 0x4e, 0xa3, 0x64, 0x0f,
+	0x00, 0xe7, 0x7c, 0xb8, 0x37, 0x8a, 0x46, 0x11, 0x18, 0xf0, 0x8b, 0x13, 0x77, 0xcc, 0x51, 0x14,
+	0x8d, 0x02, 0x5a, 0xb0, 0x52, 0x7f, 0x42, 0x93, 0xd4, 0x9e, 0x4c, 0x05, 0x61, 0x9b, 0xc5, 0x87,
+	0x9f, 0x6e, 0x14, 0xec, 0x39, 0x34, 0xc7, 0x9b, 0xf4, 0xd3, 0x94, 0xff, 0xc4, 0x7f, 0xac, 0xa0,
+	0xd6, 0xb1, 0x1f, 0xd0, 0x27, 0x34, 0x4e, 0xfc, 0x28, 0xd4, 0x3f, 0x42, 0xf5, 0x0b, 0xfe, 0xd3,
+	0xd0, 0xba, 0x5a, 0xaf, 0xb5, 0xbf, 0xd1, 0xcf, 0x03, 0xf4, 0x9f, 0x50, 0x37, 0x8d, 0x62, 0xab,
+	0xfb, 0x2c, 0x33, 0x97, 0xe6, 0x99, 0x99, 0x13, 0x17, 0x99, 0xb9, 0xfa, 0xe9, 0x24, 0x78, 0x88,
+	0x85, 0x8d, 0x49, 0xee, 0xd1, 0x0f, 0x50, 0xdd, 0xa3, 0x01, 0x4d, 0xa9, 0x67, 0x54, 0xba, 0x5a,
+	0xaf, 0x61, 0x7d, 0x9d, 0xad, 0x13, 0x90, 0x5c, 0x27, 0x6c, 0x4c, 0x72, 0x8f, 0xfe, 0x0e, 0x5b,
+	0x77, 0xe1, 0xbb, 0x34, 0x31, 0xaa, 0xdd, 0x6a, 0xaf, 0x6d, 0x7d, 0x8d, 0xaf, 0x03, 0x68, 0x91,
+	0x99, 0x6d, 0xb1, 0x8e, 0xd9, 0xb0, 0x0c, 0x1c, 0x3a, 0x41, 0xeb, 0x7e, 0x78, 0x61, 0x07, 0xbe,
+	0x37, 0xc8, 0x97, 0xd7, 0x60, 0xf9, 0xb7, 0xe6, 0x99, 0xb9, 0x26, 0x5c, 0x47, 0x32, 0xca, 0x03,
+	0x88, 0x52, 0x82, 0x31, 0xb9, 0x45, 0xc3, 0xbf, 0xd0, 0x50, 0x4b, 0x24, 0xe7, 0x23, 0x3f, 0x49,
+	0xf5, 0x00, 0x35, 0xc4, 0xee, 0x12, 0x43, 0xeb, 0x56, 0x7b, 0xad, 0xfd, 0xf5, 0xbe, 0xe7, 0xf4,
+	0x95, 0x1c, 0x5a, 0xef, 0xb2, 0x04, 0x5d, 0x67, 0x66, 0x8b, 0xd8, 0x97, 0x02, 0x4b, 0xe6, 0x99,
+	0x29, 0xd7, 0xdd, 0x49, 0xd8, 0x17, 0x57, 0xbb, 0x2a, 0x97, 0x48, 0xe6, 0xc3, 0xda, 0xef, 0xbe,
+	0x34, 0x97, 0xf0, 0x6f, 0x56, 0xd1, 0x26, 0xfb, 0x07, 0x27, 0xe1, 0x30, 0x7a, 0x1c, 0x9f, 0x87,
+	0xae, 0xcd, 0x92, 0xf4, 0x6d, 0x54, 0x0b, 0xed, 0x09, 0x85, 0x73, 0x6a, 0x5a, 0xdb, 0xf3, 0xcc,
+	0x04, 0x7b, 0x91, 0x99, 0x08, 0xa2, 0x33, 0x03, 0x13, 0xc0, 0x18, 0x37, 0xf1, 0x3f, 0xa3, 0x46,
+	0xb5, 0xab, 0xf5, 0xaa, 0x9c, 0xcb, 0x6c, 0xc9, 0x65, 0x06, 0x26, 0x80, 0xe9, 0xef, 0x22, 0x34,
+	0x89, 0x3c, 0x7f, 0xe8, 0x53, 0x6f, 0x90, 0x18, 0xcb, 0xb0, 0xa2, 0x3b, 0xcf, 0xcc, 0x66, 0x8e,
+	0x9e, 0x2d, 0x32, 0x73, 0x1d, 0x96, 0x49, 0x04, 0x93, 0xc2, 0xab, 0xff, 0x59, 0x43, 0x2d, 0x19,
+	0xc1, 0x99, 0x19, 0xed, 0xae, 0xd6, 0xab, 0x59, 0xbf, 0xd5, 0x58, 0x5a, 0xfe, 0x9e, 0x99, 0x6f,
+	0x8f, 0xfc, 0x74, 0x7c, 0xee, 0xf4, 0xdd, 0x68, 0xb2, 0x97, 0xcc, 0x42, 0x37, 0x1d, 0xfb, 0xe1,
+	0x48, 0xf9, 0xa5, 0x8a, 0xb6, 0x7f, 0x36, 0x8e, 0xe2, 0xf4, 0xe4, 0x68, 0x9e, 0x99, 0xf2, 0xa3,
+	0xac, 0xd9, 0x22, 0x33, 0x37, 0x4a, 0xff, 0xdf, 0x9a, 0xe1, 0xdf, 0x5f, 0xed, 0xbe, 0x4e, 0x60,
+	0xa2, 0x84, 0x55, 0xc5, 0xdf, 0xfc, 0xff, 0xc5, 0xff, 0x10, 0x35, 0x12, 0xfa, 0xf3, 0x73, 0x1a,
+	0xba, 0xd4, 0x40, 0x90, 0xc5, 0x0e, 0x53, 0x41, 0x8e, 0x2d, 0x32, 0x73, 0x8d, 0xe7, 0x5e, 0x00,
+	0x98, 0x48, 0x9f, 0xfe, 0x08, 0xad, 0x25, 0xb3, 0x49, 0xe0, 0x87, 0x4f, 0x07, 0xa9, 0x1d, 0x8f,
+	0x68, 0x6a, 0x6c, 0xc2, 0x29, 0xf7, 0xe6, 0x99, 0xb9, 0x2a, 0x3c, 0x8f, 0xc1, 0x21, 0x75, 0x5c,
+	0x42, 0x31, 0x29, 0xb3, 0xf4, 0x43, 0xd4, 0x72, 0x82, 0xc8, 0x7d, 0x9a, 0x0c, 0xc6, 0x76, 0x32,
+	0x36, 0xf4, 0xae, 0xd6, 0x6b, 0x5b, 0x98, 0xa5, 0x95, 0xc3, 0x1f, 0xd8, 0xc9, 0x58, 0xa6, 0xb5,
+	0x80, 0x30, 0x51, 0xfc, 0xfa, 0x0f, 0x50, 0x93, 0x86, 0x6e, 0x3c, 0x9b, 0xb2, 0x0b, 0xfd, 0x00,
+	0x42, 0x80, 0x30, 0x24, 0x28, 0x85, 0x21, 0x11, 0x4c, 0x0a, 0xaf, 0x6e, 0xa1, 0x5a, 0x3a, 0x9b,
+	0x52, 0xa8, 0x05, 0x6b, 0xfb, 0xdb, 0x45, 0x72, 0xa5, 0xb8, 0x67, 0x53, 0xca, 0xd5, 0xc9, 0x78,
+	0x52, 0x9d, 0xcc, 0xc0, 0x04, 0x30, 0xfd, 0x18, 0xb5, 0xa6, 0x34, 0x9e, 0xf8, 0x09, 0xbf, 0x82,
+	0xb5, 0xae, 0xd6, 0x5b, 0xb5, 0x76, 0xe7, 0x99, 0xa9, 0xc2, 0x8b, 0xcc, 0xdc, 0x84, 0x95, 0x0a,
+	0x86, 0x89, 0xca, 0xd0, 0x3f, 0x54, 0x34, 0x1a, 0x26, 0x46, 0xab, 0xab, 0xf5, 0x96, 0xa1, 0x4e,
+	0x48, 0x41, 0x9c, 0x26, 0x77, 0x74, 0x76, 0x9a, 0xe0, 0x7f, 0x67, 0x66, 0xd5, 0x0f, 0x53, 0xa2,
+	0xd0, 0xf4, 0x21, 0xe2, 0x59, 0x1a, 0xc0, 0x1d, 0x5b, 0x85, 0x50, 0xef, 0x5f, 0x67, 0x66, 0x9b,
+	0xd8, 0x97, 0x16, 0x73, 0x9c, 0xf9, 0x9f, 0x51, 0x96, 0x28, 0x27, 0x37, 0x64, 0xa2, 0x24, 0x92,
+	0x07, 0xfe, 0xe2, 0x6a, 0xb7, 0xb4, 0x8c, 0x14, 0x8b, 0xf4, 0x27, 0xa8, 0x31, 0x0d, 0xec, 0x74,
+	0x18, 0xc5, 0x13, 0x63, 0x0d, 0x04, 0xaa, 0xe4, 0xf0, 0x63, 0xe1, 0x39, 0xb2, 0x53, 0xdb, 0xc2,
+	0x42, 0xa6, 0x92, 0x2f, 0xd5, 0x96, 0x03, 0x98, 0x48, 0x9f, 0x3e, 0x46, 0x6b, 0xfc, 0xfb, 0xdd,
+	0xf1, 0x79, 0xf8, 0xd4, 0x0f, 0x47, 0xc6, 0x1b, 0x70, 0x42, 0x5f, 0x29, 0xa2, 0xc3, 0xf7, 0x1c,
+	0x0a, 0x37, 0x97, 0xa1, 0xa3, 0x42, 0x52, 0x86, 0x25, 0x14, 0x93, 0x32, 0x4b, 0x3f, 0x42, 0xad,
+	0x20, 0x72, 0xed, 0x60, 0x30, 0x0c, 0xec, 0x51, 0x62, 0xfc, 0xb3, 0x0e, 0xc7, 0x07, 0x3a, 0x04,
+	0xfc, 0x98, 0xc1, 0x32, 0xed, 0x05, 0x84, 0x89, 0xe2, 0xd7, 0x3f, 0x40, 0x6d, 0x71, 0xc9, 0xb8,
+	0x9a, 0xff, 0x55, 0x07, 0x2d, 0x82, 0x0a, 0x84, 0x43, 0xe8, 0x79, 0x53, 0xbd, 0x9b, 0x5c, 0xd0,
+	0x2a, 0x43, 0xff, 0x31, 0xeb, 0x18, 0x91, 0x47, 0x07, 0xee, 0xd8, 0x0e, 0x47, 0x94, 0x29, 0x61,
+	0x5e, 0x87, 0xbb, 0x0a, 0x5b, 0x04, 0xdf, 0x21, 0xb8, 0x4e, 0xd5, 0x8e, 0xa1, 0xa0, 0x98, 0x94,
+	0x59, 0x6a, 0xcf, 0x5b, 0x79, 0x95, 0x9e, 0x47, 0x50, 0x5d, 0xb4, 0x1e, 0xa3, 0x0e, 0xeb, 0xbe,
+	0x77, 0x9d, 0x99, 0x88, 0xd8, 0x97, 0x27, 0x1c, 0x65, 0x51, 0x04, 0x41, 0x46, 0x11, 0x36, 0x6b,
+	0x20, 0x0a, 0x93, 0xe4, 0x3c, 0x56, 0x46, 0xc2, 0x68, 0xa0, 0xde, 0x97, 0x06, 0x84, 0x86, 0xcd,
+	0x85, 0xd1, 0xc7, 0xa5, 0x1b, 0xc3, 0x37, 0x57, 0x42, 0x31, 0x29, 0xb3, 0x44, 0x3f, 0xfa, 0x04,
+	0x35, 0x41, 0x0f, 0xd0, 0x10, 0x3f, 0x44, 0x2b, 0xbc, 0x44, 0x88, 0x76, 0xf8, 0xe0, 0x96, 0x68,
+	0xd8, 0xbd, 0xb6, 0xbe, 0x21, 0xf4, 0x28, 0xa8, 0x8b, 0xcc, 0x6c, 0x15, 0x6a, 0xc1, 0x44, 0xc0,
+	0xf8, 0x4f, 0x1a, 0xda, 0x3a, 0x09, 0x3d, 0x3f, 0xa6, 0x6e, 0x2a, 0x8e, 0x88, 0x26, 0x8f, 0xc2,
+	0x60, 0x76, 0x3f, 0xf5, 0xeb, 0xde, 0x74, 0x83, 0xff, 0x50, 0x43, 0x2b, 0x87, 0xd1, 0x79, 0x98,
+	0x26, 0xfa, 0x3b, 0x68, 0x79, 0xe8, 0x07, 0x34, 0x81, 0x3e, 0xbc, 0x6c, 0x99, 0xf3, 0xcc, 0xe4,
+	0x80, 0xdc, 0x24, 0x58, 0xb2, 0x70, 0x70, 0xa7, 0xfe, 0x23, 0xd4, 0xe2, 0xfb, 0x8c, 0x62, 0x9f,
+	0x26, 0x50, 0x12, 0x97, 0xad, 0xef, 0xb0, 0x2f, 0x51, 0x60, 0xf9, 0x25, 0x0a, 0x26, 0x03, 0xa9,
+	0x44, 0xfd, 0x3d, 0xd4, 0x10, 0x05, 0x3f, 0x81, 0x26, 0xbf, 0x6c, 0xbd, 0x05, 0xcd, 0x46, 0x60,
+	0x45, 0xb3, 0x11, 0x80, 0x8c, 0x22, 0x29, 0xfa, 0xf7, 0x0b, 0xe1, 0xd6, 0x20, 0xc2, 0x9b, 0xff,
+	0x4d, 0xb8, 0xf9, 0x7a, 0xa9, 0xdf, 0x3e, 0x5a, 0x76, 0x66, 0x29, 0xcd, 0x27, 0x06, 0x83, 0xe5,
+	0x01, 0x80, 0xe2, 0xb0, 0x99, 0x85, 0x09, 0x47, 0x4b, 0xed, 0x71, 0xe5, 0x15, 0xdb, 0xe3, 0x19,
+	0x6a, 0xf2, 0x01, 0x6f, 0xe0, 0x7b, 0xd0, 0x19, 0xdb, 0xd6, 0xc1, 0x75, 0x66, 0x36, 0xf8, 0xd0,
+	0x06, 0xe3, 0x42, 0x83, 0x13, 0x4e, 0x3c, 0x19, 0x28, 0x07, 0xd8, 0x6d, 0x91, 0x4c, 0x22, 0x79,
+	0x4c, 0x62, 0x6a, 0x6d, 0xd2, 0x5f, 0xa7, 0x34, 0x89, 0x0b, 0xf2, 0x4b, 0x0d, 0x35, 0xb9, 0x3c,
+	0xce, 0x68, 0xaa, 0xbf, 0x87, 0x56, 0x5c, 0x30, 0xc4, 0x0d, 0x41, 0x6c, 0x60, 0xe4, 0xee, 0xe2,
+	0x62, 0x70, 0x86, 0xcc, 0x15, 0x98, 0x98, 0x08, 0x98, 0x15, 0x15, 0x37, 0xa6, 0x76, 0x3e, 0x48,
+	0x57, 0x79, 0x51, 0x11, 0x90, 0x3c, 0x1b, 0x61, 0x63, 0x92, 0x7b, 0xf0, 0xaf, 0x2a, 0x68, 0x4b,
+	0x19, 0x4d, 0x8f, 0xe8, 0x34, 0xa6, 0x7c, 0x7a, 0xbc, 0xdf, 0x41, 0x7f, 0x1f, 0xad, 0xf0, 0x3c,
+	0xc2, 0xe7, 0xb5, 0xad, 0x1d, 0xb6, 0x25, 0x8e, 0xdc, 0x19, 0xd7, 0x05, 0xce, 0xf6, 0x94, 0x17,
+	0xbc, 0x6a, 0x51, 0x28, 0x5f, 0x54, 0xe2, 0x8a, 0xa2, 0x76, 0x50, 0xd6, 0xe9, 0xcb, 0x16, 0x58,
+	0x7c, 0x89, 0xb6, 0x94, 0x41, 0x5e, 0x49, 0xc5, 0x4f, 0xee, 0x8c, 0xf4, 0x5f, 0xbd, 0x35, 0xd2,
+	0x17, 0x64, 0xeb, 0x9b, 0x79, 0x67, 0x7d, 0xe1, 0x34, 0x7f, 0x67, 0x7c, 0xff, 0x6b, 0x05, 0xad,
+	0x3d, 0x72, 0x12, 0x1a, 0x5f, 0x50, 0xef, 0x38, 0x0a, 0x3c, 0x1a, 0xeb, 0xa7, 0xa8, 0xc6, 0x1e,
+	0x6b, 0x22, 0xf5, 0x3b, 0x7d, 0xfe, 0x92, 0xeb, 0xe7, 0x2f, 0xb9, 0xfe, 0xe3, 0xfc, 0x25, 0x67,
+	0x75, 0xc4, 0xff, 0x03, 0x7e, 0x31, 0x11, 0xf9, 0x13, 0x8a, 0x3f, 0xff, 0x87, 0xa9, 0x11, 0xc0,
+	0xd9, 0xe5, 0x0b, 0x6c, 0x87, 0x06, 0x90, 0xfe, 0x26, 0xbf, 0x7c, 0x00, 0x48, 0x41, 0x81, 0x85,
+	0x09, 0x47, 0xf5, 0x9f, 0xa1, 0xcd, 0x98, 0xba, 0xd4, 0xbf, 0xa0, 0x83, 0x62, 0xa2, 0xe3, 0xa7,
+	0xd0, 0x9f, 0x67, 0xe6, 0x86, 0x70, 0xfe, 0x50, 0x19, 0xec, 0xb6, 0x21, 0xcc, 0x6d, 0x07, 0x26,
+	0x77, 0xb8, 0xfa, 0x27, 0x68, 0x23, 0xa6, 0x93, 0x28, 0x55, 0x63, 0xf3, 0x93, 0xfa, 0xee, 0x3c,
+	0x33, 0xd7, 0xb9, 0x4f, 0x0d, 0xbd, 0x25, 0x42, 0x97, 0x70, 0x4c, 0x6e, 0x33, 0xf1, 0x5f, 0xb4,
+	0x22, 0x91, 0xfc, 0x02, 0xdf, 0x7b, 0x22, 0xf3, 0x47, 0x55, 0xe5, 0x25, 0x1e, 0x55, 0x07, 0xa8,
+	0x6e, 0x7b, 0x5e, 0x4c, 0x13, 0x5e, 0x72, 0x9b, 0x5c, 0x88, 0x02, 0x92, 0xb2, 0x10, 0x36, 0x26,
+	0xb9, 0x07, 0xff, 0xba, 0x82, 0x1a, 0x87, 0x51, 0x38, 0x0c, 0x7c, 0x37, 0x65, 0x65, 0x30, 0x8a,
+	0xfd, 0x91, 0x1f, 0xda, 0x81, 0x78, 0xc9, 0x41, 0x19, 0xcc, 0x31, 0x59, 0xbd, 0x72, 0x00, 0x13,
+	0xe9, 0x93, 0x9b, 0xaf, 0xdc, 0xd3, 0xe6, 0x0f, 0xcb, 0xef, 0x36, 0xbe, 0x29, 0xfc, 0xbf, 0xdf,
+	0x5e, 0xa5, 0x47, 0x14, 0x93, 0x22, 0xab, 0x87, 0xe2, 0xc8, 0xb9, 0x14, 0x19, 0x50, 0x48, 0x91,
+	0x59, 0x4c, 0x8a, 0xec, 0xaf, 0xf5, 0xfe, 0xb3, 0xe7, 0x9d, 0xa5, 0xab, 0xe7, 0x9d, 0xa5, 0x67,
+	0xd7, 0x1d, 0xed, 0xea, 0xba, 0xa3, 0x7d, 0x7e, 0xd3, 0x59, 0xfa, 0xf2, 0xa6, 0xa3, 0x5d, 0xdd,
+	0x74, 0x96, 0xfe, 0x76, 0xd3, 0x59, 0xfa, 0xe9, 0x5b, 0x2f, 0xf1, 0xae, 0xf3, 0x1c, 0x67, 0x05,
+	0xf6, 0xfd, 0xf6, 0x7f, 0x06, 0x00, 0x6c, 0x9e, 0x18, 0x40, 0x56, 0x11, 0x00, 0x00,
 }
 
 func (m *FileVersion) Marshal() (dAtA []byte, err error) {
@@ -738,6 +789,13 @@ func (m *FileInfoTruncated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.BlockChunking != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.BlockChunking))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if len(m.Encrypted) > 0 {
 		i -= len(m.Encrypted)
 		copy(dAtA[i:], m.Encrypted)
@@ -1254,6 +1312,61 @@ func (m *ObservedDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Conflict) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Conflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Conflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Local {
+		i--
+		if m.Local {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ModifiedBy) > 0 {
+		i -= len(m.ModifiedBy)
+		copy(dAtA[i:], m.ModifiedBy)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.ModifiedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStructs(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Original) > 0 {
+		i -= len(m.Original)
+		copy(dAtA[i:], m.Original)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.Original)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStructs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStructs(v)
 	base := offset
@@ -1365,6 +1478,9 @@ func (m *FileInfoTruncated) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovStructs(uint64(l))
 	}
+	if m.BlockChunking != 0 {
+		n += 2 + sovStructs(uint64(m.BlockChunking))
+	}
 	if m.LocalFlags != 0 {
 		n += 2 + sovStructs(uint64(m.LocalFlags))
 	}
@@ -1538,6 +1654,28 @@ func (m *ObservedDevice) ProtoSize() (n int) {
 	return n
 }
 
+func (m *Conflict) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Original)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStructs(uint64(l))
+	l = len(m.ModifiedBy)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	if m.Local {
+		n += 2
+	}
+	return n
+}
+
 func sovStructs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2234,6 +2372,25 @@ func (m *FileInfoTruncated) Unmarshal(dAtA []byte) error {
 				m.Encrypted = []byte{}
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockChunking", wireType)
+			}
+			m.BlockChunking = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockChunking |= protocol.BlockChunking(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 1000:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LocalFlags", wireType)
@@ -3392,6 +3549,173 @@ func (m *ObservedDevice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Conflict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Conflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Conflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Original", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Original = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModifiedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Local", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Local = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStructs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -48,7 +48,7 @@ func openJSONS(file string) (backend.Backend, error) {
 	}
 	dec := json.NewDecoder(fd)
 
-	db := backend.OpenMemory()
+	db := openMemory()
 
 	for {
 		var row map[string][]byte
@@ -77,8 +77,18 @@ func newLowlevel(t testing.TB, backend backend.Backend) *Lowlevel {
 	return ll
 }
 
+// openMemory returns an in-memory database of the backend the tests run
+// against: LevelDB, or SQLite when STTESTBACKEND is "sqlite". The build
+// script runs the tests with each.
+func openMemory() backend.Backend {
+	if os.Getenv("STTESTBACKEND") == "sqlite" {
+		return backend.OpenSQLiteMemory()
+	}
+	return backend.OpenMemory()
+}
+
 func newLowlevelMemory(t testing.TB) *Lowlevel {
-	return newLowlevel(t, backend.OpenMemory())
+	return newLowlevel(t, openMemory())
 }
 
 func newFileSet(t testing.TB, folder string, db *Lowlevel) *FileSet {
@@ -58,6 +58,9 @@ const (
 	ListenAddressesChanged
 	LoginAttempt
 	Failure
+	NetworkMeteredChanged
+	FolderConflictsChanged
+	MassChangeDetected
 
 	AllEvents = (1 << iota) - 1
 )
@@ -135,6 +138,12 @@ func (t EventType) String() string {
 		return "FolderWatchStateChanged"
 	case Failure:
 		return "Failure"
+	case NetworkMeteredChanged:
+		return "NetworkMeteredChanged"
+	case FolderConflictsChanged:
+		return "FolderConflictsChanged"
+	case MassChangeDetected:
+		return "MassChangeDetected"
 	default:
 		return "Unknown"
 	}
@@ -222,6 +231,12 @@ func UnmarshalEventType(s string) EventType {
 		return FolderWatchStateChanged
 	case "Failure":
 		return Failure
+	case "NetworkMeteredChanged":
+		return NetworkMeteredChanged
+	case "FolderConflictsChanged":
+		return FolderConflictsChanged
+	case "MassChangeDetected":
+		return MassChangeDetected
 	default:
 		return 0
 	}
@@ -256,6 +256,10 @@ func NewFilesystem(fsType FilesystemType, uri string, opts ...Option) Filesystem
 		fs = newBasicFilesystem(uri, opts...)
 	case FilesystemTypeFake:
 		fs = newFakeFilesystem(uri, opts...)
+	case FilesystemTypeS3:
+		fs = newS3Filesystem(uri, opts...)
+	case FilesystemTypeSFTP:
+		fs = newSFTPFilesystem(uri, opts...)
 	default:
 		l.Debugln("Unknown filesystem", fsType, uri)
 		fs = &errorFilesystem{
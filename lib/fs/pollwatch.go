@@ -0,0 +1,168 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"context"
+	"time"
+)
+
+// pollEntry is what we remember about a file between two polls.
+type pollEntry struct {
+	size  int64
+	mtime time.Time
+	mode  FileMode
+	isDir bool
+}
+
+// pollWatcher implements Watch for filesystems that have no native change
+// notifications, by walking the tree at a fixed interval and comparing the
+// result to the previous walk.
+type pollWatcher struct {
+	fs       Filesystem
+	name     string
+	ignore   Matcher
+	interval time.Duration
+	perms    bool
+}
+
+// watchByPolling starts a polling watcher for the given filesystem. The
+// returned channels follow the same contract as Filesystem.Watch.
+func watchByPolling(fs Filesystem, name string, ignore Matcher, ctx context.Context, ignorePerms bool, interval time.Duration) (<-chan Event, <-chan error, error) {
+	name, err := Canonicalize(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := fs.Lstat(name); err != nil {
+		return nil, nil, err
+	}
+
+	w := &pollWatcher{
+		fs:       fs,
+		name:     name,
+		ignore:   ignore,
+		interval: interval,
+		perms:    !ignorePerms,
+	}
+
+	outChan := make(chan Event)
+	errChan := make(chan error)
+	go w.loop(ctx, outChan, errChan)
+
+	return outChan, errChan, nil
+}
+
+func (w *pollWatcher) loop(ctx context.Context, outChan chan<- Event, errChan chan<- error) {
+	prev, err := w.snapshot(ctx)
+	if err != nil {
+		w.sendErr(ctx, errChan, err)
+		return
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			l.Debugln(w.fs.Type(), w.fs.URI(), "Watch: Stopped")
+			return
+		}
+
+		cur, err := w.snapshot(ctx)
+		if err != nil {
+			w.sendErr(ctx, errChan, err)
+			return
+		}
+
+		for _, ev := range w.diff(prev, cur) {
+			l.Debugln(w.fs.Type(), w.fs.URI(), "Watch: Sending", ev.Name, ev.Type)
+			select {
+			case outChan <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+		prev = cur
+	}
+}
+
+func (w *pollWatcher) sendErr(ctx context.Context, errChan chan<- error, err error) {
+	l.Debugln(w.fs.Type(), w.fs.URI(), "Watch: Sending error", err)
+	select {
+	case errChan <- err:
+	case <-ctx.Done():
+	}
+}
+
+// snapshot walks the watched tree and returns the current state of every
+// non-ignored item.
+func (w *pollWatcher) snapshot(ctx context.Context) (map[string]pollEntry, error) {
+	entries := make(map[string]pollEntry)
+	walkFn := func(path string, info FileInfo, err error) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			if IsNotExist(err) {
+				// Deleted while we were walking; the next round will
+				// pick it up.
+				return nil
+			}
+			return err
+		}
+		if IsInternal(path) {
+			if info.IsDir() {
+				return SkipDir
+			}
+			return nil
+		}
+		if w.ignore.ShouldIgnore(path) {
+			if info.IsDir() && w.ignore.SkipIgnoredDirs() {
+				return SkipDir
+			}
+			return nil
+		}
+		entries[path] = pollEntry{
+			size:  info.Size(),
+			mtime: info.ModTime(),
+			mode:  info.Mode(),
+			isDir: info.IsDir(),
+		}
+		return nil
+	}
+	if err := NewWalkFilesystem(w.fs).Walk(w.name, walkFn); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// diff returns the events that transform the prev state into cur.
+func (w *pollWatcher) diff(prev, cur map[string]pollEntry) []Event {
+	var evs []Event
+	for name, c := range cur {
+		p, ok := prev[name]
+		switch {
+		case !ok:
+			evs = append(evs, Event{Name: name, Type: NonRemove})
+		case p.isDir != c.isDir:
+			evs = append(evs, Event{Name: name, Type: Mixed})
+		case c.isDir:
+			if w.perms && p.mode != c.mode {
+				evs = append(evs, Event{Name: name, Type: NonRemove})
+			}
+		case p.size != c.size || !p.mtime.Equal(c.mtime) || (w.perms && p.mode != c.mode):
+			evs = append(evs, Event{Name: name, Type: NonRemove})
+		}
+	}
+	for name := range prev {
+		if _, ok := cur[name]; !ok {
+			evs = append(evs, Event{Name: name, Type: Remove})
+		}
+	}
+	return evs
+}
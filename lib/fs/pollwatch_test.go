@@ -0,0 +1,112 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type noIgnoreMatcher struct{}
+
+func (noIgnoreMatcher) ShouldIgnore(string) bool { return false }
+func (noIgnoreMatcher) SkipIgnoredDirs() bool    { return true }
+
+func TestPollWatcherDiff(t *testing.T) {
+	t0 := time.Unix(1000, 0)
+	t1 := time.Unix(2000, 0)
+	prev := map[string]pollEntry{
+		"unchanged": {size: 1, mtime: t0, mode: 0o644},
+		"modified":  {size: 1, mtime: t0, mode: 0o644},
+		"chmodded":  {size: 1, mtime: t0, mode: 0o644},
+		"removed":   {size: 1, mtime: t0, mode: 0o644},
+		"dir":       {isDir: true, mtime: t0, mode: 0o755},
+		"typechg":   {size: 1, mtime: t0, mode: 0o644},
+	}
+	cur := map[string]pollEntry{
+		"unchanged": {size: 1, mtime: t0, mode: 0o644},
+		"modified":  {size: 1, mtime: t1, mode: 0o644},
+		"chmodded":  {size: 1, mtime: t0, mode: 0o600},
+		"dir":       {isDir: true, mtime: t1, mode: 0o755},
+		"typechg":   {isDir: true, mtime: t0, mode: 0o755},
+		"added":     {size: 1, mtime: t0, mode: 0o644},
+	}
+
+	expected := map[Event]bool{
+		{Name: "modified", Type: NonRemove}: true,
+		{Name: "chmodded", Type: NonRemove}: true,
+		{Name: "removed", Type: Remove}:     true,
+		{Name: "typechg", Type: Mixed}:      true,
+		{Name: "added", Type: NonRemove}:    true,
+	}
+
+	w := &pollWatcher{perms: true}
+	evs := w.diff(prev, cur)
+	if len(evs) != len(expected) {
+		t.Errorf("expected %d events, got %v", len(expected), evs)
+	}
+	for _, ev := range evs {
+		if !expected[ev] {
+			t.Error("unexpected event", ev)
+		}
+	}
+
+	// Permission changes are not reported when ignoring permissions
+	w.perms = false
+	for _, ev := range w.diff(prev, cur) {
+		if ev.Name == "chmodded" {
+			t.Error("unexpected event", ev)
+		}
+	}
+}
+
+func TestPollWatcher(t *testing.T) {
+	fs := newBasicFilesystem(t.TempDir())
+	if err := fs.MkdirAll("dir", 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(fs, "dir/file", []byte("data"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evChan, errChan, err := watchByPolling(fs, ".", noIgnoreMatcher{}, ctx, false, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Let the watcher take its initial snapshot
+	time.Sleep(50 * time.Millisecond)
+	if err := fs.Remove("dir/file"); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(fs, "dir/other", []byte("data"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[Event]bool)
+	timeout := time.After(5 * time.Second)
+	for len(got) < 2 {
+		select {
+		case ev := <-evChan:
+			if ev.Name == "dir" {
+				// Directory mtime changes are not of interest here
+				continue
+			}
+			got[ev] = true
+		case err := <-errChan:
+			t.Fatal(err)
+		case <-timeout:
+			t.Fatal("timed out waiting for events, got", got)
+		}
+	}
+	if !got[Event{Name: "dir/file", Type: Remove}] || !got[Event{Name: "dir/other", Type: NonRemove}] {
+		t.Errorf("unexpected events: %v", got)
+	}
+}
@@ -0,0 +1,1126 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+const (
+	// Objects carry their modification time and permissions as user
+	// metadata, as neither has a native equivalent in object storage.
+	s3MetaMtime = "Mtime"
+	s3MetaMode  = "Mode"
+
+	// Directories are represented by an empty object with a trailing
+	// slash, in addition to any directory implied by an object key.
+	s3DirSuffix = "/"
+
+	s3DefaultFileMode = 0o644
+	s3DefaultDirMode  = 0o755
+
+	// How often the polling watcher lists the bucket. Listing is billed
+	// per request by most providers, so this is deliberately not too
+	// aggressive.
+	s3PollInterval = time.Minute
+
+	// How long a cached stat result is trusted before it's revalidated.
+	s3StatCacheTTL = time.Minute
+)
+
+var (
+	errS3Unsupported = errors.New("operation not supported by object storage")
+	errS3InvalidURI  = errors.New("invalid object storage URI, expected http(s)://[key:secret@]host[:port]/bucket[/prefix]")
+	errS3NotEmpty    = errors.New("directory not empty")
+	errS3IsDir       = errors.New("is a directory")
+	errS3ReadOnly    = errors.New("file not opened for writing")
+)
+
+// s3Filesystem implements Filesystem on top of an S3 compatible object
+// storage bucket. The root URI has the format
+//
+//	http(s)://[accesskey:secretkey@]host[:port]/bucket[/prefix]
+//
+// and credentials not given in the URI are taken from the environment
+// (AWS_ACCESS_KEY_ID / AWS_SECRET_ACCESS_KEY, MINIO_ACCESS_KEY /
+// MINIO_SECRET_KEY, or the AWS shared credentials file).
+//
+// Files are stored as objects, with their modification time and
+// permissions as user metadata. Directories are represented by marker
+// objects ending in a slash. Writes are spooled to a local temporary file
+// and uploaded when the file is synced or closed. Symlinks, ownership and
+// extended attributes are not supported. Changes are detected by
+// periodically listing the bucket.
+type s3Filesystem struct {
+	*s3Bucket
+	uri     string
+	prefix  string
+	options []Option
+}
+
+// s3Bucket is the state shared between all filesystems that point into the
+// same bucket with the same credentials.
+type s3Bucket struct {
+	client *minio.Client
+	bucket string
+	cache  *s3StatCache
+}
+
+var (
+	s3BucketsMut sync.Mutex
+	s3Buckets    = make(map[string]*s3Bucket)
+)
+
+// OptionS3Credentials carries the credentials given in the URI of an S3
+// filesystem, which are kept out of the URI it reports. Filesystems derived
+// from that URI, such as the versioner's, are given this option to use the
+// same credentials.
+type OptionS3Credentials struct {
+	user *url.Userinfo
+}
+
+func (*OptionS3Credentials) apply(fs Filesystem) Filesystem {
+	// Applied by newS3Filesystem itself, as the credentials are needed
+	// before the filesystem is.
+	if _, ok := fs.(*s3Filesystem); !ok {
+		l.Warnln("OptionS3Credentials must only be used with FilesystemTypeS3")
+	}
+	return fs
+}
+
+func (o *OptionS3Credentials) String() string {
+	// The secret is hashed so that it doesn't show up should the option
+	// ever get logged.
+	secret, _ := o.user.Password()
+	hash := sha256.Sum256([]byte(secret))
+	return fmt.Sprintf("s3(key=%s,secret=%s)", o.user.Username(), hex.EncodeToString(hash[:8]))
+}
+
+func newS3Filesystem(uri string, opts ...Option) Filesystem {
+	ep, err := parseS3URI(uri)
+	if err != nil {
+		return &errorFilesystem{fsType: FilesystemTypeS3, uri: redactS3URI(uri), err: err}
+	}
+	for _, opt := range opts {
+		if creds, ok := opt.(*OptionS3Credentials); ok && ep.user == nil {
+			ep.user = creds.user
+		}
+	}
+
+	s3BucketsMut.Lock()
+	defer s3BucketsMut.Unlock()
+
+	b, ok := s3Buckets[ep.bucketKey()]
+	if !ok {
+		client, err := minio.New(ep.host, &minio.Options{
+			Creds:  ep.credentials(),
+			Secure: ep.secure,
+		})
+		if err != nil {
+			return &errorFilesystem{fsType: FilesystemTypeS3, uri: ep.String(), err: err}
+		}
+		b = &s3Bucket{
+			client: client,
+			bucket: ep.bucket,
+			cache:  newS3StatCache(),
+		}
+		s3Buckets[ep.bucketKey()] = b
+	}
+
+	// The credentials are passed on as an option, whether they were given
+	// in the URI or as one.
+	var options []Option
+	for _, opt := range opts {
+		if _, ok := opt.(*OptionS3Credentials); !ok {
+			options = append(options, opt)
+		}
+	}
+	if ep.user != nil {
+		options = append(options, &OptionS3Credentials{user: ep.user})
+	}
+
+	return &s3Filesystem{
+		s3Bucket: b,
+		uri:      ep.String(),
+		prefix:   ep.prefix,
+		options:  options,
+	}
+}
+
+type s3Endpoint struct {
+	secure bool
+	host   string
+	user   *url.Userinfo
+	bucket string
+	prefix string
+}
+
+func parseS3URI(uri string) (s3Endpoint, error) {
+	// The URI may have been mangled by filepath.Join and friends, e.g.
+	// when the versioner derives a path below the folder root.
+	uri = filepath.ToSlash(uri)
+	for _, scheme := range []string{"http:/", "https:/"} {
+		if strings.HasPrefix(uri, scheme) && !strings.HasPrefix(uri, scheme+"/") {
+			uri = scheme + uri[len(scheme)-1:]
+		}
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return s3Endpoint{}, fmt.Errorf("%w: %v", errS3InvalidURI, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return s3Endpoint{}, errS3InvalidURI
+	}
+	bucket, prefix, _ := strings.Cut(strings.Trim(path.Clean("/"+u.Path), "/"), "/")
+	if bucket == "" {
+		return s3Endpoint{}, errS3InvalidURI
+	}
+	return s3Endpoint{
+		secure: u.Scheme == "https",
+		host:   u.Host,
+		user:   u.User,
+		bucket: bucket,
+		prefix: prefix,
+	}, nil
+}
+
+func (e s3Endpoint) credentials() *credentials.Credentials {
+	if e.user != nil {
+		secret, _ := e.user.Password()
+		return credentials.NewStaticV4(e.user.Username(), secret, "")
+	}
+	return credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvAWS{},
+		&credentials.EnvMinio{},
+		&credentials.FileAWSCredentials{},
+	})
+}
+
+func (e s3Endpoint) bucketKey() string {
+	u := url.URL{Scheme: "http", Host: e.host, User: e.user, Path: "/" + e.bucket}
+	if e.secure {
+		u.Scheme = "https"
+	}
+	return u.String()
+}
+
+// String returns the URI of the endpoint, without the credentials, such
+// that it can be logged and shown.
+func (e s3Endpoint) String() string {
+	u := url.URL{Scheme: "http", Host: e.host, Path: "/" + e.bucket}
+	if e.secure {
+		u.Scheme = "https"
+	}
+	if e.prefix != "" {
+		u.Path += "/" + e.prefix
+	}
+	return u.String()
+}
+
+// redactS3URI returns the URI without the credentials, if any, whether it
+// parses or not.
+func redactS3URI(uri string) string {
+	scheme, rest, ok := strings.Cut(uri, "://")
+	if !ok {
+		return uri
+	}
+	authority, _, _ := strings.Cut(rest, "/")
+	if i := strings.LastIndex(authority, "@"); i >= 0 {
+		rest = rest[i+1:]
+	}
+	return scheme + "://" + rest
+}
+
+// key returns the object key for the given relative path. The root is the
+// empty key when the filesystem isn't rooted below a prefix.
+func (f *s3Filesystem) key(name string) (string, error) {
+	name, err := Canonicalize(name)
+	if err != nil {
+		return "", err
+	}
+	name = filepath.ToSlash(name)
+	if name == "." {
+		return f.prefix, nil
+	}
+	if f.prefix == "" {
+		return name, nil
+	}
+	return f.prefix + "/" + name, nil
+}
+
+// dirPrefix returns the listing prefix for the contents of the directory
+// with the given key.
+func dirPrefix(key string) string {
+	if key == "" {
+		return ""
+	}
+	return key + s3DirSuffix
+}
+
+func (f *s3Filesystem) Chmod(name string, mode FileMode) error {
+	info, err := f.lstat(name)
+	if err != nil {
+		return err
+	}
+	if info.isDir {
+		return f.putDirMarker(info.key, mode&ModePerm)
+	}
+	return f.replaceMetadata("chmod", info, map[string]string{
+		s3MetaMtime: formatS3Mtime(info.mtime),
+		s3MetaMode:  formatS3Mode(mode & ModePerm),
+	})
+}
+
+func (*s3Filesystem) Lchown(_, _, _ string) error {
+	return errS3Unsupported
+}
+
+func (f *s3Filesystem) Chtimes(name string, _ time.Time, mtime time.Time) error {
+	info, err := f.lstat(name)
+	if err != nil {
+		return err
+	}
+	if info.isDir {
+		// Directory modification times carry no meaning in object
+		// storage; the mtime layer keeps track of them for us.
+		return nil
+	}
+	return f.replaceMetadata("chtimes", info, map[string]string{
+		s3MetaMtime: formatS3Mtime(mtime),
+		s3MetaMode:  formatS3Mode(info.mode),
+	})
+}
+
+// replaceMetadata rewrites the metadata of a file object, by copying it
+// onto itself.
+func (f *s3Filesystem) replaceMetadata(op string, info *s3FileInfo, meta map[string]string) error {
+	defer f.cache.invalidate(info.key)
+	_, err := f.client.CopyObject(context.TODO(), minio.CopyDestOptions{
+		Bucket:          f.bucket,
+		Object:          info.key,
+		UserMetadata:    meta,
+		ReplaceMetadata: true,
+	}, minio.CopySrcOptions{
+		Bucket: f.bucket,
+		Object: info.key,
+	})
+	return f.pathError(op, info.name, err)
+}
+
+func (f *s3Filesystem) Create(name string) (File, error) {
+	return f.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, s3DefaultFileMode)
+}
+
+func (*s3Filesystem) CreateSymlink(_, _ string) error {
+	return errS3Unsupported
+}
+
+func (f *s3Filesystem) DirNames(name string) ([]string, error) {
+	key, err := f.key(name)
+	if err != nil {
+		return nil, err
+	}
+	prefix := dirPrefix(key)
+
+	var names []string
+	for obj := range f.client.ListObjects(context.TODO(), f.bucket, minio.ListObjectsOptions{Prefix: prefix}) {
+		if obj.Err != nil {
+			return nil, f.pathError("readdirent", name, obj.Err)
+		}
+		if obj.Key == prefix {
+			// The marker of the directory itself
+			continue
+		}
+		child := strings.TrimSuffix(strings.TrimPrefix(obj.Key, prefix), s3DirSuffix)
+		if child == "" || len(names) > 0 && names[len(names)-1] == child {
+			// A file and a directory of the same name may both exist,
+			// and they are listed next to each other.
+			continue
+		}
+		if !strings.HasSuffix(obj.Key, s3DirSuffix) {
+			f.cache.validate(obj.Key, obj.ETag, obj.LastModified, obj.Size)
+		}
+		names = append(names, child)
+	}
+
+	if len(names) == 0 {
+		// Distinguish an empty directory from one that doesn't exist.
+		info, err := f.lstat(name)
+		if err != nil {
+			return nil, err
+		}
+		if !info.isDir {
+			return nil, f.pathError("readdirent", name, errors.New("not a directory"))
+		}
+	}
+	return names, nil
+}
+
+func (f *s3Filesystem) Lstat(name string) (FileInfo, error) {
+	info, err := f.lstat(name)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (f *s3Filesystem) lstat(name string) (*s3FileInfo, error) {
+	key, err := f.key(name)
+	if err != nil {
+		return nil, err
+	}
+	if info, ok := f.cache.get(key); ok {
+		return info, nil
+	}
+
+	info, err := f.lstatKey(key)
+	if err != nil {
+		return nil, f.pathError("lstat", name, err)
+	}
+	info.name = filepath.Base(name)
+	f.cache.put(key, info)
+	return info, nil
+}
+
+func (f *s3Filesystem) lstatKey(key string) (*s3FileInfo, error) {
+	ctx := context.TODO()
+
+	if key == "" {
+		// The bucket itself is the root.
+		ok, err := f.client.BucketExists(ctx, f.bucket)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrNotExist
+		}
+		return &s3FileInfo{key: key, isDir: true, mode: s3DefaultDirMode}, nil
+	}
+
+	obj, err := f.client.StatObject(ctx, f.bucket, key, minio.StatObjectOptions{})
+	if err == nil {
+		return newS3FileInfo(obj), nil
+	} else if !isS3NotExist(err) {
+		return nil, err
+	}
+
+	obj, err = f.client.StatObject(ctx, f.bucket, dirPrefix(key), minio.StatObjectOptions{})
+	if err == nil {
+		return newS3DirInfo(key, obj), nil
+	} else if !isS3NotExist(err) {
+		return nil, err
+	}
+
+	// There might still be objects below this key, making it an implicit
+	// directory.
+	for obj := range f.client.ListObjects(ctx, f.bucket, minio.ListObjectsOptions{Prefix: dirPrefix(key), MaxKeys: 1}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		return &s3FileInfo{key: key, isDir: true, mode: s3DefaultDirMode}, nil
+	}
+
+	return nil, ErrNotExist
+}
+
+func (f *s3Filesystem) Mkdir(name string, perm FileMode) error {
+	if _, err := f.lstat(name); err == nil {
+		return f.pathError("mkdir", name, ErrExist)
+	} else if !IsNotExist(err) {
+		return err
+	}
+	if parent, err := f.lstat(filepath.Dir(name)); err != nil {
+		return err
+	} else if !parent.isDir {
+		return f.pathError("mkdir", name, errors.New("not a directory"))
+	}
+	key, err := f.key(name)
+	if err != nil {
+		return err
+	}
+	return f.pathError("mkdir", name, f.putDirMarker(key, perm))
+}
+
+func (f *s3Filesystem) MkdirAll(name string, perm FileMode) error {
+	name, err := Canonicalize(name)
+	if err != nil {
+		return err
+	}
+
+	if info, err := f.lstat(name); err == nil {
+		if !info.isDir {
+			return f.pathError("mkdir", name, errors.New("not a directory"))
+		}
+		return nil
+	} else if !IsNotExist(err) {
+		return err
+	}
+
+	if name == "." {
+		if err := f.ensureBucket(); err != nil {
+			return f.pathError("mkdir", name, err)
+		}
+	} else if err := f.MkdirAll(filepath.Dir(name), perm); err != nil {
+		return err
+	}
+
+	key, err := f.key(name)
+	if err != nil {
+		return err
+	}
+	return f.pathError("mkdir", name, f.putDirMarker(key, perm))
+}
+
+func (f *s3Filesystem) ensureBucket() error {
+	ctx := context.TODO()
+	defer f.cache.invalidate("")
+	if ok, err := f.client.BucketExists(ctx, f.bucket); err != nil || ok {
+		return err
+	}
+	return f.client.MakeBucket(ctx, f.bucket, minio.MakeBucketOptions{})
+}
+
+func (f *s3Filesystem) putDirMarker(key string, perm FileMode) error {
+	if key == "" {
+		// The bucket root has no marker.
+		return nil
+	}
+	defer f.cache.invalidate(key)
+	_, err := f.client.PutObject(context.TODO(), f.bucket, dirPrefix(key), strings.NewReader(""), 0, minio.PutObjectOptions{
+		UserMetadata: map[string]string{s3MetaMode: formatS3Mode(perm & ModePerm)},
+	})
+	return err
+}
+
+func (f *s3Filesystem) Open(name string) (File, error) {
+	return f.OpenFile(name, os.O_RDONLY, 0)
+}
+
+func (f *s3Filesystem) OpenFile(name string, flags int, mode FileMode) (File, error) {
+	key, err := f.key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.lstat(name)
+	switch {
+	case err == nil && info.isDir:
+		return nil, f.pathError("open", name, errS3IsDir)
+	case err == nil && flags&(os.O_CREATE|os.O_EXCL) == os.O_CREATE|os.O_EXCL:
+		return nil, f.pathError("open", name, ErrExist)
+	case err != nil && (!IsNotExist(err) || flags&os.O_CREATE == 0):
+		return nil, err
+	case err != nil:
+		// Creating a new file; the parent must exist.
+		if parent, err := f.lstat(filepath.Dir(name)); err != nil {
+			return nil, err
+		} else if !parent.isDir {
+			return nil, f.pathError("open", name, errors.New("not a directory"))
+		}
+	}
+
+	file := &s3File{
+		fs:   f,
+		name: name,
+		key:  key,
+		mode: mode & ModePerm,
+	}
+
+	if flags&(os.O_WRONLY|os.O_RDWR) == 0 {
+		obj, err := f.client.GetObject(context.TODO(), f.bucket, key, minio.GetObjectOptions{})
+		if err != nil {
+			return nil, f.pathError("open", name, err)
+		}
+		file.obj = obj
+		file.info = info
+		return file, nil
+	}
+
+	// Writes go to a local spool file that is uploaded on sync and close.
+	spool, err := os.CreateTemp("", "syncthing-s3-")
+	if err != nil {
+		return nil, err
+	}
+	file.spool = spool
+	if info != nil {
+		file.mode = info.mode
+	}
+	if info != nil && flags&os.O_TRUNC == 0 {
+		if err := file.download(); err != nil {
+			file.discard()
+			return nil, f.pathError("open", name, err)
+		}
+	} else {
+		// A new or truncated file must be uploaded even if it's never
+		// written to.
+		file.dirty = true
+	}
+	if flags&os.O_APPEND != 0 {
+		if _, err := spool.Seek(0, io.SeekEnd); err != nil {
+			file.discard()
+			return nil, err
+		}
+	}
+	return file, nil
+}
+
+func (*s3Filesystem) ReadSymlink(_ string) (string, error) {
+	return "", errS3Unsupported
+}
+
+func (f *s3Filesystem) Remove(name string) error {
+	info, err := f.lstat(name)
+	if err != nil {
+		return err
+	}
+	defer f.cache.invalidate(info.key)
+
+	ctx := context.TODO()
+	if !info.isDir {
+		return f.pathError("remove", name, f.client.RemoveObject(ctx, f.bucket, info.key, minio.RemoveObjectOptions{}))
+	}
+
+	if info.key == "" {
+		return f.pathError("remove", name, errS3Unsupported)
+	}
+	prefix := dirPrefix(info.key)
+	for obj := range f.client.ListObjects(ctx, f.bucket, minio.ListObjectsOptions{Prefix: prefix, MaxKeys: 2}) {
+		if obj.Err != nil {
+			return f.pathError("remove", name, obj.Err)
+		}
+		if obj.Key != prefix {
+			return f.pathError("remove", name, errS3NotEmpty)
+		}
+	}
+	return f.pathError("remove", name, f.client.RemoveObject(ctx, f.bucket, prefix, minio.RemoveObjectOptions{}))
+}
+
+func (f *s3Filesystem) RemoveAll(name string) error {
+	info, err := f.lstat(name)
+	if IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if info.key == "" {
+		return f.pathError("remove", name, errS3Unsupported)
+	}
+	defer f.cache.invalidate(info.key)
+
+	ctx := context.TODO()
+	if !info.isDir {
+		return f.pathError("remove", name, f.client.RemoveObject(ctx, f.bucket, info.key, minio.RemoveObjectOptions{}))
+	}
+	for obj := range f.client.ListObjects(ctx, f.bucket, minio.ListObjectsOptions{Prefix: dirPrefix(info.key), Recursive: true}) {
+		if obj.Err != nil {
+			return f.pathError("remove", name, obj.Err)
+		}
+		f.cache.invalidate(strings.TrimSuffix(obj.Key, s3DirSuffix))
+		if err := f.client.RemoveObject(ctx, f.bucket, obj.Key, minio.RemoveObjectOptions{}); err != nil {
+			return f.pathError("remove", name, err)
+		}
+	}
+	return nil
+}
+
+// Rename copies the object(s) to their new name and removes the old ones.
+// Object storage has no atomic rename, so this is neither atomic nor cheap
+// for directories.
+func (f *s3Filesystem) Rename(oldname, newname string) error {
+	src, err := f.lstat(oldname)
+	if err != nil {
+		return err
+	}
+	dstKey, err := f.key(newname)
+	if err != nil {
+		return err
+	}
+	if dst, err := f.lstat(newname); err == nil && dst.isDir {
+		return f.pathError("rename", newname, errS3IsDir)
+	}
+	if src.key == "" {
+		return f.pathError("rename", oldname, errS3Unsupported)
+	}
+	defer f.cache.invalidate(src.key)
+	defer f.cache.invalidate(dstKey)
+
+	if !src.isDir {
+		return f.pathError("rename", oldname, f.move(src.key, dstKey))
+	}
+
+	srcPrefix := dirPrefix(src.key)
+	dstPrefix := dirPrefix(dstKey)
+	for obj := range f.client.ListObjects(context.TODO(), f.bucket, minio.ListObjectsOptions{Prefix: srcPrefix, Recursive: true}) {
+		if obj.Err != nil {
+			return f.pathError("rename", oldname, obj.Err)
+		}
+		f.cache.invalidate(strings.TrimSuffix(obj.Key, s3DirSuffix))
+		if err := f.move(obj.Key, dstPrefix+strings.TrimPrefix(obj.Key, srcPrefix)); err != nil {
+			return f.pathError("rename", oldname, err)
+		}
+	}
+	return nil
+}
+
+func (f *s3Filesystem) move(src, dst string) error {
+	ctx := context.TODO()
+	_, err := f.client.CopyObject(ctx, minio.CopyDestOptions{
+		Bucket: f.bucket,
+		Object: dst,
+	}, minio.CopySrcOptions{
+		Bucket: f.bucket,
+		Object: src,
+	})
+	if err != nil {
+		return err
+	}
+	return f.client.RemoveObject(ctx, f.bucket, src, minio.RemoveObjectOptions{})
+}
+
+func (f *s3Filesystem) Stat(name string) (FileInfo, error) {
+	return f.Lstat(name)
+}
+
+func (*s3Filesystem) SymlinksSupported() bool {
+	return false
+}
+
+func (*s3Filesystem) Walk(_ string, _ WalkFunc) error {
+	return errors.New("not implemented")
+}
+
+func (f *s3Filesystem) Watch(name string, ignore Matcher, ctx context.Context, ignorePerms bool) (<-chan Event, <-chan error, error) {
+	return watchByPolling(f, name, ignore, ctx, ignorePerms, s3PollInterval)
+}
+
+func (*s3Filesystem) Hide(_ string) error {
+	return nil
+}
+
+func (*s3Filesystem) Unhide(_ string) error {
+	return nil
+}
+
+func (f *s3Filesystem) Glob(pattern string) ([]string, error) {
+	dir := filepath.Dir(pattern)
+	file := filepath.Base(pattern)
+	names, err := f.DirNames(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, n := range names {
+		matched, err := filepath.Match(file, n)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			matches = append(matches, filepath.Join(dir, n))
+		}
+	}
+	return matches, nil
+}
+
+func (*s3Filesystem) Roots() ([]string, error) {
+	return []string{"/"}, nil
+}
+
+func (*s3Filesystem) Usage(_ string) (Usage, error) {
+	return Usage{}, errS3Unsupported
+}
+
+func (*s3Filesystem) Type() FilesystemType {
+	return FilesystemTypeS3
+}
+
+func (f *s3Filesystem) URI() string {
+	return f.uri
+}
+
+func (f *s3Filesystem) Options() []Option {
+	return f.options
+}
+
+func (*s3Filesystem) SameFile(fi1, fi2 FileInfo) bool {
+	f1, ok1 := fi1.(*s3FileInfo)
+	f2, ok2 := fi2.(*s3FileInfo)
+	if !ok1 || !ok2 {
+		return false
+	}
+	return f1.key == f2.key
+}
+
+func (*s3Filesystem) PlatformData(_ string, _, _ bool, _ XattrFilter) (protocol.PlatformData, error) {
+	return protocol.PlatformData{}, nil
+}
+
+func (*s3Filesystem) GetXattr(_ string, _ XattrFilter) ([]protocol.Xattr, error) {
+	return nil, ErrXattrsNotSupported
+}
+
+func (*s3Filesystem) SetXattr(_ string, _ []protocol.Xattr, _ XattrFilter) error {
+	return ErrXattrsNotSupported
+}
+
+func (*s3Filesystem) underlying() (Filesystem, bool) {
+	return nil, false
+}
+
+func (*s3Filesystem) wrapperType() filesystemWrapperType {
+	return filesystemWrapperTypeNone
+}
+
+func (*s3Filesystem) pathError(op, name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if isS3NotExist(err) {
+		err = ErrNotExist
+	}
+	var pathErr *iofs.PathError
+	if errors.As(err, &pathErr) {
+		return err
+	}
+	return &iofs.PathError{Op: op, Path: name, Err: err}
+}
+
+func isS3NotExist(err error) bool {
+	if errors.Is(err, ErrNotExist) {
+		return true
+	}
+	switch minio.ToErrorResponse(err).Code {
+	case "NoSuchKey", "NoSuchBucket":
+		return true
+	}
+	return false
+}
+
+func formatS3Mtime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func formatS3Mode(mode FileMode) string {
+	return strconv.FormatUint(uint64(mode), 8)
+}
+
+// s3File is an open object. Files opened for reading stream the object
+// contents; files opened for writing are spooled locally.
+type s3File struct {
+	fs   *s3Filesystem
+	name string
+	key  string
+	mode FileMode
+
+	// Read only
+	obj  *minio.Object
+	info *s3FileInfo
+
+	// Read/write
+	mut   sync.Mutex
+	spool *os.File
+	dirty bool
+}
+
+func (f *s3File) download() error {
+	obj, err := f.fs.client.GetObject(context.TODO(), f.fs.bucket, f.key, minio.GetObjectOptions{})
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+	if _, err := io.Copy(f.spool, obj); err != nil {
+		return err
+	}
+	_, err = f.spool.Seek(0, io.SeekStart)
+	return err
+}
+
+func (f *s3File) discard() {
+	f.spool.Close()
+	os.Remove(f.spool.Name())
+}
+
+func (f *s3File) Close() error {
+	if f.obj != nil {
+		return f.obj.Close()
+	}
+	defer f.discard()
+	return f.Sync()
+}
+
+func (f *s3File) Read(p []byte) (int, error) {
+	if f.obj != nil {
+		return f.obj.Read(p)
+	}
+	return f.spool.Read(p)
+}
+
+func (f *s3File) ReadAt(p []byte, off int64) (int, error) {
+	if f.obj != nil {
+		return f.obj.ReadAt(p, off)
+	}
+	return f.spool.ReadAt(p, off)
+}
+
+func (f *s3File) Seek(offset int64, whence int) (int64, error) {
+	if f.obj != nil {
+		return f.obj.Seek(offset, whence)
+	}
+	return f.spool.Seek(offset, whence)
+}
+
+func (f *s3File) Write(p []byte) (int, error) {
+	if f.obj != nil {
+		return 0, f.fs.pathError("write", f.name, errS3ReadOnly)
+	}
+	f.mut.Lock()
+	f.dirty = true
+	f.mut.Unlock()
+	return f.spool.Write(p)
+}
+
+func (f *s3File) WriteAt(p []byte, off int64) (int, error) {
+	if f.obj != nil {
+		return 0, f.fs.pathError("write", f.name, errS3ReadOnly)
+	}
+	f.mut.Lock()
+	f.dirty = true
+	f.mut.Unlock()
+	return f.spool.WriteAt(p, off)
+}
+
+func (f *s3File) Name() string {
+	return f.name
+}
+
+func (f *s3File) Truncate(size int64) error {
+	if f.obj != nil {
+		return f.fs.pathError("truncate", f.name, errS3ReadOnly)
+	}
+	f.mut.Lock()
+	f.dirty = true
+	f.mut.Unlock()
+	return f.spool.Truncate(size)
+}
+
+func (f *s3File) Stat() (FileInfo, error) {
+	if f.obj != nil {
+		return f.info, nil
+	}
+	fi, err := f.spool.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return &s3FileInfo{
+		name:  filepath.Base(f.name),
+		key:   f.key,
+		size:  fi.Size(),
+		mtime: fi.ModTime(),
+		mode:  f.mode,
+	}, nil
+}
+
+// Sync uploads the spooled contents, if they have changed since the last
+// upload.
+func (f *s3File) Sync() error {
+	if f.obj != nil {
+		return nil
+	}
+
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	if !f.dirty {
+		return nil
+	}
+
+	fi, err := f.spool.Stat()
+	if err != nil {
+		return err
+	}
+	defer f.fs.cache.invalidate(f.key)
+	_, err = f.fs.client.PutObject(context.TODO(), f.fs.bucket, f.key, io.NewSectionReader(f.spool, 0, fi.Size()), fi.Size(), minio.PutObjectOptions{
+		UserMetadata: map[string]string{
+			s3MetaMtime: formatS3Mtime(time.Now()),
+			s3MetaMode:  formatS3Mode(f.mode),
+		},
+	})
+	if err != nil {
+		return f.fs.pathError("sync", f.name, err)
+	}
+	f.dirty = false
+	return nil
+}
+
+// s3FileInfo is the stat result of an object or directory.
+type s3FileInfo struct {
+	name  string
+	key   string
+	isDir bool
+	size  int64
+	mtime time.Time
+	mode  FileMode
+
+	// Used to revalidate cached entries against listings
+	etag         string
+	lastModified time.Time
+}
+
+func newS3FileInfo(obj minio.ObjectInfo) *s3FileInfo {
+	info := &s3FileInfo{
+		key:          obj.Key,
+		size:         obj.Size,
+		mtime:        obj.LastModified,
+		mode:         s3DefaultFileMode,
+		etag:         obj.ETag,
+		lastModified: obj.LastModified,
+	}
+	if ns, err := strconv.ParseInt(obj.UserMetadata[s3MetaMtime], 10, 64); err == nil {
+		info.mtime = time.Unix(0, ns)
+	}
+	if mode, err := strconv.ParseUint(obj.UserMetadata[s3MetaMode], 8, 32); err == nil {
+		info.mode = FileMode(mode)
+	}
+	return info
+}
+
+func newS3DirInfo(key string, obj minio.ObjectInfo) *s3FileInfo {
+	info := &s3FileInfo{
+		key:   key,
+		isDir: true,
+		mode:  s3DefaultDirMode,
+	}
+	if mode, err := strconv.ParseUint(obj.UserMetadata[s3MetaMode], 8, 32); err == nil {
+		info.mode = FileMode(mode)
+	}
+	return info
+}
+
+func (f *s3FileInfo) Name() string {
+	return f.name
+}
+
+func (f *s3FileInfo) Mode() FileMode {
+	if f.isDir {
+		return f.mode | FileMode(os.ModeDir)
+	}
+	return f.mode
+}
+
+func (f *s3FileInfo) Size() int64 {
+	return f.size
+}
+
+func (f *s3FileInfo) ModTime() time.Time {
+	if f.isDir {
+		return time.Unix(0, 0)
+	}
+	return f.mtime
+}
+
+func (f *s3FileInfo) IsDir() bool {
+	return f.isDir
+}
+
+func (f *s3FileInfo) IsRegular() bool {
+	return !f.isDir
+}
+
+func (*s3FileInfo) IsSymlink() bool {
+	return false
+}
+
+func (*s3FileInfo) Owner() int {
+	return -1
+}
+
+func (*s3FileInfo) Group() int {
+	return -1
+}
+
+func (*s3FileInfo) Sys() interface{} {
+	return nil
+}
+
+func (*s3FileInfo) InodeChangeTime() time.Time {
+	return time.Time{}
+}
+
+// s3StatCache remembers stat results, as every stat is a round trip to the
+// server. Entries are trusted for a limited time, or as long as listings
+// show the object unchanged.
+type s3StatCache struct {
+	mut     sync.Mutex
+	entries map[string]s3StatCacheEntry
+}
+
+type s3StatCacheEntry struct {
+	info      *s3FileInfo
+	validated time.Time
+}
+
+func newS3StatCache() *s3StatCache {
+	return &s3StatCache{entries: make(map[string]s3StatCacheEntry)}
+}
+
+func (c *s3StatCache) get(key string) (*s3FileInfo, bool) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	e, ok := c.entries[key]
+	if !ok || time.Since(e.validated) > s3StatCacheTTL {
+		return nil, false
+	}
+	return e.info, true
+}
+
+func (c *s3StatCache) put(key string, info *s3FileInfo) {
+	c.mut.Lock()
+	c.entries[key] = s3StatCacheEntry{info: info, validated: time.Now()}
+	c.mut.Unlock()
+}
+
+// validate refreshes the entry for key if the listed object is unchanged
+// from what we have cached, and drops it otherwise.
+func (c *s3StatCache) validate(key, etag string, lastModified time.Time, size int64) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	if e.info.isDir || e.info.etag != etag || !e.info.lastModified.Equal(lastModified) || e.info.size != size {
+		delete(c.entries, key)
+		return
+	}
+	e.validated = time.Now()
+	c.entries[key] = e
+}
+
+func (c *s3StatCache) invalidate(key string) {
+	c.mut.Lock()
+	delete(c.entries, key)
+	c.mut.Unlock()
+}
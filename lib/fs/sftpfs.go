@@ -0,0 +1,684 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"net"
+	"net/url"
+	"os"
+	"os/user"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+const (
+	sftpDefaultPort         = "22"
+	sftpDefaultPollInterval = 10 * time.Second
+	sftpDialTimeout         = 30 * time.Second
+	sftpDefaultKnownHosts   = "~/.ssh/known_hosts"
+)
+
+var (
+	errSFTPInvalidURI = errors.New("invalid SFTP URI, expected sftp://[user@]host[:port]/path")
+	errSFTPNoAuth     = errors.New("no SFTP authentication method configured")
+	errSFTPNoLchown   = errors.New("changing ownership of symlinks is not supported over SFTP")
+)
+
+// OptionSFTP carries the connection options for an SFTP filesystem.
+type OptionSFTP struct {
+	PrivateKeyFile string
+	Password       string
+	// HostKey is the expected host key, either in authorized_keys format
+	// or as a "SHA256:..." fingerprint. When empty, the host key is looked
+	// up in the known hosts file.
+	HostKey        string
+	KnownHostsFile string
+	PollInterval   time.Duration
+}
+
+func (o *OptionSFTP) apply(fs Filesystem) Filesystem {
+	if sfs, ok := fs.(*sftpFilesystem); !ok {
+		l.Warnln("OptionSFTP must only be used with FilesystemTypeSFTP")
+	} else {
+		sfs.opt = *o
+	}
+	return fs
+}
+
+func (o *OptionSFTP) String() string {
+	// The password is hashed so that it doesn't show up should the option
+	// ever get logged.
+	pw := sha256.Sum256([]byte(o.Password))
+	return fmt.Sprintf("sftp(key=%s,password=%s,hostkey=%s,knownhosts=%s,poll=%v)", o.PrivateKeyFile, hex.EncodeToString(pw[:8]), o.HostKey, o.KnownHostsFile, o.PollInterval)
+}
+
+// sftpFilesystem implements Filesystem on a remote directory reached over
+// SSH/SFTP. The root URI has the format sftp://[user@]host[:port]/path,
+// where a path starting with /~/ is relative to the login directory.
+// Authentication and host key verification are set up by OptionSFTP.
+// Changes are detected by periodically walking the remote tree.
+type sftpFilesystem struct {
+	uri     string
+	user    string
+	addr    string
+	root    string
+	opt     OptionSFTP
+	options []Option
+
+	connOnce sync.Once
+	conn     *sftpConn
+}
+
+func newSFTPFilesystem(uri string, opts ...Option) Filesystem {
+	u, err := parseSFTPURI(uri)
+	if err != nil {
+		return &errorFilesystem{fsType: FilesystemTypeSFTP, uri: uri, err: err}
+	}
+
+	username := u.User.Username()
+	if username == "" {
+		if cur, err := user.Current(); err == nil {
+			username = cur.Username
+		}
+	}
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), sftpDefaultPort)
+	}
+	root := path.Clean("/" + u.Path)
+
+	fs := &sftpFilesystem{
+		uri:     (&url.URL{Scheme: "sftp", User: u.User, Host: u.Host, Path: root}).String(),
+		user:    username,
+		addr:    addr,
+		root:    root,
+		options: opts,
+	}
+	for _, opt := range opts {
+		opt.apply(fs)
+	}
+	return fs
+}
+
+func parseSFTPURI(uri string) (*url.URL, error) {
+	// The URI may have been mangled by filepath.Join and friends, e.g.
+	// when the versioner derives a path below the folder root.
+	uri = filepath.ToSlash(uri)
+	if strings.HasPrefix(uri, "sftp:/") && !strings.HasPrefix(uri, "sftp://") {
+		uri = "sftp://" + uri[len("sftp:/"):]
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errSFTPInvalidURI, err)
+	}
+	if u.Scheme != "sftp" || u.Host == "" {
+		return nil, errSFTPInvalidURI
+	}
+	return u, nil
+}
+
+// sftpConn is an SSH connection with an SFTP session, shared by all
+// filesystems using the same server, user and options. It is
+// re-established on demand after the connection is lost.
+type sftpConn struct {
+	mut    sync.Mutex
+	dial   func() (*ssh.Client, error)
+	client *sftp.Client
+	home   string
+}
+
+var (
+	sftpConnsMut sync.Mutex
+	sftpConns    = make(map[string]*sftpConn)
+)
+
+func (f *sftpFilesystem) getConn() *sftpConn {
+	f.connOnce.Do(func() {
+		key := f.user + "@" + f.addr + " " + f.opt.String()
+		sftpConnsMut.Lock()
+		defer sftpConnsMut.Unlock()
+		conn, ok := sftpConns[key]
+		if !ok {
+			conn = &sftpConn{dial: f.dialSSH}
+			sftpConns[key] = conn
+		}
+		f.conn = conn
+	})
+	return f.conn
+}
+
+func (c *sftpConn) get() (*sftp.Client, string, error) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if c.client != nil {
+		return c.client, c.home, nil
+	}
+
+	sshClient, err := c.dial()
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+		return nil, "", err
+	}
+	home, err := client.Getwd()
+	if err != nil {
+		client.Close()
+		sshClient.Close()
+		return nil, "", err
+	}
+
+	go func() {
+		// Forget the client once the connection is gone, so that the next
+		// operation reconnects.
+		_ = client.Wait()
+		sshClient.Close()
+		c.mut.Lock()
+		if c.client == client {
+			c.client = nil
+		}
+		c.mut.Unlock()
+	}()
+
+	c.client = client
+	c.home = home
+	return client, home, nil
+}
+
+func (f *sftpFilesystem) dialSSH() (*ssh.Client, error) {
+	auth, err := f.authMethods()
+	if err != nil {
+		return nil, err
+	}
+	hostKeyCallback, err := f.hostKeyCallback()
+	if err != nil {
+		return nil, err
+	}
+	return ssh.Dial("tcp", f.addr, &ssh.ClientConfig{
+		User:            f.user,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         sftpDialTimeout,
+	})
+}
+
+func (f *sftpFilesystem) authMethods() ([]ssh.AuthMethod, error) {
+	var methods []ssh.AuthMethod
+	if f.opt.PrivateKeyFile != "" {
+		keyFile, err := ExpandTilde(f.opt.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		bs, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := ssh.ParsePrivateKey(bs)
+		if err != nil {
+			return nil, fmt.Errorf("parsing private key: %w", err)
+		}
+		methods = append(methods, ssh.PublicKeys(signer))
+	}
+	if f.opt.Password != "" {
+		methods = append(methods, ssh.Password(f.opt.Password))
+	}
+	if len(methods) == 0 {
+		if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+			if conn, err := net.Dial("unix", sock); err == nil {
+				methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+			}
+		}
+	}
+	if len(methods) == 0 {
+		return nil, errSFTPNoAuth
+	}
+	return methods, nil
+}
+
+func (f *sftpFilesystem) hostKeyCallback() (ssh.HostKeyCallback, error) {
+	if f.opt.HostKey != "" {
+		expected := strings.TrimSpace(f.opt.HostKey)
+		if !strings.HasPrefix(expected, "SHA256:") {
+			pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(expected))
+			if err != nil {
+				return nil, fmt.Errorf("parsing host key: %w", err)
+			}
+			return ssh.FixedHostKey(pub), nil
+		}
+		return func(_ string, _ net.Addr, key ssh.PublicKey) error {
+			if fp := ssh.FingerprintSHA256(key); fp != expected {
+				return fmt.Errorf("host key mismatch: got %s, expected %s", fp, expected)
+			}
+			return nil
+		}, nil
+	}
+
+	knownHosts := f.opt.KnownHostsFile
+	if knownHosts == "" {
+		knownHosts = sftpDefaultKnownHosts
+	}
+	knownHosts, err := ExpandTilde(knownHosts)
+	if err != nil {
+		return nil, err
+	}
+	return knownhosts.New(knownHosts)
+}
+
+// rooted returns the remote path for the given relative path.
+func (f *sftpFilesystem) rooted(name string) (*sftp.Client, string, error) {
+	name, err := Canonicalize(name)
+	if err != nil {
+		return nil, "", err
+	}
+	client, home, err := f.getConn().get()
+	if err != nil {
+		return nil, "", err
+	}
+	root := f.root
+	if root == "/~" || strings.HasPrefix(root, "/~/") {
+		root = path.Join(home, root[2:])
+	}
+	return client, path.Join(root, filepath.ToSlash(name)), nil
+}
+
+func (f *sftpFilesystem) Chmod(name string, mode FileMode) error {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return err
+	}
+	return client.Chmod(p, os.FileMode(mode))
+}
+
+func (f *sftpFilesystem) Lchown(name, uid, gid string) error {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return err
+	}
+	nuid, err := strconv.Atoi(uid)
+	if err != nil {
+		return err
+	}
+	ngid, err := strconv.Atoi(gid)
+	if err != nil {
+		return err
+	}
+	// SFTP has no lchown; chown follows symlinks, which we must not do.
+	if info, err := client.Lstat(p); err != nil {
+		return err
+	} else if info.Mode()&os.ModeSymlink != 0 {
+		return &iofs.PathError{Op: "lchown", Path: name, Err: errSFTPNoLchown}
+	}
+	return client.Chown(p, nuid, ngid)
+}
+
+func (f *sftpFilesystem) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return err
+	}
+	return client.Chtimes(p, atime, mtime)
+}
+
+func (f *sftpFilesystem) Create(name string) (File, error) {
+	return f.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+}
+
+func (f *sftpFilesystem) CreateSymlink(target, name string) error {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return err
+	}
+	return client.Symlink(target, p)
+}
+
+func (f *sftpFilesystem) DirNames(name string) ([]string, error) {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return nil, err
+	}
+	infos, err := client.ReadDir(p)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	return names, nil
+}
+
+func (f *sftpFilesystem) Lstat(name string) (FileInfo, error) {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := client.Lstat(p)
+	if err != nil {
+		return nil, err
+	}
+	return sftpFileInfo{info}, nil
+}
+
+func (f *sftpFilesystem) Mkdir(name string, perm FileMode) error {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return err
+	}
+	if err := client.Mkdir(p); err != nil {
+		// Most servers report nothing more specific than a failure
+		if _, serr := client.Lstat(p); serr == nil {
+			return &iofs.PathError{Op: "mkdir", Path: name, Err: os.ErrExist}
+		}
+		return err
+	}
+	return client.Chmod(p, os.FileMode(perm))
+}
+
+func (f *sftpFilesystem) MkdirAll(name string, perm FileMode) error {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return err
+	}
+	if err := client.MkdirAll(p); err != nil {
+		return err
+	}
+	return client.Chmod(p, os.FileMode(perm))
+}
+
+func (f *sftpFilesystem) Open(name string) (File, error) {
+	return f.OpenFile(name, os.O_RDONLY, 0)
+}
+
+func (f *sftpFilesystem) OpenFile(name string, flags int, mode FileMode) (File, error) {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return nil, err
+	}
+	created := false
+	if flags&os.O_CREATE != 0 {
+		if _, err := client.Lstat(p); IsNotExist(err) {
+			created = true
+		}
+	}
+	fd, err := client.OpenFile(p, flags)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		// SFTP creates files with the server's default permissions
+		if err := fd.Chmod(os.FileMode(mode)); err != nil {
+			l.Debugln("sftp: chmod of new file failed:", name, err)
+		}
+	}
+	return sftpFile{File: fd, name: name}, nil
+}
+
+func (f *sftpFilesystem) ReadSymlink(name string) (string, error) {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return "", err
+	}
+	return client.ReadLink(p)
+}
+
+func (f *sftpFilesystem) Remove(name string) error {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return err
+	}
+	info, err := client.Lstat(p)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return client.RemoveDirectory(p)
+	}
+	return client.Remove(p)
+}
+
+func (f *sftpFilesystem) RemoveAll(name string) error {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return err
+	}
+	if _, err := client.Lstat(p); IsNotExist(err) {
+		return nil
+	}
+	return client.RemoveAll(p)
+}
+
+func (f *sftpFilesystem) Rename(oldname, newname string) error {
+	client, oldp, err := f.rooted(oldname)
+	if err != nil {
+		return err
+	}
+	_, newp, err := f.rooted(newname)
+	if err != nil {
+		return err
+	}
+	if _, ok := client.HasExtension("posix-rename@openssh.com"); ok {
+		return client.PosixRename(oldp, newp)
+	}
+	// Plain SFTP rename refuses to overwrite the destination.
+	if info, err := client.Lstat(newp); err == nil && !info.IsDir() {
+		if err := client.Remove(newp); err != nil {
+			return err
+		}
+	}
+	return client.Rename(oldp, newp)
+}
+
+func (f *sftpFilesystem) Stat(name string) (FileInfo, error) {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := client.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	return sftpFileInfo{info}, nil
+}
+
+func (*sftpFilesystem) SymlinksSupported() bool {
+	return true
+}
+
+func (*sftpFilesystem) Walk(_ string, _ WalkFunc) error {
+	return errors.New("not implemented")
+}
+
+func (f *sftpFilesystem) Watch(name string, ignore Matcher, ctx context.Context, ignorePerms bool) (<-chan Event, <-chan error, error) {
+	interval := f.opt.PollInterval
+	if interval <= 0 {
+		interval = sftpDefaultPollInterval
+	}
+	return watchByPolling(f, name, ignore, ctx, ignorePerms, interval)
+}
+
+func (*sftpFilesystem) Hide(_ string) error {
+	return nil
+}
+
+func (*sftpFilesystem) Unhide(_ string) error {
+	return nil
+}
+
+func (f *sftpFilesystem) Glob(pattern string) ([]string, error) {
+	dir := filepath.Dir(pattern)
+	file := filepath.Base(pattern)
+	names, err := f.DirNames(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, n := range names {
+		matched, err := filepath.Match(file, n)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			matches = append(matches, filepath.Join(dir, n))
+		}
+	}
+	return matches, nil
+}
+
+func (*sftpFilesystem) Roots() ([]string, error) {
+	return []string{"/"}, nil
+}
+
+func (f *sftpFilesystem) Usage(name string) (Usage, error) {
+	client, p, err := f.rooted(name)
+	if err != nil {
+		return Usage{}, err
+	}
+	vfs, err := client.StatVFS(p)
+	if err != nil {
+		return Usage{}, err
+	}
+	return Usage{
+		Free:  vfs.FreeSpace(),
+		Total: vfs.TotalSpace(),
+	}, nil
+}
+
+func (*sftpFilesystem) Type() FilesystemType {
+	return FilesystemTypeSFTP
+}
+
+func (f *sftpFilesystem) URI() string {
+	return f.uri
+}
+
+func (f *sftpFilesystem) Options() []Option {
+	return f.options
+}
+
+func (*sftpFilesystem) SameFile(fi1, fi2 FileInfo) bool {
+	f1, ok1 := fi1.(sftpFileInfo)
+	f2, ok2 := fi2.(sftpFileInfo)
+	if !ok1 || !ok2 {
+		return false
+	}
+	// SFTP exposes no inode numbers, so this is a best effort.
+	return f1.Name() == f2.Name() && f1.Size() == f2.Size() && f1.ModTime().Equal(f2.ModTime()) && f1.Mode() == f2.Mode()
+}
+
+func (f *sftpFilesystem) PlatformData(name string, withOwnership, _ bool, _ XattrFilter) (protocol.PlatformData, error) {
+	var pd protocol.PlatformData
+	if withOwnership {
+		// Names can't be resolved as the users live on the remote host.
+		stat, err := f.Lstat(name)
+		if err != nil {
+			return protocol.PlatformData{}, err
+		}
+		pd.Unix = &protocol.UnixData{UID: stat.Owner(), GID: stat.Group()}
+	}
+	return pd, nil
+}
+
+func (*sftpFilesystem) GetXattr(_ string, _ XattrFilter) ([]protocol.Xattr, error) {
+	return nil, ErrXattrsNotSupported
+}
+
+func (*sftpFilesystem) SetXattr(_ string, _ []protocol.Xattr, _ XattrFilter) error {
+	return ErrXattrsNotSupported
+}
+
+func (*sftpFilesystem) underlying() (Filesystem, bool) {
+	return nil, false
+}
+
+func (*sftpFilesystem) wrapperType() filesystemWrapperType {
+	return filesystemWrapperTypeNone
+}
+
+// sftpFile implements the fs.File interface on top of an sftp.File
+type sftpFile struct {
+	*sftp.File
+	name string
+}
+
+func (f sftpFile) Name() string {
+	return f.name
+}
+
+func (f sftpFile) Stat() (FileInfo, error) {
+	info, err := f.File.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return sftpFileInfo{info}, nil
+}
+
+func (f sftpFile) Sync() error {
+	err := f.File.Sync()
+	var statusErr *sftp.StatusError
+	if errors.As(err, &statusErr) && statusErr.FxCode() == sftp.ErrSSHFxOpUnsupported {
+		// The fsync@openssh.com extension is not universally supported
+		return nil
+	}
+	return err
+}
+
+// sftpFileInfo implements the fs.FileInfo interface on top of the
+// os.FileInfo returned by the sftp package.
+type sftpFileInfo struct {
+	os.FileInfo
+}
+
+func (e sftpFileInfo) Mode() FileMode {
+	return FileMode(e.FileInfo.Mode())
+}
+
+func (e sftpFileInfo) IsSymlink() bool {
+	return e.FileInfo.Mode()&os.ModeSymlink != 0
+}
+
+func (e sftpFileInfo) IsRegular() bool {
+	return e.FileInfo.Mode()&os.ModeType == 0
+}
+
+func (e sftpFileInfo) Owner() int {
+	if st, ok := e.Sys().(*sftp.FileStat); ok {
+		return int(st.UID)
+	}
+	return -1
+}
+
+func (e sftpFileInfo) Group() int {
+	if st, ok := e.Sys().(*sftp.FileStat); ok {
+		return int(st.GID)
+	}
+	return -1
+}
+
+func (sftpFileInfo) InodeChangeTime() time.Time {
+	return time.Time{}
+}
@@ -0,0 +1,289 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const sftpTestPassword = "hunter2"
+
+// newFakeSFTPServer starts an in-process SSH server that accepts the test
+// password and serves the sftp subsystem on the local filesystem. It
+// returns the listening address and the server's public host key.
+func newFakeSFTPServer(t *testing.T) (string, ssh.PublicKey) {
+	t.Helper()
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &ssh.ServerConfig{
+		PasswordCallback: func(_ ssh.ConnMetadata, pw []byte) (*ssh.Permissions, error) {
+			if string(pw) != sftpTestPassword {
+				return nil, os.ErrPermission
+			}
+			return nil, nil
+		},
+	}
+	cfg.AddHostKey(signer)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveFakeSFTP(conn, cfg)
+		}
+	}()
+
+	return ln.Addr().String(), signer.PublicKey()
+}
+
+func serveFakeSFTP(conn net.Conn, cfg *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, cfg)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChan := range chans {
+		if newChan.ChannelType() != "session" {
+			newChan.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		ch, reqs, err := newChan.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range reqs {
+				ok := req.Type == "subsystem" && string(req.Payload[4:]) == "sftp"
+				req.Reply(ok, nil)
+				if ok {
+					go func() {
+						srv, err := sftp.NewServer(ch)
+						if err != nil {
+							ch.Close()
+							return
+						}
+						_ = srv.Serve()
+						srv.Close()
+					}()
+				}
+			}
+		}()
+	}
+}
+
+func newTestSFTPFilesystem(t *testing.T, pollInterval time.Duration) (Filesystem, string) {
+	t.Helper()
+	addr, hostKey := newFakeSFTPServer(t)
+	dir := t.TempDir()
+	uri := "sftp://tester@" + addr + filepath.ToSlash(dir)
+	fs := NewFilesystem(FilesystemTypeSFTP, uri, &OptionSFTP{
+		Password:     sftpTestPassword,
+		HostKey:      string(ssh.MarshalAuthorizedKey(hostKey)),
+		PollInterval: pollInterval,
+	})
+	return fs, dir
+}
+
+func TestSFTPFS(t *testing.T) {
+	fs, dir := newTestSFTPFilesystem(t, 0)
+
+	if err := fs.MkdirAll("a/b", 0o755); err != nil {
+		t.Fatal(err)
+	}
+	fd, err := fs.Create("a/b/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fd.Write([]byte("hello world")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fd.WriteAt([]byte("there"), 6); err != nil {
+		t.Fatal(err)
+	}
+	if err := fd.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if err := fd.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The data ended up in the directory served over SFTP
+	bs, err := os.ReadFile(filepath.Join(dir, "a", "b", "file"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != "hello there" {
+		t.Errorf("got %q, expected %q", bs, "hello there")
+	}
+
+	info, err := fs.Lstat("a/b/file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.IsRegular() || info.Size() != 11 {
+		t.Errorf("unexpected file info: regular=%v size=%d", info.IsRegular(), info.Size())
+	}
+
+	mtime := time.Unix(1234567890, 0)
+	if err := fs.Chtimes("a/b/file", mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := fs.Stat("a/b/file"); err != nil {
+		t.Fatal(err)
+	} else if !info.ModTime().Equal(mtime) {
+		t.Errorf("got mtime %v, expected %v", info.ModTime(), mtime)
+	}
+
+	if err := fs.CreateSymlink("b/file", "a/link"); err != nil {
+		t.Fatal(err)
+	}
+	if target, err := fs.ReadSymlink("a/link"); err != nil {
+		t.Fatal(err)
+	} else if target != "b/file" {
+		t.Errorf("got symlink target %q, expected %q", target, "b/file")
+	}
+	if info, err := fs.Lstat("a/link"); err != nil {
+		t.Fatal(err)
+	} else if !info.IsSymlink() {
+		t.Error("expected a symlink")
+	}
+
+	// Rename overwrites an existing destination
+	if err := os.WriteFile(filepath.Join(dir, "a", "other"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.Rename("a/b/file", "a/other"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fs.Lstat("a/b/file"); !IsNotExist(err) {
+		t.Errorf("expected not exist, got %v", err)
+	}
+
+	names, err := fs.DirNames("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(names)
+	if strings.Join(names, ",") != "b,link,other" {
+		t.Errorf("unexpected dir names %v", names)
+	}
+
+	if err := fs.Mkdir("a/b", 0o755); !IsExist(err) {
+		t.Errorf("expected exist error, got %v", err)
+	}
+	if err := fs.Remove("a/b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.RemoveAll("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.RemoveAll("a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Lstat(filepath.Join(dir, "a")); !os.IsNotExist(err) {
+		t.Errorf("expected removed, got %v", err)
+	}
+}
+
+func TestSFTPFSHostKeyMismatch(t *testing.T) {
+	addr, _ := newFakeSFTPServer(t)
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, hostKey := range []string{string(ssh.MarshalAuthorizedKey(other)), ssh.FingerprintSHA256(other)} {
+		fs := NewFilesystem(FilesystemTypeSFTP, "sftp://tester@"+addr+"/", &OptionSFTP{
+			Password: sftpTestPassword,
+			HostKey:  hostKey,
+		})
+		if _, err := fs.Lstat("."); err == nil {
+			t.Errorf("expected host key %q to be rejected", hostKey)
+		}
+	}
+}
+
+func TestSFTPFSURI(t *testing.T) {
+	cases := []struct {
+		in, out string
+	}{
+		{"sftp://host/path/to/dir", "sftp://host/path/to/dir"},
+		{"sftp://user@host:2222/path/", "sftp://user@host:2222/path"},
+		{"sftp:/user@host/path/.stversions", "sftp://user@host/path/.stversions"},
+		{"sftp://host", "sftp://host/"},
+	}
+	for _, tc := range cases {
+		fs := newSFTPFilesystem(tc.in)
+		if uri := fs.URI(); uri != tc.out {
+			t.Errorf("%q: got %q, expected %q", tc.in, uri, tc.out)
+		}
+	}
+
+	for _, uri := range []string{"/local/path", "http://host/path", "sftp:///path"} {
+		if _, ok := newSFTPFilesystem(uri).(*errorFilesystem); !ok {
+			t.Errorf("%q: expected an error filesystem", uri)
+		}
+	}
+}
+
+func TestSFTPFSWatch(t *testing.T) {
+	fs, dir := newTestSFTPFilesystem(t, 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evs, errs, err := fs.Watch(".", noIgnoreMatcher{}, ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Let the watcher take its initial snapshot
+	time.Sleep(100 * time.Millisecond)
+	if err := os.WriteFile(filepath.Join(dir, "file"), []byte("data"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ev := <-evs:
+		if ev.Name != "file" || ev.Type != NonRemove {
+			t.Errorf("unexpected event %v", ev)
+		}
+	case err := <-errs:
+		t.Fatal(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
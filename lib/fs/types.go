@@ -12,6 +12,10 @@ func (t FilesystemType) String() string {
 		return "basic"
 	case FilesystemTypeFake:
 		return "fake"
+	case FilesystemTypeS3:
+		return "s3"
+	case FilesystemTypeSFTP:
+		return "sftp"
 	default:
 		return "unknown"
 	}
@@ -27,6 +31,10 @@ func (t *FilesystemType) UnmarshalText(bs []byte) error {
 		*t = FilesystemTypeBasic
 	case "fake":
 		*t = FilesystemTypeFake
+	case "s3":
+		*t = FilesystemTypeS3
+	case "sftp":
+		*t = FilesystemTypeSFTP
 	default:
 		*t = FilesystemTypeBasic
 	}
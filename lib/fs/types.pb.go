@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
 	math "math"
 )
 
@@ -26,16 +27,22 @@ type FilesystemType int32
 const (
 	FilesystemTypeBasic FilesystemType = 0
 	FilesystemTypeFake  FilesystemType = 1
+	FilesystemTypeS3    FilesystemType = 2
+	FilesystemTypeSFTP  FilesystemType = 3
 )
 
 var FilesystemType_name = map[int32]string{
 	0: "FILESYSTEM_TYPE_BASIC",
 	1: "FILESYSTEM_TYPE_FAKE",
+	2: "FILESYSTEM_TYPE_S3",
+	3: "FILESYSTEM_TYPE_SFTP",
 }
 
 var FilesystemType_value = map[string]int32{
 	"FILESYSTEM_TYPE_BASIC": 0,
 	"FILESYSTEM_TYPE_FAKE":  1,
+	"FILESYSTEM_TYPE_S3":    2,
+	"FILESYSTEM_TYPE_SFTP":  3,
 }
 
 func (FilesystemType) EnumDescriptor() ([]byte, []int) {
@@ -49,20 +56,23 @@ func init() {
 func init() { proto.RegisterFile("lib/fs/types.proto", fileDescriptor_b556f45c4309ad5d) }
 
 var fileDescriptor_b556f45c4309ad5d = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
+	// 280 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xca, 0xc9, 0x4c, 0xd2,
 	0x4f, 0x2b, 0xd6, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62,
 	0x4a, 0x2b, 0x96, 0x52, 0x2e, 0x4a, 0x2d, 0xc8, 0x2f, 0xd6, 0x07, 0x0b, 0x24, 0x95, 0xa6, 0xe9,
-	0xa7, 0xe7, 0xa7, 0xe7, 0x83, 0x39, 0x60, 0x16, 0x44, 0xa1, 0x56, 0x0d, 0x17, 0x9f, 0x5b, 0x66,
-	0x4e, 0x6a, 0x71, 0x65, 0x71, 0x49, 0x6a, 0x6e, 0x48, 0x65, 0x41, 0xaa, 0x90, 0x11, 0x97, 0xa8,
-	0x9b, 0xa7, 0x8f, 0x6b, 0x70, 0x64, 0x70, 0x88, 0xab, 0x6f, 0x7c, 0x48, 0x64, 0x80, 0x6b, 0xbc,
-	0x93, 0x63, 0xb0, 0xa7, 0xb3, 0x00, 0x83, 0x94, 0x78, 0xd7, 0x5c, 0x05, 0x61, 0x54, 0xe5, 0x4e,
-	0x89, 0xc5, 0x99, 0xc9, 0x42, 0x06, 0x5c, 0x22, 0xe8, 0x7a, 0xdc, 0x1c, 0xbd, 0x5d, 0x05, 0x18,
-	0xa5, 0xc4, 0xba, 0xe6, 0x2a, 0x08, 0xa1, 0x6a, 0x71, 0x4b, 0xcc, 0x4e, 0x95, 0x62, 0x59, 0xb1,
-	0x44, 0x8e, 0xc1, 0xc9, 0xfd, 0xc4, 0x43, 0x39, 0x86, 0x0b, 0x0f, 0xe5, 0x18, 0x4e, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x05, 0x8f, 0xe5, 0x18, 0x2f, 0x3c,
-	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x35, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
-	0x39, 0x3f, 0x57, 0xbf, 0xb8, 0x32, 0x2f, 0xb9, 0x24, 0x23, 0x33, 0x2f, 0x1d, 0x89, 0x05, 0xf1,
-	0x7b, 0x12, 0x1b, 0xd8, 0x37, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x30, 0x9a, 0x86,
-	0x0c, 0x01, 0x00, 0x00,
+	0xa7, 0xe7, 0xa7, 0xe7, 0x83, 0x39, 0x60, 0x16, 0x44, 0xa1, 0x14, 0x67, 0x6a, 0x45, 0x09, 0x84,
+	0xa9, 0xd5, 0xc6, 0xc4, 0xc5, 0xe7, 0x96, 0x99, 0x93, 0x5a, 0x5c, 0x59, 0x5c, 0x92, 0x9a, 0x1b,
+	0x52, 0x59, 0x90, 0x2a, 0x64, 0xc4, 0x25, 0xea, 0xe6, 0xe9, 0xe3, 0x1a, 0x1c, 0x19, 0x1c, 0xe2,
+	0xea, 0x1b, 0x1f, 0x12, 0x19, 0xe0, 0x1a, 0xef, 0xe4, 0x18, 0xec, 0xe9, 0x2c, 0xc0, 0x20, 0x25,
+	0xde, 0x35, 0x57, 0x41, 0x18, 0x55, 0xb9, 0x53, 0x62, 0x71, 0x66, 0xb2, 0x90, 0x01, 0x97, 0x08,
+	0xba, 0x1e, 0x37, 0x47, 0x6f, 0x57, 0x01, 0x46, 0x29, 0xb1, 0xae, 0xb9, 0x0a, 0x42, 0xa8, 0x5a,
+	0xdc, 0x12, 0xb3, 0x53, 0x85, 0x1c, 0xb8, 0x84, 0xd0, 0x75, 0x04, 0x1b, 0x0b, 0x30, 0x49, 0x69,
+	0x74, 0xcd, 0x55, 0x10, 0x40, 0x55, 0x1f, 0x6c, 0x7c, 0xa9, 0x4f, 0x15, 0x43, 0x4c, 0xc8, 0x0d,
+	0xd3, 0xce, 0x60, 0xb7, 0x90, 0x00, 0x01, 0x66, 0x29, 0x1d, 0x4c, 0x3b, 0x41, 0x32, 0x97, 0xfa,
+	0x54, 0xb1, 0x88, 0x4a, 0xb1, 0xac, 0x58, 0x22, 0xc7, 0xe0, 0xe4, 0x7e, 0xe2, 0xa1, 0x1c, 0xc3,
+	0x85, 0x87, 0x72, 0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
+	0xc3, 0x82, 0xc7, 0x72, 0x8c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9a,
+	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x5f, 0x5c, 0x99, 0x97, 0x5c, 0x92,
+	0x91, 0x99, 0x97, 0x8e, 0xc4, 0x82, 0xc4, 0x48, 0x12, 0x1b, 0x38, 0x60, 0x8d, 0x01, 0x03, 0x00,
+	0xf4, 0x4b, 0x00, 0x19, 0xa2, 0x01, 0x00, 0x00,
 }
@@ -22,18 +22,20 @@ type LocationEnum string
 // Use strings as keys to make printout and serialization of the locations map
 // more meaningful.
 const (
-	ConfigFile    LocationEnum = "config"
-	CertFile      LocationEnum = "certFile"
-	KeyFile       LocationEnum = "keyFile"
-	HTTPSCertFile LocationEnum = "httpsCertFile"
-	HTTPSKeyFile  LocationEnum = "httpsKeyFile"
-	Database      LocationEnum = "database"
-	LogFile       LocationEnum = "logFile"
-	CsrfTokens    LocationEnum = "csrfTokens"
-	PanicLog      LocationEnum = "panicLog"
-	AuditLog      LocationEnum = "auditLog"
-	GUIAssets     LocationEnum = "guiAssets"
-	DefFolder     LocationEnum = "defFolder"
+	ConfigFile     LocationEnum = "config"
+	CertFile       LocationEnum = "certFile"
+	KeyFile        LocationEnum = "keyFile"
+	HTTPSCertFile  LocationEnum = "httpsCertFile"
+	HTTPSKeyFile   LocationEnum = "httpsKeyFile"
+	Database       LocationEnum = "database"
+	DatabaseSQLite LocationEnum = "databaseSQLite"
+	LogFile        LocationEnum = "logFile"
+	CsrfTokens     LocationEnum = "csrfTokens"
+	PanicLog       LocationEnum = "panicLog"
+	AuditLog       LocationEnum = "auditLog"
+	AuditTrail     LocationEnum = "auditTrail"
+	GUIAssets      LocationEnum = "guiAssets"
+	DefFolder      LocationEnum = "defFolder"
 )
 
 type BaseDirEnum string
@@ -48,6 +50,7 @@ const (
 	UserHomeBaseDir BaseDirEnum = "userHome"
 
 	LevelDBDir          = "index-v0.14.0.db"
+	SQLiteDBFile        = "index.sqlite"
 	configFileName      = "config.xml"
 	defaultStateDir     = ".local/state/syncthing"
 	oldDefaultConfigDir = ".config/syncthing"
@@ -114,18 +117,20 @@ func GetBaseDir(baseDir BaseDirEnum) string {
 
 // Use the variables from baseDirs here
 var locationTemplates = map[LocationEnum]string{
-	ConfigFile:    "${config}/config.xml",
-	CertFile:      "${config}/cert.pem",
-	KeyFile:       "${config}/key.pem",
-	HTTPSCertFile: "${config}/https-cert.pem",
-	HTTPSKeyFile:  "${config}/https-key.pem",
-	Database:      "${data}/" + LevelDBDir,
-	LogFile:       "${data}/syncthing.log", // --logfile on Windows
-	CsrfTokens:    "${data}/csrftokens.txt",
-	PanicLog:      "${data}/panic-%{timestamp}.log",
-	AuditLog:      "${data}/audit-%{timestamp}.log",
-	GUIAssets:     "${config}/gui",
-	DefFolder:     "${userHome}/Sync",
+	ConfigFile:     "${config}/config.xml",
+	CertFile:       "${config}/cert.pem",
+	KeyFile:        "${config}/key.pem",
+	HTTPSCertFile:  "${config}/https-cert.pem",
+	HTTPSKeyFile:   "${config}/https-key.pem",
+	Database:       "${data}/" + LevelDBDir,
+	DatabaseSQLite: "${data}/" + SQLiteDBFile,
+	LogFile:        "${data}/syncthing.log", // --logfile on Windows
+	CsrfTokens:     "${data}/csrftokens.txt",
+	PanicLog:       "${data}/panic-%{timestamp}.log",
+	AuditLog:       "${data}/audit-%{timestamp}.log",
+	AuditTrail:     "${data}/audit-trail.log",
+	GUIAssets:      "${config}/gui",
+	DefFolder:      "${userHome}/Sync",
 }
 
 var locations = make(map[LocationEnum]string)
@@ -168,9 +173,11 @@ func PrettyPaths() string {
 	fmt.Fprintf(&b, "Device private key & certificate files:\n\t%s\n\t%s\n\n", Get(KeyFile), Get(CertFile))
 	fmt.Fprintf(&b, "GUI / API HTTPS private key & certificate files:\n\t%s\n\t%s\n\n", Get(HTTPSKeyFile), Get(HTTPSCertFile))
 	fmt.Fprintf(&b, "Database location:\n\t%s\n\n", Get(Database))
+	fmt.Fprintf(&b, "SQLite database location:\n\t%s\n\n", Get(DatabaseSQLite))
 	fmt.Fprintf(&b, "Log file:\n\t%s\n\n", Get(LogFile))
 	fmt.Fprintf(&b, "GUI override directory:\n\t%s\n\n", Get(GUIAssets))
 	fmt.Fprintf(&b, "CSRF tokens file:\n\t%s\n\n", Get(CsrfTokens))
+	fmt.Fprintf(&b, "Audit trail file:\n\t%s\n\n", Get(AuditTrail))
 	fmt.Fprintf(&b, "Default sync folder directory:\n\t%s\n\n", Get(DefFolder))
 	return b.String()
 }
@@ -0,0 +1,204 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package merge
+
+import (
+	"bytes"
+)
+
+func init() {
+	// Register the constructor for this type of merger with the name "lines"
+	factories["lines"] = newLines
+}
+
+// lines is a line based three-way merge, in the manner of diff3. Lines
+// which are unchanged on both sides anchor the merge; between them, a
+// change on only one side is taken, as is the same change on both sides.
+// Different changes to the same lines are a conflict.
+type lines struct{}
+
+func newLines() Merger {
+	return lines{}
+}
+
+func (lines) Merge(base, local, remote []byte) ([]byte, error) {
+	o, a, b := splitLines(base), splitLines(local), splitLines(remote)
+	ma, mb := matchLines(o, a), matchLines(o, b)
+
+	var res [][]byte
+	io, ia, ib := 0, 0, 0
+	for {
+		// Find the next base line that is unchanged on both sides, or the
+		// end of all three.
+		so, sa, sb := io, len(a), len(b)
+		for ; so < len(o); so++ {
+			if ma[so] >= 0 && mb[so] >= 0 {
+				sa, sb = ma[so], mb[so]
+				break
+			}
+		}
+
+		chunk, ok := mergeChunk(o[io:so], a[ia:sa], b[ib:sb])
+		if !ok {
+			return nil, ErrConflict
+		}
+		res = append(res, chunk...)
+
+		if so == len(o) {
+			break
+		}
+		res = append(res, o[so])
+		io, ia, ib = so+1, sa+1, sb+1
+	}
+
+	return bytes.Join(res, nil), nil
+}
+
+// mergeChunk merges a region which differs between the base and at least
+// one of the sides.
+func mergeChunk(o, a, b [][]byte) ([][]byte, bool) {
+	switch {
+	case equalLines(o, a):
+		return b, true
+	case equalLines(o, b), equalLines(a, b):
+		return a, true
+	default:
+		return nil, false
+	}
+}
+
+// splitLines splits the data after each newline, keeping the line endings
+// so that the merged result can be put back together as is.
+func splitLines(data []byte) [][]byte {
+	ls := bytes.SplitAfter(data, []byte("\n"))
+	if len(ls[len(ls)-1]) == 0 {
+		ls = ls[:len(ls)-1]
+	}
+	return ls
+}
+
+func equalLines(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchLines returns, for each line of a, the index of the line of b it's
+// matched with in a shortest edit script between the two, or -1 if it was
+// removed. The edit script is found using the linear space variant of
+// Myers' algorithm, which divides the problem at the middle snake of the
+// edit script, rather than keeping the trace of all edits.
+func matchLines(a, b [][]byte) []int {
+	match := make([]int, len(a))
+	for i := range match {
+		match[i] = -1
+	}
+	size := len(a) + len(b) + 2
+	m := &matcher{
+		a:     a,
+		b:     b,
+		match: match,
+		vf:    make([]int, 2*size),
+		vb:    make([]int, 2*size),
+		off:   size,
+	}
+	m.compare(0, len(a), 0, len(b))
+	return match
+}
+
+type matcher struct {
+	a, b   [][]byte
+	match  []int
+	vf, vb []int // furthest reaching x per diagonal, forward and backward
+	off    int   // offset of diagonal zero in vf and vb
+}
+
+// compare matches the lines of a[aLo:aHi] with those of b[bLo:bHi].
+func (m *matcher) compare(aLo, aHi, bLo, bHi int) {
+	// Common lines at the start and end are matched as is.
+	for aLo < aHi && bLo < bHi && bytes.Equal(m.a[aLo], m.b[bLo]) {
+		m.match[aLo] = bLo
+		aLo++
+		bLo++
+	}
+	for aLo < aHi && bLo < bHi && bytes.Equal(m.a[aHi-1], m.b[bHi-1]) {
+		aHi--
+		bHi--
+		m.match[aHi] = bHi
+	}
+	if aLo == aHi || bLo == bHi {
+		// Only removals or additions remain.
+		return
+	}
+
+	// Both halves on either side of the middle snake have a shorter edit
+	// script than the whole, as there are at least two edits after
+	// removing the common start and end.
+	x, y, u, v := m.middleSnake(aLo, aHi, bLo, bHi)
+	m.compare(aLo, x, bLo, y)
+	for ; x < u; x, y = x+1, y+1 {
+		m.match[x] = y
+	}
+	m.compare(u, aHi, v, bHi)
+}
+
+// middleSnake returns the snake, from (x, y) to (u, v), in the middle of a
+// shortest edit script from a[aLo:aHi] to b[bLo:bHi]. It's found by
+// searching forward from the start and backward from the end at the same
+// time, until the paths overlap.
+func (m *matcher) middleSnake(aLo, aHi, bLo, bHi int) (x, y, u, v int) {
+	n, mm := aHi-aLo, bHi-bLo
+	delta := n - mm
+	odd := delta%2 != 0
+	vf, vb, off := m.vf, m.vb, m.off
+
+	// The backward search is over the reversed lines, so that both keep
+	// the furthest x reached on each diagonal, counted from their start.
+	// Forward diagonal k corresponds to backward diagonal delta-k.
+	vf[off+1], vb[off+1] = 0, 0
+	for d := 0; d <= (n+mm+1)/2; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x0 int
+			if k == -d || (k != d && vf[off+k-1] < vf[off+k+1]) {
+				x0 = vf[off+k+1]
+			} else {
+				x0 = vf[off+k-1] + 1
+			}
+			x := x0
+			for x < n && x-k < mm && bytes.Equal(m.a[aLo+x], m.b[bLo+x-k]) {
+				x++
+			}
+			vf[off+k] = x
+			if kb := delta - k; odd && kb >= -(d-1) && kb <= d-1 && x+vb[off+kb] >= n {
+				return aLo + x0, bLo + x0 - k, aLo + x, bLo + x - k
+			}
+		}
+		for k := -d; k <= d; k += 2 {
+			var x0 int
+			if k == -d || (k != d && vb[off+k-1] < vb[off+k+1]) {
+				x0 = vb[off+k+1]
+			} else {
+				x0 = vb[off+k-1] + 1
+			}
+			x := x0
+			for x < n && x-k < mm && bytes.Equal(m.a[aHi-1-x], m.b[bHi-1-(x-k)]) {
+				x++
+			}
+			vb[off+k] = x
+			if kf := delta - k; !odd && kf >= -d && kf <= d && x+vf[off+kf] >= n {
+				return aHi - x, bHi - (x - k), aHi - x0, bHi - (x0 - k)
+			}
+		}
+	}
+	panic("bug: no middle snake")
+}
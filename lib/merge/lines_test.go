@@ -0,0 +1,171 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package merge
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestLinesMerge(t *testing.T) {
+	cases := []struct {
+		name                string
+		base, local, remote string
+		result              string
+		conflict            bool
+	}{
+		{
+			name:   "unchanged",
+			base:   "a\nb\nc\n",
+			local:  "a\nb\nc\n",
+			remote: "a\nb\nc\n",
+			result: "a\nb\nc\n",
+		},
+		{
+			name:   "one side",
+			base:   "a\nb\nc\n",
+			local:  "a\nb\nc\n",
+			remote: "a\nB\nc\n",
+			result: "a\nB\nc\n",
+		},
+		{
+			name:   "separate lines",
+			base:   "a\nb\nc\nd\ne\n",
+			local:  "A\nb\nc\nd\ne\n",
+			remote: "a\nb\nc\nd\nE\n",
+			result: "A\nb\nc\nd\nE\n",
+		},
+		{
+			name:   "insert and delete",
+			base:   "a\nb\nc\nd\n",
+			local:  "a\nx\nb\nc\nd\n",
+			remote: "a\nb\nc\n",
+			result: "a\nx\nb\nc\n",
+		},
+		{
+			name:   "same change",
+			base:   "a\nb\nc\n",
+			local:  "a\nB\nc\n",
+			remote: "a\nB\nc\n",
+			result: "a\nB\nc\n",
+		},
+		{
+			name:   "no trailing newline",
+			base:   "a\nb\nc",
+			local:  "A\nb\nc",
+			remote: "a\nb\nc\nd",
+			result: "A\nb\nc\nd",
+		},
+		{
+			name:   "empty base",
+			base:   "",
+			local:  "a\n",
+			remote: "",
+			result: "a\n",
+		},
+		{
+			name:     "same line",
+			base:     "a\nb\nc\n",
+			local:    "a\nx\nc\n",
+			remote:   "a\ny\nc\n",
+			conflict: true,
+		},
+		{
+			name:     "same place",
+			base:     "a\nb\n",
+			local:    "a\nx\nb\n",
+			remote:   "a\ny\nb\n",
+			conflict: true,
+		},
+	}
+
+	m, err := New("lines")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := m.Merge([]byte(tc.base), []byte(tc.local), []byte(tc.remote))
+			if tc.conflict {
+				if !errors.Is(err, ErrConflict) {
+					t.Fatalf("got %q, %v, expected conflict", res, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(res) != tc.result {
+				t.Errorf("got %q, expected %q", res, tc.result)
+			}
+		})
+	}
+}
+
+func TestMatchLines(t *testing.T) {
+	a := splitLines([]byte("a\nb\nc\nd\n"))
+	b := splitLines([]byte("x\na\nc\nd\ny\n"))
+	expected := []int{1, -1, 2, 3}
+	match := matchLines(a, b)
+	for i := range expected {
+		if match[i] != expected[i] {
+			t.Fatalf("got %v, expected %v", match, expected)
+		}
+	}
+}
+
+func TestMatchLinesShortest(t *testing.T) {
+	// The matched lines must be a longest common subsequence, compared to
+	// a straightforward dynamic programming solution.
+	rnd := rand.New(rand.NewSource(42))
+	lines := func() [][]byte {
+		ls := make([][]byte, rnd.Intn(40))
+		for i := range ls {
+			ls[i] = []byte{byte('a' + rnd.Intn(4)), '\n'}
+		}
+		return ls
+	}
+	for i := 0; i < 1000; i++ {
+		a, b := lines(), lines()
+		match := matchLines(a, b)
+		common, prev := 0, -1
+		for i, j := range match {
+			if j < 0 {
+				continue
+			}
+			if j <= prev || string(a[i]) != string(b[j]) {
+				t.Fatalf("invalid match %v for %q and %q", match, a, b)
+			}
+			prev = j
+			common++
+		}
+		if lcs := lcsLen(a, b); common != lcs {
+			t.Fatalf("matched %d lines of %q and %q, expected %d", common, a, b, lcs)
+		}
+	}
+}
+
+func lcsLen(a, b [][]byte) int {
+	l := make([][]int, len(a)+1)
+	for i := range l {
+		l[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case string(a[i]) == string(b[j]):
+				l[i][j] = l[i+1][j+1] + 1
+			case l[i+1][j] > l[i][j+1]:
+				l[i][j] = l[i+1][j]
+			default:
+				l[i][j] = l[i][j+1]
+			}
+		}
+	}
+	return l[0][0]
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package merge implements three-way merging of files that were changed
+// independently on two devices.
+package merge
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A Merger combines the changes made to a common ancestor in two versions
+// derived from it.
+type Merger interface {
+	// Merge returns the merged contents, or ErrConflict if the changes
+	// overlap and can't be combined.
+	Merge(base, local, remote []byte) ([]byte, error)
+}
+
+var ErrConflict = errors.New("conflicting changes")
+
+type factory func() Merger
+
+var factories = make(map[string]factory)
+
+// New returns the merger of the given type.
+func New(mergeType string) (Merger, error) {
+	fac, ok := factories[mergeType]
+	if !ok {
+		return nil, fmt.Errorf("requested merge type %q does not exist", mergeType)
+	}
+	return fac(), nil
+}
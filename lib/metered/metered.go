@@ -0,0 +1,199 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package metered decides whether the network we are on should be treated
+// as metered, according to the conditions in the configuration.
+package metered
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+// ErrDevicePaused is the reason connections to devices that are paused on
+// metered networks are closed or refused.
+var ErrDevicePaused = errors.New("device is paused on metered networks")
+
+// How often the network interfaces are looked at.
+const pollInterval = 10 * time.Second
+
+type Status struct {
+	Metered bool   `json:"metered"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// Interface is the part of a network interface the conditions look at.
+type Interface struct {
+	Name  string
+	Addrs []net.IP
+}
+
+// Evaluate returns the status according to the options, given the network
+// interfaces that are up.
+func Evaluate(opts config.OptionsConfiguration, ifaces []Interface) Status {
+	if opts.Metered {
+		return Status{Metered: true, Reason: "set as metered"}
+	}
+
+	for _, pattern := range opts.MeteredInterfaces {
+		for _, iface := range ifaces {
+			if ok, err := path.Match(pattern, iface.Name); err != nil {
+				l.Debugf("Bad interface pattern %q: %v", pattern, err)
+				break
+			} else if ok {
+				return Status{Metered: true, Reason: fmt.Sprintf("interface %s is up", iface.Name)}
+			}
+		}
+	}
+
+	for _, subnet := range opts.MeteredSubnets {
+		_, ipnet, err := net.ParseCIDR(subnet)
+		if err != nil {
+			l.Debugf("Bad subnet %q: %v", subnet, err)
+			continue
+		}
+		for _, iface := range ifaces {
+			for _, addr := range iface.Addrs {
+				if ipnet.Contains(addr) {
+					return Status{Metered: true, Reason: fmt.Sprintf("address %s on interface %s is in %s", addr, iface.Name, ipnet)}
+				}
+			}
+		}
+	}
+
+	return Status{}
+}
+
+// upInterfaces returns the network interfaces that are up, with their
+// addresses.
+func upInterfaces() ([]Interface, error) {
+	intfs, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Interface, 0, len(intfs))
+	for _, intf := range intfs {
+		if intf.Flags&net.FlagUp == 0 {
+			continue
+		}
+		iface := Interface{Name: intf.Name}
+		addrs, err := intf.Addrs()
+		if err != nil {
+			l.Debugf("Getting addresses of %s: %v", intf.Name, err)
+		}
+		for _, addr := range addrs {
+			if ipnet, ok := addr.(*net.IPNet); ok {
+				iface.Addrs = append(iface.Addrs, ipnet.IP)
+			}
+		}
+		res = append(res, iface)
+	}
+	return res, nil
+}
+
+// Monitor keeps track of the status as the configuration and network
+// interfaces change. Changes are logged, announced as events and passed to
+// the change callback.
+type Monitor struct {
+	cfg      config.Wrapper
+	evLogger events.Logger
+	onChange func(Status)
+	changed  chan struct{}
+
+	mut    sync.Mutex
+	status Status
+}
+
+// NewMonitor returns a new Monitor, which calls onChange (if non-nil) from
+// the Serve routine whenever the status changes.
+func NewMonitor(cfg config.Wrapper, evLogger events.Logger, onChange func(Status)) *Monitor {
+	return &Monitor{
+		cfg:      cfg,
+		evLogger: evLogger,
+		onChange: onChange,
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+func (m *Monitor) Serve(ctx context.Context) error {
+	m.cfg.Subscribe(m)
+	defer m.cfg.Unsubscribe(m)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+		case <-m.changed:
+			if !timer.Stop() {
+				<-timer.C
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		m.update()
+		timer.Reset(pollInterval)
+	}
+}
+
+func (m *Monitor) update() {
+	opts := m.cfg.Options()
+	var ifaces []Interface
+	if len(opts.MeteredInterfaces) > 0 || len(opts.MeteredSubnets) > 0 {
+		var err error
+		ifaces, err = upInterfaces()
+		if err != nil {
+			l.Debugln("Listing network interfaces:", err)
+		}
+	}
+	status := Evaluate(opts, ifaces)
+
+	m.mut.Lock()
+	prev := m.status
+	m.status = status
+	m.mut.Unlock()
+	if status == prev {
+		return
+	}
+
+	if status.Metered {
+		l.Infof("Network is metered (%s)", status.Reason)
+	} else {
+		l.Infoln("Network is no longer metered")
+	}
+	m.evLogger.Log(events.NetworkMeteredChanged, status)
+	if m.onChange != nil {
+		m.onChange(status)
+	}
+}
+
+// Status returns the current status.
+func (m *Monitor) Status() Status {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	return m.status
+}
+
+func (m *Monitor) CommitConfiguration(_, _ config.Configuration) bool {
+	select {
+	case m.changed <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+func (m *Monitor) String() string {
+	return fmt.Sprintf("metered.Monitor@%p", m)
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package metered
+
+import (
+	"net"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+func TestEvaluate(t *testing.T) {
+	ifaces := []Interface{
+		{Name: "lo", Addrs: []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")}},
+		{Name: "wlan0", Addrs: []net.IP{net.ParseIP("172.20.10.3")}},
+		{Name: "usb0", Addrs: []net.IP{net.ParseIP("192.168.42.17")}},
+	}
+
+	cases := []struct {
+		opts    config.OptionsConfiguration
+		metered bool
+	}{
+		{config.OptionsConfiguration{}, false},
+		{config.OptionsConfiguration{Metered: true}, true},
+		{config.OptionsConfiguration{MeteredInterfaces: []string{"usb0"}}, true},
+		{config.OptionsConfiguration{MeteredInterfaces: []string{"rndis*", "usb*"}}, true},
+		{config.OptionsConfiguration{MeteredInterfaces: []string{"eth*"}}, false},
+		{config.OptionsConfiguration{MeteredInterfaces: []string{"[bad"}}, false},
+		{config.OptionsConfiguration{MeteredSubnets: []string{"172.20.10.0/28"}}, true},
+		{config.OptionsConfiguration{MeteredSubnets: []string{"10.0.0.0/8"}}, false},
+		{config.OptionsConfiguration{MeteredSubnets: []string{"not a subnet", "192.168.42.0/24"}}, true},
+	}
+
+	for i, tc := range cases {
+		status := Evaluate(tc.opts, ifaces)
+		if status.Metered != tc.metered {
+			t.Errorf("%d: got %v, expected %v", i, status.Metered, tc.metered)
+		}
+		if status.Metered && status.Reason == "" {
+			t.Errorf("%d: metered without a reason", i)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// folderBandwidth enforces the send and receive rate limits of a folder,
+// across all devices it is shared with. A nil limiter means unlimited.
+type folderBandwidth struct {
+	send *rate.Limiter
+	recv *rate.Limiter
+}
+
+func newFolderBandwidth(cfg config.FolderConfiguration) *folderBandwidth {
+	return &folderBandwidth{
+		send: newKbpsLimiter(cfg.MaxSendKbps),
+		recv: newKbpsLimiter(cfg.MaxRecvKbps),
+	}
+}
+
+func newKbpsLimiter(kbps int) *rate.Limiter {
+	if kbps <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(kbps)*1024, protocol.MinBlockSize)
+}
+
+func (b *folderBandwidth) waitSend(ctx context.Context, size int) error {
+	if b == nil {
+		return nil
+	}
+	return waitLimiter(ctx, b.send, size)
+}
+
+// waitSendConn waits as waitSend, until the connection is closed at the
+// latest, as it is on shutdown.
+func (b *folderBandwidth) waitSendConn(conn protocol.Connection, size int) error {
+	if b == nil || b.send == nil {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-conn.Closed():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return b.waitSend(ctx, size)
+}
+
+func (b *folderBandwidth) waitRecv(ctx context.Context, size int) error {
+	if b == nil {
+		return nil
+	}
+	return waitLimiter(ctx, b.recv, size)
+}
+
+// waitLimiter waits for size bytes from the limiter, in increments of at
+// most the burst size as blocks can be larger than that.
+func waitLimiter(ctx context.Context, lim *rate.Limiter, size int) error {
+	if lim == nil {
+		return nil
+	}
+	for size > 0 {
+		n := lim.Burst()
+		if size < n {
+			n = size
+		}
+		if err := lim.WaitN(ctx, n); err != nil {
+			return err
+		}
+		size -= n
+	}
+	return nil
+}
+
+// takePullBandwidth waits until the folder may request size bytes from the
+// device: the folder's receive rate limit must allow it, and the folder
+// gets a share of the requests in flight to the device according to its
+// priority. The returned function must be called when the request is done.
+func (m *model) takePullBandwidth(ctx context.Context, deviceID protocol.DeviceID, folderCfg config.FolderConfiguration, size int) (func(), error) {
+	m.mut.RLock()
+	limiter := m.pullRequestLimiters[deviceID]
+	bw := m.folderBandwidths[folderCfg.ID]
+	m.mut.RUnlock()
+
+	if err := bw.waitRecv(ctx, size); err != nil {
+		return nil, err
+	}
+	if limiter == nil {
+		return func() {}, nil
+	}
+	if err := limiter.TakeWithContext(ctx, folderCfg.ID, folderCfg.Priority, size); err != nil {
+		return nil, err
+	}
+	return func() { limiter.Give(size) }, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+	protocolmocks "github.com/syncthing/syncthing/lib/protocol/mocks"
+)
+
+func TestFolderBandwidth(t *testing.T) {
+	bw := newFolderBandwidth(config.FolderConfiguration{MaxSendKbps: 10 << 10})
+	if bw.recv != nil {
+		t.Fatal("receive should be unlimited")
+	}
+
+	// Requests larger than the burst size must not fail, but take time
+	// according to the rate.
+	t0 := time.Now()
+	if err := bw.waitSend(context.Background(), 2*protocol.MinBlockSize); err != nil {
+		t.Fatal(err)
+	}
+	if err := bw.waitSend(context.Background(), protocol.MinBlockSize); err != nil {
+		t.Fatal(err)
+	}
+	// 384 KiB at 10 MiB/s, of which 128 KiB is available from the start.
+	if d := time.Since(t0); d < 20*time.Millisecond {
+		t.Errorf("sending was not limited, took %v", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := bw.waitSend(ctx, protocol.MinBlockSize); err == nil {
+		t.Error("expected error for cancelled wait")
+	}
+	if err := bw.waitRecv(ctx, protocol.MinBlockSize); err != nil {
+		t.Error("unlimited wait should not fail:", err)
+	}
+}
+
+func TestFolderBandwidthConnClosed(t *testing.T) {
+	bw := newFolderBandwidth(config.FolderConfiguration{MaxSendKbps: 1})
+	conn := new(protocolmocks.Connection)
+	closed := make(chan struct{})
+	conn.ClosedReturns(closed)
+
+	// Waiting for more than the burst is abandoned when the connection
+	// closes.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(closed)
+	}()
+	if err := bw.waitSendConn(conn, 2*protocol.MinBlockSize); err == nil {
+		t.Error("expected error for closed connection")
+	}
+}
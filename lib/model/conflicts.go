@@ -0,0 +1,339 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/versioner"
+)
+
+// FolderConflict is a conflict copy of a file, as created by conflictName.
+// Local conflict copies were created here and hold what was the local
+// version of the original file; others were received from other devices
+// and hold one of their versions.
+type FolderConflict struct {
+	Folder     string    `json:"folder"`
+	Name       string    `json:"name"`
+	Original   string    `json:"original"`
+	Time       time.Time `json:"time"`
+	ModifiedBy string    `json:"modifiedBy"`
+	Local      bool      `json:"local"`
+}
+
+// ConflictResolution is a way to resolve a conflict.
+type ConflictResolution string
+
+const (
+	// Keep the local version under the original name, removing the other.
+	ConflictKeepLocal ConflictResolution = "keep-local"
+	// Keep the remote version under the original name, removing the other.
+	ConflictKeepRemote ConflictResolution = "keep-remote"
+	// Keep both, renaming the conflict copy to a regular name.
+	ConflictKeepBoth ConflictResolution = "keep-both"
+	// Keep both, renaming the conflict copy to a given name.
+	ConflictMergeByRename ConflictResolution = "merge-by-rename"
+)
+
+var (
+	ErrNoSuchConflict    = errors.New("no such conflict")
+	ErrUnknownResolution = errors.New("unknown conflict resolution")
+	errConflictExists    = errors.New("file already exists")
+	errConflictName      = errors.New("new name is a conflict copy name")
+	errConflictNotReg    = errors.New("not a regular file")
+	errInternalName      = errors.New("new name is reserved for internal use")
+	errNoRenameTarget    = errors.New("no new name given")
+)
+
+var conflictNameExp = regexp.MustCompile(`^(.*)\.sync-conflict-(\d{8}-\d{6})-([0-9A-Z]+)(.*)$`)
+
+// parseConflictName returns the conflict recorded in a conflict copy's
+// name, as created by conflictName.
+func parseConflictName(name string) (db.Conflict, bool) {
+	dir, base := filepath.Split(name)
+	m := conflictNameExp.FindStringSubmatch(base)
+	if m == nil {
+		return db.Conflict{}, false
+	}
+	t, err := time.ParseInLocation("20060102-150405", m[2], time.Local)
+	if err != nil {
+		return db.Conflict{}, false
+	}
+	return db.Conflict{
+		Original:   dir + m[1] + m[4],
+		Time:       t,
+		ModifiedBy: m[3],
+	}, true
+}
+
+// recordLocalConflict records a conflict copy just created by moving the
+// local version of a file away.
+func (f *folder) recordLocalConflict(name string) {
+	c, ok := parseConflictName(name)
+	if !ok {
+		return
+	}
+	c.Local = true
+	if err := f.model.db.AddConflict(f.ID, name, c); err != nil {
+		l.Debugln(f, "recording conflict:", err)
+		return
+	}
+	f.model.emitConflictsChanged(f.ID)
+}
+
+// updateConflicts keeps the recorded conflicts up to date with changes to
+// conflict copies among the given files.
+func (f *folder) updateConflicts(fs []protocol.FileInfo) {
+	var recorded map[string]db.Conflict
+	changed := false
+	for _, file := range fs {
+		if file.IsDirectory() || file.IsSymlink() || !isConflict(file.Name) {
+			continue
+		}
+		if recorded == nil {
+			var err error
+			if recorded, err = f.model.db.Conflicts(f.ID); err != nil {
+				l.Debugln(f, "updating conflicts:", err)
+				return
+			}
+		}
+		if f.updateConflict(recorded, file) {
+			changed = true
+		}
+	}
+	if changed {
+		f.model.emitConflictsChanged(f.ID)
+	}
+}
+
+// updateConflict updates the record of the file if it's a conflict copy,
+// and returns whether the recorded conflicts changed.
+func (f *folder) updateConflict(recorded map[string]db.Conflict, file protocol.FileIntf) bool {
+	name := file.FileName()
+	_, ok := recorded[name]
+	var err error
+	switch {
+	case file.IsDeleted() || file.IsInvalid():
+		if !ok {
+			return false
+		}
+		err = f.model.db.RemoveConflict(f.ID, name)
+		delete(recorded, name)
+	case !ok:
+		c, parsed := parseConflictName(name)
+		if !parsed {
+			return false
+		}
+		err = f.model.db.AddConflict(f.ID, name, c)
+		recorded[name] = c
+	default:
+		return false
+	}
+	if err != nil {
+		l.Debugln(f, "updating conflict:", err)
+	}
+	return true
+}
+
+// reconcileConflicts brings the recorded conflicts in line with the
+// conflict copies in the local index, which is needed for conflict copies
+// that predate conflicts being recorded.
+func (f *folder) reconcileConflicts() {
+	recorded, err := f.model.db.Conflicts(f.ID)
+	if err != nil {
+		l.Debugln(f, "reconciling conflicts:", err)
+		return
+	}
+	snap, err := f.fset.Snapshot()
+	if err != nil {
+		l.Debugln(f, "reconciling conflicts:", err)
+		return
+	}
+	defer snap.Release()
+
+	changed := false
+	seen := make(map[string]struct{})
+	snap.WithHaveTruncated(protocol.LocalDeviceID, func(file protocol.FileIntf) bool {
+		if file.IsDirectory() || file.IsSymlink() || !isConflict(file.FileName()) {
+			return true
+		}
+		if !file.IsDeleted() && !file.IsInvalid() {
+			seen[file.FileName()] = struct{}{}
+		}
+		if f.updateConflict(recorded, file) {
+			changed = true
+		}
+		return true
+	})
+	for name := range recorded {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		if err := f.model.db.RemoveConflict(f.ID, name); err != nil {
+			l.Debugln(f, "reconciling conflicts:", err)
+		}
+		changed = true
+	}
+	if changed {
+		f.model.emitConflictsChanged(f.ID)
+	}
+}
+
+func (m *model) FolderConflicts(folder string) ([]FolderConflict, error) {
+	m.mut.RLock()
+	_, ok := m.folderCfgs[folder]
+	m.mut.RUnlock()
+	if !ok {
+		return nil, ErrFolderMissing
+	}
+	return m.folderConflicts(folder)
+}
+
+func (m *model) folderConflicts(folder string) ([]FolderConflict, error) {
+	recorded, err := m.db.Conflicts(folder)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]FolderConflict, 0, len(recorded))
+	for name, c := range recorded {
+		res = append(res, FolderConflict{
+			Folder:     folder,
+			Name:       name,
+			Original:   c.Original,
+			Time:       c.Time,
+			ModifiedBy: c.ModifiedBy,
+			Local:      c.Local,
+		})
+	}
+	sort.Slice(res, func(a, b int) bool {
+		return res[a].Name < res[b].Name
+	})
+	return res, nil
+}
+
+func (m *model) emitConflictsChanged(folder string) {
+	conflicts, err := m.folderConflicts(folder)
+	if err != nil {
+		l.Debugln("listing conflicts:", err)
+		return
+	}
+	m.evLogger.Log(events.FolderConflictsChanged, map[string]interface{}{
+		"folder":    folder,
+		"conflicts": conflicts,
+	})
+}
+
+// ResolveFolderConflict resolves the conflict of the named conflict copy.
+// The new name is used when merging by rename, and is otherwise ignored.
+// Files that are removed or replaced are archived if the folder has a
+// versioner.
+func (m *model) ResolveFolderConflict(folder, name string, resolution ConflictResolution, newName string) error {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	fcfg := m.folderCfgs[folder]
+	ver := m.folderVersioners[folder]
+	m.mut.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	recorded, err := m.db.Conflicts(folder)
+	if err != nil {
+		return err
+	}
+	c, ok := recorded[name]
+	if !ok {
+		return ErrNoSuchConflict
+	}
+
+	ffs := fcfg.Filesystem(nil)
+	if info, err := ffs.Lstat(name); err != nil {
+		return err
+	} else if !info.IsRegular() {
+		return fmt.Errorf("%s: %w", name, errConflictNotReg)
+	}
+
+	switch resolution {
+	case ConflictKeepLocal, ConflictKeepRemote:
+		// The conflict copy holds the local version when the conflict
+		// happened here, and the remote one otherwise.
+		if (resolution == ConflictKeepLocal) == c.Local {
+			err = replaceWithConflictCopy(ffs, ver, name, c.Original)
+		} else {
+			err = removeConflictCopy(ffs, ver, name)
+		}
+	case ConflictKeepBoth:
+		ext := filepath.Ext(c.Original)
+		newName = fmt.Sprintf("%s (conflict %s %s)%s", c.Original[:len(c.Original)-len(ext)], c.Time.Format("20060102-150405"), c.ModifiedBy, ext)
+		err = renameConflictCopy(ffs, name, newName)
+	case ConflictMergeByRename:
+		err = renameConflictCopy(ffs, name, newName)
+	default:
+		err = fmt.Errorf("%w: %q", ErrUnknownResolution, resolution)
+	}
+	if err != nil {
+		return err
+	}
+
+	l.Infof("Resolved conflict %s in folder %s (%s)", name, fcfg.Description(), resolution)
+	if err := m.db.RemoveConflict(folder, name); err != nil {
+		return err
+	}
+	m.emitConflictsChanged(folder)
+
+	subs := []string{name, c.Original}
+	if newName != "" {
+		subs = append(subs, newName)
+	}
+	go func() { _ = m.ScanFolderSubdirs(folder, subs) }()
+	return nil
+}
+
+func removeConflictCopy(ffs fs.Filesystem, ver versioner.Versioner, name string) error {
+	if ver != nil {
+		return ver.Archive(name)
+	}
+	return ffs.Remove(name)
+}
+
+func replaceWithConflictCopy(ffs fs.Filesystem, ver versioner.Versioner, name, original string) error {
+	if info, err := ffs.Lstat(original); err == nil && !info.IsRegular() {
+		return fmt.Errorf("%s: %w", original, errConflictNotReg)
+	}
+	if ver != nil {
+		if err := ver.Archive(original); err != nil {
+			return err
+		}
+	}
+	return ffs.Rename(name, original)
+}
+
+func renameConflictCopy(ffs fs.Filesystem, name, newName string) error {
+	switch {
+	case newName == "":
+		return errNoRenameTarget
+	case isConflict(newName):
+		return errConflictName
+	case fs.IsInternal(newName):
+		return errInternalName
+	}
+	if _, err := ffs.Lstat(newName); err == nil {
+		return errConflictExists
+	} else if !fs.IsNotExist(err) {
+		return err
+	}
+	return ffs.Rename(name, newName)
+}
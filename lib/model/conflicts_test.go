@@ -0,0 +1,95 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseConflictName(t *testing.T) {
+	cases := []struct {
+		name     string
+		ok       bool
+		original string
+		by       string
+	}{
+		{"foo.sync-conflict-20240102-030405-ABCDEFG.txt", true, "foo.txt", "ABCDEFG"},
+		{"dir/foo.sync-conflict-20240102-030405-ABCDEFG", true, "dir/foo", "ABCDEFG"},
+		{"foo.tar.sync-conflict-20240102-030405-ABCDEFG.gz", true, "foo.tar.gz", "ABCDEFG"},
+		{"foo.txt", false, "", ""},
+		{"foo.sync-conflict-2024-ABCDEFG.txt", false, "", ""},
+	}
+	for _, tc := range cases {
+		c, ok := parseConflictName(tc.name)
+		if ok != tc.ok {
+			t.Errorf("%s: got ok %v, expected %v", tc.name, ok, tc.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if c.Original != tc.original || c.ModifiedBy != tc.by {
+			t.Errorf("%s: got %q by %q, expected %q by %q", tc.name, c.Original, c.ModifiedBy, tc.original, tc.by)
+		}
+		if exp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local); !c.Time.Equal(exp) {
+			t.Errorf("%s: got time %v, expected %v", tc.name, c.Time, exp)
+		}
+	}
+}
+
+func TestResolveFolderConflict(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	ffs := fcfg.Filesystem(nil)
+	m := setupModel(t, w)
+	defer cleanupModel(m)
+
+	a := "a.txt"
+	aConflict := "a.sync-conflict-20240102-030405-ABCDEFG.txt"
+	b := "b.txt"
+	bConflict := "b.sync-conflict-20240102-030405-ABCDEFG.txt"
+	writeFile(t, ffs, a, []byte("a"))
+	writeFile(t, ffs, aConflict, []byte("a remote"))
+	writeFile(t, ffs, b, []byte("b"))
+	writeFile(t, ffs, bConflict, []byte("b remote"))
+	must(t, m.ScanFolder(fcfg.ID))
+
+	conflicts, err := m.FolderConflicts(fcfg.ID)
+	must(t, err)
+	if len(conflicts) != 2 {
+		t.Fatalf("got %d conflicts, expected 2", len(conflicts))
+	}
+	if c := conflicts[0]; c.Name != aConflict || c.Original != a || c.Local {
+		t.Errorf("unexpected conflict %+v", c)
+	}
+
+	// The conflict copies were received, thus hold the remote version.
+	must(t, m.ResolveFolderConflict(fcfg.ID, aConflict, ConflictKeepRemote, ""))
+	must(t, equalContents(ffs, a, []byte("a remote")))
+	if _, err := ffs.Lstat(aConflict); err == nil {
+		t.Error("conflict copy still exists after keeping remote")
+	}
+
+	err = m.ResolveFolderConflict(fcfg.ID, bConflict, ConflictMergeByRename, a)
+	if !errors.Is(err, errConflictExists) {
+		t.Errorf("got error %v, expected %v", err, errConflictExists)
+	}
+	must(t, m.ResolveFolderConflict(fcfg.ID, bConflict, ConflictMergeByRename, "b-merged.txt"))
+	must(t, equalContents(ffs, "b-merged.txt", []byte("b remote")))
+	must(t, equalContents(ffs, b, []byte("b")))
+
+	if err := m.ResolveFolderConflict(fcfg.ID, bConflict, ConflictKeepLocal, ""); !errors.Is(err, ErrNoSuchConflict) {
+		t.Errorf("got error %v, expected %v", err, ErrNoSuchConflict)
+	}
+	conflicts, err = m.FolderConflicts(fcfg.ID)
+	must(t, err)
+	if len(conflicts) != 0 {
+		t.Errorf("got %d conflicts after resolving, expected none", len(conflicts))
+	}
+}
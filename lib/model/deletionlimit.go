@@ -0,0 +1,152 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// deletionLimit holds back deletions from other devices when a single index
+// update deletes more items than the folder allows, until they are
+// approved. Deletions arriving a few at a time are applied as usual. The
+// held deletions are kept in the database, as the index updates holding
+// them are not seen again when the folder restarts.
+type deletionLimit struct {
+	folderID      string
+	folder        string
+	kv            *db.NamespacedKV
+	maxDeletes    int
+	maxDeletesPct int
+
+	mut  sync.Mutex
+	held map[string]protocol.Vector // name -> version of the held deletion
+}
+
+func newDeletionLimit(cfg config.FolderConfiguration, ldb *db.Lowlevel) *deletionLimit {
+	d := &deletionLimit{
+		folderID:      cfg.ID,
+		folder:        cfg.Description(),
+		kv:            db.NewMiscDataNamespace(ldb),
+		maxDeletes:    cfg.MaxDeletes,
+		maxDeletesPct: cfg.MaxDeletesPct,
+		mut:           sync.NewMutex(),
+		held:          make(map[string]protocol.Vector),
+	}
+	if !d.enabled() {
+		// Anything held from before the limit was disabled is released.
+		_ = d.kv.Delete(heldDeletionsKey(d.folderID))
+		return d
+	}
+	if bs, ok, _ := d.kv.Bytes(heldDeletionsKey(d.folderID)); ok {
+		if err := json.Unmarshal(bs, &d.held); err != nil {
+			l.Warnf("Folder %s: Reading held deletions: %v", d.folder, err)
+		}
+	}
+	return d
+}
+
+func (d *deletionLimit) enabled() bool {
+	return d.maxDeletes > 0 || d.maxDeletesPct > 0
+}
+
+// check holds back the deletions of an index update from another device if
+// there are more of them than allowed, given the deletions of items which
+// exist here and how many items the folder has.
+func (d *deletionLimit) check(deletions []protocol.FileInfo, items int) {
+	pending := len(deletions)
+	over := (d.maxDeletes > 0 && pending > d.maxDeletes) ||
+		(d.maxDeletesPct > 0 && pending*100 > d.maxDeletesPct*items)
+	if !over {
+		return
+	}
+
+	d.mut.Lock()
+	defer d.mut.Unlock()
+	l.Warnf("Folder %s: Not applying %d deletions from another device until approved", d.folder, pending)
+	for _, file := range deletions {
+		d.held[file.Name] = file.Version
+	}
+	d.saveLocked()
+}
+
+// isHeld returns whether the deletion of the named item is held back.
+func (d *deletionLimit) isHeld(name string) bool {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+	_, ok := d.held[name]
+	return ok
+}
+
+// forgetDone stops holding back the deletions of items which are deleted
+// already, or have been changed here since.
+func (d *deletionLimit) forgetDone(snap *db.Snapshot) {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+	forgotten := false
+	for name, version := range d.held {
+		if cur, ok := snap.Get(protocol.LocalDeviceID, name); !ok || cur.IsDeleted() || cur.Version.GreaterEqual(version) {
+			delete(d.held, name)
+			forgotten = true
+		}
+	}
+	if forgotten {
+		d.saveLocked()
+	}
+}
+
+// heldDeletions returns the names of the items whose deletion is held back.
+func (d *deletionLimit) heldDeletions() []string {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+	names := make([]string, 0, len(d.held))
+	for name := range d.held {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// approve allows the currently held deletions to be applied, and returns
+// how many that is.
+func (d *deletionLimit) approve() int {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+	approved := len(d.held)
+	if approved > 0 {
+		l.Infof("Folder %s: %d deletions approved", d.folder, approved)
+	}
+	d.held = make(map[string]protocol.Vector)
+	d.saveLocked()
+	return approved
+}
+
+func (d *deletionLimit) saveLocked() {
+	key := heldDeletionsKey(d.folderID)
+	if len(d.held) == 0 {
+		if err := d.kv.Delete(key); err != nil {
+			l.Warnf("Folder %s: Storing held deletions: %v", d.folder, err)
+		}
+		return
+	}
+	bs, err := json.Marshal(d.held)
+	if err == nil {
+		err = d.kv.PutBytes(key, bs)
+	}
+	if err != nil {
+		l.Warnf("Folder %s: Storing held deletions: %v", d.folder, err)
+	}
+}
+
+func heldDeletionsKey(folder string) string {
+	return "heldDeletions/" + folder
+}
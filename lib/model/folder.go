@@ -25,6 +25,7 @@ import (
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/scanner"
 	"github.com/syncthing/syncthing/lib/semaphore"
+	"github.com/syncthing/syncthing/lib/snapshot"
 	"github.com/syncthing/syncthing/lib/stats"
 	"github.com/syncthing/syncthing/lib/stringutil"
 	"github.com/syncthing/syncthing/lib/svcutil"
@@ -64,6 +65,7 @@ type folder struct {
 	pullScheduled chan struct{}
 	pullPause     time.Duration
 	pullFailTimer *time.Timer
+	pullSuspended bool // held off on a metered network
 
 	scanErrors []FileError
 	pullErrors []FileError
@@ -81,8 +83,10 @@ type folder struct {
 	watchErr         error
 	watchMut         sync.Mutex
 
-	puller    puller
-	versioner versioner.Versioner
+	puller      puller
+	versioner   versioner.Versioner
+	massChange  *massChangeGuard
+	snapshotter snapshot.Snapshotter // nil unless scanning from snapshots
 
 	warnedKqueue bool
 }
@@ -133,7 +137,13 @@ func newFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg conf
 		restartWatchChan: make(chan struct{}, 1),
 		watchMut:         sync.NewMutex(),
 
-		versioner: ver,
+		versioner:  ver,
+		massChange: newMassChangeGuard(cfg, model.db, evLogger),
+	}
+	if snapshotter, err := snapshot.New(cfg); err != nil {
+		l.Warnf("Scanning folder %v from snapshots: %v", cfg.Description(), err)
+	} else {
+		f.snapshotter = snapshotter
 	}
 	f.pullPause = f.pullBasePause()
 	f.pullFailTimer = time.NewTimer(0)
@@ -163,6 +173,8 @@ func (f *folder) Serve(ctx context.Context) error {
 		f.startWatch()
 	}
 
+	f.reconcileConflicts()
+
 	// If we're configured to not do version cleanup, or we don't have a
 	// versioner, cancel and drain that timer now.
 	if f.versionCleanupInterval == 0 || f.versioner == nil {
@@ -245,6 +257,12 @@ func (*folder) Override() {}
 
 func (*folder) Revert() {}
 
+func (*folder) HeldDeletions() []string { return nil }
+
+func (*folder) ApproveDeletions() int { return 0 }
+
+func (*folder) holdRemoteDeletions([]protocol.FileInfo) {}
+
 func (f *folder) DelayScan(next time.Duration) {
 	select {
 	case f.scanDelay <- next:
@@ -333,8 +351,15 @@ func (f *folder) getHealthErrorWithoutIgnores() error {
 		return err
 	}
 
+	if err := f.massChange.err(); err != nil {
+		return err
+	}
+
 	if minFree := f.model.cfg.Options().MinHomeDiskFree; minFree.Value > 0 {
 		dbPath := locations.Get(locations.Database)
+		if f.model.cfg.Options().DatabaseBackend == config.DatabaseBackendSQLite {
+			dbPath = filepath.Dir(locations.Get(locations.DatabaseSQLite))
+		}
 		if usage, err := fs.NewFilesystem(fs.FilesystemTypeBasic, dbPath).Usage("."); err == nil {
 			if err = config.CheckFreeSpace(minFree, usage); err != nil {
 				return fmt.Errorf("insufficient space on disk for database (%v): %w", dbPath, err)
@@ -382,9 +407,18 @@ func (f *folder) pull() (success bool, err error) {
 		f.errorsMut.Lock()
 		f.pullErrors = nil
 		f.errorsMut.Unlock()
+		f.setPullSuspended(false)
 		return true, nil
 	}
 
+	// Hold off on metered networks, a pull is scheduled when that changes.
+	if f.PauseWhenMetered && f.model.NetworkMetered().Metered {
+		l.Debugln("Suspending pull of", f.Description(), "on metered network")
+		f.setPullSuspended(true)
+		return false, nil
+	}
+	f.setPullSuspended(false)
+
 	// Abort early (before acquiring a token) if there's a folder error
 	err = f.getHealthErrorWithoutIgnores()
 	if err != nil {
@@ -433,6 +467,27 @@ func (f *folder) pull() (success bool, err error) {
 	return false, err
 }
 
+// setPullSuspended records whether pulling is held off on a metered
+// network, which is the state of the folder while it's otherwise idle.
+func (f *folder) setPullSuspended(suspended bool) {
+	if suspended {
+		f.pullSuspended = true
+		f.setState(FolderSyncSuspended)
+	} else if f.pullSuspended {
+		f.pullSuspended = false
+		f.setState(FolderIdle)
+	}
+}
+
+// setIdle sets the state of the folder when done doing something.
+func (f *folder) setIdle() {
+	if f.pullSuspended {
+		f.setState(FolderSyncSuspended)
+	} else {
+		f.setState(FolderIdle)
+	}
+}
+
 func (f *folder) scanSubdirs(subDirs []string) error {
 	l.Debugf("%v scanning", f)
 
@@ -456,7 +511,7 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 	}()
 
 	f.setState(FolderScanWaiting)
-	defer f.setState(FolderIdle)
+	defer f.setIdle()
 
 	if err := f.ioLimiter.TakeWithContext(f.ctx, 1); err != nil {
 		return err
@@ -492,11 +547,24 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 		_, ok := snap.Get(protocol.LocalDeviceID, file)
 		return ok
 	})
+	files := snap.LocalSize().Files
 	snap.Release()
 
 	f.setState(FolderScanning)
 	f.clearScanErrors(subDirs)
 
+	f.model.hooks.beforeScan(f.ctx, f.folderID, subDirs)
+
+	scanFs, release, err := f.scanFilesystem()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// Changes are held back until we know this isn't a mass change.
+	f.massChange.startScan(f.fset.Sequence(protocol.LocalDeviceID), files)
+	defer f.massChange.abortScan()
+
 	batch := f.newScanBatch()
 
 	// Schedule a pull after scanning, but only if we actually detected any
@@ -509,7 +577,7 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 		}
 	}()
 
-	changesHere, err := f.scanSubdirsChangedAndNew(subDirs, batch)
+	changesHere, err := f.scanSubdirsChangedAndNew(subDirs, batch, scanFs)
 	changes += changesHere
 	if err != nil {
 		return err
@@ -528,7 +596,7 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 	// Do a scan of the database for each prefix, to check for deleted and
 	// ignored files.
 
-	changesHere, err = f.scanSubdirsDeletedAndIgnored(subDirs, batch)
+	changesHere, err = f.scanSubdirsDeletedAndIgnored(subDirs, batch, scanFs)
 	changes += changesHere
 	if err != nil {
 		return err
@@ -538,10 +606,42 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 		return err
 	}
 
+	if err := f.massChange.finishScan(); err != nil {
+		return err
+	}
+
 	f.ScanCompleted()
 	return nil
 }
 
+// scanFilesystem returns the filesystem to scan, which is a snapshot of
+// the folder if it uses them, and a function to call once the scan is
+// done.
+func (f *folder) scanFilesystem() (fs.Filesystem, func(), error) {
+	if f.snapshotter == nil {
+		return f.mtimefs, func() {}, nil
+	}
+
+	path, err := f.snapshotter.Create(f.ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating snapshot: %w", err)
+	}
+	l.Debugf("%v scanning snapshot at %v", f, path)
+
+	// The snapshot has the same file names, and thus shares the mtime
+	// mappings, with the folder.
+	cfg := f.FolderConfiguration
+	cfg.Path = path
+	release := func() {
+		// Release even when the folder is stopping, so no snapshot is left
+		// behind.
+		if err := f.snapshotter.Release(context.Background(), path); err != nil {
+			l.Warnf("Releasing snapshot of folder %s: %v", f.Description(), err)
+		}
+	}
+	return cfg.Filesystem(f.fset), release, nil
+}
+
 const maxToRemove = 1000
 
 type scanBatch struct {
@@ -624,11 +724,12 @@ func (b *scanBatch) Update(fi protocol.FileInfo, snap *db.Snapshot) bool {
 		l.Debugf("%v scanning: Merging identical locally changed item with global", b.f, fi)
 		fi = gf
 	}
+	b.f.massChange.observe(b.f.mtimefs, fi, snap)
 	b.updateBatch.Append(fi)
 	return true
 }
 
-func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *scanBatch) (int, error) {
+func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *scanBatch, scanFs fs.Filesystem) (int, error) {
 	changes := 0
 	snap, err := f.dbSnapshot()
 	if err != nil {
@@ -647,7 +748,7 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *scanBatch) (i
 		Matcher:               f.ignores,
 		TempLifetime:          time.Duration(f.model.cfg.Options().KeepTemporariesH) * time.Hour,
 		CurrentFiler:          cFiler{snap},
-		Filesystem:            f.mtimefs,
+		Filesystem:            scanFs,
 		IgnorePerms:           f.IgnorePerms,
 		AutoNormalize:         f.AutoNormalize,
 		Hashers:               f.model.numHashers(f.ID),
@@ -659,6 +760,7 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *scanBatch) (i
 		ScanOwnership:         f.SendOwnership || f.SyncOwnership,
 		ScanXattrs:            f.SendXattrs || f.SyncXattrs,
 		XattrFilter:           f.XattrFilter,
+		BlockChunking:         f.model.blockChunking(f.ID),
 	}
 	var fchan chan scanner.ScanResult
 	if f.Type == config.FolderTypeReceiveEncrypted {
@@ -690,7 +792,7 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *scanBatch) (i
 		switch f.Type {
 		case config.FolderTypeReceiveOnly, config.FolderTypeReceiveEncrypted:
 		default:
-			if nf, ok := f.findRename(snap, res.File, alreadyUsedOrExisting); ok {
+			if nf, ok := f.findRename(snap, res.File, alreadyUsedOrExisting, scanFs); ok {
 				if batch.Update(nf, snap) {
 					changes++
 				}
@@ -701,7 +803,7 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *scanBatch) (i
 	return changes, nil
 }
 
-func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *scanBatch) (int, error) {
+func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *scanBatch, scanFs fs.Filesystem) (int, error) {
 	var toIgnore []db.FileInfoTruncated
 	ignoredParent := ""
 	changes := 0
@@ -775,7 +877,7 @@ func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *scanBatch
 				// it's still here. Simply stat:ing it won't do as there are
 				// tons of corner cases (e.g. parent dir->symlink, missing
 				// permissions)
-				if !osutil.IsDeleted(f.mtimefs, file.Name) {
+				if !osutil.IsDeleted(scanFs, file.Name) {
 					if ignoredParent != "" {
 						// Don't ignore parents of this not ignored item
 						toIgnore = toIgnore[:0]
@@ -855,7 +957,7 @@ func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *scanBatch
 	return changes, nil
 }
 
-func (f *folder) findRename(snap *db.Snapshot, file protocol.FileInfo, alreadyUsedOrExisting map[string]struct{}) (protocol.FileInfo, bool) {
+func (f *folder) findRename(snap *db.Snapshot, file protocol.FileInfo, alreadyUsedOrExisting map[string]struct{}, scanFs fs.Filesystem) (protocol.FileInfo, bool) {
 	if len(file.Blocks) == 0 || file.Size == 0 {
 		return protocol.FileInfo{}, false
 	}
@@ -898,7 +1000,7 @@ func (f *folder) findRename(snap *db.Snapshot, file protocol.FileInfo, alreadyUs
 
 		alreadyUsedOrExisting[fi.Name] = struct{}{}
 
-		if !osutil.IsDeleted(f.mtimefs, fi.Name) {
+		if !osutil.IsDeleted(scanFs, fi.Name) {
 			return true
 		}
 
@@ -933,7 +1035,7 @@ func (f *folder) scanTimerFired() error {
 
 func (f *folder) versionCleanupTimerFired() {
 	f.setState(FolderCleanWaiting)
-	defer f.setState(FolderIdle)
+	defer f.setIdle()
 
 	if err := f.ioLimiter.TakeWithContext(f.ctx, 1); err != nil {
 		return
@@ -1204,6 +1306,16 @@ func (f *folder) Errors() []FileError {
 	return errors
 }
 
+func (f *folder) confirmMassChange() {
+	f.massChange.confirm()
+	// Clear the folder error
+	f.ScheduleScan()
+}
+
+func (f *folder) sendLimit() (int64, <-chan struct{}) {
+	return f.massChange.sendLimit()
+}
+
 // ScheduleForceRescan marks the file such that it gets rehashed on next scan, and schedules a scan.
 func (f *folder) ScheduleForceRescan(path string) {
 	f.forcedRescanPathsMut.Lock()
@@ -1230,6 +1342,7 @@ func (f *folder) updateLocalsFromPulling(fs []protocol.FileInfo) {
 
 func (f *folder) updateLocals(fs []protocol.FileInfo) {
 	f.fset.Update(protocol.LocalDeviceID, fs)
+	f.updateConflicts(fs)
 
 	filenames := make([]string, len(fs))
 	f.forcedRescanPathsMut.Lock()
@@ -24,6 +24,7 @@ import (
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
+	"github.com/syncthing/syncthing/lib/merge"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/scanner"
@@ -126,6 +127,8 @@ type sendReceiveFolder struct {
 	queue              *jobQueue
 	blockPullReorderer blockPullReorderer
 	writeLimiter       *semaphore.Semaphore
+	merger             merge.Merger // nil unless merging conflicts is enabled
+	deletions          *deletionLimit
 
 	tempPullErrors map[string]string // pull errors that might be just transient
 }
@@ -136,6 +139,7 @@ func newSendReceiveFolder(model *model, fset *db.FileSet, ignores *ignore.Matche
 		queue:              newJobQueue(),
 		blockPullReorderer: newBlockPullReorderer(cfg.BlockPullOrder, model.id, cfg.DeviceIDs()),
 		writeLimiter:       semaphore.New(cfg.MaxConcurrentWrites),
+		deletions:          newDeletionLimit(cfg, model.db),
 	}
 	f.folder.puller = f
 
@@ -153,6 +157,15 @@ func newSendReceiveFolder(model *model, fset *db.FileSet, ignores *ignore.Matche
 		f.PullerMaxPendingKiB = blockSizeKiB
 	}
 
+	if cfg.Merge.Type != "" {
+		merger, err := merge.New(cfg.Merge.Type)
+		if err != nil {
+			l.Warnf("Merging conflicts in folder %v: %v", cfg.Description(), err)
+		} else {
+			f.merger = merger
+		}
+	}
+
 	return f
 }
 
@@ -247,6 +260,8 @@ func (f *sendReceiveFolder) pullerIteration(scanChan chan<- string) (int, error)
 	}
 	defer snap.Release()
 
+	f.deletions.forgetDone(snap)
+
 	pullChan := make(chan pullBlockState)
 	copyChan := make(chan copyBlocksState)
 	finisherChan := make(chan *sharedPullerState)
@@ -337,6 +352,10 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 			l.Debugln(f, "ignore file deletion (config)", intf.FileName())
 			return true
 		}
+		if intf.IsDeleted() && f.deletions.isHeld(intf.FileName()) {
+			l.Debugln(f, "hold back file deletion (not approved)", intf.FileName())
+			return true
+		}
 
 		changed++
 
@@ -907,7 +926,7 @@ func (f *sendReceiveFolder) deleteFileWithCurrent(file, cur protocol.FileInfo, h
 	}
 
 	if f.versioner != nil && !cur.IsSymlink() {
-		err = f.inWritableDir(f.versioner.Archive, file.Name)
+		err = f.inWritableDir(f.archiveFunc(cur), file.Name)
 	} else {
 		err = f.inWritableDir(f.mtimefs.Remove, file.Name)
 	}
@@ -1026,7 +1045,7 @@ func (f *sendReceiveFolder) renameFile(cur, source, target protocol.FileInfo, sn
 		if err == nil {
 			err = osutil.Copy(f.CopyRangeMethod, f.mtimefs, f.mtimefs, source.Name, tempName)
 			if err == nil {
-				err = f.inWritableDir(f.versioner.Archive, source.Name)
+				err = f.inWritableDir(f.archiveFunc(source), source.Name)
 			}
 		}
 	} else {
@@ -1094,7 +1113,12 @@ func (f *sendReceiveFolder) renameFile(cur, source, target protocol.FileInfo, sn
 func (f *sendReceiveFolder) handleFile(file protocol.FileInfo, snap *db.Snapshot, copyChan chan<- copyBlocksState) {
 	curFile, hasCurFile := snap.Get(protocol.LocalDeviceID, file.Name)
 
-	have, _ := blockDiff(curFile.Blocks, file.Blocks)
+	var have []protocol.BlockInfo
+	if file.BlockChunking == protocol.BlockChunkingCDC {
+		have = blocksPresent(curFile.Blocks, file.Blocks)
+	} else {
+		have, _ = blockDiff(curFile.Blocks, file.Blocks)
+	}
 
 	tempName := fs.TempName(file.Name)
 
@@ -1141,12 +1165,12 @@ func (f *sendReceiveFolder) handleFile(file protocol.FileInfo, snap *db.Snapshot
 func (f *sendReceiveFolder) reuseBlocks(blocks []protocol.BlockInfo, reused []int, file protocol.FileInfo, tempName string) ([]protocol.BlockInfo, []int) {
 	// Check for an old temporary file which might have some blocks we could
 	// reuse.
-	tempBlocks, err := scanner.HashFile(f.ctx, f.ID, f.mtimefs, tempName, file.BlockSize(), nil, false)
+	tempBlocks, err := scanner.HashFile(f.ctx, f.ID, f.mtimefs, tempName, file.BlockSize(), file.BlockChunking, nil, false)
 	if err != nil {
 		var caseErr *fs.ErrCaseConflict
 		if errors.As(err, &caseErr) {
 			if rerr := f.mtimefs.Rename(caseErr.Real, tempName); rerr == nil {
-				tempBlocks, err = scanner.HashFile(f.ctx, f.ID, f.mtimefs, tempName, file.BlockSize(), nil, false)
+				tempBlocks, err = scanner.HashFile(f.ctx, f.ID, f.mtimefs, tempName, file.BlockSize(), file.BlockChunking, nil, false)
 			}
 		}
 	}
@@ -1154,8 +1178,13 @@ func (f *sendReceiveFolder) reuseBlocks(blocks []protocol.BlockInfo, reused []in
 		return blocks, reused
 	}
 
-	// Check for any reusable blocks in the temp file
-	tempCopyBlocks, _ := blockDiff(tempBlocks, file.Blocks)
+	// Check for any reusable blocks in the temp file. Content defined
+	// blocks don't line up by index, but as we match on offset and hash
+	// below all of them are candidates.
+	tempCopyBlocks := tempBlocks
+	if file.BlockChunking != protocol.BlockChunkingCDC {
+		tempCopyBlocks, _ = blockDiff(tempBlocks, file.Blocks)
+	}
 
 	// block.String() returns a string unique to the block
 	existingBlocks := make(map[string]struct{}, len(tempCopyBlocks))
@@ -1207,6 +1236,23 @@ func blockDiff(src, tgt []protocol.BlockInfo) ([]protocol.BlockInfo, []protocol.
 	return have, need
 }
 
+// blocksPresent returns the blocks of tgt whose contents are also found
+// somewhere in src, regardless of offset. With content defined chunking
+// an insertion shifts the blocks after it, which are still present.
+func blocksPresent(src, tgt []protocol.BlockInfo) []protocol.BlockInfo {
+	hashes := make(map[string]struct{}, len(src))
+	for _, b := range src {
+		hashes[string(b.Hash)] = struct{}{}
+	}
+	have := make([]protocol.BlockInfo, 0, len(tgt))
+	for _, b := range tgt {
+		if _, ok := hashes[string(b.Hash)]; ok {
+			have = append(have, b)
+		}
+	}
+	return have
+}
+
 // populateOffsets sets the Offset field on each block
 func populateOffsets(blocks []protocol.BlockInfo) {
 	var offset int64
@@ -1315,7 +1361,14 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 			f.model.progressEmitter.Register(state.sharedPullerState)
 		}
 
-		weakHashFinder, file := f.initWeakHashFinder(state)
+		var weakHashFinder *weakhash.Finder
+		var shiftedChunks map[string]int64
+		var file fs.File
+		if state.file.BlockChunking == protocol.BlockChunkingCDC {
+			shiftedChunks, file = f.initShiftedChunks(state)
+		} else {
+			weakHashFinder, file = f.initWeakHashFinder(state)
+		}
 
 	blocks:
 		for _, block := range state.blocks {
@@ -1342,7 +1395,19 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 			buf = protocol.BufferPool.Upgrade(buf, int(block.Size))
 
 			var found bool
-			if f.Type != config.FolderTypeReceiveEncrypted {
+			if offset, ok := shiftedChunks[string(block.Hash)]; ok {
+				if _, err := file.ReadAt(buf, offset); err == nil && f.verifyBuffer(buf, block) == nil {
+					if err := f.limitedWriteAt(dstFd, buf, block.Offset); err != nil {
+						state.fail(fmt.Errorf("dst write: %w", err))
+					}
+					if offset == block.Offset {
+						state.copiedFromOrigin(block.Size)
+					} else {
+						state.copiedFromOriginShifted(block.Size)
+					}
+					found = true
+				}
+			} else if f.Type != config.FolderTypeReceiveEncrypted {
 				found, err = weakHashFinder.Iterate(block.WeakHash, buf, func(offset int64) bool {
 					if f.verifyBuffer(buf, block) != nil {
 						return true
@@ -1366,7 +1431,7 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 			}
 
 			if !found {
-				found = f.model.finder.Iterate(folders, block.Hash, func(folder, path string, index int32) bool {
+				found = f.model.finder.Iterate(folders, block.Hash, func(folder, path string, index int32, offset int64) bool {
 					ffs := folderFilesystems[folder]
 					fd, err := ffs.Open(path)
 					if err != nil {
@@ -1374,7 +1439,10 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 					}
 					defer fd.Close()
 
-					srcOffset := int64(state.file.BlockSize()) * int64(index)
+					srcOffset := offset
+					if srcOffset < 0 {
+						srcOffset = int64(state.file.BlockSize()) * int64(index)
+					}
 					_, err = fd.ReadAt(buf, srcOffset)
 					if err != nil {
 						return false
@@ -1442,13 +1510,8 @@ func (f *sendReceiveFolder) initWeakHashFinder(state copyBlocksState) (*weakhash
 		return nil, nil
 	}
 
-	blocksPercentChanged := 0
-	if tot := len(state.file.Blocks); tot > 0 {
-		blocksPercentChanged = (tot - state.have) * 100 / tot
-	}
-
-	if blocksPercentChanged < f.WeakHashThresholdPct {
-		l.Debugf("not weak hashing %s. not enough changed %.02f < %d", state.file.Name, blocksPercentChanged, f.WeakHashThresholdPct)
+	if pct := blocksPercentChanged(state); pct < f.WeakHashThresholdPct {
+		l.Debugf("not weak hashing %s. not enough changed %d < %d", state.file.Name, pct, f.WeakHashThresholdPct)
 		return nil, nil
 	}
 
@@ -1478,6 +1541,59 @@ func (f *sendReceiveFolder) initWeakHashFinder(state copyBlocksState) (*weakhash
 	return weakHashFinder, file
 }
 
+// initShiftedChunks splits the existing file into content defined chunks
+// the same way as the file we're pulling, and returns the offsets of the
+// chunks we need keyed by hash. This finds data that moved within the file
+// when the block map can't, because the existing file was hashed with fixed
+// blocks or has been changed since it was scanned.
+func (f *sendReceiveFolder) initShiftedChunks(state copyBlocksState) (map[string]int64, fs.File) {
+	if f.Type == config.FolderTypeReceiveEncrypted {
+		return nil, nil
+	}
+
+	if pct := blocksPercentChanged(state); pct < f.WeakHashThresholdPct {
+		l.Debugf("not chunking existing %s. not enough changed %d < %d", state.file.Name, pct, f.WeakHashThresholdPct)
+		return nil, nil
+	}
+
+	file, err := f.mtimefs.Open(state.file.Name)
+	if err != nil {
+		l.Debugln("chunking existing", err)
+		return nil, nil
+	}
+	info, err := file.Stat()
+	if err != nil {
+		l.Debugln("chunking existing", err)
+		return nil, file
+	}
+	existing, err := scanner.CDCBlocks(f.ctx, file, state.file.BlockSize(), info.Size(), nil, false)
+	if err != nil {
+		l.Debugln("chunking existing", err)
+		return nil, file
+	}
+
+	needed := make(map[string]struct{}, len(state.blocks))
+	for _, block := range state.blocks {
+		needed[string(block.Hash)] = struct{}{}
+	}
+	offsets := make(map[string]int64)
+	for _, block := range existing {
+		if _, ok := needed[string(block.Hash)]; ok {
+			offsets[string(block.Hash)] = block.Offset
+		}
+	}
+	return offsets, file
+}
+
+// blocksPercentChanged returns how much of the file we don't already have
+// at the right place locally.
+func blocksPercentChanged(state copyBlocksState) int {
+	if tot := len(state.file.Blocks); tot > 0 {
+		return (tot - state.have) * 100 / tot
+	}
+	return 0
+}
+
 func (*sendReceiveFolder) verifyBuffer(buf []byte, block protocol.BlockInfo) error {
 	if len(buf) != int(block.Size) {
 		return fmt.Errorf("length mismatch %d != %d", len(buf), block.Size)
@@ -1547,6 +1663,7 @@ func (f *sendReceiveFolder) pullBlock(state pullBlockState, snap *db.Snapshot, o
 	}
 
 	var lastError error
+	old := f.deltaBase(state)
 	candidates := f.model.availabilityInSnapshot(f.FolderConfiguration, snap, state.file, state.block)
 loop:
 	for {
@@ -1577,9 +1694,28 @@ loop:
 		// Fetch the block, while marking the selected device as in use so that
 		// leastBusy can select another device when someone else asks.
 		activity.using(selected)
+		release, err := f.model.takePullBandwidth(f.ctx, selected.ID, f.FolderConfiguration, int(state.block.Size))
+		if err != nil {
+			activity.done(selected)
+			state.fail(fmt.Errorf("folder stopped: %w", err))
+			break
+		}
 		var buf []byte
-		blockNo := int(state.block.Offset / int64(state.file.BlockSize()))
-		buf, lastError = f.model.requestGlobal(f.ctx, selected.ID, f.folderID, state.file.Name, blockNo, state.block.Offset, int(state.block.Size), state.block.Hash, state.block.WeakHash, selected.FromTemporary)
+		blockNo := state.file.BlockIndex(state.block.Offset)
+		if old != nil {
+			buf, lastError = f.model.requestGlobalDelta(f.ctx, selected.ID, f.folderID, state.file.Name, blockNo, state.block.Offset, int(state.block.Size), state.block.Hash, state.block.WeakHash, selected.FromTemporary, old)
+			if lastError == nil {
+				lastError = f.verifyBuffer(buf, state.block)
+			}
+			if lastError != nil {
+				// Whatever went wrong, the plain request might still work.
+				l.Debugln("delta request:", f.folderID, state.file.Name, state.block.Offset, state.block.Size, selected.ID.Short(), "failed, requesting whole block:", lastError)
+				buf, lastError = f.model.requestGlobal(f.ctx, selected.ID, f.folderID, state.file.Name, blockNo, state.block.Offset, int(state.block.Size), state.block.Hash, state.block.WeakHash, selected.FromTemporary)
+			}
+		} else {
+			buf, lastError = f.model.requestGlobal(f.ctx, selected.ID, f.folderID, state.file.Name, blockNo, state.block.Offset, int(state.block.Size), state.block.Hash, state.block.WeakHash, selected.FromTemporary)
+		}
+		release()
 		activity.done(selected)
 		if lastError != nil {
 			l.Debugln("request:", f.folderID, state.file.Name, state.block.Offset, state.block.Size, selected.ID.Short(), "returned error:", lastError)
@@ -1612,6 +1748,41 @@ loop:
 	out <- state.sharedPullerState
 }
 
+// deltaBase returns the data of the existing local file at and around the
+// offset of the block to pull, for use as the base of a delta request. It
+// returns nil if there is no such data.
+func (f *sendReceiveFolder) deltaBase(state pullBlockState) []byte {
+	if f.Type == config.FolderTypeReceiveEncrypted || !state.hasCurFile || state.curFile.IsDeleted() || state.curFile.IsDirectory() || state.curFile.IsSymlink() {
+		return nil
+	}
+
+	// Include a chunk on either side, to catch data that has moved a bit.
+	chunkSize := int64(protocol.DeltaChunkSize(int(state.block.Size)))
+	start := state.block.Offset - chunkSize
+	if start < 0 {
+		start = 0
+	}
+	end := state.block.Offset + int64(state.block.Size) + chunkSize
+	if end > state.curFile.Size {
+		end = state.curFile.Size
+	}
+	if end-start < chunkSize {
+		return nil
+	}
+
+	fd, err := f.mtimefs.Open(state.curFile.Name)
+	if err != nil {
+		return nil
+	}
+	defer fd.Close()
+	buf := make([]byte, end-start)
+	n, err := fd.ReadAt(buf, start)
+	if err != nil && !errors.Is(err, io.EOF) || int64(n) < chunkSize {
+		return nil
+	}
+	return buf[:n]
+}
+
 func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCurFile bool, tempName string, snap *db.Snapshot, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) error {
 	// Set the correct permission bits on the new file
 	if !f.IgnorePerms && !file.NoPermissions {
@@ -1625,6 +1796,7 @@ func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCu
 		return fmt.Errorf("setting metadata: %w", err)
 	}
 
+	merged := false
 	if stat, err := f.mtimefs.Lstat(file.Name); err == nil {
 		// There is an old file or directory already in place. We need to
 		// handle that.
@@ -1635,13 +1807,17 @@ func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCu
 
 		if !curFile.IsDirectory() && !curFile.IsSymlink() && f.inConflict(curFile.Version, file.Version) {
 			// The new file has been changed in conflict with the existing one. We
-			// should file it away as a conflict instead of just removing or
-			// archiving.
+			// should merge the changes if possible, or else file it away as a
+			// conflict instead of just removing or archiving.
 			// Directories and symlinks aren't checked for conflicts.
 
-			err = f.inWritableDir(func(name string) error {
-				return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
-			}, curFile.Name)
+			if merged = f.mergeConflict(file, curFile, tempName); merged {
+				err = f.deleteItemOnDisk(curFile, snap, scanChan)
+			} else {
+				err = f.inWritableDir(func(name string) error {
+					return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
+				}, curFile.Name)
+			}
 		} else {
 			err = f.deleteItemOnDisk(curFile, snap, scanChan)
 		}
@@ -1658,8 +1834,18 @@ func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCu
 		return fmt.Errorf("replacing file: %w", err)
 	}
 
-	// Set the correct timestamp on the new file
-	f.mtimefs.Chtimes(file.Name, file.ModTime(), file.ModTime()) // never fails
+	if merged {
+		// The merged file supersedes both versions, and is recorded with
+		// its actual contents as a change made here.
+		var err error
+		if file, err = f.mergedFileInfo(file, curFile); err != nil {
+			scanChan <- file.Name
+			return fmt.Errorf("hashing merged file: %w", err)
+		}
+	} else {
+		// Set the correct timestamp on the new file
+		f.mtimefs.Chtimes(file.Name, file.ModTime(), file.ModTime()) // never fails
+	}
 
 	// Record the updated file in the index
 	dbUpdateChan <- dbUpdateJob{file, dbUpdateHandleFile}
@@ -1717,6 +1903,43 @@ func (f *sendReceiveFolder) Jobs(page, perpage int) ([]string, []string, int) {
 	return f.queue.Jobs(page, perpage)
 }
 
+func (f *sendReceiveFolder) HeldDeletions() []string {
+	return f.deletions.heldDeletions()
+}
+
+// holdRemoteDeletions holds back the deletions in an index update from
+// another device, if it deletes more of the items here than allowed.
+func (f *sendReceiveFolder) holdRemoteDeletions(files []protocol.FileInfo) {
+	if f.IgnoreDelete || !f.deletions.enabled() {
+		return
+	}
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return
+	}
+	defer snap.Release()
+
+	var deletions []protocol.FileInfo
+	for _, file := range files {
+		if !file.IsDeleted() {
+			continue
+		}
+		if cur, ok := snap.Get(protocol.LocalDeviceID, file.Name); ok && !cur.IsDeleted() && !cur.Version.GreaterEqual(file.Version) {
+			deletions = append(deletions, file)
+		}
+	}
+	local := snap.LocalSize()
+	f.deletions.check(deletions, local.Files+local.Directories+local.Symlinks)
+}
+
+func (f *sendReceiveFolder) ApproveDeletions() int {
+	approved := f.deletions.approve()
+	if approved > 0 {
+		f.SchedulePull()
+	}
+	return approved
+}
+
 // dbUpdaterRoutine aggregates db updates and commits them in batches no
 // larger than 1000 items, and no more delayed than 2 seconds.
 func (f *sendReceiveFolder) dbUpdaterRoutine(dbUpdateChan <-chan dbUpdateJob) {
@@ -1867,6 +2090,8 @@ func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan cha
 		// remote modification and a local delete. In either way it does not
 		// matter, go ahead as if the move succeeded.
 		err = nil
+	} else if err == nil {
+		f.recordLocalConflict(newName)
 	}
 	if f.MaxConflicts > -1 {
 		matches := existingConflicts(name, f.mtimefs)
@@ -1885,6 +2110,140 @@ func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan cha
 	return err
 }
 
+// mergeConflict tries to merge the conflicting changes of the current and
+// the new version of a file into the temporary file, using the last
+// version of the file archived by the versioner before either change as
+// their common ancestor. It returns whether the temporary file now holds
+// the merged contents.
+func (f *sendReceiveFolder) mergeConflict(file, curFile protocol.FileInfo, tempName string) bool {
+	if f.merger == nil || f.versioner == nil || !f.Merge.Matches(file.Name) {
+		return false
+	}
+	maxSize := f.Merge.MaxSize()
+	if file.Size > maxSize || curFile.Size > maxSize {
+		return false
+	}
+
+	versions, err := f.versioner.GetVersions()
+	if err != nil {
+		l.Debugln(f, "merging conflict:", err)
+		return false
+	}
+	ancestor, ok := lastCommonVersion(versions[file.Name], curFile.ModTime(), file.ModTime(), maxSize)
+	if !ok {
+		l.Debugln(f, "merging conflict: no common version of", file.Name)
+		return false
+	}
+
+	base, err := readMergeFile(func() (fs.File, error) { return f.versioner.Open(file.Name, ancestor.VersionTime) }, maxSize)
+	if err != nil {
+		l.Debugln(f, "merging conflict:", err)
+		return false
+	}
+	local, err := readMergeFile(func() (fs.File, error) { return f.mtimefs.Open(curFile.Name) }, maxSize)
+	if err != nil {
+		l.Debugln(f, "merging conflict:", err)
+		return false
+	}
+	remote, err := readMergeFile(func() (fs.File, error) { return f.mtimefs.Open(tempName) }, maxSize)
+	if err != nil {
+		l.Debugln(f, "merging conflict:", err)
+		return false
+	}
+
+	merged, err := f.merger.Merge(base, local, remote)
+	if err != nil {
+		l.Infof("Could not merge conflicting changes to %s in folder %s: %v", file.Name, f.Description(), err)
+		return false
+	}
+
+	fd, err := f.mtimefs.OpenFile(tempName, fs.OptWriteOnly|fs.OptTruncate, 0o644)
+	if err != nil {
+		l.Debugln(f, "merging conflict:", err)
+		return false
+	}
+	if _, err := fd.Write(merged); err != nil {
+		fd.Close()
+		l.Debugln(f, "merging conflict:", err)
+		return false
+	}
+	if err := fd.Close(); err != nil {
+		l.Debugln(f, "merging conflict:", err)
+		return false
+	}
+
+	l.Infof("Merged conflicting changes to %s in folder %s", file.Name, f.Description())
+	return true
+}
+
+// mergedFileInfo returns the file info for the merged contents now in
+// place of the file, with their blocks, size and modification time, as a
+// change made on this device on top of both versions.
+func (f *sendReceiveFolder) mergedFileInfo(file, curFile protocol.FileInfo) (protocol.FileInfo, error) {
+	info, err := f.mtimefs.Lstat(file.Name)
+	if err != nil {
+		return file, err
+	}
+	blockSize := protocol.BlockSize(info.Size())
+	chunking := f.model.blockChunking(f.ID)
+	blocks, err := scanner.HashFile(f.ctx, f.ID, f.mtimefs, file.Name, blockSize, chunking, nil, true)
+	if err != nil {
+		return file, err
+	}
+
+	file.Blocks = blocks
+	file.BlocksHash = protocol.BlocksHash(blocks)
+	file.RawBlockSize = blockSize
+	file.BlockChunking = chunking
+	file.Size = 0
+	for _, b := range blocks {
+		file.Size += int64(b.Size)
+	}
+	file.ModifiedS = info.ModTime().Unix()
+	file.ModifiedNs = info.ModTime().Nanosecond()
+	file.ModifiedBy = f.shortID
+	file.Version = file.Version.Merge(curFile.Version).Update(f.shortID)
+	return file, nil
+}
+
+// lastCommonVersion returns the last version which was modified before
+// both of the given times, as that is the one the conflicting changes were
+// most likely made to.
+func lastCommonVersion(versions []versioner.FileVersion, a, b time.Time, maxSize int64) (versioner.FileVersion, bool) {
+	before := a
+	if b.Before(a) {
+		before = b
+	}
+	var last versioner.FileVersion
+	found := false
+	for _, v := range versions {
+		if v.ModTime.After(before) || v.Size > maxSize {
+			continue
+		}
+		if !found || v.ModTime.After(last.ModTime) {
+			last = v
+			found = true
+		}
+	}
+	return last, found
+}
+
+func readMergeFile(open func() (fs.File, error), maxSize int64) ([]byte, error) {
+	fd, err := open()
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	data, err := io.ReadAll(io.LimitReader(fd, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%s: file too large to merge", fd.Name())
+	}
+	return data, nil
+}
+
 func (f *sendReceiveFolder) newPullError(path string, err error) {
 	if errors.Is(err, f.ctx.Err()) {
 		// Error because the folder stopped - no point logging/tracking
@@ -1930,12 +2289,23 @@ func (f *sendReceiveFolder) deleteItemOnDisk(item protocol.FileInfo, snap *db.Sn
 		// an error.
 		// Symlinks aren't archived.
 
-		return f.inWritableDir(f.versioner.Archive, item.Name)
+		return f.inWritableDir(f.archiveFunc(item), item.Name)
 	}
 
 	return f.inWritableDir(f.mtimefs.Remove, item.Name)
 }
 
+// archiveFunc returns the function archiving the given file with the
+// versioner, passing along its blocks when the versioner can use them.
+func (f *sendReceiveFolder) archiveFunc(file protocol.FileInfo) func(string) error {
+	if ba, ok := f.versioner.(versioner.BlockArchiver); ok {
+		return func(name string) error {
+			return ba.ArchiveBlocks(name, file)
+		}
+	}
+	return f.versioner.Archive
+}
+
 // deleteDirOnDisk attempts to delete a directory. It checks for files/dirs inside
 // the directory and removes them if possible or returns an error if it fails
 func (f *sendReceiveFolder) deleteDirOnDisk(dir string, snap *db.Snapshot, scanChan chan<- string) error {
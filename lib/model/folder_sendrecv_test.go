@@ -24,10 +24,12 @@ import (
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
+	"github.com/syncthing/syncthing/lib/merge"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/rand"
 	"github.com/syncthing/syncthing/lib/scanner"
 	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/versioner"
 )
 
 var blocks = []protocol.BlockInfo{
@@ -300,7 +302,7 @@ func TestCopierFinder(t *testing.T) {
 	}
 
 	// Verify that the fetched blocks have actually been written to the temp file
-	blks, err := scanner.HashFile(context.TODO(), f.ID, f.Filesystem(nil), tempFile, protocol.MinBlockSize, nil, false)
+	blks, err := scanner.HashFile(context.TODO(), f.ID, f.Filesystem(nil), tempFile, protocol.MinBlockSize, protocol.BlockChunkingFixed, nil, false)
 	if err != nil {
 		t.Log(err)
 	}
@@ -434,9 +436,103 @@ func TestWeakHash(t *testing.T) {
 	}
 }
 
+func TestCDCShiftedChunks(t *testing.T) {
+	// Setup the model/pull environment
+	_, fo, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+	ffs := fo.Filesystem(nil)
+
+	tempFile := fs.TempName("cdc")
+	var shift int64 = 10
+	var size int64 = 4 << 20
+
+	f, err := ffs.Create("cdc")
+	must(t, err)
+	defer f.Close()
+	if _, err := io.CopyN(f, rand.Reader, size); err != nil {
+		t.Fatal(err)
+	}
+	info, err := f.Stat()
+	must(t, err)
+
+	// The existing file was hashed with fixed blocks, so none of its
+	// blocks are useful as such. The desired file has a random prefix,
+	// shifting the remaining data.
+	f.Seek(0, io.SeekStart)
+	existing, err := scanner.Blocks(context.TODO(), f, protocol.MinBlockSize, size, nil, true)
+	must(t, err)
+	f.Seek(0, io.SeekStart)
+	existingChunks, err := scanner.CDCBlocks(context.TODO(), f, protocol.MinBlockSize, size, nil, false)
+	must(t, err)
+
+	f.Seek(0, io.SeekStart)
+	nf := io.MultiReader(io.LimitReader(rand.Reader, shift), io.LimitReader(f, size-shift))
+	desired, err := scanner.CDCBlocks(context.TODO(), nf, protocol.MinBlockSize, size, nil, true)
+	must(t, err)
+
+	expectPulls := len(desired) - len(blocksPresent(existingChunks, desired))
+	if expectPulls == len(desired) {
+		t.Fatal("expected chunks to survive the shift")
+	}
+
+	existingFile := protocol.FileInfo{
+		Name:       "cdc",
+		Blocks:     existing,
+		Size:       size,
+		ModifiedS:  info.ModTime().Unix(),
+		ModifiedNs: info.ModTime().Nanosecond(),
+	}
+	desiredFile := protocol.FileInfo{
+		Name:          "cdc",
+		Size:          size,
+		Blocks:        desired,
+		ModifiedS:     info.ModTime().Unix() + 1,
+		RawBlockSize:  protocol.MinBlockSize,
+		BlockChunking: protocol.BlockChunkingCDC,
+	}
+
+	fo.updateLocalsFromScanning([]protocol.FileInfo{existingFile})
+
+	copyChan := make(chan copyBlocksState)
+	pullChan := make(chan pullBlockState, len(desired))
+	finisherChan := make(chan *sharedPullerState, 1)
+
+	go fo.copierRoutine(copyChan, pullChan, finisherChan)
+	defer close(copyChan)
+
+	fo.WeakHashThresholdPct = -1
+	fo.handleFile(desiredFile, fsetSnapshot(t, fo.fset), copyChan)
+
+	var pulls []pullBlockState
+	timeout := time.After(10 * time.Second)
+	for len(pulls) < expectPulls {
+		select {
+		case pull := <-pullChan:
+			pulls = append(pulls, pull)
+		case <-timeout:
+			t.Fatalf("timed out, got %d pulls expected %d", len(pulls), expectPulls)
+		}
+	}
+	finish := <-finisherChan
+	cleanupSharedPullerState(finish)
+	if err := ffs.Remove(tempFile); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-pullChan:
+		t.Fatal("Pull channel has data to be read")
+	default:
+	}
+
+	if expectShifted := len(desired) - expectPulls; finish.copyOriginShifted != expectShifted {
+		t.Errorf("copied %d shifted, expected %d", finish.copyOriginShifted, expectShifted)
+	}
+}
+
 // Test that updating a file removes its old blocks from the blockmap
 func TestCopierCleanup(t *testing.T) {
-	iterFn := func(folder, file string, index int32) bool {
+	iterFn := func(folder, file string, index int32, _ int64) bool {
 		return true
 	}
 
@@ -1138,6 +1234,162 @@ func TestPullCaseOnlyPerformFinish(t *testing.T) {
 	}
 }
 
+// mergeVersioner keeps a single version of a file, as the ancestor when
+// merging conflicts.
+type mergeVersioner struct {
+	ffs      fs.Filesystem
+	ancestor string
+	version  versioner.FileVersion
+}
+
+func (v *mergeVersioner) Archive(name string) error {
+	return v.ffs.Remove(name)
+}
+
+func (v *mergeVersioner) GetVersions() (map[string][]versioner.FileVersion, error) {
+	return map[string][]versioner.FileVersion{"doc.txt": {v.version}}, nil
+}
+
+func (*mergeVersioner) Restore(string, time.Time) error {
+	return versioner.ErrRestorationNotSupported
+}
+
+func (v *mergeVersioner) Open(string, time.Time) (fs.File, error) {
+	return v.ffs.Open(v.ancestor)
+}
+
+func (*mergeVersioner) Clean(context.Context) error {
+	return nil
+}
+
+func TestPullMergeConflict(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+	ffs := f.Filesystem(nil)
+
+	name := "doc.txt"
+	writeFile(t, ffs, "ancestor", []byte("a\nb\nc\nd\n"))
+	f.versioner = &mergeVersioner{
+		ffs:      ffs,
+		ancestor: "ancestor",
+		version:  versioner.FileVersion{VersionTime: time.Unix(1e9, 0), ModTime: time.Unix(1e9, 0), Size: 8},
+	}
+	f.Merge = config.MergeConfiguration{Type: "lines", Patterns: []string{"*.txt"}, MaxSizeKiB: 1}
+	f.merger, _ = merge.New("lines")
+
+	writeFile(t, ffs, name, []byte("a\nB\nc\nd\n"))
+	must(t, f.scanSubdirs([]string{name}))
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	cur, ok := snap.Get(protocol.LocalDeviceID, name)
+	if !ok {
+		t.Fatal("file is missing")
+	}
+
+	remote := cur
+	remote.Version = protocol.Vector{}.Update(device1.Short())
+	remote.ModifiedBy = device1.Short()
+	temp := fs.TempName(name)
+	writeFile(t, ffs, temp, []byte("a\nb\nc\nD\n"))
+
+	scanChan := make(chan string, 1)
+	dbUpdateChan := make(chan dbUpdateJob, 1)
+	must(t, f.performFinish(remote, cur, true, temp, snap, dbUpdateChan, scanChan))
+
+	must(t, equalContents(ffs, name, []byte("a\nB\nc\nD\n")))
+	if conflicts := existingConflicts(name, ffs); len(conflicts) != 0 {
+		t.Errorf("unexpected conflict copies %v", conflicts)
+	}
+	job := <-dbUpdateChan
+	if !job.file.Version.GreaterEqual(cur.Version) || !job.file.Version.GreaterEqual(remote.Version) {
+		t.Errorf("merged version %v doesn't supersede %v and %v", job.file.Version, cur.Version, remote.Version)
+	}
+	blocks, err := scanner.HashFile(context.Background(), f.ID, ffs, name, protocol.MinBlockSize, protocol.BlockChunkingFixed, nil, true)
+	must(t, err)
+	if !job.file.BlocksEqual(protocol.FileInfo{Blocks: blocks}) || job.file.Size != 8 {
+		t.Errorf("merged file recorded with size %d and blocks %v, expected 8 and %v", job.file.Size, job.file.Blocks, blocks)
+	}
+	info, err := ffs.Lstat(name)
+	must(t, err)
+	if !job.file.ModTime().Equal(info.ModTime()) {
+		t.Errorf("merged file recorded with modification time %v, expected %v", job.file.ModTime(), info.ModTime())
+	}
+}
+
+func TestPullHeldDeletions(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer wcfgCancel()
+	conn := addFakeConn(m, device1, f.ID)
+	f.MaxDeletes = 1
+	f.deletions = newDeletionLimit(f.FolderConfiguration, m.db)
+
+	names := []string{"a", "b", "c"}
+	for _, name := range names {
+		writeFile(t, f.mtimefs, name, []byte(name))
+	}
+	must(t, f.scanSubdirs(nil))
+
+	deleted := func(name string) protocol.FileInfo {
+		file, ok := m.testCurrentFolderFile(f.ID, name)
+		if !ok {
+			t.Fatal("file missing")
+		}
+		file.SetDeleted(device1.Short())
+		return file
+	}
+	// One update deleting more than allowed, and one within the limit.
+	must(t, m.Index(conn, f.ID, []protocol.FileInfo{deleted("a"), deleted("b")}))
+	must(t, m.IndexUpdate(conn, f.ID, []protocol.FileInfo{deleted("c")}))
+
+	scanChan := make(chan string, len(names))
+	changed, err := f.pullerIteration(scanChan)
+	must(t, err)
+	if changed != 1 {
+		t.Errorf("got %d changes with deletions held, expected 1", changed)
+	}
+	if held := f.HeldDeletions(); len(held) != 2 || held[0] != "a" || held[1] != "b" {
+		t.Errorf("got held deletions %v, expected [a b]", held)
+	}
+	for _, name := range names[:2] {
+		if _, err := f.mtimefs.Lstat(name); err != nil {
+			t.Errorf("%s was deleted before approval: %v", name, err)
+		}
+	}
+	if _, err := f.mtimefs.Lstat("c"); !fs.IsNotExist(err) {
+		t.Errorf("c wasn't deleted: %v", err)
+	}
+
+	// The deletions stay held when the folder restarts, though the index
+	// updates holding them aren't received again.
+	f = newSendReceiveFolder(f.model, f.fset, f.ignores, f.FolderConfiguration, f.versioner, events.NoopLogger, f.ioLimiter).(*sendReceiveFolder)
+	f.tempPullErrors = make(map[string]string)
+	f.ctx = context.Background()
+	_, err = f.pullerIteration(scanChan)
+	must(t, err)
+	if held := f.HeldDeletions(); len(held) != 2 {
+		t.Errorf("got held deletions %v after restart, expected [a b]", held)
+	}
+	for _, name := range names[:2] {
+		if _, err := f.mtimefs.Lstat(name); err != nil {
+			t.Errorf("%s was deleted after restart: %v", name, err)
+		}
+	}
+
+	if approved := f.ApproveDeletions(); approved != 2 {
+		t.Errorf("approved %d deletions, expected 2", approved)
+	}
+	_, err = f.pullerIteration(scanChan)
+	must(t, err)
+	for _, name := range names[:2] {
+		if _, err := f.mtimefs.Lstat(name); !fs.IsNotExist(err) {
+			t.Errorf("%s wasn't deleted after approval: %v", name, err)
+		}
+	}
+	if held := f.HeldDeletions(); len(held) != 0 {
+		t.Errorf("got held deletions %v after approval, expected none", held)
+	}
+}
+
 func TestPullCaseOnlyDir(t *testing.T) {
 	testPullCaseOnlyDirOrSymlink(t, true)
 }
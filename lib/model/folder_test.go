@@ -7,6 +7,7 @@
 package model
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -191,3 +192,36 @@ func TestSetPlatformData(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestScanSnapshot(t *testing.T) {
+	if build.IsWindows {
+		t.Skip("uses shell commands")
+	}
+
+	// The snapshot is another fake filesystem, holding a file that the
+	// folder itself doesn't.
+	snapPath := rand.String(32) + "?content=true"
+	snapFs := fs.NewFilesystem(fs.FilesystemTypeFake, snapPath)
+	writeFile(t, snapFs, "snapshotted", []byte("data"))
+	released := filepath.Join(t.TempDir(), "released")
+
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	fcfg.Snapshot = config.SnapshotConfiguration{
+		Type:           "command",
+		CreateCommand:  "echo " + snapPath,
+		ReleaseCommand: "touch " + released,
+		TimeoutS:       10,
+	}
+	setFolder(t, w, fcfg)
+	m := setupModel(t, w)
+	defer cleanupModel(m)
+
+	must(t, m.ScanFolder(fcfg.ID))
+	if _, ok := m.testCurrentFolderFile(fcfg.ID, "snapshotted"); !ok {
+		t.Error("file in the snapshot wasn't scanned")
+	}
+	if _, err := os.Stat(released); err != nil {
+		t.Error("snapshot wasn't released:", err)
+	}
+}
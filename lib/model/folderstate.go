@@ -25,6 +25,7 @@ const (
 	FolderCleaning
 	FolderCleanWaiting
 	FolderError
+	FolderSyncSuspended
 )
 
 func (s folderState) String() string {
@@ -47,6 +48,8 @@ func (s folderState) String() string {
 		return "clean-waiting"
 	case FolderError:
 		return "error"
+	case FolderSyncSuspended:
+		return "sync-suspended"
 	default:
 		return "unknown"
 	}
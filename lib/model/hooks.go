@@ -0,0 +1,228 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// At most this many changed files are listed in the environment of a hook,
+// to stay within the limits on the size of environment variables.
+const maxHookFiles = 1000
+
+// folderHooks runs the hooks configured for folders. The hooks run after
+// pulls are driven by the ItemFinished and StateChanged events, while
+// those run before scans are called by the folders themselves. The errors
+// of the last run of each hook are reported as folder errors.
+type folderHooks struct {
+	cfg      config.Wrapper
+	evLogger events.Logger
+
+	mut     sync.Mutex
+	changed map[string]map[string]struct{}  // folder ID -> files changed by the ongoing pull
+	errors  map[string]map[string]FileError // folder ID -> hook key -> error
+}
+
+func newFolderHooks(cfg config.Wrapper, evLogger events.Logger) *folderHooks {
+	return &folderHooks{
+		cfg:      cfg,
+		evLogger: evLogger,
+		mut:      sync.NewMutex(),
+		changed:  make(map[string]map[string]struct{}),
+		errors:   make(map[string]map[string]FileError),
+	}
+}
+
+func (h *folderHooks) Serve(ctx context.Context) error {
+	sub := h.evLogger.Subscribe(events.ItemFinished | events.StateChanged)
+	defer sub.Unsubscribe()
+
+	for {
+		// This loop needs to be fast so we don't miss events, hence hooks
+		// are run in the background.
+
+		select {
+		case ev, ok := <-sub.C():
+			if !ok {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			h.processEvent(ctx, ev)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (h *folderHooks) processEvent(ctx context.Context, ev events.Event) {
+	data, ok := ev.Data.(map[string]interface{})
+	if !ok {
+		return
+	}
+	folder, _ := data["folder"].(string)
+	cfg, ok := h.cfg.Folder(folder)
+	if !ok {
+		return
+	}
+	pulled := cfg.HooksFor(config.HookPulled)
+	updated := cfg.HooksFor(config.HookFileUpdated)
+	if len(pulled) == 0 && len(updated) == 0 {
+		return
+	}
+
+	switch ev.Type {
+	case events.ItemFinished:
+		if err, _ := data["error"].(*string); err != nil {
+			return
+		}
+		item, _ := data["item"].(string)
+		h.mut.Lock()
+		if h.changed[folder] == nil {
+			h.changed[folder] = make(map[string]struct{})
+		}
+		h.changed[folder][item] = struct{}{}
+		h.mut.Unlock()
+
+	case events.StateChanged:
+		if data["to"] != FolderIdle.String() {
+			return
+		}
+		if from := data["from"]; from != FolderSyncing.String() && from != FolderSyncPreparing.String() {
+			return
+		}
+		h.mut.Lock()
+		changed := h.changed[folder]
+		delete(h.changed, folder)
+		h.mut.Unlock()
+		if len(changed) == 0 {
+			return
+		}
+
+		files := make([]string, 0, len(changed))
+		for name := range changed {
+			files = append(files, name)
+		}
+		sort.Strings(files)
+
+		go func() {
+			for _, hook := range pulled {
+				h.run(ctx, cfg, hook, files, nil)
+			}
+			for _, hook := range updated {
+				var matching []string
+				for _, name := range files {
+					if hook.Matches(name) {
+						matching = append(matching, name)
+					}
+				}
+				if len(matching) > 0 {
+					h.run(ctx, cfg, hook, matching, nil)
+				}
+			}
+		}()
+	}
+}
+
+// beforeScan runs the folder's hooks for before scanning the given
+// subdirectories, or all of the folder if there are none.
+func (h *folderHooks) beforeScan(ctx context.Context, folder string, subDirs []string) {
+	cfg, ok := h.cfg.Folder(folder)
+	if !ok {
+		return
+	}
+	for _, hook := range cfg.HooksFor(config.HookBeforeScan) {
+		h.run(ctx, cfg, hook, nil, []string{"STSCAN_SUBDIRS=" + strings.Join(subDirs, "\n")})
+	}
+}
+
+// run runs the hook with the changed files listed in its environment, and
+// records the error if it fails.
+func (h *folderHooks) run(ctx context.Context, cfg config.FolderConfiguration, hook config.FolderHook, files []string, env []string) {
+	count := len(files)
+	if len(files) > maxHookFiles {
+		files = files[:maxHookFiles]
+	}
+	env = append(env,
+		"STFOLDER_ID="+cfg.ID,
+		"STFOLDER_LABEL="+cfg.Label,
+		"STFOLDER_PATH="+cfg.Path,
+		"STHOOK_EVENT="+hook.Event,
+		"STCHANGED_FILES="+strings.Join(files, "\n"),
+		"STCHANGED_COUNT="+strconv.Itoa(count),
+	)
+
+	err := runHookCommand(ctx, cfg, hook, env)
+	key := hook.Event + "\x00" + hook.Command
+
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	if err == nil {
+		delete(h.errors[cfg.ID], key)
+		return
+	}
+	if ctx.Err() != nil {
+		// Killed because we're stopping.
+		return
+	}
+	l.Infof("Running %s hook for folder %s: %v", hook.Event, cfg.Description(), err)
+	if h.errors[cfg.ID] == nil {
+		h.errors[cfg.ID] = make(map[string]FileError)
+	}
+	h.errors[cfg.ID][key] = FileError{
+		Path: hook.Command,
+		Err:  fmt.Sprintf("%s hook: %v", hook.Event, err),
+	}
+}
+
+// folderErrors returns the errors of the folder's hooks which are still
+// configured.
+func (h *folderHooks) folderErrors(folder string) []FileError {
+	cfg, ok := h.cfg.Folder(folder)
+	if !ok {
+		return nil
+	}
+	configured := make(map[string]struct{}, len(cfg.Hooks))
+	for _, hook := range cfg.Hooks {
+		configured[hook.Event+"\x00"+hook.Command] = struct{}{}
+	}
+
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	var errors []FileError
+	for key, fe := range h.errors[folder] {
+		if _, ok := configured[key]; ok {
+			errors = append(errors, fe)
+		}
+	}
+	sort.Sort(fileErrorList(errors))
+	return errors
+}
+
+func runHookCommand(ctx context.Context, cfg config.FolderConfiguration, hook config.FolderHook, env []string) error {
+	cmd := osutil.Command{
+		Line: hook.Command,
+		Placeholders: map[string]string{
+			"%FOLDER_ID%":   cfg.ID,
+			"%FOLDER_PATH%": cfg.Path,
+		},
+		Env:     env,
+		Timeout: time.Duration(hook.TimeoutS) * time.Second,
+	}
+	out, err := cmd.Run(ctx)
+	l.Debugf("hook %q output: %q", hook.Command, out)
+	return err
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+func TestHooksAfterPull(t *testing.T) {
+	if build.IsWindows {
+		t.Skip("uses shell commands")
+	}
+
+	dir := t.TempDir()
+	pulled := filepath.Join(dir, "pulled")
+	updated := filepath.Join(dir, "updated")
+
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	fcfg.Hooks = []config.FolderHook{
+		{Event: config.HookPulled, Command: `sh -c 'echo "$STFOLDER_ID $STCHANGED_COUNT" > "$1"' - ` + pulled},
+		{Event: config.HookFileUpdated, Command: `sh -c 'echo "$STCHANGED_FILES" > "$1"' - ` + updated, Patterns: []string{"*.conf"}},
+		{Event: config.HookFileUpdated, Command: "false", Patterns: []string{"app.conf"}},
+	}
+	setFolder(t, w, fcfg)
+	h := newFolderHooks(w, events.NoopLogger)
+
+	itemFinished := func(name string, err error) {
+		h.processEvent(context.Background(), events.Event{Type: events.ItemFinished, Data: map[string]interface{}{
+			"folder": fcfg.ID,
+			"item":   name,
+			"error":  events.Error(err),
+		}})
+	}
+	itemFinished("app.conf", nil)
+	itemFinished("data", nil)
+	itemFinished("failed.conf", os.ErrPermission)
+	h.processEvent(context.Background(), events.Event{Type: events.StateChanged, Data: map[string]interface{}{
+		"folder": fcfg.ID,
+		"from":   FolderSyncing.String(),
+		"to":     FolderIdle.String(),
+	}})
+
+	// The hooks run in the background, the failing one last.
+	var errs []FileError
+	for i := 0; i < 100; i++ {
+		if errs = h.folderErrors(fcfg.ID); len(errs) > 0 {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if len(errs) != 1 || errs[0].Path != "false" {
+		t.Errorf("unexpected hook errors %v", errs)
+	}
+
+	if data, err := os.ReadFile(pulled); err != nil || string(data) != "default 2\n" {
+		t.Errorf("unexpected pulled hook output %q, %v", data, err)
+	}
+	if data, err := os.ReadFile(updated); err != nil || string(data) != "app.conf\n" {
+		t.Errorf("unexpected file-updated hook output %q, %v", data, err)
+	}
+
+	// Errors of hooks which are no longer configured aren't reported.
+	fcfg.Hooks = fcfg.Hooks[:2]
+	setFolder(t, w, fcfg)
+	if errs := h.folderErrors(fcfg.ID); len(errs) != 0 {
+		t.Errorf("unexpected hook errors %v", errs)
+	}
+}
@@ -108,21 +108,22 @@ func newIndexHandler(conn protocol.Connection, downloads *deviceDownloadState, f
 	}
 }
 
-// waitForFileset waits for the handler to resume and fetches the current fileset.
-func (s *indexHandler) waitForFileset(ctx context.Context) (*db.FileSet, error) {
+// waitForFileset waits for the handler to resume and fetches the current
+// fileset and folder runner.
+func (s *indexHandler) waitForFileset(ctx context.Context) (*db.FileSet, service, error) {
 	s.cond.L.Lock()
 	defer s.cond.L.Unlock()
 
 	for s.paused {
 		select {
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			return nil, nil, ctx.Err()
 		default:
 			s.cond.Wait()
 		}
 	}
 
-	return s.fset, nil
+	return s.fset, s.runner, nil
 }
 
 func (s *indexHandler) Serve(ctx context.Context) (err error) {
@@ -145,11 +146,12 @@ func (s *indexHandler) Serve(ctx context.Context) (err error) {
 	}()
 
 	// We need to send one index, regardless of whether there is something to send or not
-	fset, err := s.waitForFileset(ctx)
+	fset, runner, err := s.waitForFileset(ctx)
 	if err != nil {
 		return err
 	}
-	err = s.sendIndexTo(ctx, fset)
+	limit, limitChanged := runner.sendLimit()
+	err = s.sendIndexTo(ctx, fset, limit)
 
 	// Subscribe to LocalIndexUpdated (we have new information to send) and
 	// DeviceDisconnected (it might be us who disconnected, so we should
@@ -162,26 +164,32 @@ func (s *indexHandler) Serve(ctx context.Context) (err error) {
 	defer ticker.Stop()
 
 	for err == nil {
-		fset, err = s.waitForFileset(ctx)
+		fset, runner, err = s.waitForFileset(ctx)
 		if err != nil {
 			return err
 		}
 
 		// While we have sent a sequence at least equal to the one
-		// currently in the database, wait for the local index to update. The
-		// local index may update for other folders than the one we are
-		// sending for.
-		if fset.Sequence(protocol.LocalDeviceID) <= s.prevSequence {
+		// currently in the database, or the one we may send up to, wait for
+		// the local index to update or the limit to change. The local index
+		// may update for other folders than the one we are sending for.
+		limit, limitChanged = runner.sendLimit()
+		sequence := fset.Sequence(protocol.LocalDeviceID)
+		if limit >= 0 && limit < sequence {
+			sequence = limit
+		}
+		if sequence <= s.prevSequence {
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
 			case <-evChan:
+			case <-limitChanged:
 			case <-ticker.C:
 			}
 			continue
 		}
 
-		err = s.sendIndexTo(ctx, fset)
+		err = s.sendIndexTo(ctx, fset, limit)
 
 		// Wait a short amount of time before entering the next loop. If there
 		// are continuous changes happening to the local index, this gives us
@@ -219,9 +227,10 @@ func (s *indexHandler) pause() {
 	s.cond.L.Unlock()
 }
 
-// sendIndexTo sends file infos with a sequence number higher than prevSequence and
-// returns the highest sent sequence number.
-func (s *indexHandler) sendIndexTo(ctx context.Context, fset *db.FileSet) error {
+// sendIndexTo sends file infos with a sequence number higher than prevSequence,
+// and no higher than limit unless that's negative, and returns the highest
+// sent sequence number.
+func (s *indexHandler) sendIndexTo(ctx context.Context, fset *db.FileSet, limit int64) error {
 	initial := s.prevSequence == 0
 	batch := db.NewFileInfoBatch(nil)
 	batch.SetFlushFunc(func(fs []protocol.FileInfo) error {
@@ -242,6 +251,10 @@ func (s *indexHandler) sendIndexTo(ctx context.Context, fset *db.FileSet) error
 	defer snap.Release()
 	previousWasDelete := false
 	snap.WithHaveSequence(s.prevSequence+1, func(fi protocol.FileIntf) bool {
+		if limit >= 0 && fi.SequenceNo() > limit {
+			return false
+		}
+
 		// This is to make sure that renames (which is an add followed by a delete) land in the same batch.
 		// Even if the batch is full, we allow a last delete to slip in, we do this by making sure that
 		// the batch ends with a non-delete, or that the last item in the batch is already a delete
@@ -331,6 +344,7 @@ func (s *indexHandler) receive(fs []protocol.FileInfo, update bool, op string) e
 		fs[i].LocalFlags = 0
 		fs[i].VersionHash = nil
 	}
+	runner.holdRemoteDeletions(fs)
 	fset.Update(deviceID, fs)
 
 	seq := fset.Sequence(deviceID)
@@ -0,0 +1,316 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+var errMassChange = errors.New("mass change detected, changes are not sent to other devices until confirmed")
+
+const (
+	// Amount of data read from a rewritten file to judge whether it looks
+	// encrypted, and the entropy in bits per byte above which it does.
+	entropySampleSize = 64 << 10
+	entropyThreshold  = 7.5
+)
+
+// File types whose contents look random anyway, for which high entropy
+// doesn't mean anything.
+var compressedExtensions = map[string]struct{}{
+	".7z": {}, ".bz2": {}, ".gz": {}, ".rar": {}, ".xz": {}, ".zip": {}, ".zst": {},
+	".gif": {}, ".heic": {}, ".jpeg": {}, ".jpg": {}, ".png": {}, ".webp": {},
+	".flac": {}, ".m4a": {}, ".mkv": {}, ".mov": {}, ".mp3": {}, ".mp4": {}, ".ogg": {},
+}
+
+// massChangeGuard holds back the local changes of a folder when a scan
+// modifies or deletes an abnormal share of its files, such as when
+// ransomware encrypts them. While a scan is running, nothing past the
+// sequence it started at is sent. The changes of all scans since are
+// counted together until a scan completes without them amounting to a mass
+// change. If they do, they remain held, across restarts, until the changes
+// are confirmed.
+type massChangeGuard struct {
+	cfg      config.MassChangeConfiguration
+	folder   string
+	kv       *db.NamespacedKV
+	evLogger events.Logger
+
+	mut      sync.Mutex
+	limit    int64 // highest sequence to send, or -1 for no limit
+	reason   string
+	scanning bool
+	changed  chan struct{} // closed when the limit changes
+
+	// Counts of the changes held back
+	files     int
+	modified  int
+	deleted   int
+	encrypted int
+}
+
+func newMassChangeGuard(cfg config.FolderConfiguration, ldb *db.Lowlevel, evLogger events.Logger) *massChangeGuard {
+	g := &massChangeGuard{
+		folder:   cfg.ID,
+		kv:       db.NewMiscDataNamespace(ldb),
+		evLogger: evLogger,
+		mut:      sync.NewMutex(),
+		limit:    -1,
+		changed:  make(chan struct{}),
+	}
+	switch cfg.Type {
+	case config.FolderTypeReceiveOnly, config.FolderTypeReceiveEncrypted:
+		// Local changes aren't sent
+	default:
+		g.cfg = cfg.MassChange
+	}
+
+	if !g.cfg.Enabled() {
+		// Anything held from before the protection was disabled is
+		// released.
+		g.clearHold()
+		return g
+	}
+	if limit, ok, _ := g.kv.Int64(massChangeLimitKey(g.folder)); ok {
+		g.limit = limit
+		g.reason, _, _ = g.kv.String(massChangeReasonKey(g.folder))
+	}
+	return g
+}
+
+// startScan holds back the changes of a scan starting at the given
+// sequence, in a folder with the given number of files. If changes are
+// held already, the scan's changes are counted along with them.
+func (g *massChangeGuard) startScan(sequence int64, files int) {
+	if !g.cfg.Enabled() {
+		return
+	}
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	g.scanning = true
+	if g.limit < 0 {
+		g.setLimitLocked(sequence)
+		g.resetCountsLocked(files)
+	}
+}
+
+// observe counts a change found by the current scan.
+func (g *massChangeGuard) observe(ffs fs.Filesystem, fi protocol.FileInfo, snap *db.Snapshot) {
+	if !g.cfg.Enabled() || fi.IsDirectory() {
+		return
+	}
+	cur, ok := snap.Get(protocol.LocalDeviceID, fi.Name)
+	if !ok || cur.IsDeleted() || cur.IsDirectory() {
+		// New files don't count
+		return
+	}
+	encrypted := false
+	if !fi.IsDeleted() {
+		if cur.BlocksEqual(fi) {
+			// Only metadata changed
+			return
+		}
+		encrypted = g.cfg.CheckEntropy && looksEncrypted(ffs, fi.Name)
+	}
+
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	if !g.scanning {
+		return
+	}
+	switch {
+	case fi.IsDeleted():
+		g.deleted++
+	case encrypted:
+		g.modified++
+		g.encrypted++
+	default:
+		g.modified++
+	}
+}
+
+// finishScan checks the counts of the changes held since the scan started,
+// or before it, and either keeps holding them back or releases them. It
+// returns errMassChange while changes are held.
+func (g *massChangeGuard) finishScan() error {
+	if !g.cfg.Enabled() {
+		return nil
+	}
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	if !g.scanning {
+		return g.errLocked()
+	}
+	g.scanning = false
+
+	detected := g.reason != ""
+	changed := g.modified + g.deleted
+	switch {
+	case changed >= g.cfg.MinFiles && changed*100 >= g.cfg.MaxChangedPct*g.files:
+		g.reason = fmt.Sprintf("%d of %d files modified or deleted", changed, g.files)
+	case g.cfg.CheckEntropy && g.encrypted >= g.cfg.MinFiles:
+		g.reason = fmt.Sprintf("%d files rewritten with contents that look encrypted", g.encrypted)
+	case detected:
+		// Held from before a restart, without the counts
+		return g.errLocked()
+	default:
+		g.setLimitLocked(-1)
+		return nil
+	}
+
+	_ = g.kv.PutInt64(massChangeLimitKey(g.folder), g.limit)
+	_ = g.kv.PutString(massChangeReasonKey(g.folder), g.reason)
+	if detected {
+		return g.errLocked()
+	}
+	l.Warnf("Mass change detected in folder %s: %s", g.folder, g.reason)
+	g.evLogger.Log(events.MassChangeDetected, map[string]interface{}{
+		"folder":    g.folder,
+		"files":     g.files,
+		"modified":  g.modified,
+		"deleted":   g.deleted,
+		"encrypted": g.encrypted,
+	})
+	return g.errLocked()
+}
+
+// abortScan ends a scan which didn't complete, unless it was finished
+// already. Its changes stay held, and are counted along with those of the
+// next scan.
+func (g *massChangeGuard) abortScan() {
+	if !g.cfg.Enabled() {
+		return
+	}
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	g.scanning = false
+}
+
+// confirm releases the held changes.
+func (g *massChangeGuard) confirm() {
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	if g.reason == "" {
+		return
+	}
+	l.Infof("Mass change in folder %s confirmed, sending changes", g.folder)
+	g.reason = ""
+	g.clearHold()
+	g.resetCountsLocked(g.files)
+	if g.scanning {
+		// The changes from the current scan are still checked.
+		return
+	}
+	g.setLimitLocked(-1)
+}
+
+// err returns errMassChange while changes are held.
+func (g *massChangeGuard) err() error {
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	return g.errLocked()
+}
+
+func (g *massChangeGuard) errLocked() error {
+	if g.reason == "" {
+		return nil
+	}
+	return fmt.Errorf("%w (%s)", errMassChange, g.reason)
+}
+
+// sendLimit returns the highest sequence which may be sent, or -1 if there
+// is no limit, and a channel which is closed when that changes.
+func (g *massChangeGuard) sendLimit() (int64, <-chan struct{}) {
+	g.mut.Lock()
+	defer g.mut.Unlock()
+	return g.limit, g.changed
+}
+
+func (g *massChangeGuard) resetCountsLocked(files int) {
+	g.files = files
+	g.modified, g.deleted, g.encrypted = 0, 0, 0
+}
+
+func (g *massChangeGuard) setLimitLocked(limit int64) {
+	if limit == g.limit {
+		return
+	}
+	g.limit = limit
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
+
+func (g *massChangeGuard) clearHold() {
+	clearMassChangeHold(g.kv, g.folder)
+}
+
+// clearMassChangeHold removes the stored state of a held mass change in the
+// folder.
+func clearMassChangeHold(kv *db.NamespacedKV, folder string) {
+	_ = kv.Delete(massChangeLimitKey(folder))
+	_ = kv.Delete(massChangeReasonKey(folder))
+}
+
+func massChangeLimitKey(folder string) string {
+	return "massChangeLimit/" + folder
+}
+
+func massChangeReasonKey(folder string) string {
+	return "massChangeReason/" + folder
+}
+
+// looksEncrypted returns whether the start of the file looks like random
+// data, for a type of file that isn't expected to.
+func looksEncrypted(ffs fs.Filesystem, name string) bool {
+	if _, ok := compressedExtensions[strings.ToLower(filepath.Ext(name))]; ok {
+		return false
+	}
+	fd, err := ffs.Open(name)
+	if err != nil {
+		return false
+	}
+	defer fd.Close()
+	buf := make([]byte, entropySampleSize)
+	n, err := io.ReadFull(fd, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return false
+	}
+	// Too little data to tell
+	if n < 1024 {
+		return false
+	}
+	return entropy(buf[:n]) > entropyThreshold
+}
+
+// entropy returns the Shannon entropy of the data, in bits per byte.
+func entropy(data []byte) float64 {
+	var counts [256]int
+	for _, b := range data {
+		counts[b]++
+	}
+	var e float64
+	for _, c := range counts {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / float64(len(data))
+		e -= p * math.Log2(p)
+	}
+	return e
+}
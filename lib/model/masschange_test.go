@@ -0,0 +1,132 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
+)
+
+func TestEntropy(t *testing.T) {
+	random := make([]byte, entropySampleSize)
+	rand.Read(random)
+	if e := entropy(random); e < entropyThreshold {
+		t.Errorf("random data has entropy %v, expected more than %v", e, entropyThreshold)
+	}
+	text := []byte(strings.Repeat("the quick brown fox jumps over the lazy dog\n", 1000))
+	if e := entropy(text); e > entropyThreshold {
+		t.Errorf("text has entropy %v, expected less than %v", e, entropyThreshold)
+	}
+}
+
+func TestMassChangeHold(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	fcfg.MassChange = config.MassChangeConfiguration{
+		MaxChangedPct: 50,
+		MinFiles:      2,
+		CheckEntropy:  true,
+	}
+	setFolder(t, w, fcfg)
+	ffs := fcfg.Filesystem(nil)
+
+	names := []string{"a", "b", "c", "d"}
+	for _, name := range names {
+		writeFile(t, ffs, name, []byte(strings.Repeat(name, 2048)))
+	}
+	m := setupModel(t, w)
+	defer cleanupModel(m)
+
+	runner, _ := m.folderRunners.Get(fcfg.ID)
+	if limit, _ := runner.sendLimit(); limit != -1 {
+		t.Fatalf("got send limit %d after initial scan, expected none", limit)
+	}
+	sequence := m.folderFiles[fcfg.ID].Sequence(protocol.LocalDeviceID)
+
+	// Changing one file is fine.
+	writeFile(t, ffs, "a", []byte(strings.Repeat("x", 2048)))
+	must(t, m.ScanFolder(fcfg.ID))
+	if limit, _ := runner.sendLimit(); limit != -1 {
+		t.Fatalf("got send limit %d after changing one file, expected none", limit)
+	}
+	sequence++
+
+	// Encrypting the others isn't.
+	for _, name := range names[1:] {
+		data := make([]byte, 4096)
+		rand.Read(data)
+		writeFile(t, ffs, name, data)
+	}
+	if err := m.ScanFolder(fcfg.ID); !errors.Is(err, errMassChange) {
+		t.Fatalf("got error %v, expected %v", err, errMassChange)
+	}
+	limit, limitChanged := runner.sendLimit()
+	if limit != sequence {
+		t.Errorf("got send limit %d, expected %d", limit, sequence)
+	}
+	if _, _, err := runner.getState(); !errors.Is(err, errMassChange) {
+		t.Errorf("got folder error %v, expected %v", err, errMassChange)
+	}
+
+	must(t, m.ConfirmMassChange(fcfg.ID))
+	<-limitChanged
+	if limit, _ := runner.sendLimit(); limit != -1 {
+		t.Errorf("got send limit %d after confirming, expected none", limit)
+	}
+}
+
+func TestMassChangeAccumulates(t *testing.T) {
+	ldb, err := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	g := newMassChangeGuard(config.FolderConfiguration{
+		ID: "default",
+		MassChange: config.MassChangeConfiguration{
+			MaxChangedPct: 50,
+			MinFiles:      2,
+		},
+	}, ldb, events.NoopLogger)
+
+	// The changes of an aborted scan stay held, and count along with the
+	// next scan's.
+	g.startScan(10, 4)
+	g.modified++
+	g.abortScan()
+	if limit, _ := g.sendLimit(); limit != 10 {
+		t.Fatalf("got send limit %d after aborted scan, expected 10", limit)
+	}
+	g.startScan(12, 4)
+	g.deleted++
+	if err := g.finishScan(); !errors.Is(err, errMassChange) {
+		t.Fatalf("got error %v, expected %v", err, errMassChange)
+	}
+	if limit, _ := g.sendLimit(); limit != 10 {
+		t.Errorf("got send limit %d, expected 10", limit)
+	}
+
+	// Confirming releases them and starts counting over.
+	g.confirm()
+	if limit, _ := g.sendLimit(); limit != -1 {
+		t.Fatalf("got send limit %d after confirming, expected none", limit)
+	}
+	g.startScan(14, 4)
+	g.modified++
+	must(t, g.finishScan())
+	if limit, _ := g.sendLimit(); limit != -1 {
+		t.Errorf("got send limit %d after a small change, expected none", limit)
+	}
+}
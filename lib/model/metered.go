@@ -0,0 +1,51 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"github.com/syncthing/syncthing/lib/metered"
+)
+
+// NetworkMetered returns whether the network is currently considered
+// metered, and why.
+func (m *model) NetworkMetered() metered.Status {
+	return m.metered.Status()
+}
+
+// meteredChanged disconnects the devices that shouldn't be connected on a
+// metered network, or resumes pulling in folders that were held off.
+// Dialing and accepting connections is handled by the connection service.
+func (m *model) meteredChanged(status metered.Status) {
+	if status.Metered {
+		var conns []string
+		for deviceID, cfg := range m.cfg.Devices() {
+			if !cfg.PauseWhenMetered {
+				continue
+			}
+			m.mut.RLock()
+			conns = append(conns, m.deviceConnIDs[deviceID]...)
+			m.mut.RUnlock()
+		}
+		m.mut.RLock()
+		for _, connID := range conns {
+			if conn, ok := m.connections[connID]; ok {
+				go conn.Close(metered.ErrDevicePaused)
+			}
+		}
+		m.mut.RUnlock()
+		return
+	}
+
+	for id, cfg := range m.cfg.Folders() {
+		if !cfg.PauseWhenMetered {
+			continue
+		}
+		if runner, ok := m.folderRunners.Get(id); ok {
+			runner.SchedulePull()
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/metered"
 	"github.com/syncthing/syncthing/lib/model"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/stats"
@@ -23,6 +24,19 @@ type Model struct {
 		arg1 protocol.Connection
 		arg2 protocol.Hello
 	}
+	ApproveDeletionsStub        func(string) (int, error)
+	approveDeletionsMutex       sync.RWMutex
+	approveDeletionsArgsForCall []struct {
+		arg1 string
+	}
+	approveDeletionsReturns struct {
+		result1 int
+		result2 error
+	}
+	approveDeletionsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	AvailabilityStub        func(string, protocol.FileInfo, protocol.BlockInfo) ([]model.Availability, error)
 	availabilityMutex       sync.RWMutex
 	availabilityArgsForCall []struct {
@@ -76,6 +90,17 @@ type Model struct {
 		result1 model.FolderCompletion
 		result2 error
 	}
+	ConfirmMassChangeStub        func(string) error
+	confirmMassChangeMutex       sync.RWMutex
+	confirmMassChangeArgsForCall []struct {
+		arg1 string
+	}
+	confirmMassChangeReturns struct {
+		result1 error
+	}
+	confirmMassChangeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ConnectedToStub        func(protocol.DeviceID) bool
 	connectedToMutex       sync.RWMutex
 	connectedToArgsForCall []struct {
@@ -211,6 +236,19 @@ type Model struct {
 	downloadProgressReturnsOnCall map[int]struct {
 		result1 error
 	}
+	FolderConflictsStub        func(string) ([]model.FolderConflict, error)
+	folderConflictsMutex       sync.RWMutex
+	folderConflictsArgsForCall []struct {
+		arg1 string
+	}
+	folderConflictsReturns struct {
+		result1 []model.FolderConflict
+		result2 error
+	}
+	folderConflictsReturnsOnCall map[int]struct {
+		result1 []model.FolderConflict
+		result2 error
+	}
 	FolderErrorsStub        func(string) ([]model.FileError, error)
 	folderErrorsMutex       sync.RWMutex
 	folderErrorsArgsForCall []struct {
@@ -290,6 +328,19 @@ type Model struct {
 		result1 []*model.TreeEntry
 		result2 error
 	}
+	HeldDeletionsStub        func(string) ([]db.FileInfoTruncated, error)
+	heldDeletionsMutex       sync.RWMutex
+	heldDeletionsArgsForCall []struct {
+		arg1 string
+	}
+	heldDeletionsReturns struct {
+		result1 []db.FileInfoTruncated
+		result2 error
+	}
+	heldDeletionsReturnsOnCall map[int]struct {
+		result1 []db.FileInfoTruncated
+		result2 error
+	}
 	IndexStub        func(protocol.Connection, string, []protocol.FileInfo) error
 	indexMutex       sync.RWMutex
 	indexArgsForCall []struct {
@@ -365,6 +416,16 @@ type Model struct {
 		result3 []db.FileInfoTruncated
 		result4 error
 	}
+	NetworkMeteredStub        func() metered.Status
+	networkMeteredMutex       sync.RWMutex
+	networkMeteredArgsForCall []struct {
+	}
+	networkMeteredReturns struct {
+		result1 metered.Status
+	}
+	networkMeteredReturnsOnCall map[int]struct {
+		result1 metered.Status
+	}
 	OnHelloStub        func(protocol.DeviceID, net.Addr, protocol.Hello) error
 	onHelloMutex       sync.RWMutex
 	onHelloArgsForCall []struct {
@@ -456,6 +517,36 @@ type Model struct {
 	resetFolderReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResolveFolderConflictStub        func(string, string, model.ConflictResolution, string) error
+	resolveFolderConflictMutex       sync.RWMutex
+	resolveFolderConflictArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 model.ConflictResolution
+		arg4 string
+	}
+	resolveFolderConflictReturns struct {
+		result1 error
+	}
+	resolveFolderConflictReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestoreFolderToTimeStub        func(string, string, time.Time, bool) ([]model.RestoredFile, error)
+	restoreFolderToTimeMutex       sync.RWMutex
+	restoreFolderToTimeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+		arg4 bool
+	}
+	restoreFolderToTimeReturns struct {
+		result1 []model.RestoredFile
+		result2 error
+	}
+	restoreFolderToTimeReturnsOnCall map[int]struct {
+		result1 []model.RestoredFile
+		result2 error
+	}
 	RestoreFolderVersionsStub        func(string, map[string]time.Time) (map[string]error, error)
 	restoreFolderVersionsMutex       sync.RWMutex
 	restoreFolderVersionsArgsForCall []struct {
@@ -601,6 +692,70 @@ func (fake *Model) AddConnectionArgsForCall(i int) (protocol.Connection, protoco
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *Model) ApproveDeletions(arg1 string) (int, error) {
+	fake.approveDeletionsMutex.Lock()
+	ret, specificReturn := fake.approveDeletionsReturnsOnCall[len(fake.approveDeletionsArgsForCall)]
+	fake.approveDeletionsArgsForCall = append(fake.approveDeletionsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ApproveDeletionsStub
+	fakeReturns := fake.approveDeletionsReturns
+	fake.recordInvocation("ApproveDeletions", []interface{}{arg1})
+	fake.approveDeletionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) ApproveDeletionsCallCount() int {
+	fake.approveDeletionsMutex.RLock()
+	defer fake.approveDeletionsMutex.RUnlock()
+	return len(fake.approveDeletionsArgsForCall)
+}
+
+func (fake *Model) ApproveDeletionsCalls(stub func(string) (int, error)) {
+	fake.approveDeletionsMutex.Lock()
+	defer fake.approveDeletionsMutex.Unlock()
+	fake.ApproveDeletionsStub = stub
+}
+
+func (fake *Model) ApproveDeletionsArgsForCall(i int) string {
+	fake.approveDeletionsMutex.RLock()
+	defer fake.approveDeletionsMutex.RUnlock()
+	argsForCall := fake.approveDeletionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ApproveDeletionsReturns(result1 int, result2 error) {
+	fake.approveDeletionsMutex.Lock()
+	defer fake.approveDeletionsMutex.Unlock()
+	fake.ApproveDeletionsStub = nil
+	fake.approveDeletionsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ApproveDeletionsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.approveDeletionsMutex.Lock()
+	defer fake.approveDeletionsMutex.Unlock()
+	fake.ApproveDeletionsStub = nil
+	if fake.approveDeletionsReturnsOnCall == nil {
+		fake.approveDeletionsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.approveDeletionsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) Availability(arg1 string, arg2 protocol.FileInfo, arg3 protocol.BlockInfo) ([]model.Availability, error) {
 	fake.availabilityMutex.Lock()
 	ret, specificReturn := fake.availabilityReturnsOnCall[len(fake.availabilityArgsForCall)]
@@ -860,6 +1015,67 @@ func (fake *Model) CompletionReturnsOnCall(i int, result1 model.FolderCompletion
 	}{result1, result2}
 }
 
+func (fake *Model) ConfirmMassChange(arg1 string) error {
+	fake.confirmMassChangeMutex.Lock()
+	ret, specificReturn := fake.confirmMassChangeReturnsOnCall[len(fake.confirmMassChangeArgsForCall)]
+	fake.confirmMassChangeArgsForCall = append(fake.confirmMassChangeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ConfirmMassChangeStub
+	fakeReturns := fake.confirmMassChangeReturns
+	fake.recordInvocation("ConfirmMassChange", []interface{}{arg1})
+	fake.confirmMassChangeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ConfirmMassChangeCallCount() int {
+	fake.confirmMassChangeMutex.RLock()
+	defer fake.confirmMassChangeMutex.RUnlock()
+	return len(fake.confirmMassChangeArgsForCall)
+}
+
+func (fake *Model) ConfirmMassChangeCalls(stub func(string) error) {
+	fake.confirmMassChangeMutex.Lock()
+	defer fake.confirmMassChangeMutex.Unlock()
+	fake.ConfirmMassChangeStub = stub
+}
+
+func (fake *Model) ConfirmMassChangeArgsForCall(i int) string {
+	fake.confirmMassChangeMutex.RLock()
+	defer fake.confirmMassChangeMutex.RUnlock()
+	argsForCall := fake.confirmMassChangeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ConfirmMassChangeReturns(result1 error) {
+	fake.confirmMassChangeMutex.Lock()
+	defer fake.confirmMassChangeMutex.Unlock()
+	fake.ConfirmMassChangeStub = nil
+	fake.confirmMassChangeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ConfirmMassChangeReturnsOnCall(i int, result1 error) {
+	fake.confirmMassChangeMutex.Lock()
+	defer fake.confirmMassChangeMutex.Unlock()
+	fake.ConfirmMassChangeStub = nil
+	if fake.confirmMassChangeReturnsOnCall == nil {
+		fake.confirmMassChangeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.confirmMassChangeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) ConnectedTo(arg1 protocol.DeviceID) bool {
 	fake.connectedToMutex.Lock()
 	ret, specificReturn := fake.connectedToReturnsOnCall[len(fake.connectedToArgsForCall)]
@@ -1521,6 +1737,70 @@ func (fake *Model) DownloadProgressReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) FolderConflicts(arg1 string) ([]model.FolderConflict, error) {
+	fake.folderConflictsMutex.Lock()
+	ret, specificReturn := fake.folderConflictsReturnsOnCall[len(fake.folderConflictsArgsForCall)]
+	fake.folderConflictsArgsForCall = append(fake.folderConflictsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FolderConflictsStub
+	fakeReturns := fake.folderConflictsReturns
+	fake.recordInvocation("FolderConflicts", []interface{}{arg1})
+	fake.folderConflictsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) FolderConflictsCallCount() int {
+	fake.folderConflictsMutex.RLock()
+	defer fake.folderConflictsMutex.RUnlock()
+	return len(fake.folderConflictsArgsForCall)
+}
+
+func (fake *Model) FolderConflictsCalls(stub func(string) ([]model.FolderConflict, error)) {
+	fake.folderConflictsMutex.Lock()
+	defer fake.folderConflictsMutex.Unlock()
+	fake.FolderConflictsStub = stub
+}
+
+func (fake *Model) FolderConflictsArgsForCall(i int) string {
+	fake.folderConflictsMutex.RLock()
+	defer fake.folderConflictsMutex.RUnlock()
+	argsForCall := fake.folderConflictsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) FolderConflictsReturns(result1 []model.FolderConflict, result2 error) {
+	fake.folderConflictsMutex.Lock()
+	defer fake.folderConflictsMutex.Unlock()
+	fake.FolderConflictsStub = nil
+	fake.folderConflictsReturns = struct {
+		result1 []model.FolderConflict
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FolderConflictsReturnsOnCall(i int, result1 []model.FolderConflict, result2 error) {
+	fake.folderConflictsMutex.Lock()
+	defer fake.folderConflictsMutex.Unlock()
+	fake.FolderConflictsStub = nil
+	if fake.folderConflictsReturnsOnCall == nil {
+		fake.folderConflictsReturnsOnCall = make(map[int]struct {
+			result1 []model.FolderConflict
+			result2 error
+		})
+	}
+	fake.folderConflictsReturnsOnCall[i] = struct {
+		result1 []model.FolderConflict
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) FolderErrors(arg1 string) ([]model.FileError, error) {
 	fake.folderErrorsMutex.Lock()
 	ret, specificReturn := fake.folderErrorsReturnsOnCall[len(fake.folderErrorsArgsForCall)]
@@ -1898,6 +2178,70 @@ func (fake *Model) GlobalDirectoryTreeReturnsOnCall(i int, result1 []*model.Tree
 	}{result1, result2}
 }
 
+func (fake *Model) HeldDeletions(arg1 string) ([]db.FileInfoTruncated, error) {
+	fake.heldDeletionsMutex.Lock()
+	ret, specificReturn := fake.heldDeletionsReturnsOnCall[len(fake.heldDeletionsArgsForCall)]
+	fake.heldDeletionsArgsForCall = append(fake.heldDeletionsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.HeldDeletionsStub
+	fakeReturns := fake.heldDeletionsReturns
+	fake.recordInvocation("HeldDeletions", []interface{}{arg1})
+	fake.heldDeletionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) HeldDeletionsCallCount() int {
+	fake.heldDeletionsMutex.RLock()
+	defer fake.heldDeletionsMutex.RUnlock()
+	return len(fake.heldDeletionsArgsForCall)
+}
+
+func (fake *Model) HeldDeletionsCalls(stub func(string) ([]db.FileInfoTruncated, error)) {
+	fake.heldDeletionsMutex.Lock()
+	defer fake.heldDeletionsMutex.Unlock()
+	fake.HeldDeletionsStub = stub
+}
+
+func (fake *Model) HeldDeletionsArgsForCall(i int) string {
+	fake.heldDeletionsMutex.RLock()
+	defer fake.heldDeletionsMutex.RUnlock()
+	argsForCall := fake.heldDeletionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) HeldDeletionsReturns(result1 []db.FileInfoTruncated, result2 error) {
+	fake.heldDeletionsMutex.Lock()
+	defer fake.heldDeletionsMutex.Unlock()
+	fake.HeldDeletionsStub = nil
+	fake.heldDeletionsReturns = struct {
+		result1 []db.FileInfoTruncated
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) HeldDeletionsReturnsOnCall(i int, result1 []db.FileInfoTruncated, result2 error) {
+	fake.heldDeletionsMutex.Lock()
+	defer fake.heldDeletionsMutex.Unlock()
+	fake.HeldDeletionsStub = nil
+	if fake.heldDeletionsReturnsOnCall == nil {
+		fake.heldDeletionsReturnsOnCall = make(map[int]struct {
+			result1 []db.FileInfoTruncated
+			result2 error
+		})
+	}
+	fake.heldDeletionsReturnsOnCall[i] = struct {
+		result1 []db.FileInfoTruncated
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) Index(arg1 protocol.Connection, arg2 string, arg3 []protocol.FileInfo) error {
 	var arg3Copy []protocol.FileInfo
 	if arg3 != nil {
@@ -2239,6 +2583,59 @@ func (fake *Model) NeedFolderFilesReturnsOnCall(i int, result1 []db.FileInfoTrun
 	}{result1, result2, result3, result4}
 }
 
+func (fake *Model) NetworkMetered() metered.Status {
+	fake.networkMeteredMutex.Lock()
+	ret, specificReturn := fake.networkMeteredReturnsOnCall[len(fake.networkMeteredArgsForCall)]
+	fake.networkMeteredArgsForCall = append(fake.networkMeteredArgsForCall, struct {
+	}{})
+	stub := fake.NetworkMeteredStub
+	fakeReturns := fake.networkMeteredReturns
+	fake.recordInvocation("NetworkMetered", []interface{}{})
+	fake.networkMeteredMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) NetworkMeteredCallCount() int {
+	fake.networkMeteredMutex.RLock()
+	defer fake.networkMeteredMutex.RUnlock()
+	return len(fake.networkMeteredArgsForCall)
+}
+
+func (fake *Model) NetworkMeteredCalls(stub func() metered.Status) {
+	fake.networkMeteredMutex.Lock()
+	defer fake.networkMeteredMutex.Unlock()
+	fake.NetworkMeteredStub = stub
+}
+
+func (fake *Model) NetworkMeteredReturns(result1 metered.Status) {
+	fake.networkMeteredMutex.Lock()
+	defer fake.networkMeteredMutex.Unlock()
+	fake.NetworkMeteredStub = nil
+	fake.networkMeteredReturns = struct {
+		result1 metered.Status
+	}{result1}
+}
+
+func (fake *Model) NetworkMeteredReturnsOnCall(i int, result1 metered.Status) {
+	fake.networkMeteredMutex.Lock()
+	defer fake.networkMeteredMutex.Unlock()
+	fake.NetworkMeteredStub = nil
+	if fake.networkMeteredReturnsOnCall == nil {
+		fake.networkMeteredReturnsOnCall = make(map[int]struct {
+			result1 metered.Status
+		})
+	}
+	fake.networkMeteredReturnsOnCall[i] = struct {
+		result1 metered.Status
+	}{result1}
+}
+
 func (fake *Model) OnHello(arg1 protocol.DeviceID, arg2 net.Addr, arg3 protocol.Hello) error {
 	fake.onHelloMutex.Lock()
 	ret, specificReturn := fake.onHelloReturnsOnCall[len(fake.onHelloArgsForCall)]
@@ -2659,6 +3056,137 @@ func (fake *Model) ResetFolderReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) ResolveFolderConflict(arg1 string, arg2 string, arg3 model.ConflictResolution, arg4 string) error {
+	fake.resolveFolderConflictMutex.Lock()
+	ret, specificReturn := fake.resolveFolderConflictReturnsOnCall[len(fake.resolveFolderConflictArgsForCall)]
+	fake.resolveFolderConflictArgsForCall = append(fake.resolveFolderConflictArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 model.ConflictResolution
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResolveFolderConflictStub
+	fakeReturns := fake.resolveFolderConflictReturns
+	fake.recordInvocation("ResolveFolderConflict", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resolveFolderConflictMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ResolveFolderConflictCallCount() int {
+	fake.resolveFolderConflictMutex.RLock()
+	defer fake.resolveFolderConflictMutex.RUnlock()
+	return len(fake.resolveFolderConflictArgsForCall)
+}
+
+func (fake *Model) ResolveFolderConflictCalls(stub func(string, string, model.ConflictResolution, string) error) {
+	fake.resolveFolderConflictMutex.Lock()
+	defer fake.resolveFolderConflictMutex.Unlock()
+	fake.ResolveFolderConflictStub = stub
+}
+
+func (fake *Model) ResolveFolderConflictArgsForCall(i int) (string, string, model.ConflictResolution, string) {
+	fake.resolveFolderConflictMutex.RLock()
+	defer fake.resolveFolderConflictMutex.RUnlock()
+	argsForCall := fake.resolveFolderConflictArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *Model) ResolveFolderConflictReturns(result1 error) {
+	fake.resolveFolderConflictMutex.Lock()
+	defer fake.resolveFolderConflictMutex.Unlock()
+	fake.ResolveFolderConflictStub = nil
+	fake.resolveFolderConflictReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ResolveFolderConflictReturnsOnCall(i int, result1 error) {
+	fake.resolveFolderConflictMutex.Lock()
+	defer fake.resolveFolderConflictMutex.Unlock()
+	fake.ResolveFolderConflictStub = nil
+	if fake.resolveFolderConflictReturnsOnCall == nil {
+		fake.resolveFolderConflictReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resolveFolderConflictReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) RestoreFolderToTime(arg1 string, arg2 string, arg3 time.Time, arg4 bool) ([]model.RestoredFile, error) {
+	fake.restoreFolderToTimeMutex.Lock()
+	ret, specificReturn := fake.restoreFolderToTimeReturnsOnCall[len(fake.restoreFolderToTimeArgsForCall)]
+	fake.restoreFolderToTimeArgsForCall = append(fake.restoreFolderToTimeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RestoreFolderToTimeStub
+	fakeReturns := fake.restoreFolderToTimeReturns
+	fake.recordInvocation("RestoreFolderToTime", []interface{}{arg1, arg2, arg3, arg4})
+	fake.restoreFolderToTimeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) RestoreFolderToTimeCallCount() int {
+	fake.restoreFolderToTimeMutex.RLock()
+	defer fake.restoreFolderToTimeMutex.RUnlock()
+	return len(fake.restoreFolderToTimeArgsForCall)
+}
+
+func (fake *Model) RestoreFolderToTimeCalls(stub func(string, string, time.Time, bool) ([]model.RestoredFile, error)) {
+	fake.restoreFolderToTimeMutex.Lock()
+	defer fake.restoreFolderToTimeMutex.Unlock()
+	fake.RestoreFolderToTimeStub = stub
+}
+
+func (fake *Model) RestoreFolderToTimeArgsForCall(i int) (string, string, time.Time, bool) {
+	fake.restoreFolderToTimeMutex.RLock()
+	defer fake.restoreFolderToTimeMutex.RUnlock()
+	argsForCall := fake.restoreFolderToTimeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *Model) RestoreFolderToTimeReturns(result1 []model.RestoredFile, result2 error) {
+	fake.restoreFolderToTimeMutex.Lock()
+	defer fake.restoreFolderToTimeMutex.Unlock()
+	fake.RestoreFolderToTimeStub = nil
+	fake.restoreFolderToTimeReturns = struct {
+		result1 []model.RestoredFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) RestoreFolderToTimeReturnsOnCall(i int, result1 []model.RestoredFile, result2 error) {
+	fake.restoreFolderToTimeMutex.Lock()
+	defer fake.restoreFolderToTimeMutex.Unlock()
+	fake.RestoreFolderToTimeStub = nil
+	if fake.restoreFolderToTimeReturnsOnCall == nil {
+		fake.restoreFolderToTimeReturnsOnCall = make(map[int]struct {
+			result1 []model.RestoredFile
+			result2 error
+		})
+	}
+	fake.restoreFolderToTimeReturnsOnCall[i] = struct {
+		result1 []model.RestoredFile
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) RestoreFolderVersions(arg1 string, arg2 map[string]time.Time) (map[string]error, error) {
 	fake.restoreFolderVersionsMutex.Lock()
 	ret, specificReturn := fake.restoreFolderVersionsReturnsOnCall[len(fake.restoreFolderVersionsArgsForCall)]
@@ -3232,6 +3760,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addConnectionMutex.RLock()
 	defer fake.addConnectionMutex.RUnlock()
+	fake.approveDeletionsMutex.RLock()
+	defer fake.approveDeletionsMutex.RUnlock()
 	fake.availabilityMutex.RLock()
 	defer fake.availabilityMutex.RUnlock()
 	fake.bringToFrontMutex.RLock()
@@ -3242,6 +3772,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.clusterConfigMutex.RUnlock()
 	fake.completionMutex.RLock()
 	defer fake.completionMutex.RUnlock()
+	fake.confirmMassChangeMutex.RLock()
+	defer fake.confirmMassChangeMutex.RUnlock()
 	fake.connectedToMutex.RLock()
 	defer fake.connectedToMutex.RUnlock()
 	fake.connectionStatsMutex.RLock()
@@ -3264,6 +3796,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.dismissPendingFolderMutex.RUnlock()
 	fake.downloadProgressMutex.RLock()
 	defer fake.downloadProgressMutex.RUnlock()
+	fake.folderConflictsMutex.RLock()
+	defer fake.folderConflictsMutex.RUnlock()
 	fake.folderErrorsMutex.RLock()
 	defer fake.folderErrorsMutex.RUnlock()
 	fake.folderProgressBytesCompletedMutex.RLock()
@@ -3276,6 +3810,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.getMtimeMappingMutex.RUnlock()
 	fake.globalDirectoryTreeMutex.RLock()
 	defer fake.globalDirectoryTreeMutex.RUnlock()
+	fake.heldDeletionsMutex.RLock()
+	defer fake.heldDeletionsMutex.RUnlock()
 	fake.indexMutex.RLock()
 	defer fake.indexMutex.RUnlock()
 	fake.indexUpdateMutex.RLock()
@@ -3286,6 +3822,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.localChangedFolderFilesMutex.RUnlock()
 	fake.needFolderFilesMutex.RLock()
 	defer fake.needFolderFilesMutex.RUnlock()
+	fake.networkMeteredMutex.RLock()
+	defer fake.networkMeteredMutex.RUnlock()
 	fake.onHelloMutex.RLock()
 	defer fake.onHelloMutex.RUnlock()
 	fake.overrideMutex.RLock()
@@ -3300,6 +3838,10 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.requestMutex.RUnlock()
 	fake.resetFolderMutex.RLock()
 	defer fake.resetFolderMutex.RUnlock()
+	fake.resolveFolderConflictMutex.RLock()
+	defer fake.resolveFolderConflictMutex.RUnlock()
+	fake.restoreFolderToTimeMutex.RLock()
+	defer fake.restoreFolderToTimeMutex.RUnlock()
 	fake.restoreFolderVersionsMutex.RLock()
 	defer fake.restoreFolderVersionsMutex.RUnlock()
 	fake.revertMutex.RLock()
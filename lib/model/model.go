@@ -35,6 +35,7 @@ import (
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
+	"github.com/syncthing/syncthing/lib/metered"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/rand"
@@ -52,6 +53,8 @@ type service interface {
 	BringToFront(string)
 	Override()
 	Revert()
+	HeldDeletions() []string
+	ApproveDeletions() int
 	DelayScan(d time.Duration)
 	ScheduleScan()
 	SchedulePull()                                    // something relevant changed, we should try a pull
@@ -63,6 +66,9 @@ type service interface {
 	GetStatistics() (stats.FolderStatistics, error)
 
 	getState() (folderState, time.Time, error)
+	confirmMassChange()
+	sendLimit() (int64, <-chan struct{})
+	holdRemoteDeletions(files []protocol.FileInfo)
 }
 
 type Availability struct {
@@ -82,9 +88,14 @@ type Model interface {
 	ScanFolderSubdirs(folder string, subs []string) error
 	State(folder string) (string, time.Time, error)
 	FolderErrors(folder string) ([]FileError, error)
+	FolderConflicts(folder string) ([]FolderConflict, error)
+	ResolveFolderConflict(folder, name string, resolution ConflictResolution, newName string) error
 	WatchError(folder string) error
 	Override(folder string)
 	Revert(folder string)
+	ConfirmMassChange(folder string) error
+	HeldDeletions(folder string) ([]db.FileInfoTruncated, error)
+	ApproveDeletions(folder string) (int, error)
 	BringToFront(folder, file string)
 	LoadIgnores(folder string) ([]string, []string, error)
 	CurrentIgnores(folder string) ([]string, []string, error)
@@ -92,6 +103,7 @@ type Model interface {
 
 	GetFolderVersions(folder string) (map[string][]versioner.FileVersion, error)
 	RestoreFolderVersions(folder string, versions map[string]time.Time) (map[string]error, error)
+	RestoreFolderToTime(folder, prefix string, at time.Time, dryRun bool) ([]RestoredFile, error)
 
 	DBSnapshot(folder string) (*db.Snapshot, error)
 	NeedFolderFiles(folder string, page, perpage int) ([]db.FileInfoTruncated, []db.FileInfoTruncated, []db.FileInfoTruncated, error)
@@ -110,6 +122,7 @@ type Model interface {
 	FolderStatistics() (map[string]stats.FolderStatistics, error)
 	UsageReportingStats(report *contract.Report, version int, preview bool)
 	ConnectedTo(remoteID protocol.DeviceID) bool
+	NetworkMetered() metered.Status
 
 	PendingDevices() (map[protocol.DeviceID]db.ObservedDevice, error)
 	PendingFolders(device protocol.DeviceID) (map[string]db.PendingFolder, error)
@@ -132,6 +145,7 @@ type model struct {
 	// constant or concurrency safe fields
 	finder          *db.BlockFinder
 	progressEmitter *ProgressEmitter
+	hooks           *folderHooks
 	shortID         protocol.ShortID
 	// globalRequestLimiter limits the amount of data in concurrent incoming
 	// requests
@@ -139,6 +153,7 @@ type model struct {
 	// folderIOLimiter limits the number of concurrent I/O heavy operations,
 	// such as scans and pulls.
 	folderIOLimiter *semaphore.Semaphore
+	metered         *metered.Monitor
 	fatalChan       chan error
 	started         chan struct{}
 	keyGen          *protocol.KeyGenerator
@@ -155,14 +170,17 @@ type model struct {
 	folderVersioners               map[string]versioner.Versioner                         // folder -> versioner (may be nil)
 	folderEncryptionPasswordTokens map[string][]byte                                      // folder -> encryption token (may be missing, and only for encryption type folders)
 	folderEncryptionFailures       map[string]map[protocol.DeviceID]error                 // folder -> device -> error regarding encryption consistency (may be missing)
+	folderBandwidths               map[string]*folderBandwidth                            // folder -> rate limits
 	connections                    map[string]protocol.Connection                         // connection ID -> connection
 	deviceConnIDs                  map[protocol.DeviceID][]string                         // device -> connection IDs (invariant: if the key exists, the value is len >= 1, with the primary connection at the start of the slice)
 	promotedConnID                 map[protocol.DeviceID]string                           // device -> latest promoted connection ID
-	connRequestLimiters            map[protocol.DeviceID]*semaphore.Semaphore
-	closed                         map[string]chan struct{} // connection ID -> closed channel
+	connRequestLimiters            map[protocol.DeviceID]*semaphore.Fair                  // device -> incoming requests, shared between folders by priority
+	pullRequestLimiters            map[protocol.DeviceID]*semaphore.Fair                  // device -> outgoing requests, shared between folders by priority
+	closed                         map[string]chan struct{}                               // connection ID -> closed channel
 	helloMessages                  map[protocol.DeviceID]protocol.Hello
 	deviceDownloads                map[protocol.DeviceID]*deviceDownloadState
-	remoteFolderStates             map[protocol.DeviceID]map[string]remoteFolderState // deviceID -> folders
+	remoteFolderStates             map[protocol.DeviceID]map[string]remoteFolderState      // deviceID -> folders
+	remoteBlockChunking            map[protocol.DeviceID]map[string]protocol.BlockChunking // deviceID -> folder -> last announced chunking
 	indexHandlers                  *serviceMap[protocol.DeviceID, *indexHandlerRegistry]
 
 	// for testing only
@@ -215,6 +233,7 @@ func NewModel(cfg config.Wrapper, id protocol.DeviceID, ldb *db.Lowlevel, protec
 		// constant or concurrency safe fields
 		finder:               db.NewBlockFinder(ldb),
 		progressEmitter:      NewProgressEmitter(cfg, evLogger),
+		hooks:                newFolderHooks(cfg, evLogger),
 		shortID:              id.Short(),
 		globalRequestLimiter: semaphore.New(1024 * cfg.Options().MaxConcurrentIncomingRequestKiB()),
 		folderIOLimiter:      semaphore.New(cfg.Options().MaxFolderConcurrency()),
@@ -233,14 +252,17 @@ func NewModel(cfg config.Wrapper, id protocol.DeviceID, ldb *db.Lowlevel, protec
 		folderVersioners:               make(map[string]versioner.Versioner),
 		folderEncryptionPasswordTokens: make(map[string][]byte),
 		folderEncryptionFailures:       make(map[string]map[protocol.DeviceID]error),
+		folderBandwidths:               make(map[string]*folderBandwidth),
 		connections:                    make(map[string]protocol.Connection),
 		deviceConnIDs:                  make(map[protocol.DeviceID][]string),
 		promotedConnID:                 make(map[protocol.DeviceID]string),
-		connRequestLimiters:            make(map[protocol.DeviceID]*semaphore.Semaphore),
+		connRequestLimiters:            make(map[protocol.DeviceID]*semaphore.Fair),
+		pullRequestLimiters:            make(map[protocol.DeviceID]*semaphore.Fair),
 		closed:                         make(map[string]chan struct{}),
 		helloMessages:                  make(map[protocol.DeviceID]protocol.Hello),
 		deviceDownloads:                make(map[protocol.DeviceID]*deviceDownloadState),
 		remoteFolderStates:             make(map[protocol.DeviceID]map[string]remoteFolderState),
+		remoteBlockChunking:            make(map[protocol.DeviceID]map[string]protocol.BlockChunking),
 		indexHandlers:                  newServiceMap[protocol.DeviceID, *indexHandlerRegistry](evLogger),
 	}
 	for devID, cfg := range cfg.Devices() {
@@ -249,8 +271,12 @@ func NewModel(cfg config.Wrapper, id protocol.DeviceID, ldb *db.Lowlevel, protec
 	}
 	m.Add(m.folderRunners)
 	m.Add(m.progressEmitter)
+	m.Add(m.hooks)
 	m.Add(m.indexHandlers)
+	m.metered = metered.NewMonitor(cfg, evLogger, m.meteredChanged)
+	m.Add(m.metered)
 	m.Add(svcutil.AsService(m.serve, m.String()))
+	m.Add(svcutil.AsService(m.serveSyncSchedules, fmt.Sprintf("%s/serveSyncSchedules", m)))
 
 	return m
 }
@@ -404,6 +430,7 @@ func (m *model) addAndStartFolderLockedWithIgnores(cfg config.FolderConfiguratio
 		}
 	}
 	m.folderVersioners[folder] = ver
+	m.folderBandwidths[folder] = newFolderBandwidth(cfg)
 
 	m.warnAboutOverwritingProtectedFiles(cfg, ignores)
 
@@ -481,6 +508,7 @@ func (m *model) removeFolder(cfg config.FolderConfiguration) {
 
 	// Remove it from the database
 	db.DropFolder(m.db, cfg.ID)
+	clearMassChangeHold(db.NewMiscDataNamespace(m.db), cfg.ID)
 }
 
 // Need to hold lock on m.mut when calling this.
@@ -493,6 +521,7 @@ func (m *model) cleanupFolderLocked(cfg config.FolderConfiguration) {
 	delete(m.folderVersioners, cfg.ID)
 	delete(m.folderEncryptionPasswordTokens, cfg.ID)
 	delete(m.folderEncryptionFailures, cfg.ID)
+	delete(m.folderBandwidths, cfg.ID)
 }
 
 func (m *model) restartFolder(from, to config.FolderConfiguration, cacheIgnoredFiles bool) error {
@@ -695,6 +724,8 @@ type ConnectionStats struct {
 
 	Primary   ConnectionInfo   `json:"primary,omitempty"`
 	Secondary []ConnectionInfo `json:"secondary,omitempty"`
+
+	BandwidthLimits config.BandwidthLimits `json:"bandwidthLimits"` // currently in effect, possibly from a schedule
 }
 
 type ConnectionInfo struct {
@@ -710,6 +741,7 @@ func (m *model) ConnectionStats() map[string]interface{} {
 	m.mut.RLock()
 	defer m.mut.RUnlock()
 
+	now := time.Now()
 	res := make(map[string]interface{})
 	devs := m.cfg.Devices()
 	conns := make(map[string]ConnectionStats, len(devs))
@@ -724,9 +756,10 @@ func (m *model) ConnectionStats() map[string]interface{} {
 		}
 		connIDs, ok := m.deviceConnIDs[device]
 		cs := ConnectionStats{
-			Connected:     ok,
-			Paused:        deviceCfg.Paused,
-			ClientVersion: strings.TrimSpace(versionString),
+			Connected:       ok,
+			Paused:          deviceCfg.Paused,
+			ClientVersion:   strings.TrimSpace(versionString),
+			BandwidthLimits: deviceCfg.BandwidthLimitsAt(now),
 		}
 		if ok {
 			conn := m.connections[connIDs[0]]
@@ -771,9 +804,10 @@ func (m *model) ConnectionStats() map[string]interface{} {
 
 	in, out := protocol.TotalInOut()
 	res["total"] = map[string]interface{}{
-		"at":            time.Now().Truncate(time.Second),
-		"inBytesTotal":  in,
-		"outBytesTotal": out,
+		"at":              now.Truncate(time.Second),
+		"inBytesTotal":    in,
+		"outBytesTotal":   out,
+		"bandwidthLimits": m.cfg.Options().BandwidthLimitsAt(now),
 	}
 
 	return res
@@ -1275,8 +1309,16 @@ func (m *model) ClusterConfig(conn protocol.Connection, cm protocol.ClusterConfi
 		return err
 	}
 
+	// Remembered past the connection, so that we don't fall back to fixed
+	// blocks every time a device goes away for a bit.
+	chunking := make(map[string]protocol.BlockChunking, len(cm.Folders))
+	for _, folder := range cm.Folders {
+		chunking[folder.ID] = folder.BlockChunking
+	}
+
 	m.mut.Lock()
 	m.remoteFolderStates[deviceID] = states
+	m.remoteBlockChunking[deviceID] = chunking
 	m.mut.Unlock()
 
 	m.evLogger.Log(events.ClusterConfigReceived, ClusterConfigReceivedEventData{
@@ -1885,6 +1927,7 @@ func (m *model) Closed(conn protocol.Connection, err error) {
 		delete(m.deviceConnIDs, deviceID)
 		delete(m.promotedConnID, deviceID)
 		delete(m.connRequestLimiters, deviceID)
+		delete(m.pullRequestLimiters, deviceID)
 		delete(m.helloMessages, deviceID)
 		delete(m.remoteFolderStates, deviceID)
 		delete(m.deviceDownloads, deviceID)
@@ -1993,15 +2036,32 @@ func (m *model) Request(conn protocol.Connection, folder, name string, _, size i
 		return nil, protocol.ErrInvalid
 	}
 
-	// Restrict parallel requests by connection/device
+	// Apply the folder's send rate limit, then restrict parallel requests
+	// by connection/device, sharing those between folders according to
+	// their priority.
 
 	m.mut.RLock()
 	limiter := m.connRequestLimiters[deviceID]
+	bw := m.folderBandwidths[folder]
 	m.mut.RUnlock()
 
+	if err := bw.waitSendConn(conn, int(size)); err != nil {
+		l.Debugf("%v REQ(in) abandoned, connection closed: %s: %q / %q o=%d s=%d", m, deviceID.Short(), folder, name, offset, size)
+		return nil, protocol.ErrGeneric
+	}
+	if limiter != nil {
+		limiter.Take(folder, folderCfg.Priority, int(size))
+	}
+
 	// The requestResponse releases the bytes to the buffer pool and the
 	// limiters when its Close method is called.
-	res := newLimitedRequestResponse(int(size), limiter, m.globalRequestLimiter)
+	res := newLimitedRequestResponse(int(size), m.globalRequestLimiter)
+	if limiter != nil {
+		go func() {
+			res.Wait()
+			limiter.Give(int(size))
+		}()
+	}
 
 	defer func() {
 		// Close it ourselves if it isn't returned due to an error
@@ -2104,7 +2164,7 @@ func (m *model) recheckFile(deviceID protocol.DeviceID, folder, name string, off
 		return
 	}
 
-	blockIndex := int(offset / int64(cf.BlockSize()))
+	blockIndex := cf.BlockIndex(offset)
 	if blockIndex >= len(cf.Blocks) {
 		l.Debugf("%v recheckFile: %s: %q / %q i=%d: block index too far", m, deviceID, folder, name, blockIndex)
 		return
@@ -2328,6 +2388,10 @@ func (m *model) AddConnection(conn protocol.Connection, hello protocol.Hello) {
 	if m.deviceDownloads[deviceID] == nil {
 		m.deviceDownloads[deviceID] = newDeviceDownloadState()
 	}
+	if m.pullRequestLimiters[deviceID] == nil {
+		// Matches what the other side allows in flight by default.
+		m.pullRequestLimiters[deviceID] = semaphore.NewFair(1024 * defaultPullerPendingKiB)
+	}
 
 	event := map[string]string{
 		"id":            deviceID.String(),
@@ -2468,6 +2532,26 @@ func (m *model) requestGlobal(ctx context.Context, deviceID protocol.DeviceID, f
 	return conn.Request(ctx, folder, name, blockNo, offset, size, hash, weakHash, fromTemporary)
 }
 
+// requestGlobalDelta is like requestGlobal, but when the device supports it
+// only the parts of the block that differ from old are transferred.
+func (m *model) requestGlobalDelta(ctx context.Context, deviceID protocol.DeviceID, folder, name string, blockNo int, offset int64, size int, hash []byte, weakHash uint32, fromTemporary bool, old []byte) ([]byte, error) {
+	conn, connOK := m.requestConnectionForDevice(deviceID)
+	if !connOK {
+		return nil, fmt.Errorf("requestGlobalDelta: no connection to device: %s", deviceID.Short())
+	}
+
+	m.mut.RLock()
+	supported := m.helloMessages[deviceID].HasFeature(protocol.FeatureDeltaRequest)
+	m.mut.RUnlock()
+	if !supported {
+		l.Debugf("%v REQ(out): %s (%s): %q / %q b=%d o=%d s=%d h=%x wh=%x ft=%t", m, deviceID.Short(), conn, folder, name, blockNo, offset, size, hash, weakHash, fromTemporary)
+		return conn.Request(ctx, folder, name, blockNo, offset, size, hash, weakHash, fromTemporary)
+	}
+
+	l.Debugf("%v DELTAREQ(out): %s (%s): %q / %q b=%d o=%d s=%d h=%x ft=%t old=%d", m, deviceID.Short(), conn, folder, name, blockNo, offset, size, hash, fromTemporary, len(old))
+	return conn.DeltaRequest(ctx, folder, name, blockNo, offset, size, hash, fromTemporary, old)
+}
+
 // requestConnectionForDevice returns a connection to the given device, to
 // be used for sending a request. If there is only one device connection,
 // this is the one to use. If there are multiple then we avoid the first
@@ -2580,6 +2664,29 @@ func (m *model) numHashers(folder string) int {
 	return 1
 }
 
+// blockChunking returns how to split newly hashed files of the given folder
+// into blocks. Content defined chunking needs to be enabled locally and
+// announced by every other device sharing the folder, as we can't know
+// whether devices that don't announce it can handle variable size blocks.
+func (m *model) blockChunking(folder string) protocol.BlockChunking {
+	m.mut.RLock()
+	defer m.mut.RUnlock()
+
+	folderCfg := m.folderCfgs[folder]
+	if folderCfg.BlockChunking != protocol.BlockChunkingCDC {
+		return protocol.BlockChunkingFixed
+	}
+	for _, dev := range folderCfg.Devices {
+		if dev.DeviceID == m.id {
+			continue
+		}
+		if m.remoteBlockChunking[dev.DeviceID][folder] != protocol.BlockChunkingCDC {
+			return protocol.BlockChunkingFixed
+		}
+	}
+	return protocol.BlockChunkingCDC
+}
+
 // generateClusterConfig returns a ClusterConfigMessage that is correct and the
 // set of folder passwords for the given peer device
 func (m *model) generateClusterConfig(device protocol.DeviceID) (protocol.ClusterConfig, map[string]string) {
@@ -2611,6 +2718,7 @@ func (m *model) generateClusterConfigRLocked(device protocol.DeviceID) (protocol
 			IgnorePermissions:  folderCfg.IgnorePerms,
 			IgnoreDelete:       folderCfg.IgnoreDelete,
 			DisableTempIndexes: folderCfg.DisableTempIndexes,
+			BlockChunking:      folderCfg.BlockChunking,
 		}
 
 		fs := m.folderFiles[folderCfg.ID]
@@ -2683,7 +2791,7 @@ func (m *model) FolderErrors(folder string) ([]FileError, error) {
 	if err != nil {
 		return nil, err
 	}
-	return runner.Errors(), nil
+	return append(runner.Errors(), m.hooks.folderErrors(folder)...), nil
 }
 
 func (m *model) WatchError(folder string) error {
@@ -2727,6 +2835,69 @@ func (m *model) Revert(folder string) {
 	runner.Revert()
 }
 
+// ConfirmMassChange sends the changes of a folder which were held back due
+// to a mass change.
+func (m *model) ConfirmMassChange(folder string) error {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	m.mut.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	runner.confirmMassChange()
+	return nil
+}
+
+// HeldDeletions returns the deletions from other devices which aren't
+// applied as they exceed the folder's limits.
+func (m *model) HeldDeletions(folder string) ([]db.FileInfoTruncated, error) {
+	m.mut.RLock()
+	_, cfgOk := m.folderCfgs[folder]
+	runner, runnerOk := m.folderRunners.Get(folder)
+	fset := m.folderFiles[folder]
+	m.mut.RUnlock()
+	if !cfgOk {
+		return nil, ErrFolderMissing
+	}
+	if !runnerOk {
+		// Paused, so nothing is pulled
+		return nil, nil
+	}
+
+	names := runner.HeldDeletions()
+	if len(names) == 0 {
+		return nil, nil
+	}
+	snap, err := fset.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+	held := make([]db.FileInfoTruncated, 0, len(names))
+	for _, name := range names {
+		if file, ok := snap.GetGlobalTruncated(name); ok && file.IsDeleted() {
+			held = append(held, file)
+		}
+	}
+	return held, nil
+}
+
+// ApproveDeletions allows the held deletions of a folder to be applied, and
+// returns how many there are.
+func (m *model) ApproveDeletions(folder string) (int, error) {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	runner, _ := m.folderRunners.Get(folder)
+	m.mut.RUnlock()
+	if err != nil {
+		return 0, err
+	}
+
+	return runner.ApproveDeletions(), nil
+}
+
 type TreeEntry struct {
 	Name     string                `json:"name"`
 	ModTime  time.Time             `json:"modTime"`
@@ -2902,7 +3073,7 @@ func (m *model) availabilityInSnapshotRLocked(cfg config.FolderConfiguration, sn
 	}
 
 	for _, device := range cfg.Devices {
-		if m.deviceDownloads[device.DeviceID].Has(cfg.ID, file.Name, file.Version, int(block.Offset/int64(file.BlockSize()))) {
+		if m.deviceDownloads[device.DeviceID].Has(cfg.ID, file.Name, file.Version, file.BlockIndex(block.Offset)) {
 			availabilities = append(availabilities, Availability{ID: device.DeviceID, FromTemporary: true})
 		}
 	}
@@ -3129,9 +3300,9 @@ func (m *model) setConnRequestLimitersLocked(cfg config.DeviceConfiguration) {
 	// 0: default, <0: no limiting
 	switch {
 	case cfg.MaxRequestKiB > 0:
-		m.connRequestLimiters[cfg.DeviceID] = semaphore.New(1024 * cfg.MaxRequestKiB)
+		m.connRequestLimiters[cfg.DeviceID] = semaphore.NewFair(1024 * cfg.MaxRequestKiB)
 	case cfg.MaxRequestKiB == 0:
-		m.connRequestLimiters[cfg.DeviceID] = semaphore.New(1024 * defaultPullerPendingKiB)
+		m.connRequestLimiters[cfg.DeviceID] = semaphore.NewFair(1024 * defaultPullerPendingKiB)
 	}
 }
 
@@ -31,6 +31,7 @@ import (
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
+	"github.com/syncthing/syncthing/lib/metered"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
 	protocolmocks "github.com/syncthing/syncthing/lib/protocol/mocks"
@@ -473,6 +474,45 @@ func TestClusterConfig(t *testing.T) {
 	}
 }
 
+func TestBlockChunkingNegotiation(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	fcfg.BlockChunking = protocol.BlockChunkingCDC
+	setFolder(t, w, fcfg)
+	m := setupModel(t, w)
+	defer cleanupModel(m)
+
+	cm, _ := m.generateClusterConfig(device1)
+	if cm.Folders[0].BlockChunking != protocol.BlockChunkingCDC {
+		t.Error("expected cdc to be announced")
+	}
+
+	// The other device hasn't announced anything yet
+	if c := m.blockChunking(fcfg.ID); c != protocol.BlockChunkingFixed {
+		t.Errorf("got %v before cluster config, expected fixed", c)
+	}
+
+	fc := addFakeConn(m, device1, fcfg.ID)
+	if c := m.blockChunking(fcfg.ID); c != protocol.BlockChunkingFixed {
+		t.Errorf("got %v with remote not announcing cdc, expected fixed", c)
+	}
+
+	cc := basicClusterConfig(myID, device1, fcfg.ID)
+	cc.Folders[0].BlockChunking = protocol.BlockChunkingCDC
+	if err := m.ClusterConfig(fc, cc); err != nil {
+		t.Fatal(err)
+	}
+	if c := m.blockChunking(fcfg.ID); c != protocol.BlockChunkingCDC {
+		t.Errorf("got %v with remote announcing cdc, expected cdc", c)
+	}
+
+	// Still in agreement after the device disconnects
+	m.Closed(fc, errStopped)
+	if c := m.blockChunking(fcfg.ID); c != protocol.BlockChunkingCDC {
+		t.Errorf("got %v after disconnect, expected cdc", c)
+	}
+}
+
 func TestIntroducer(t *testing.T) {
 	var introducedByAnyone protocol.DeviceID
 
@@ -2732,6 +2772,126 @@ func TestPausedFolders(t *testing.T) {
 	}
 }
 
+func TestSyncSchedule(t *testing.T) {
+	wrapper, cancel := newConfigWrapper(defaultCfgWrapper.RawCopy())
+	defer cancel()
+	m := setupModel(t, wrapper)
+	defer cleanupModel(m)
+
+	sub := m.evLogger.Subscribe(events.FolderPaused | events.FolderResumed)
+	defer sub.Unsubscribe()
+
+	cfg := wrapper.RawCopy()
+	cfg.Folders[0].SyncWindows = []config.SyncWindow{{Days: "mon", Start: "10:00", End: "10:01"}}
+	replace(t, wrapper, cfg)
+
+	// 2024-01-01 is a Monday.
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.Local)
+	}
+	expectEvent := func(typ events.EventType) {
+		t.Helper()
+		select {
+		case ev := <-sub.C():
+			if ev.Type != typ {
+				t.Fatalf("got event %v, expected %v", ev.Type, typ)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for %v", typ)
+		}
+	}
+
+	// Initially the folder is brought in line with its windows.
+	m.applySyncSchedules(time.Time{}, at(12, 0))
+	expectEvent(events.FolderPaused)
+	if err := m.ScanFolder("default"); err != ErrFolderPaused {
+		t.Errorf("Expected folder paused error, received: %v", err)
+	}
+
+	// A manual resume sticks until the next window change.
+	pauseFolder(t, wrapper, "default", false)
+	expectEvent(events.FolderResumed)
+	m.applySyncSchedules(at(12, 0), at(13, 0))
+	if wrapper.Folders()["default"].Paused {
+		t.Error("folder should not have been paused without a window change")
+	}
+	pauseFolder(t, wrapper, "default", true)
+	expectEvent(events.FolderPaused)
+
+	m.applySyncSchedules(at(9, 59), at(10, 0))
+	expectEvent(events.FolderResumed)
+	if err := m.ScanFolder("default"); err != nil {
+		t.Error(err)
+	}
+
+	m.applySyncSchedules(at(10, 0), at(10, 1))
+	expectEvent(events.FolderPaused)
+}
+
+func TestMeteredDisconnect(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	m, fc := setupModelWithConnectionFromWrapper(t, w)
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem(nil).URI())
+
+	// Nothing happens to devices that don't care.
+	m.meteredChanged(metered.Status{Metered: true})
+	select {
+	case <-fc.closed:
+		t.Fatal("connection should not have been closed")
+	default:
+	}
+
+	dev, _ := w.Device(device1)
+	dev.PauseWhenMetered = true
+	setDevice(t, w, dev)
+	m.meteredChanged(metered.Status{Metered: true})
+	select {
+	case <-fc.closed:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for connection to close")
+	}
+}
+
+func TestMeteredPullSuspended(t *testing.T) {
+	w, fcfg, wCancel := newDefaultCfgWrapper()
+	defer wCancel()
+	fcfg.PauseWhenMetered = true
+	setFolder(t, w, fcfg)
+	waiter, err := w.Modify(func(cfg *config.Configuration) {
+		cfg.Options.Metered = true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+	m, fc := setupModelWithConnectionFromWrapper(t, w)
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem(nil).URI())
+
+	waitFor := func(cond func() bool) {
+		t.Helper()
+		timeout := time.After(10 * time.Second)
+		for !cond() {
+			select {
+			case <-timeout:
+				t.Fatal("timed out")
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	waitFor(func() bool { return m.NetworkMetered().Metered })
+
+	// A needed file isn't pulled, which shows as the folder's state.
+	must(t, m.Index(fc, fcfg.ID, []protocol.FileInfo{{Name: "foo", Version: protocol.Vector{}.Update(device1.Short()), Sequence: 1}}))
+	waitFor(func() bool {
+		state, _, _ := m.State(fcfg.ID)
+		return state == FolderSyncSuspended.String()
+	})
+	if _, ok := m.testCurrentFolderFile(fcfg.ID, "foo"); ok {
+		t.Error("file should not have been pulled")
+	}
+}
+
 func TestIssue4094(t *testing.T) {
 	// Create a separate wrapper not to pollute other tests.
 	wrapper, cancel := newConfigWrapper(config.Configuration{Version: config.CurrentVersion})
@@ -2972,7 +3132,7 @@ func TestConnCloseOnRestart(t *testing.T) {
 	nw := &testutil.NoopRW{}
 	ci := &protocolmocks.ConnectionInfo{}
 	ci.ConnectionIDReturns(srand.String(16))
-	m.AddConnection(protocol.NewConnection(device1, br, nw, testutil.NoopCloser{}, m, ci, protocol.CompressionNever, nil, m.keyGen), protocol.Hello{})
+	m.AddConnection(protocol.NewConnection(device1, br, nw, testutil.NoopCloser{}, m, ci, protocol.CompressionNever, protocol.CompressionAlgorithmLZ4, 0, nil, m.keyGen), protocol.Hello{})
 	m.mut.RLock()
 	if len(m.closed) != 1 {
 		t.Fatalf("Expected just one conn (len(m.closed) == %v)", len(m.closed))
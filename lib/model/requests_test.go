@@ -1462,3 +1462,77 @@ func TestRequestGlobalInvalidToValid(t *testing.T) {
 		}
 	}
 }
+
+func TestRequestDelta(t *testing.T) {
+	m, fc, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	tfs := fcfg.Filesystem(nil)
+	defer cleanupModelAndRemoveDir(m, tfs.URI())
+
+	m.mut.Lock()
+	m.helloMessages[device1] = protocol.Hello{Features: []string{protocol.FeatureDeltaRequest}}
+	m.mut.Unlock()
+
+	fc.RequestCalls(func(_ context.Context, _, name string, _ int, offset int64, size int, _ []byte, _ uint32, _ bool) ([]byte, error) {
+		fc.mut.Lock()
+		defer fc.mut.Unlock()
+		return fc.fileData[name][offset : offset+int64(size)], nil
+	})
+	var deltaOld [][]byte
+	fc.DeltaRequestCalls(func(_ context.Context, _, name string, _ int, offset int64, size int, _ []byte, _ bool, old []byte) ([]byte, error) {
+		fc.mut.Lock()
+		defer fc.mut.Unlock()
+		deltaOld = append(deltaOld, old)
+		return fc.fileData[name][offset : offset+int64(size)], nil
+	})
+
+	synced := make(chan struct{}, 1)
+	fc.setIndexFn(func(_ context.Context, _ string, fs []protocol.FileInfo) error {
+		for _, f := range fs {
+			if f.Name == "testfile" {
+				synced <- struct{}{}
+			}
+		}
+		return nil
+	})
+	waitSynced := func() {
+		t.Helper()
+		select {
+		case <-synced:
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out")
+		}
+	}
+
+	// A file of two blocks, first synced in full.
+	contents := make([]byte, 2*protocol.MinBlockSize)
+	_, err := io.ReadFull(rand.Reader, contents)
+	must(t, err)
+	fc.addFile("testfile", 0o644, protocol.FileInfoTypeFile, contents)
+	fc.sendIndexUpdate()
+	waitSynced()
+	if fc.DeltaRequestCallCount() != 0 {
+		t.Error("unexpected delta request for new file")
+	}
+
+	// Then a version where both blocks changed a little, which should be
+	// pulled using delta requests based on the existing data.
+	changed := append([]byte(nil), contents...)
+	copy(changed[1000:], "first change")
+	copy(changed[protocol.MinBlockSize+1000:], "second change")
+	fc.updateFile("testfile", 0o644, protocol.FileInfoTypeFile, changed)
+	fc.sendIndexUpdate()
+	waitSynced()
+
+	if err := equalContents(tfs, "testfile", changed); err != nil {
+		t.Error("File did not sync correctly:", err)
+	}
+	if n := fc.DeltaRequestCallCount(); n != 2 {
+		t.Fatalf("got %d delta requests, expected 2", n)
+	}
+	for _, old := range deltaOld {
+		if !bytes.Contains(contents, old) {
+			t.Error("delta request not based on the existing data")
+		}
+	}
+}
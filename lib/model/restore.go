@@ -0,0 +1,132 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/versioner"
+)
+
+// RestoredFile is a file which a point in time restore replaces with, or
+// recreates from, a version in the versioner archive.
+type RestoredFile struct {
+	Name        string    `json:"name"`
+	VersionTime time.Time `json:"versionTime"`
+	ModTime     time.Time `json:"modTime"`
+	Size        int64     `json:"size"`
+	// Recreated is set when the file doesn't currently exist.
+	Recreated bool   `json:"recreated"`
+	Error     string `json:"error,omitempty"`
+}
+
+// RestoreFolderToTime restores the files in the folder, or in the given
+// subdirectory of it, to the versions they had at the given time, as far
+// as the versioner archive has them. Files which haven't changed since, or
+// which were created after the time, are left as they are. With dryRun set
+// nothing is restored, and the result is what would have been.
+func (m *model) RestoreFolderToTime(folder, prefix string, at time.Time, dryRun bool) ([]RestoredFile, error) {
+	m.mut.RLock()
+	err := m.checkFolderRunningRLocked(folder)
+	fcfg := m.folderCfgs[folder]
+	ver := m.folderVersioners[folder]
+	fset := m.folderFiles[folder]
+	m.mut.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if ver == nil {
+		return nil, errNoVersioner
+	}
+
+	versions, err := ver.GetVersions()
+	if err != nil {
+		return nil, err
+	}
+	snap, err := fset.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+
+	prefix = strings.Trim(osutil.NativeFilename(prefix), string(filepath.Separator))
+	restored := make([]RestoredFile, 0)
+	for name, fileVersions := range versions {
+		if prefix != "" && name != prefix && !strings.HasPrefix(name, prefix+string(filepath.Separator)) {
+			continue
+		}
+		v, ok := pointInTimeVersion(fileVersions, at)
+		if !ok {
+			continue
+		}
+		cur, ok := snap.Get(protocol.LocalDeviceID, name)
+		exists := ok && !cur.IsDeleted()
+		if exists && cur.Size == v.Size && cur.ModTime().Truncate(time.Second).Equal(v.ModTime) {
+			// Already restored
+			continue
+		}
+		restored = append(restored, RestoredFile{
+			Name:        name,
+			VersionTime: v.VersionTime,
+			ModTime:     v.ModTime,
+			Size:        v.Size,
+			Recreated:   !exists,
+		})
+	}
+	sort.Slice(restored, func(a, b int) bool {
+		return restored[a].Name < restored[b].Name
+	})
+
+	if dryRun || len(restored) == 0 {
+		return restored, nil
+	}
+
+	for i, f := range restored {
+		if err := ver.Restore(f.Name, f.VersionTime); err != nil {
+			restored[i].Error = err.Error()
+		}
+	}
+
+	// Trigger scan
+	if !fcfg.FSWatcherEnabled {
+		go func() { _ = m.ScanFolder(folder) }()
+	}
+
+	return restored, nil
+}
+
+// pointInTimeVersion returns the version of a file which was in place at the
+// given time. That's the first version archived after the time, as long as
+// it already existed then; otherwise the file was created, or recreated,
+// after the time, or a previous restore already put it back.
+func pointInTimeVersion(versions []versioner.FileVersion, at time.Time) (versioner.FileVersion, bool) {
+	var res versioner.FileVersion
+	found := false
+	for _, v := range versions {
+		if !v.VersionTime.After(at) {
+			continue
+		}
+		if !found || v.VersionTime.Before(res.VersionTime) {
+			res = v
+			found = true
+		}
+	}
+	if !found {
+		return res, false
+	}
+	// The trash can doesn't keep the modification time of the file, only
+	// when it was archived, so there's nothing to check.
+	if res.ModTime.After(at) && !res.ModTime.Equal(res.VersionTime) {
+		return res, false
+	}
+	return res, true
+}
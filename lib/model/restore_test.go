@@ -0,0 +1,79 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/versioner"
+)
+
+func TestPointInTimeVersion(t *testing.T) {
+	at := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.Local)
+	before := func(d time.Duration) time.Time { return at.Add(-d) }
+	after := func(d time.Duration) time.Time { return at.Add(d) }
+
+	cases := []struct {
+		name     string
+		versions []versioner.FileVersion
+		expected int // index into versions, -1 for none
+	}{
+		{
+			name:     "no versions",
+			expected: -1,
+		},
+		{
+			name: "only older versions",
+			versions: []versioner.FileVersion{
+				{VersionTime: before(time.Hour), ModTime: before(2 * time.Hour)},
+			},
+			expected: -1,
+		},
+		{
+			name: "first version after",
+			versions: []versioner.FileVersion{
+				{VersionTime: after(2 * time.Hour), ModTime: after(time.Hour)},
+				{VersionTime: after(time.Hour), ModTime: before(time.Hour)},
+				{VersionTime: before(time.Hour), ModTime: before(2 * time.Hour)},
+			},
+			expected: 1,
+		},
+		{
+			name: "created after",
+			versions: []versioner.FileVersion{
+				{VersionTime: after(2 * time.Hour), ModTime: after(time.Hour)},
+			},
+			expected: -1,
+		},
+		{
+			name: "trash can",
+			versions: []versioner.FileVersion{
+				{VersionTime: after(time.Hour), ModTime: after(time.Hour)},
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, ok := pointInTimeVersion(tc.versions, at)
+			if tc.expected < 0 {
+				if ok {
+					t.Errorf("got version %+v, expected none", v)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("got no version")
+			}
+			if v != tc.versions[tc.expected] {
+				t.Errorf("got version %+v, expected %+v", v, tc.versions[tc.expected])
+			}
+		})
+	}
+}
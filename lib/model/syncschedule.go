@@ -0,0 +1,87 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+// serveSyncSchedules pauses and resumes folders that have sync windows, as
+// the windows open and close. Pausing is done through the configuration,
+// the same as when done by the user, so the usual FolderPaused and
+// FolderResumed events are emitted. Folders are only touched when a window
+// opens or closes, and when starting up, so they may still be paused or
+// resumed manually in between.
+func (m *model) serveSyncSchedules(ctx context.Context) error {
+	select {
+	case <-m.started:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	var prev time.Time
+	for {
+		now := time.Now()
+		m.applySyncSchedules(prev, now)
+		prev = now
+
+		// Windows have a granularity of one minute.
+		timer := time.NewTimer(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// applySyncSchedules updates the paused state of folders whose sync window
+// state differs between prev and now. A zero prev means all folders with
+// sync windows are updated.
+func (m *model) applySyncSchedules(prev, now time.Time) {
+	changed := func(cfg config.FolderConfiguration) bool {
+		if len(cfg.SyncWindows) == 0 {
+			return false
+		}
+		active := cfg.InSyncWindow(now)
+		if !prev.IsZero() && active == cfg.InSyncWindow(prev) {
+			return false
+		}
+		return cfg.Paused == active
+	}
+
+	needed := false
+	for _, cfg := range m.cfg.Folders() {
+		if changed(cfg) {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return
+	}
+
+	w, _ := m.cfg.Modify(func(cfg *config.Configuration) {
+		for i := range cfg.Folders {
+			folder := &cfg.Folders[i]
+			if !changed(*folder) {
+				continue
+			}
+			folder.Paused = !folder.Paused
+			if folder.Paused {
+				l.Infof("Pausing folder %s outside of its sync windows", folder.Description())
+			} else {
+				l.Infof("Resuming folder %s within its sync windows", folder.Description())
+			}
+		}
+	})
+	w.Wait()
+}
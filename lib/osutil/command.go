@@ -0,0 +1,99 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package osutil
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/build"
+
+	"github.com/kballard/go-shellquote"
+)
+
+// Command is a user configured command line, such as for an external
+// versioner or a folder hook.
+type Command struct {
+	// Line is split into words like a shell would, with backslashes kept
+	// as they are on Windows.
+	Line string
+	// Placeholders are replaced by their values in each word.
+	Placeholders map[string]string
+	// Env is added to the environment, which otherwise is Syncthing's
+	// without the GUI credentials.
+	Env []string
+	// Timeout, if set, kills the command when it runs for longer.
+	Timeout time.Duration
+}
+
+// Run runs the command and returns its standard output. When it fails, the
+// error includes the last line of its output, preferring standard error.
+func (c Command) Run(ctx context.Context) ([]byte, error) {
+	line := c.Line
+	if build.IsWindows {
+		line = strings.ReplaceAll(line, `\`, `\\`)
+	}
+	words, err := shellquote.Split(line)
+	if err != nil {
+		return nil, fmt.Errorf("command is invalid: %w", err)
+	}
+	if len(words) == 0 {
+		return nil, errors.New("command is empty, please enter a valid command")
+	}
+	for i, word := range words {
+		for key, val := range c.Placeholders {
+			word = strings.ReplaceAll(word, key, val)
+		}
+		words[i] = word
+	}
+
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+	cmd := exec.CommandContext(ctx, words[0], words[1:]...)
+	// filter STGUIAUTH and STGUIAPIKEY from environment variables
+	for _, x := range os.Environ() {
+		if !strings.HasPrefix(x, "STGUIAUTH=") && !strings.HasPrefix(x, "STGUIAPIKEY=") {
+			cmd.Env = append(cmd.Env, x)
+		}
+	}
+	cmd.Env = append(cmd.Env, c.Env...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if c.Timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("timed out after %v", c.Timeout)
+	}
+	if err != nil {
+		output := stderr.String()
+		if strings.TrimSpace(output) == "" {
+			output = stdout.String()
+		}
+		if output = lastLine(output); output != "" {
+			return nil, fmt.Errorf("%w: %s", err, output)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+func lastLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		return strings.TrimSpace(s[i+1:])
+	}
+	return s
+}
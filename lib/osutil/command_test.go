@@ -0,0 +1,52 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package osutil_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/osutil"
+)
+
+func TestCommand(t *testing.T) {
+	if build.IsWindows {
+		t.Skip("uses a POSIX shell")
+	}
+	t.Setenv("STGUIAPIKEY", "secret")
+
+	cmd := osutil.Command{
+		Line:         `sh -c 'echo %WHAT% "$EXTRA" "$STGUIAPIKEY"'`,
+		Placeholders: map[string]string{"%WHAT%": "placeholder"},
+		Env:          []string{"EXTRA=extra"},
+	}
+	out, err := cmd.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(out)); got != "placeholder extra" {
+		t.Errorf("got output %q, expected %q", got, "placeholder extra")
+	}
+
+	cmd = osutil.Command{Line: `sh -c 'echo first >&2; echo last >&2; exit 1'`}
+	if _, err := cmd.Run(context.Background()); err == nil || !strings.HasSuffix(err.Error(), ": last") {
+		t.Errorf("got error %v, expected the last line of output", err)
+	}
+
+	cmd = osutil.Command{Line: "sleep 10", Timeout: 100 * time.Millisecond}
+	if _, err := cmd.Run(context.Background()); err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("got error %v, expected a timeout", err)
+	}
+
+	cmd = osutil.Command{Line: " "}
+	if _, err := cmd.Run(context.Background()); err == nil {
+		t.Error("expected an error for an empty command")
+	}
+}
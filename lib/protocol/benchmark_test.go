@@ -6,10 +6,13 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/binary"
+	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/rand"
 	"github.com/syncthing/syncthing/lib/testutil"
 )
 
@@ -60,9 +63,9 @@ func benchmarkRequestsTLS(b *testing.B, conn0, conn1 net.Conn) {
 
 func benchmarkRequestsConnPair(b *testing.B, conn0, conn1 net.Conn) {
 	// Start up Connections on them
-	c0 := NewConnection(LocalDeviceID, conn0, conn0, testutil.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, nil, testKeyGen)
+	c0 := NewConnection(LocalDeviceID, conn0, conn0, testutil.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, CompressionAlgorithmLZ4, 0, nil, testKeyGen)
 	c0.Start()
-	c1 := NewConnection(LocalDeviceID, conn1, conn1, testutil.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, nil, testKeyGen)
+	c1 := NewConnection(LocalDeviceID, conn1, conn1, testutil.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, CompressionAlgorithmLZ4, 0, nil, testKeyGen)
 	c1.Start()
 
 	// Satisfy the assertions in the protocol by sending an initial cluster config
@@ -103,6 +106,121 @@ func benchmarkRequestsConnPair(b *testing.B, conn0, conn1 net.Conn) {
 	}
 }
 
+var benchmarkCompressors = []struct {
+	name  string
+	algo  CompressionAlgorithm
+	level int
+}{
+	{"lz4", CompressionAlgorithmLZ4, 0},
+	{"zstd-1", CompressionAlgorithmZstd, 1},
+	{"zstd-3", CompressionAlgorithmZstd, 3},
+	{"zstd-9", CompressionAlgorithmZstd, 9},
+}
+
+func BenchmarkCompressIndex(b *testing.B) {
+	data, err := benchmarkIndexMessage().Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+	buf := make([]byte, len(data))
+
+	for _, tc := range benchmarkCompressors {
+		b.Run(tc.name, func(b *testing.B) {
+			enc := zstdEncoder(tc.level)
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			b.ResetTimer()
+
+			var n int
+			var err error
+			for i := 0; i < b.N; i++ {
+				if tc.algo == CompressionAlgorithmZstd {
+					n, err = zstdCompress(enc, data, buf)
+				} else {
+					n, err = lz4Compress(data, buf)
+				}
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(n)/float64(len(data)), "ratio")
+		})
+	}
+}
+
+func BenchmarkDecompressIndex(b *testing.B) {
+	data, err := benchmarkIndexMessage().Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, tc := range benchmarkCompressors {
+		b.Run(tc.name, func(b *testing.B) {
+			buf := make([]byte, len(data))
+			var n int
+			if tc.algo == CompressionAlgorithmZstd {
+				n, err = zstdCompress(zstdEncoder(tc.level), data, buf)
+			} else {
+				n, err = lz4Compress(data, buf)
+			}
+			if err != nil {
+				b.Fatal(err)
+			}
+			buf = buf[:n]
+
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				var res []byte
+				if tc.algo == CompressionAlgorithmZstd {
+					res, err = zstdDecompress(buf)
+				} else {
+					res, err = lz4Decompress(buf)
+				}
+				if err != nil {
+					b.Fatal(err)
+				}
+				BufferPool.Put(res)
+			}
+		})
+	}
+}
+
+// benchmarkIndexMessage returns an index of a few thousand files, with the
+// kind of redundancy in names and versions that real indexes have.
+func benchmarkIndexMessage() *Index {
+	files := make([]FileInfo, 0, 5000)
+	var version Vector
+	version = version.Update(LocalDeviceID.Short())
+	for i := 0; i < cap(files); i++ {
+		blocks := make([]BlockInfo, 1+i%8)
+		for j := range blocks {
+			hash := make([]byte, 32)
+			rand.Read(hash)
+			blocks[j] = BlockInfo{
+				Offset:   int64(j) * MinBlockSize,
+				Size:     MinBlockSize,
+				Hash:     hash,
+				WeakHash: uint32(rand.Uint64()),
+			}
+		}
+		files = append(files, FileInfo{
+			Name:         fmt.Sprintf("some/directory/structure/%d/file-%d.txt", i/100, i),
+			Size:         int64(len(blocks)) * MinBlockSize,
+			ModifiedS:    time.Now().Unix() - int64(i),
+			ModifiedBy:   LocalDeviceID.Short(),
+			Permissions:  0o644,
+			Version:      version,
+			Sequence:     int64(i),
+			RawBlockSize: MinBlockSize,
+			Blocks:       blocks,
+		})
+	}
+	return &Index{Folder: "default", Files: files}
+}
+
 // returns the two endpoints of a TCP connection over lo0
 func getTCPConnectionPair() (net.Conn, net.Conn, error) {
 	lst, err := net.Listen("tcp", "127.0.0.1:0")
@@ -35,6 +35,8 @@ const (
 	MessageTypeDownloadProgress MessageType = 5
 	MessageTypePing             MessageType = 6
 	MessageTypeClose            MessageType = 7
+	MessageTypeDeltaRequest     MessageType = 8
+	MessageTypeDeltaResponse    MessageType = 9
 )
 
 var MessageType_name = map[int32]string{
@@ -46,6 +48,8 @@ var MessageType_name = map[int32]string{
 	5: "MESSAGE_TYPE_DOWNLOAD_PROGRESS",
 	6: "MESSAGE_TYPE_PING",
 	7: "MESSAGE_TYPE_CLOSE",
+	8: "MESSAGE_TYPE_DELTA_REQUEST",
+	9: "MESSAGE_TYPE_DELTA_RESPONSE",
 }
 
 var MessageType_value = map[string]int32{
@@ -57,6 +61,8 @@ var MessageType_value = map[string]int32{
 	"MESSAGE_TYPE_DOWNLOAD_PROGRESS": 5,
 	"MESSAGE_TYPE_PING":              6,
 	"MESSAGE_TYPE_CLOSE":             7,
+	"MESSAGE_TYPE_DELTA_REQUEST":     8,
+	"MESSAGE_TYPE_DELTA_RESPONSE":    9,
 }
 
 func (x MessageType) String() string {
@@ -72,16 +78,19 @@ type MessageCompression int32
 const (
 	MessageCompressionNone MessageCompression = 0
 	MessageCompressionLZ4  MessageCompression = 1
+	MessageCompressionZstd MessageCompression = 2
 )
 
 var MessageCompression_name = map[int32]string{
 	0: "MESSAGE_COMPRESSION_NONE",
 	1: "MESSAGE_COMPRESSION_LZ4",
+	2: "MESSAGE_COMPRESSION_ZSTD",
 }
 
 var MessageCompression_value = map[string]int32{
 	"MESSAGE_COMPRESSION_NONE": 0,
 	"MESSAGE_COMPRESSION_LZ4":  1,
+	"MESSAGE_COMPRESSION_ZSTD": 2,
 }
 
 func (x MessageCompression) String() string {
@@ -92,6 +101,31 @@ func (MessageCompression) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_311ef540e10d9705, []int{1}
 }
 
+type BlockChunking int32
+
+const (
+	BlockChunkingFixed BlockChunking = 0
+	BlockChunkingCDC   BlockChunking = 1
+)
+
+var BlockChunking_name = map[int32]string{
+	0: "BLOCK_CHUNKING_FIXED",
+	1: "BLOCK_CHUNKING_CDC",
+}
+
+var BlockChunking_value = map[string]int32{
+	"BLOCK_CHUNKING_FIXED": 0,
+	"BLOCK_CHUNKING_CDC":   1,
+}
+
+func (x BlockChunking) String() string {
+	return proto.EnumName(BlockChunking_name, int32(x))
+}
+
+func (BlockChunking) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{2}
+}
+
 type Compression int32
 
 const (
@@ -117,7 +151,32 @@ func (x Compression) String() string {
 }
 
 func (Compression) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{2}
+	return fileDescriptor_311ef540e10d9705, []int{3}
+}
+
+type CompressionAlgorithm int32
+
+const (
+	CompressionAlgorithmLZ4  CompressionAlgorithm = 0
+	CompressionAlgorithmZstd CompressionAlgorithm = 1
+)
+
+var CompressionAlgorithm_name = map[int32]string{
+	0: "COMPRESSION_ALGORITHM_LZ4",
+	1: "COMPRESSION_ALGORITHM_ZSTD",
+}
+
+var CompressionAlgorithm_value = map[string]int32{
+	"COMPRESSION_ALGORITHM_LZ4":  0,
+	"COMPRESSION_ALGORITHM_ZSTD": 1,
+}
+
+func (x CompressionAlgorithm) String() string {
+	return proto.EnumName(CompressionAlgorithm_name, int32(x))
+}
+
+func (CompressionAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{4}
 }
 
 type FileInfoType int32
@@ -151,7 +210,7 @@ func (x FileInfoType) String() string {
 }
 
 func (FileInfoType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{3}
+	return fileDescriptor_311ef540e10d9705, []int{5}
 }
 
 type ErrorCode int32
@@ -182,7 +241,7 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{4}
+	return fileDescriptor_311ef540e10d9705, []int{6}
 }
 
 type FileDownloadProgressUpdateType int32
@@ -207,7 +266,7 @@ func (x FileDownloadProgressUpdateType) String() string {
 }
 
 func (FileDownloadProgressUpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{5}
+	return fileDescriptor_311ef540e10d9705, []int{7}
 }
 
 type Hello struct {
@@ -216,6 +275,9 @@ type Hello struct {
 	ClientVersion  string `protobuf:"bytes,3,opt,name=client_version,json=clientVersion,proto3" json:"clientVersion" xml:"clientVersion"`
 	NumConnections int    `protobuf:"varint,4,opt,name=num_connections,json=numConnections,proto3,casttype=int" json:"numConnections" xml:"numConnections"`
 	Timestamp      int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp" xml:"timestamp"`
+	// Optional protocol features supported by the sender. A feature is
+	// only used when both sides announce it.
+	Features []string `protobuf:"bytes,6,rep,name=features,proto3" json:"features" xml:"feature"`
 }
 
 func (m *Hello) Reset()         { *m = Hello{} }
@@ -328,14 +390,17 @@ func (m *ClusterConfig) XXX_DiscardUnknown() {
 var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 
 type Folder struct {
-	ID                 string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id"`
-	Label              string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label"`
-	ReadOnly           bool     `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"readOnly" xml:"readOnly"`
-	IgnorePermissions  bool     `protobuf:"varint,4,opt,name=ignore_permissions,json=ignorePermissions,proto3" json:"ignorePermissions" xml:"ignorePermissions"`
-	IgnoreDelete       bool     `protobuf:"varint,5,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
-	DisableTempIndexes bool     `protobuf:"varint,6,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
-	Paused             bool     `protobuf:"varint,7,opt,name=paused,proto3" json:"paused" xml:"paused"`
-	Devices            []Device `protobuf:"bytes,16,rep,name=devices,proto3" json:"devices" xml:"device"`
+	ID                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id"`
+	Label              string `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label"`
+	ReadOnly           bool   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"readOnly" xml:"readOnly"`
+	IgnorePermissions  bool   `protobuf:"varint,4,opt,name=ignore_permissions,json=ignorePermissions,proto3" json:"ignorePermissions" xml:"ignorePermissions"`
+	IgnoreDelete       bool   `protobuf:"varint,5,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
+	DisableTempIndexes bool   `protobuf:"varint,6,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
+	Paused             bool   `protobuf:"varint,7,opt,name=paused,proto3" json:"paused" xml:"paused"`
+	// The chunking the sender wants to use for new files in the folder;
+	// content defined chunking is only used when all devices agree.
+	BlockChunking BlockChunking `protobuf:"varint,8,opt,name=block_chunking,json=blockChunking,proto3,enum=protocol.BlockChunking" json:"blockChunking" xml:"blockChunking"`
+	Devices       []Device      `protobuf:"bytes,16,rep,name=devices,proto3" json:"devices" xml:"device"`
 }
 
 func (m *Folder) Reset()         { *m = Folder{} }
@@ -509,6 +574,9 @@ type FileInfo struct {
 	ModifiedNs    int          `protobuf:"varint,11,opt,name=modified_ns,json=modifiedNs,proto3,casttype=int" json:"modifiedNs" xml:"modifiedNs"`
 	RawBlockSize  int          `protobuf:"varint,13,opt,name=block_size,json=blockSize,proto3,casttype=int" json:"blockSize" xml:"blockSize"`
 	Platform      PlatformData `protobuf:"bytes,14,opt,name=platform,proto3" json:"platform" xml:"platform"`
+	// With content defined chunking the blocks vary in size and block_size
+	// is the average block size the chunker aimed for.
+	BlockChunking BlockChunking `protobuf:"varint,20,opt,name=block_chunking,json=blockChunking,proto3,enum=protocol.BlockChunking" json:"blockChunking" xml:"blockChunking"`
 	// The local_flags fields stores flags that are relevant to the local
 	// host only. It is not part of the protocol, doesn't get sent or
 	// received (we make sure to zero it), nonetheless we need it on our
@@ -959,6 +1027,174 @@ func (m *Response) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Response proto.InternalMessageInfo
 
+// A DeltaRequest asks for the same data as a Request, but carries the
+// checksums of the chunks of data the requester already has for the block.
+// The other side answers with a DeltaResponse describing the block in terms
+// of those chunks and literal data.
+type DeltaRequest struct {
+	ID            int             `protobuf:"varint,1,opt,name=id,proto3,casttype=int" json:"id" xml:"id"`
+	Folder        string          `protobuf:"bytes,2,opt,name=folder,proto3" json:"folder" xml:"folder"`
+	Name          string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name" xml:"name"`
+	Offset        int64           `protobuf:"varint,4,opt,name=offset,proto3" json:"offset" xml:"offset"`
+	Size          int             `protobuf:"varint,5,opt,name=size,proto3,casttype=int" json:"size" xml:"size"`
+	Hash          []byte          `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash" xml:"hash"`
+	FromTemporary bool            `protobuf:"varint,7,opt,name=from_temporary,json=fromTemporary,proto3" json:"fromTemporary" xml:"fromTemporary"`
+	BlockNo       int             `protobuf:"varint,8,opt,name=block_no,json=blockNo,proto3,casttype=int" json:"blockNo" xml:"blockNo"`
+	ChunkSize     int             `protobuf:"varint,9,opt,name=chunk_size,json=chunkSize,proto3,casttype=int" json:"chunkSize" xml:"chunkSize"`
+	Checksums     []DeltaChecksum `protobuf:"bytes,10,rep,name=checksums,proto3" json:"checksums" xml:"checksum"`
+}
+
+func (m *DeltaRequest) Reset()         { *m = DeltaRequest{} }
+func (m *DeltaRequest) String() string { return proto.CompactTextString(m) }
+func (*DeltaRequest) ProtoMessage()    {}
+func (*DeltaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{18}
+}
+func (m *DeltaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeltaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeltaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeltaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeltaRequest.Merge(m, src)
+}
+func (m *DeltaRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeltaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeltaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeltaRequest proto.InternalMessageInfo
+
+type DeltaChecksum struct {
+	Weak   uint32 `protobuf:"varint,1,opt,name=weak,proto3" json:"weak" xml:"weak"`
+	Strong []byte `protobuf:"bytes,2,opt,name=strong,proto3" json:"strong" xml:"strong"`
+}
+
+func (m *DeltaChecksum) Reset()         { *m = DeltaChecksum{} }
+func (m *DeltaChecksum) String() string { return proto.CompactTextString(m) }
+func (*DeltaChecksum) ProtoMessage()    {}
+func (*DeltaChecksum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{19}
+}
+func (m *DeltaChecksum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeltaChecksum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeltaChecksum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeltaChecksum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeltaChecksum.Merge(m, src)
+}
+func (m *DeltaChecksum) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeltaChecksum) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeltaChecksum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeltaChecksum proto.InternalMessageInfo
+
+type DeltaResponse struct {
+	ID   int       `protobuf:"varint,1,opt,name=id,proto3,casttype=int" json:"id" xml:"id"`
+	Ops  []DeltaOp `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops" xml:"op"`
+	Code ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=protocol.ErrorCode" json:"code" xml:"code"`
+}
+
+func (m *DeltaResponse) Reset()         { *m = DeltaResponse{} }
+func (m *DeltaResponse) String() string { return proto.CompactTextString(m) }
+func (*DeltaResponse) ProtoMessage()    {}
+func (*DeltaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{20}
+}
+func (m *DeltaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeltaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeltaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeltaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeltaResponse.Merge(m, src)
+}
+func (m *DeltaResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeltaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeltaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeltaResponse proto.InternalMessageInfo
+
+// A DeltaOp is either literal data or a reference to a run of chunks
+// given by their index in the DeltaRequest checksums.
+type DeltaOp struct {
+	Data       []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data" xml:"data"`
+	ChunkIndex int    `protobuf:"varint,2,opt,name=chunk_index,json=chunkIndex,proto3,casttype=int" json:"chunkIndex" xml:"chunkIndex"`
+	ChunkCount int    `protobuf:"varint,3,opt,name=chunk_count,json=chunkCount,proto3,casttype=int" json:"chunkCount" xml:"chunkCount"`
+}
+
+func (m *DeltaOp) Reset()         { *m = DeltaOp{} }
+func (m *DeltaOp) String() string { return proto.CompactTextString(m) }
+func (*DeltaOp) ProtoMessage()    {}
+func (*DeltaOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{21}
+}
+func (m *DeltaOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeltaOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeltaOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeltaOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeltaOp.Merge(m, src)
+}
+func (m *DeltaOp) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeltaOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeltaOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeltaOp proto.InternalMessageInfo
+
 type DownloadProgress struct {
 	Folder  string                       `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder" xml:"folder"`
 	Updates []FileDownloadProgressUpdate `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates" xml:"update"`
@@ -968,7 +1204,7 @@ func (m *DownloadProgress) Reset()         { *m = DownloadProgress{} }
 func (m *DownloadProgress) String() string { return proto.CompactTextString(m) }
 func (*DownloadProgress) ProtoMessage()    {}
 func (*DownloadProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{18}
+	return fileDescriptor_311ef540e10d9705, []int{22}
 }
 func (m *DownloadProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1009,7 +1245,7 @@ func (m *FileDownloadProgressUpdate) Reset()         { *m = FileDownloadProgress
 func (m *FileDownloadProgressUpdate) String() string { return proto.CompactTextString(m) }
 func (*FileDownloadProgressUpdate) ProtoMessage()    {}
 func (*FileDownloadProgressUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{19}
+	return fileDescriptor_311ef540e10d9705, []int{23}
 }
 func (m *FileDownloadProgressUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1045,7 +1281,7 @@ func (m *Ping) Reset()         { *m = Ping{} }
 func (m *Ping) String() string { return proto.CompactTextString(m) }
 func (*Ping) ProtoMessage()    {}
 func (*Ping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{20}
+	return fileDescriptor_311ef540e10d9705, []int{24}
 }
 func (m *Ping) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1082,7 +1318,7 @@ func (m *Close) Reset()         { *m = Close{} }
 func (m *Close) String() string { return proto.CompactTextString(m) }
 func (*Close) ProtoMessage()    {}
 func (*Close) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{21}
+	return fileDescriptor_311ef540e10d9705, []int{25}
 }
 func (m *Close) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1350,9 @@ var xxx_messageInfo_Close proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("protocol.MessageType", MessageType_name, MessageType_value)
 	proto.RegisterEnum("protocol.MessageCompression", MessageCompression_name, MessageCompression_value)
+	proto.RegisterEnum("protocol.BlockChunking", BlockChunking_name, BlockChunking_value)
 	proto.RegisterEnum("protocol.Compression", Compression_name, Compression_value)
+	proto.RegisterEnum("protocol.CompressionAlgorithm", CompressionAlgorithm_name, CompressionAlgorithm_value)
 	proto.RegisterEnum("protocol.FileInfoType", FileInfoType_name, FileInfoType_value)
 	proto.RegisterEnum("protocol.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("protocol.FileDownloadProgressUpdateType", FileDownloadProgressUpdateType_name, FileDownloadProgressUpdateType_value)
@@ -1136,6 +1374,10 @@ func init() {
 	proto.RegisterType((*Xattr)(nil), "protocol.Xattr")
 	proto.RegisterType((*Request)(nil), "protocol.Request")
 	proto.RegisterType((*Response)(nil), "protocol.Response")
+	proto.RegisterType((*DeltaRequest)(nil), "protocol.DeltaRequest")
+	proto.RegisterType((*DeltaChecksum)(nil), "protocol.DeltaChecksum")
+	proto.RegisterType((*DeltaResponse)(nil), "protocol.DeltaResponse")
+	proto.RegisterType((*DeltaOp)(nil), "protocol.DeltaOp")
 	proto.RegisterType((*DownloadProgress)(nil), "protocol.DownloadProgress")
 	proto.RegisterType((*FileDownloadProgressUpdate)(nil), "protocol.FileDownloadProgressUpdate")
 	proto.RegisterType((*Ping)(nil), "protocol.Ping")
@@ -1145,211 +1387,238 @@ func init() {
 func init() { proto.RegisterFile("lib/protocol/bep.proto", fileDescriptor_311ef540e10d9705) }
 
 var fileDescriptor_311ef540e10d9705 = []byte{
-	// 3251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4b, 0x6c, 0x23, 0x47,
-	0x7a, 0x16, 0x9f, 0xa2, 0x4a, 0x8f, 0xa1, 0x6a, 0x5e, 0x34, 0x67, 0xac, 0x66, 0x6a, 0x67, 0x13,
-	0x59, 0x9b, 0x1d, 0xaf, 0xb5, 0xde, 0x8d, 0x63, 0x3b, 0x36, 0xc4, 0x87, 0x34, 0x5c, 0x6b, 0x48,
-	0xb9, 0xa8, 0x19, 0xaf, 0x07, 0x08, 0x88, 0x16, 0xbb, 0x44, 0x35, 0x86, 0xec, 0x66, 0xba, 0x9b,
-	0x7a, 0x2c, 0x72, 0x49, 0x16, 0x58, 0x2c, 0x74, 0x08, 0x82, 0x3d, 0x05, 0xc1, 0x0a, 0x59, 0xe4,
-	0x92, 0x5b, 0x80, 0x1c, 0x72, 0xd9, 0x53, 0x8e, 0x73, 0x1c, 0x2c, 0x10, 0x20, 0xc8, 0xa1, 0x01,
-	0xcf, 0x5c, 0x12, 0xe6, 0xc6, 0x63, 0x0e, 0x41, 0x50, 0x7f, 0x55, 0x57, 0x57, 0xeb, 0xe1, 0x68,
-	0xec, 0x43, 0x4e, 0xc3, 0xfa, 0xfe, 0xef, 0xff, 0xab, 0xba, 0xea, 0x7f, 0x55, 0x69, 0xd0, 0x9d,
-	0x81, 0xbd, 0xf7, 0xee, 0xc8, 0x73, 0x03, 0xb7, 0xe7, 0x0e, 0xde, 0xdd, 0x63, 0xa3, 0x87, 0x30,
-	0xc0, 0x85, 0x08, 0x2b, 0xcf, 0xb1, 0xe3, 0x40, 0x80, 0xe5, 0xef, 0x78, 0x6c, 0xe4, 0xfa, 0x82,
-	0xbe, 0x37, 0xde, 0x7f, 0xb7, 0xef, 0xf6, 0x5d, 0x18, 0xc0, 0x2f, 0x41, 0x22, 0xff, 0x93, 0x46,
-	0xb9, 0x47, 0x6c, 0x30, 0x70, 0x71, 0x0d, 0xcd, 0x5b, 0xec, 0xd0, 0xee, 0xb1, 0xae, 0x63, 0x0e,
-	0x59, 0x29, 0x55, 0x49, 0xad, 0xce, 0x55, 0xc9, 0x24, 0x34, 0x90, 0x80, 0x5b, 0xe6, 0x90, 0x4d,
-	0x43, 0xa3, 0x78, 0x3c, 0x1c, 0x7c, 0x48, 0x62, 0x88, 0x50, 0x4d, 0xce, 0x8d, 0xf4, 0x06, 0x36,
-	0x73, 0x02, 0x61, 0x24, 0x1d, 0x1b, 0x11, 0x70, 0xc2, 0x48, 0x0c, 0x11, 0xaa, 0xc9, 0x71, 0x1b,
-	0x2d, 0x49, 0x23, 0x87, 0xcc, 0xf3, 0x6d, 0xd7, 0x29, 0x65, 0xc0, 0xce, 0xea, 0x24, 0x34, 0x16,
-	0x85, 0xe4, 0xa9, 0x10, 0x4c, 0x43, 0xe3, 0xa6, 0x66, 0x4a, 0xa2, 0x84, 0x26, 0x59, 0xf8, 0x19,
-	0xba, 0xe1, 0x8c, 0x87, 0xdd, 0x9e, 0xeb, 0x38, 0xac, 0x17, 0xd8, 0xae, 0xe3, 0x97, 0xb2, 0x95,
-	0xd4, 0x6a, 0xae, 0xfa, 0xde, 0x24, 0x34, 0x96, 0x9c, 0xf1, 0xb0, 0x16, 0x4b, 0xa6, 0xa1, 0x71,
-	0x0b, 0x4c, 0x26, 0x61, 0xf2, 0xdf, 0xa1, 0x91, 0xb1, 0x9d, 0x80, 0x9e, 0xa3, 0xe3, 0x4f, 0xd0,
-	0x5c, 0x60, 0x0f, 0x99, 0x1f, 0x98, 0xc3, 0x51, 0x29, 0x57, 0x49, 0xad, 0x66, 0xaa, 0x95, 0x49,
-	0x68, 0xc4, 0xe0, 0x34, 0x34, 0x6e, 0x80, 0x41, 0x85, 0x10, 0x1a, 0x4b, 0xc9, 0x3f, 0xa5, 0x50,
-	0xfe, 0x11, 0x33, 0x2d, 0xe6, 0xe1, 0x0d, 0x94, 0x0d, 0x4e, 0x46, 0x62, 0xeb, 0x97, 0xd6, 0x6f,
-	0x3f, 0x8c, 0x0e, 0xf5, 0xe1, 0x63, 0xe6, 0xfb, 0x66, 0x9f, 0xed, 0x9e, 0x8c, 0x58, 0xf5, 0xce,
-	0x24, 0x34, 0x80, 0x36, 0x0d, 0x0d, 0x24, 0xec, 0x9e, 0x8c, 0x18, 0xa1, 0x80, 0x61, 0x0b, 0xcd,
-	0xf7, 0xdc, 0xe1, 0xc8, 0x63, 0x3e, 0xec, 0x5b, 0x1a, 0x2c, 0xdd, 0xbf, 0x60, 0xa9, 0x16, 0x73,
-	0xaa, 0x0f, 0x26, 0xa1, 0xa1, 0x2b, 0x4d, 0x43, 0x63, 0x59, 0xec, 0x69, 0x8c, 0x11, 0xaa, 0x33,
-	0xc8, 0xaf, 0x53, 0x68, 0xb1, 0x36, 0x18, 0xfb, 0x01, 0xf3, 0x6a, 0xae, 0xb3, 0x6f, 0xf7, 0xf1,
-	0x67, 0x68, 0x76, 0xdf, 0x1d, 0x58, 0xcc, 0xf3, 0x4b, 0xa9, 0x4a, 0x66, 0x75, 0x7e, 0xbd, 0x18,
-	0xcf, 0xb9, 0x09, 0x82, 0xaa, 0xf1, 0x22, 0x34, 0x66, 0x26, 0xa1, 0x11, 0x11, 0xa7, 0xa1, 0xb1,
-	0x00, 0xf3, 0x88, 0x31, 0xa1, 0x91, 0x80, 0x6f, 0xa9, 0xcf, 0x7a, 0xae, 0x63, 0x99, 0xde, 0x09,
-	0x7c, 0x42, 0x41, 0x6c, 0xa9, 0x02, 0xd5, 0x96, 0x2a, 0x84, 0xd0, 0x58, 0x4a, 0x7e, 0x9b, 0x45,
-	0x79, 0x31, 0x29, 0x7e, 0x88, 0xd2, 0xb6, 0x25, 0x7d, 0x79, 0xe5, 0x55, 0x68, 0xa4, 0x9b, 0xf5,
-	0x49, 0x68, 0xa4, 0x6d, 0x6b, 0x1a, 0x1a, 0x05, 0x30, 0x61, 0x5b, 0xe4, 0x57, 0x2f, 0x1f, 0xa4,
-	0x9b, 0x75, 0x9a, 0xb6, 0x2d, 0xfc, 0x10, 0xe5, 0x06, 0xe6, 0x1e, 0x1b, 0x48, 0xcf, 0x2d, 0x4d,
-	0x42, 0x43, 0x00, 0xd3, 0xd0, 0x98, 0x07, 0x3e, 0x8c, 0x08, 0x15, 0x28, 0xfe, 0x08, 0xcd, 0x79,
-	0xcc, 0xb4, 0xba, 0xae, 0x33, 0x38, 0x01, 0x2f, 0x2d, 0x54, 0x57, 0x26, 0xa1, 0x51, 0xe0, 0x60,
-	0xdb, 0x19, 0xf0, 0x95, 0x2e, 0x81, 0x5a, 0x04, 0x10, 0xaa, 0x64, 0xb8, 0x8b, 0xb0, 0xdd, 0x77,
-	0x5c, 0x8f, 0x75, 0x47, 0xcc, 0x1b, 0xda, 0xb0, 0xb7, 0xc2, 0x33, 0x0b, 0xd5, 0x1f, 0x4c, 0x42,
-	0x63, 0x59, 0x48, 0x77, 0x62, 0xe1, 0x34, 0x34, 0xee, 0x8a, 0x55, 0x9f, 0x97, 0x10, 0x7a, 0x91,
-	0x8d, 0x3f, 0x43, 0x8b, 0x72, 0x02, 0x8b, 0x0d, 0x58, 0xc0, 0xc0, 0x3f, 0x0b, 0xd5, 0xdf, 0x9f,
-	0x84, 0xc6, 0x82, 0x10, 0xd4, 0x01, 0x9f, 0x86, 0x06, 0xd6, 0xcc, 0x0a, 0x90, 0xd0, 0x04, 0x07,
-	0x5b, 0xe8, 0x96, 0x65, 0xfb, 0xe6, 0xde, 0x80, 0x75, 0x03, 0x36, 0x1c, 0x75, 0x6d, 0xc7, 0x62,
-	0xc7, 0xcc, 0x2f, 0xe5, 0xc1, 0xe6, 0xfa, 0x24, 0x34, 0xb0, 0x94, 0xef, 0xb2, 0xe1, 0xa8, 0x29,
-	0xa4, 0xd3, 0xd0, 0x28, 0x89, 0x84, 0x71, 0x41, 0x44, 0xe8, 0x25, 0x7c, 0xbc, 0x8e, 0xf2, 0x23,
-	0x73, 0xec, 0x33, 0xab, 0x34, 0x0b, 0x76, 0xcb, 0x93, 0xd0, 0x90, 0x88, 0x72, 0x18, 0x31, 0x24,
-	0x54, 0xe2, 0xdc, 0xf9, 0x44, 0x0a, 0xf2, 0x4b, 0xc5, 0xf3, 0xce, 0x57, 0x07, 0x41, 0xec, 0x7c,
-	0x92, 0xa8, 0x6c, 0x89, 0x31, 0xa1, 0x91, 0x80, 0xfc, 0x4b, 0x1e, 0xe5, 0x85, 0x12, 0xae, 0x2a,
-	0xe7, 0x59, 0xa8, 0xae, 0x73, 0x03, 0xff, 0x1e, 0x1a, 0x05, 0x21, 0x6b, 0xd6, 0xaf, 0x72, 0xa6,
-	0x5f, 0xbe, 0x7c, 0x90, 0xd2, 0x1c, 0x6a, 0x0d, 0x65, 0xb5, 0x4c, 0x08, 0xc1, 0xeb, 0x88, 0x1c,
-	0x28, 0x82, 0xd7, 0x81, 0xec, 0x07, 0x18, 0xfe, 0x18, 0xcd, 0x99, 0x96, 0xc5, 0x83, 0x8c, 0xf9,
-	0xa5, 0x4c, 0x25, 0xc3, 0x7d, 0x96, 0xfb, 0xbd, 0x02, 0xa7, 0xa1, 0xb1, 0x08, 0x5a, 0x12, 0x21,
-	0x34, 0x96, 0xe1, 0x3f, 0x4d, 0x86, 0x7e, 0xf6, 0x7c, 0x12, 0xf9, 0x76, 0x31, 0xcf, 0x3d, 0xbd,
-	0xc7, 0x3c, 0x99, 0xd7, 0x73, 0x22, 0xa0, 0xb8, 0xa7, 0x73, 0x50, 0x66, 0x75, 0xe1, 0xe9, 0x11,
-	0x40, 0xa8, 0x92, 0xe1, 0x2d, 0xb4, 0x30, 0x34, 0x8f, 0xbb, 0x3e, 0xfb, 0xb3, 0x31, 0x73, 0x7a,
-	0x0c, 0x7c, 0x26, 0x23, 0x56, 0x31, 0x34, 0x8f, 0x3b, 0x12, 0x56, 0xab, 0xd0, 0x30, 0x42, 0x75,
-	0x06, 0xae, 0x22, 0x64, 0x3b, 0x81, 0xe7, 0x5a, 0xe3, 0x1e, 0xf3, 0xa4, 0x8b, 0x40, 0x79, 0x89,
-	0x51, 0x55, 0x5e, 0x62, 0x88, 0x50, 0x4d, 0x8e, 0xfb, 0xa8, 0x00, 0xbe, 0xdb, 0xb5, 0xad, 0x52,
-	0xa1, 0x92, 0x5a, 0xcd, 0x56, 0xb7, 0xe5, 0xe1, 0xce, 0x82, 0x17, 0xc2, 0xd9, 0x46, 0x3f, 0xb9,
-	0xcf, 0x00, 0xbb, 0x69, 0xa9, 0xdd, 0x97, 0x63, 0x9e, 0x37, 0x22, 0xda, 0xdf, 0xc6, 0x3f, 0x69,
-	0xc4, 0xc7, 0x7f, 0x8e, 0xca, 0xfe, 0x73, 0x9b, 0x47, 0x8a, 0x98, 0x9b, 0x17, 0x8c, 0xae, 0xc7,
-	0x86, 0xee, 0xa1, 0x39, 0xf0, 0x4b, 0x73, 0xb0, 0xf8, 0x4f, 0x26, 0xa1, 0x51, 0xe2, 0xac, 0xa6,
-	0x46, 0xa2, 0x92, 0x33, 0x0d, 0x8d, 0x15, 0x91, 0xe7, 0xae, 0x20, 0x10, 0x7a, 0xa5, 0x2e, 0x3e,
-	0x46, 0x6f, 0x31, 0xa7, 0xe7, 0x9d, 0x8c, 0x60, 0xda, 0x91, 0xe9, 0xfb, 0x47, 0xae, 0x67, 0x75,
-	0x03, 0xf7, 0x39, 0x73, 0x4a, 0x08, 0x9c, 0xfa, 0xe3, 0x49, 0x68, 0xdc, 0x8d, 0x49, 0x3b, 0x92,
-	0xb3, 0xcb, 0x29, 0xd3, 0xd0, 0x78, 0x1b, 0xe6, 0xbe, 0x42, 0x4e, 0xe8, 0x55, 0x9a, 0xe4, 0x2f,
-	0x53, 0x28, 0x07, 0x9b, 0xc1, 0xa3, 0x59, 0x24, 0x75, 0x99, 0x82, 0x21, 0x9a, 0x05, 0x72, 0x21,
-	0xfd, 0x4b, 0x1c, 0x37, 0x50, 0x6e, 0xdf, 0x1e, 0x30, 0xbf, 0x94, 0x86, 0x58, 0xc6, 0x5a, 0x21,
-	0xb1, 0x07, 0xac, 0xe9, 0xec, 0xbb, 0xd5, 0x7b, 0x32, 0x9a, 0x05, 0x51, 0xc5, 0x12, 0x1f, 0x11,
-	0x2a, 0x40, 0xf2, 0xcb, 0x14, 0x9a, 0x87, 0x45, 0x3c, 0x19, 0x59, 0x66, 0xc0, 0xfe, 0x3f, 0x97,
-	0xf2, 0x8b, 0x45, 0x54, 0x88, 0x14, 0x54, 0x42, 0x48, 0x5d, 0x23, 0x21, 0xac, 0xa1, 0xac, 0x6f,
-	0xff, 0x8c, 0x41, 0x61, 0xc9, 0x08, 0x2e, 0x1f, 0x2b, 0x2e, 0x1f, 0x10, 0x0a, 0x18, 0xfe, 0x14,
-	0xa1, 0xa1, 0x6b, 0xd9, 0xfb, 0x36, 0xb3, 0xba, 0xbe, 0xde, 0x88, 0x44, 0x68, 0x47, 0x55, 0x4d,
-	0x85, 0x10, 0x1a, 0x4b, 0x79, 0xfe, 0x50, 0x06, 0xf6, 0x4e, 0x4a, 0x0b, 0x10, 0x19, 0x1f, 0x47,
-	0x91, 0xd1, 0x39, 0x70, 0xbd, 0x00, 0xc2, 0x41, 0x4d, 0x53, 0x3d, 0x51, 0xa1, 0x16, 0x43, 0x84,
-	0x47, 0x82, 0x24, 0x53, 0x8d, 0x8a, 0xb7, 0xd1, 0x6c, 0xd4, 0xcd, 0x71, 0xcf, 0x4f, 0x24, 0xe9,
-	0xa7, 0xac, 0x17, 0xb8, 0x5e, 0xb5, 0x12, 0x25, 0xe9, 0x43, 0xd5, 0xdd, 0x89, 0x80, 0x3b, 0x8c,
-	0xfa, 0xba, 0x48, 0x82, 0x3f, 0x44, 0x05, 0x95, 0x4c, 0x10, 0x7c, 0x2b, 0x24, 0x23, 0x3f, 0xce,
-	0x24, 0x4b, 0xb2, 0x41, 0x88, 0xd2, 0x88, 0x92, 0xe1, 0x9f, 0xa0, 0xfc, 0xde, 0xc0, 0xed, 0x3d,
-	0x8f, 0xaa, 0xc5, 0xcd, 0x78, 0x21, 0x55, 0x8e, 0xc3, 0xb9, 0xbe, 0x2d, 0xd7, 0x22, 0xa9, 0xaa,
-	0xfc, 0xc3, 0x90, 0x50, 0x09, 0xf3, 0x56, 0xd5, 0x3f, 0x19, 0x0e, 0x6c, 0xe7, 0x79, 0x37, 0x30,
-	0xbd, 0x3e, 0x0b, 0x4a, 0xcb, 0x71, 0xab, 0x2a, 0x25, 0xbb, 0x20, 0x50, 0xad, 0x6a, 0x02, 0x25,
-	0x34, 0xc9, 0xe2, 0x0d, 0xb4, 0x30, 0xdd, 0x3d, 0x30, 0xfd, 0x83, 0x12, 0x86, 0x38, 0x85, 0x0c,
-	0x27, 0xe0, 0x47, 0xa6, 0x7f, 0xa0, 0xb6, 0x3d, 0x86, 0x08, 0xd5, 0xe4, 0xbc, 0x81, 0x92, 0xb1,
-	0xc9, 0xac, 0xd2, 0x4d, 0x30, 0x01, 0xae, 0xa0, 0x40, 0xe5, 0x0a, 0x0a, 0x21, 0x34, 0x96, 0xe2,
-	0xaa, 0x6c, 0x44, 0x45, 0xfb, 0x78, 0xe7, 0xa2, 0xdb, 0x5f, 0xa3, 0x13, 0xdd, 0x44, 0xf3, 0xe7,
-	0xbb, 0x9a, 0x45, 0x91, 0xf1, 0x47, 0x89, 0x7e, 0x46, 0x64, 0xfc, 0x91, 0xde, 0xc9, 0xe8, 0x0c,
-	0xfc, 0x13, 0xcd, 0x2d, 0x1d, 0xbf, 0x34, 0x0f, 0x7d, 0xfb, 0x3b, 0xba, 0x1f, 0xb6, 0xfc, 0x0b,
-	0x7e, 0xd8, 0x8a, 0xfb, 0x75, 0x8d, 0x86, 0xf7, 0x91, 0xd8, 0xa5, 0x2e, 0x44, 0xd5, 0x22, 0x98,
-	0xda, 0x7a, 0x15, 0x1a, 0x0b, 0xd4, 0x3c, 0x82, 0xa3, 0xef, 0xd8, 0x3f, 0x63, 0x7c, 0xa3, 0xf6,
-	0xa2, 0x81, 0xda, 0x28, 0x85, 0x44, 0x86, 0x7f, 0xf5, 0xf2, 0x41, 0x42, 0x8d, 0xc6, 0x4a, 0xf8,
-	0x29, 0x2a, 0x8c, 0x06, 0x66, 0xb0, 0xef, 0x7a, 0xc3, 0xd2, 0x12, 0x38, 0xbb, 0xb6, 0x87, 0x3b,
-	0x52, 0x52, 0x37, 0x03, 0xb3, 0x4a, 0xa4, 0x9b, 0x29, 0xbe, 0xf2, 0xdc, 0x08, 0x20, 0x54, 0xc9,
-	0x70, 0x1d, 0xcd, 0x0f, 0xdc, 0x9e, 0x39, 0xe8, 0xee, 0x0f, 0xcc, 0xbe, 0x5f, 0xfa, 0x8f, 0x59,
-	0xd8, 0x54, 0xf0, 0x0e, 0xc0, 0x37, 0x39, 0xac, 0x36, 0x23, 0x86, 0x08, 0xd5, 0xe4, 0xf8, 0x11,
-	0x5a, 0x90, 0x61, 0x24, 0x7c, 0xec, 0x3f, 0x67, 0xc1, 0x43, 0xe0, 0x6c, 0xa4, 0x40, 0x7a, 0xd9,
-	0xb2, 0x1e, 0x7d, 0xc2, 0xcd, 0x74, 0x06, 0xfe, 0x1c, 0xdd, 0xb0, 0x1d, 0xd7, 0x62, 0xdd, 0xde,
-	0x81, 0xe9, 0xf4, 0x19, 0x3f, 0x9f, 0xc9, 0x2c, 0x44, 0x23, 0xf8, 0x3f, 0xc8, 0x6a, 0x20, 0x82,
-	0x33, 0xba, 0x29, 0xab, 0xa7, 0x86, 0x12, 0x9a, 0x64, 0xe1, 0x63, 0xa4, 0x95, 0x95, 0x6e, 0xe0,
-	0x99, 0xf6, 0x80, 0x79, 0xe2, 0xbc, 0xfe, 0x6b, 0x16, 0x0e, 0xec, 0xd3, 0x49, 0x68, 0xdc, 0x8e,
-	0x39, 0xbb, 0x82, 0x22, 0x0f, 0xeb, 0xde, 0xb9, 0x92, 0xa5, 0x49, 0x95, 0x47, 0x5c, 0xae, 0x8c,
-	0x7f, 0xcc, 0xbb, 0x48, 0xde, 0xe9, 0x5a, 0xb2, 0xa5, 0xbd, 0x2f, 0xfa, 0x45, 0x80, 0x54, 0x2a,
-	0x92, 0x63, 0x68, 0x18, 0xe1, 0x17, 0xa6, 0x68, 0xd6, 0x76, 0x0e, 0xcd, 0x81, 0x1d, 0xb5, 0xac,
-	0x1f, 0xbc, 0x0a, 0x0d, 0x44, 0xcd, 0xa3, 0xa6, 0x40, 0x45, 0x07, 0x01, 0x3f, 0xb5, 0x0e, 0x02,
-	0xc6, 0xbc, 0x83, 0xd0, 0x98, 0x34, 0xe2, 0xf1, 0xb4, 0xe2, 0xb8, 0x89, 0x5b, 0x41, 0x01, 0x4c,
-	0xc3, 0xb6, 0x3a, 0x6e, 0xf2, 0x46, 0x20, 0xb6, 0x35, 0x81, 0x12, 0x9a, 0x64, 0x7d, 0x98, 0xfd,
-	0x9b, 0xdf, 0x18, 0x33, 0xe4, 0xab, 0x14, 0x9a, 0x53, 0x29, 0x8e, 0x57, 0x17, 0x38, 0xff, 0x0c,
-	0x1c, 0x3f, 0x44, 0xf3, 0x81, 0x38, 0x77, 0x11, 0xcd, 0x07, 0x70, 0xe0, 0x80, 0xf1, 0xea, 0xe9,
-	0xee, 0xef, 0xfb, 0x2c, 0x80, 0xba, 0x95, 0x11, 0xd5, 0x53, 0x20, 0xaa, 0x7a, 0x8a, 0x21, 0xa1,
-	0x12, 0xc7, 0xef, 0xc9, 0xea, 0x95, 0x86, 0x63, 0x7b, 0xfb, 0xf2, 0xea, 0x15, 0x1d, 0x8a, 0x28,
-	0x62, 0x1f, 0xa1, 0xb9, 0x23, 0x66, 0x3e, 0x17, 0x7e, 0x29, 0x52, 0x06, 0xe4, 0x75, 0x0e, 0x4a,
-	0x9f, 0x14, 0xd1, 0x11, 0x01, 0x84, 0x2a, 0x99, 0xfc, 0xc6, 0x67, 0x28, 0x2f, 0xca, 0x09, 0xde,
-	0x41, 0x85, 0x9e, 0x3b, 0x76, 0x82, 0xf8, 0x52, 0xba, 0xac, 0x77, 0xc3, 0x20, 0xa9, 0xfe, 0x5e,
-	0x14, 0x80, 0x11, 0x55, 0x9d, 0x91, 0x04, 0x78, 0x1b, 0x2b, 0x45, 0xe4, 0xe7, 0x29, 0x34, 0x2b,
-	0x15, 0xf1, 0x23, 0x75, 0x39, 0xc8, 0x56, 0x3f, 0x38, 0x57, 0x25, 0xbf, 0xfe, 0xa2, 0xa9, 0x57,
-	0x48, 0x79, 0xe7, 0x3c, 0x34, 0x07, 0x63, 0xb1, 0x51, 0x59, 0x71, 0xe7, 0x04, 0x40, 0x15, 0x1d,
-	0x18, 0x11, 0x2a, 0x50, 0xf2, 0xf3, 0x2c, 0x5a, 0xd0, 0x93, 0x08, 0x4f, 0xd7, 0x63, 0xc7, 0x3e,
-	0x86, 0xc5, 0x24, 0xba, 0x94, 0x27, 0x8e, 0x7d, 0x0c, 0x69, 0xa6, 0xfc, 0x22, 0x34, 0x52, 0xfc,
-	0x00, 0x38, 0x4f, 0x1d, 0x00, 0x1f, 0x10, 0x0a, 0x18, 0xfe, 0x1c, 0xcd, 0x1e, 0xd9, 0x8e, 0xe5,
-	0x1e, 0xf9, 0xb0, 0x8c, 0x79, 0xfd, 0xe6, 0xf0, 0x85, 0x10, 0x80, 0xa5, 0x8a, 0xb4, 0x14, 0xb1,
-	0xd5, 0x76, 0xc9, 0x31, 0xa1, 0x91, 0x04, 0x6f, 0xa1, 0xdc, 0xc0, 0x76, 0xc6, 0xc7, 0xe0, 0x60,
-	0x89, 0x32, 0xfb, 0x53, 0x33, 0x08, 0x3c, 0x30, 0x77, 0x5f, 0x9a, 0x13, 0xcc, 0xf8, 0x92, 0xcd,
-	0x47, 0xfc, 0x92, 0xcd, 0xff, 0xc5, 0x9f, 0xa1, 0xbc, 0x65, 0x7a, 0x47, 0xb6, 0xb8, 0xd4, 0x5c,
-	0x61, 0x69, 0x45, 0x5a, 0x92, 0xd4, 0xf8, 0x82, 0x07, 0x43, 0x42, 0x25, 0x8e, 0x19, 0x9a, 0xdd,
-	0xf7, 0x18, 0xdb, 0xf3, 0x2d, 0x68, 0x92, 0xae, 0xb0, 0xf6, 0x63, 0x6e, 0x8d, 0x5f, 0x03, 0x36,
-	0x3d, 0xc6, 0xaa, 0x1d, 0xb8, 0x06, 0x48, 0x35, 0xf5, 0xc5, 0x72, 0x0c, 0xd7, 0x00, 0x49, 0xa3,
-	0x11, 0x09, 0x77, 0x51, 0xde, 0x61, 0x01, 0x9f, 0x25, 0x7f, 0xf5, 0x2c, 0xeb, 0x72, 0x96, 0x7c,
-	0x8b, 0x05, 0x62, 0x12, 0xa9, 0xa4, 0x56, 0x2f, 0x86, 0x7c, 0x0a, 0xc9, 0xa1, 0x92, 0x41, 0x7e,
-	0x91, 0x46, 0x85, 0xe8, 0x7c, 0x79, 0xf3, 0xe7, 0x1e, 0x39, 0xcc, 0xd3, 0x9f, 0xee, 0xa0, 0xe2,
-	0x03, 0x2a, 0xaf, 0x67, 0xa2, 0x90, 0x29, 0x84, 0xd0, 0x58, 0xca, 0x0d, 0xf4, 0x3d, 0x77, 0x3c,
-	0xd2, 0x9f, 0xed, 0xc0, 0x00, 0xa0, 0x09, 0x03, 0x0a, 0x21, 0x34, 0x96, 0xe2, 0x8f, 0x50, 0x66,
-	0x6c, 0x5b, 0x70, 0xd4, 0xb9, 0xea, 0x3b, 0xaf, 0x42, 0x23, 0xf3, 0x04, 0x22, 0x80, 0xa3, 0xd3,
-	0xd0, 0x98, 0x13, 0x0e, 0x67, 0x5b, 0x5a, 0xf9, 0xe4, 0x0c, 0xca, 0xe5, 0x5c, 0xb9, 0x6f, 0x5b,
-	0xf2, 0x4d, 0x0e, 0x94, 0xb7, 0x84, 0x72, 0x5f, 0x53, 0xee, 0x27, 0x95, 0xb7, 0xb8, 0x32, 0xc7,
-	0x7e, 0x9d, 0x42, 0xf3, 0x9a, 0x87, 0x7e, 0xfb, 0xbd, 0xd8, 0x46, 0x4b, 0xc2, 0x80, 0xed, 0x77,
-	0xe1, 0x03, 0xe5, 0x1b, 0x14, 0x3c, 0x9b, 0x80, 0xa4, 0xe9, 0x6f, 0x71, 0x5c, 0x3d, 0x9b, 0xe8,
-	0x20, 0xa1, 0x09, 0x0e, 0xe9, 0xa0, 0x39, 0x75, 0xe0, 0x78, 0x13, 0xe5, 0x8f, 0xf9, 0x20, 0x4a,
-	0x48, 0x37, 0xce, 0x79, 0x45, 0xdc, 0x76, 0x0a, 0x9a, 0x0a, 0x08, 0x18, 0x12, 0x2a, 0x61, 0xd2,
-	0x43, 0x39, 0xe0, 0xbf, 0xd1, 0x6d, 0x22, 0x91, 0x67, 0x16, 0xfe, 0xef, 0x3c, 0xf3, 0x17, 0x59,
-	0x34, 0x4b, 0x79, 0xd3, 0xec, 0x07, 0xf8, 0x47, 0x2a, 0xdb, 0xe5, 0xaa, 0xdf, 0xbd, 0x2a, 0xbd,
-	0xc5, 0xa7, 0x13, 0xbd, 0x7e, 0xc4, 0x97, 0xae, 0xf4, 0xb5, 0x2f, 0x5d, 0xd1, 0x27, 0x65, 0xae,
-	0xf1, 0x49, 0x71, 0x59, 0xca, 0xbe, 0x71, 0x59, 0xca, 0x5d, 0xbf, 0x2c, 0x45, 0x95, 0x32, 0x7f,
-	0x8d, 0x4a, 0xd9, 0x46, 0x4b, 0xfb, 0x9e, 0x3b, 0x84, 0x37, 0x32, 0xd7, 0x33, 0xbd, 0x13, 0xd9,
-	0x15, 0x40, 0xe9, 0xe6, 0x92, 0xdd, 0x48, 0xa0, 0x4a, 0x77, 0x02, 0x25, 0x34, 0xc9, 0x4a, 0xd6,
-	0xc4, 0xc2, 0x9b, 0xd5, 0x44, 0xfc, 0x09, 0x2a, 0x88, 0x8e, 0xd7, 0x71, 0xe1, 0xda, 0x95, 0xab,
-	0x7e, 0x87, 0xa7, 0x32, 0xc0, 0x5a, 0xae, 0x4a, 0x65, 0x72, 0xac, 0x3e, 0x3b, 0x22, 0x90, 0x7f,
-	0x4c, 0xa1, 0x02, 0x65, 0xfe, 0xc8, 0x75, 0x7c, 0xf6, 0x4d, 0x9d, 0x60, 0x0d, 0x65, 0x2d, 0x33,
-	0x30, 0xa5, 0xdb, 0xc1, 0xee, 0xf1, 0xb1, 0xda, 0x3d, 0x3e, 0x20, 0x14, 0x30, 0xfc, 0x29, 0xca,
-	0xf6, 0x5c, 0x4b, 0x1c, 0xfe, 0x92, 0x9e, 0x34, 0x1b, 0x9e, 0xe7, 0x7a, 0x35, 0xd7, 0x92, 0xd7,
-	0x0e, 0x4e, 0x52, 0x06, 0xf8, 0x80, 0x50, 0xc0, 0xc8, 0x3f, 0xa4, 0x50, 0xb1, 0xee, 0x1e, 0x39,
-	0x03, 0xd7, 0xb4, 0x76, 0x3c, 0xb7, 0xef, 0x31, 0xdf, 0xff, 0x46, 0x77, 0xff, 0x2e, 0x9a, 0x1d,
-	0xc3, 0xcb, 0x41, 0x74, 0xfb, 0x7f, 0x90, 0xbc, 0x06, 0x9d, 0x9f, 0x44, 0x3c, 0x33, 0xc4, 0x0f,
-	0x8d, 0x52, 0x59, 0xd9, 0x17, 0x63, 0x42, 0x23, 0x01, 0xf9, 0xfb, 0x0c, 0x2a, 0x5f, 0x6d, 0x08,
-	0x0f, 0xd1, 0xbc, 0x60, 0x76, 0xb5, 0xbf, 0x09, 0xac, 0x5e, 0x67, 0x0d, 0x70, 0x39, 0x83, 0x4b,
-	0xc1, 0x58, 0x8d, 0xd5, 0xa5, 0x20, 0x86, 0x08, 0xd5, 0xe4, 0x6f, 0xf4, 0x4e, 0xa9, 0x5d, 0xe5,
-	0x33, 0xdf, 0xfe, 0x2a, 0xdf, 0x41, 0x8b, 0xc2, 0x45, 0xa3, 0x07, 0xe5, 0x6c, 0x25, 0xb3, 0x9a,
-	0xab, 0x3e, 0xe4, 0xd9, 0x76, 0x4f, 0x34, 0xab, 0xd1, 0x53, 0xf2, 0x72, 0xec, 0xac, 0x02, 0x8c,
-	0xbc, 0xad, 0x38, 0x43, 0x13, 0x5c, 0xbc, 0x99, 0xb8, 0xe9, 0x89, 0x50, 0xff, 0x83, 0x6b, 0xde,
-	0xec, 0xb4, 0x9b, 0x1c, 0xc9, 0xa3, 0xec, 0x8e, 0xed, 0xf4, 0xc9, 0x47, 0x28, 0x57, 0x1b, 0xb8,
-	0x3e, 0x64, 0x1c, 0x8f, 0x99, 0xbe, 0xeb, 0xe8, 0xae, 0x24, 0x10, 0x75, 0xd4, 0x62, 0x48, 0xa8,
-	0xc4, 0xd7, 0x7e, 0x9b, 0x41, 0xf3, 0xda, 0x9f, 0x70, 0xf0, 0x9f, 0xa0, 0x7b, 0x8f, 0x1b, 0x9d,
-	0xce, 0xc6, 0x56, 0xa3, 0xbb, 0xfb, 0xe5, 0x4e, 0xa3, 0x5b, 0xdb, 0x7e, 0xd2, 0xd9, 0x6d, 0xd0,
-	0x6e, 0xad, 0xdd, 0xda, 0x6c, 0x6e, 0x15, 0x67, 0xca, 0xf7, 0x4f, 0xcf, 0x2a, 0x25, 0x4d, 0x23,
-	0xf9, 0xb7, 0x96, 0x3f, 0x44, 0x38, 0xa1, 0xde, 0x6c, 0xd5, 0x1b, 0x3f, 0x2d, 0xa6, 0xca, 0xb7,
-	0x4e, 0xcf, 0x2a, 0x45, 0x4d, 0x4b, 0x3c, 0xc1, 0xfd, 0x31, 0x7a, 0xeb, 0x22, 0xbb, 0xfb, 0x64,
-	0xa7, 0xbe, 0xb1, 0xdb, 0x28, 0xa6, 0xcb, 0xe5, 0xd3, 0xb3, 0xca, 0x9d, 0xf3, 0x4a, 0xd2, 0x05,
-	0x7f, 0x80, 0x6e, 0x25, 0x54, 0x69, 0xe3, 0xf3, 0x27, 0x8d, 0xce, 0x6e, 0x31, 0x53, 0xbe, 0x73,
-	0x7a, 0x56, 0xc1, 0x9a, 0x56, 0x54, 0x26, 0xd6, 0xd1, 0xed, 0x73, 0x1a, 0x9d, 0x9d, 0x76, 0xab,
-	0xd3, 0x28, 0x66, 0xcb, 0x77, 0x4f, 0xcf, 0x2a, 0x37, 0x13, 0x2a, 0x32, 0xab, 0xd4, 0xd0, 0x4a,
-	0x42, 0xa7, 0xde, 0xfe, 0xa2, 0xb5, 0xdd, 0xde, 0xa8, 0x77, 0x77, 0x68, 0x7b, 0x8b, 0x36, 0x3a,
-	0x9d, 0x62, 0xae, 0x6c, 0x9c, 0x9e, 0x55, 0xee, 0x69, 0xca, 0x17, 0x22, 0x7c, 0x0d, 0x2d, 0x27,
-	0x8c, 0xec, 0x34, 0x5b, 0x5b, 0xc5, 0x7c, 0xf9, 0xe6, 0xe9, 0x59, 0xe5, 0x86, 0xa6, 0xc7, 0xcf,
-	0xf2, 0xc2, 0xfe, 0xd5, 0xb6, 0xdb, 0x9d, 0x46, 0x71, 0xf6, 0xc2, 0xfe, 0xc1, 0x81, 0xaf, 0xfd,
-	0x5d, 0x0a, 0xe1, 0x8b, 0x7f, 0x35, 0xc3, 0x1f, 0xa0, 0x52, 0x64, 0xa4, 0xd6, 0x7e, 0xbc, 0xc3,
-	0xd7, 0xd9, 0x6c, 0xb7, 0xba, 0xad, 0x76, 0xab, 0x51, 0x9c, 0x49, 0xec, 0xaa, 0xa6, 0xd5, 0x72,
-	0x1d, 0x86, 0xdb, 0xe8, 0xee, 0x65, 0x9a, 0xdb, 0xcf, 0xde, 0x2f, 0xa6, 0xca, 0xeb, 0xa7, 0x67,
-	0x95, 0xdb, 0x17, 0x15, 0xb7, 0x9f, 0xbd, 0xff, 0xbb, 0xbf, 0xfa, 0xee, 0xe5, 0x82, 0x35, 0xde,
-	0x00, 0xe9, 0x4b, 0x7b, 0x0f, 0xdd, 0xd2, 0x0d, 0x3f, 0x6e, 0xec, 0x6e, 0xd4, 0x37, 0x76, 0x37,
-	0x8a, 0x33, 0xe2, 0x0c, 0x34, 0xea, 0x63, 0x16, 0x98, 0x90, 0x76, 0xbf, 0x87, 0x96, 0x13, 0x5f,
-	0xd1, 0x78, 0xda, 0xa0, 0x91, 0x47, 0xe9, 0xeb, 0x67, 0x87, 0xcc, 0xc3, 0xdf, 0x47, 0x58, 0x27,
-	0x6f, 0x6c, 0x7f, 0xb1, 0xf1, 0x65, 0xa7, 0x98, 0x2e, 0xdf, 0x3e, 0x3d, 0xab, 0x2c, 0x6b, 0xec,
-	0x8d, 0xc1, 0x91, 0x79, 0xe2, 0xaf, 0xfd, 0x73, 0x1a, 0x2d, 0xe8, 0xef, 0x46, 0xf8, 0xfb, 0xe8,
-	0xe6, 0x66, 0x73, 0x9b, 0x7b, 0xe2, 0x66, 0x5b, 0x9c, 0x00, 0x1f, 0x16, 0x67, 0xc4, 0x74, 0x3a,
-	0x95, 0xff, 0xc6, 0x7f, 0x84, 0x4a, 0xe7, 0xe8, 0xf5, 0x26, 0x6d, 0xd4, 0x76, 0xdb, 0xf4, 0xcb,
-	0x62, 0xaa, 0xfc, 0x16, 0xdf, 0x30, 0x5d, 0xa7, 0x6e, 0x7b, 0x90, 0x82, 0x4e, 0xf0, 0x27, 0xe8,
-	0xde, 0x39, 0xc5, 0xce, 0x97, 0x8f, 0xb7, 0x9b, 0xad, 0xcf, 0xc4, 0x7c, 0xe9, 0xf2, 0xdb, 0xa7,
-	0x67, 0x95, 0xbb, 0xba, 0x6e, 0x47, 0x3c, 0xc5, 0x71, 0xa8, 0x90, 0xc2, 0x8f, 0x50, 0xe5, 0x0a,
-	0xfd, 0x78, 0x01, 0x99, 0x32, 0x39, 0x3d, 0xab, 0xdc, 0xbf, 0xc4, 0x88, 0x5a, 0x47, 0x21, 0x85,
-	0x7f, 0x88, 0xee, 0x5c, 0x6e, 0x29, 0x8a, 0x8b, 0x4b, 0xf4, 0xd7, 0xfe, 0x35, 0x85, 0xe6, 0x54,
-	0xd5, 0xe3, 0x9b, 0xd6, 0xa0, 0xb4, 0xcd, 0x93, 0x44, 0xbd, 0xd1, 0x6d, 0xb5, 0xbb, 0x30, 0x8a,
-	0x36, 0x4d, 0xf1, 0x5a, 0x2e, 0xfc, 0xe4, 0x3e, 0xae, 0xd1, 0xb7, 0x1a, 0xad, 0x06, 0x6d, 0xd6,
-	0xa2, 0x13, 0x55, 0xec, 0x2d, 0xe6, 0x30, 0xcf, 0xee, 0xe1, 0xf7, 0xd1, 0xdd, 0xa4, 0xf1, 0xce,
-	0x93, 0xda, 0xa3, 0x68, 0x97, 0x60, 0x81, 0xda, 0x04, 0x9d, 0x71, 0xef, 0x00, 0x0e, 0xe6, 0x47,
-	0x09, 0xad, 0x66, 0xeb, 0xe9, 0xc6, 0x76, 0xb3, 0x2e, 0xb4, 0x32, 0xe5, 0xd2, 0xe9, 0x59, 0xe5,
-	0x96, 0xd2, 0x92, 0x0f, 0x1c, 0x5c, 0x6d, 0xed, 0x77, 0x29, 0xb4, 0xf2, 0xf5, 0xc5, 0x0b, 0x7f,
-	0x81, 0xde, 0x81, 0xfd, 0xba, 0x90, 0x0a, 0x64, 0xde, 0x12, 0x7b, 0xb8, 0xb1, 0xb3, 0xd3, 0x68,
-	0xd5, 0x8b, 0x33, 0xe5, 0xd5, 0xd3, 0xb3, 0xca, 0x83, 0xaf, 0x37, 0xb9, 0x31, 0x1a, 0x31, 0xc7,
-	0xba, 0xa6, 0xe1, 0xcd, 0x36, 0xdd, 0x6a, 0xec, 0x16, 0x53, 0xd7, 0x31, 0xbc, 0xe9, 0x7a, 0x7d,
-	0x16, 0x54, 0x1f, 0xbf, 0xf8, 0x6a, 0x65, 0xe6, 0xe5, 0x57, 0x2b, 0x33, 0x2f, 0x5e, 0xad, 0xa4,
-	0x5e, 0xbe, 0x5a, 0x49, 0xfd, 0xf5, 0xeb, 0x95, 0x99, 0xdf, 0xbc, 0x5e, 0x49, 0xbd, 0x7c, 0xbd,
-	0x32, 0xf3, 0x6f, 0xaf, 0x57, 0x66, 0x9e, 0x7d, 0xaf, 0x6f, 0x07, 0x07, 0xe3, 0xbd, 0x87, 0x3d,
-	0x77, 0xf8, 0xae, 0x7f, 0xe2, 0xf4, 0x82, 0x03, 0xdb, 0xe9, 0x6b, 0xbf, 0xf4, 0xff, 0xd9, 0xb1,
-	0x97, 0x87, 0x5f, 0x3f, 0xfc, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35, 0x55, 0x08, 0x69, 0xf0,
-	0x21, 0x00, 0x00,
+	// 3687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6c, 0x23, 0x47,
+	0x76, 0x56, 0x8b, 0xa4, 0x44, 0x95, 0x7e, 0x86, 0xaa, 0xf9, 0xa3, 0x39, 0x33, 0x6a, 0xa6, 0x3c,
+	0x4e, 0xc6, 0xda, 0xec, 0x78, 0x3d, 0xeb, 0x75, 0xbc, 0xf6, 0xc4, 0x8e, 0xf8, 0x23, 0x0d, 0x3d,
+	0x1a, 0x52, 0x2e, 0x4a, 0x63, 0x7b, 0x80, 0x80, 0x68, 0xb1, 0x4b, 0x54, 0x63, 0xc8, 0x6e, 0xa6,
+	0xbb, 0x39, 0x92, 0x16, 0xb9, 0x24, 0x06, 0x82, 0x85, 0x0e, 0x8b, 0x60, 0x4f, 0x41, 0xb0, 0x02,
+	0x16, 0x41, 0x80, 0x1c, 0x02, 0x04, 0xc8, 0x21, 0x01, 0x72, 0xcb, 0x25, 0x80, 0x2f, 0x01, 0x06,
+	0x06, 0x02, 0x24, 0x39, 0x34, 0xe0, 0xf1, 0x25, 0x51, 0x10, 0x20, 0xd0, 0x31, 0xa7, 0xa0, 0x5e,
+	0x55, 0x57, 0x57, 0x53, 0x92, 0xa3, 0xd9, 0x41, 0xe0, 0x4b, 0x4e, 0x62, 0x7d, 0xef, 0x7b, 0xaf,
+	0xab, 0xeb, 0xbd, 0x7a, 0xef, 0x55, 0xb5, 0xd0, 0xb5, 0xbe, 0xb3, 0xfd, 0xd6, 0xd0, 0xf7, 0x42,
+	0xaf, 0xeb, 0xf5, 0xdf, 0xda, 0x66, 0xc3, 0xbb, 0x30, 0xc0, 0xf9, 0x18, 0x2b, 0xcd, 0xb0, 0xfd,
+	0x50, 0x80, 0xa5, 0xd7, 0x7d, 0x36, 0xf4, 0x02, 0x41, 0xdf, 0x1e, 0xed, 0xbc, 0xd5, 0xf3, 0x7a,
+	0x1e, 0x0c, 0xe0, 0x97, 0x20, 0x91, 0xbf, 0xcb, 0xa0, 0xdc, 0x03, 0xd6, 0xef, 0x7b, 0xb8, 0x8a,
+	0x66, 0x6d, 0xf6, 0xcc, 0xe9, 0xb2, 0x8e, 0x6b, 0x0d, 0x58, 0xd1, 0x28, 0x1b, 0x77, 0x66, 0x2a,
+	0xe4, 0x38, 0x32, 0x91, 0x80, 0x9b, 0xd6, 0x80, 0x9d, 0x44, 0x66, 0x61, 0x7f, 0xd0, 0x7f, 0x9f,
+	0x24, 0x10, 0xa1, 0x9a, 0x9c, 0x1b, 0xe9, 0xf6, 0x1d, 0xe6, 0x86, 0xc2, 0xc8, 0x64, 0x62, 0x44,
+	0xc0, 0x29, 0x23, 0x09, 0x44, 0xa8, 0x26, 0xc7, 0x2d, 0xb4, 0x20, 0x8d, 0x3c, 0x63, 0x7e, 0xe0,
+	0x78, 0x6e, 0x31, 0x03, 0x76, 0xee, 0x1c, 0x47, 0xe6, 0xbc, 0x90, 0x3c, 0x16, 0x82, 0x93, 0xc8,
+	0xbc, 0xac, 0x99, 0x92, 0x28, 0xa1, 0x69, 0x16, 0x7e, 0x82, 0x2e, 0xb9, 0xa3, 0x41, 0xa7, 0xeb,
+	0xb9, 0x2e, 0xeb, 0x86, 0x8e, 0xe7, 0x06, 0xc5, 0x6c, 0xd9, 0xb8, 0x93, 0xab, 0xbc, 0x7d, 0x1c,
+	0x99, 0x0b, 0xee, 0x68, 0x50, 0x4d, 0x24, 0x27, 0x91, 0x79, 0x05, 0x4c, 0xa6, 0x61, 0xf2, 0xdf,
+	0x91, 0x99, 0x71, 0xdc, 0x90, 0x8e, 0xd1, 0xf1, 0x87, 0x68, 0x26, 0x74, 0x06, 0x2c, 0x08, 0xad,
+	0xc1, 0xb0, 0x98, 0x2b, 0x1b, 0x77, 0x32, 0x95, 0xf2, 0x71, 0x64, 0x26, 0xe0, 0x49, 0x64, 0x5e,
+	0x02, 0x83, 0x0a, 0x21, 0x34, 0x91, 0xe2, 0x1f, 0xa3, 0xfc, 0x0e, 0xb3, 0xc2, 0x91, 0xcf, 0x82,
+	0xe2, 0x54, 0x39, 0x73, 0x67, 0xa6, 0x72, 0xeb, 0x38, 0x32, 0x15, 0x76, 0x12, 0x99, 0xf3, 0xa0,
+	0x2d, 0x01, 0x42, 0x95, 0x88, 0xfc, 0xb5, 0x81, 0xa6, 0x1e, 0x30, 0xcb, 0x66, 0x3e, 0x5e, 0x41,
+	0xd9, 0xf0, 0x60, 0x28, 0xbc, 0xb6, 0x70, 0xef, 0xea, 0xdd, 0x38, 0x1e, 0xee, 0x3e, 0x62, 0x41,
+	0x60, 0xf5, 0xd8, 0xe6, 0xc1, 0x90, 0x55, 0xae, 0x1d, 0x47, 0x26, 0xd0, 0x4e, 0x22, 0x13, 0x89,
+	0x29, 0x1d, 0x0c, 0x19, 0xa1, 0x80, 0x61, 0x1b, 0xcd, 0x76, 0xbd, 0xc1, 0xd0, 0x67, 0x01, 0x2c,
+	0xf9, 0x24, 0x58, 0xba, 0x79, 0xca, 0x52, 0x35, 0xe1, 0x54, 0x6e, 0x1f, 0x47, 0xa6, 0xae, 0x74,
+	0x12, 0x99, 0x8b, 0xc2, 0x1d, 0x09, 0x46, 0xa8, 0xce, 0x20, 0xbf, 0x30, 0xd0, 0x7c, 0xb5, 0x3f,
+	0x0a, 0x42, 0xe6, 0x57, 0x3d, 0x77, 0xc7, 0xe9, 0xe1, 0x87, 0x68, 0x7a, 0xc7, 0xeb, 0xdb, 0xcc,
+	0x0f, 0x8a, 0x46, 0x39, 0x73, 0x67, 0xf6, 0x5e, 0x21, 0x79, 0xe6, 0x2a, 0x08, 0x2a, 0xe6, 0x97,
+	0x91, 0x39, 0x71, 0x1c, 0x99, 0x31, 0xf1, 0x24, 0x32, 0xe7, 0xc4, 0xa2, 0xc0, 0x98, 0xd0, 0x58,
+	0xc0, 0xbd, 0x11, 0xb0, 0xae, 0xe7, 0xda, 0x96, 0x7f, 0x00, 0xaf, 0x90, 0x17, 0xde, 0x50, 0xa0,
+	0xf2, 0x86, 0x42, 0x08, 0x4d, 0xa4, 0xe4, 0xcf, 0x73, 0x68, 0x4a, 0x3c, 0x14, 0xdf, 0x45, 0x93,
+	0x8e, 0x2d, 0xb7, 0xc1, 0xd2, 0x8b, 0xc8, 0x9c, 0x6c, 0xd4, 0x8e, 0x23, 0x73, 0xd2, 0xb1, 0x4f,
+	0x22, 0x33, 0x0f, 0x26, 0x1c, 0x9b, 0xfc, 0xfc, 0xf9, 0xed, 0xc9, 0x46, 0x8d, 0x4e, 0x3a, 0x36,
+	0xbe, 0x8b, 0x72, 0x7d, 0x6b, 0x9b, 0xf5, 0x65, 0xd0, 0x17, 0x8f, 0x23, 0x53, 0x00, 0x27, 0x91,
+	0x39, 0x0b, 0x7c, 0x18, 0x11, 0x2a, 0x50, 0xfc, 0x01, 0x9a, 0xf1, 0x99, 0x65, 0x77, 0x3c, 0xb7,
+	0x7f, 0x00, 0x01, 0x9e, 0xaf, 0x2c, 0x71, 0xcf, 0x73, 0xb0, 0xe5, 0xf6, 0xf9, 0x4c, 0x17, 0x40,
+	0x2d, 0x06, 0x08, 0x55, 0x32, 0xdc, 0x41, 0xd8, 0xe9, 0xb9, 0x9e, 0xcf, 0x3a, 0x43, 0xe6, 0x0f,
+	0x9c, 0x20, 0x50, 0x41, 0x9d, 0xaf, 0xfc, 0xe0, 0x38, 0x32, 0x17, 0x85, 0x74, 0x23, 0x11, 0x9e,
+	0x44, 0xe6, 0x75, 0x31, 0xeb, 0x71, 0x09, 0xa1, 0xa7, 0xd9, 0xf8, 0x21, 0x9a, 0x97, 0x0f, 0xb0,
+	0x59, 0x9f, 0x85, 0x0c, 0x42, 0x3b, 0x5f, 0xf9, 0xf5, 0xe3, 0xc8, 0x9c, 0x13, 0x82, 0x1a, 0xe0,
+	0x27, 0x91, 0x89, 0x35, 0xb3, 0x02, 0x24, 0x34, 0xc5, 0xc1, 0x36, 0xba, 0x62, 0x3b, 0x81, 0xb5,
+	0xdd, 0x67, 0x9d, 0x90, 0x0d, 0x86, 0x1d, 0xc7, 0xb5, 0xd9, 0x3e, 0xc4, 0x3b, 0xb7, 0x79, 0xef,
+	0x38, 0x32, 0xb1, 0x94, 0x6f, 0xb2, 0xc1, 0xb0, 0x21, 0xa4, 0x27, 0x91, 0x59, 0x14, 0xb9, 0xe6,
+	0x94, 0x88, 0xd0, 0x33, 0xf8, 0xf8, 0x1e, 0x9a, 0x1a, 0x5a, 0xa3, 0x80, 0xd9, 0xc5, 0x69, 0xb0,
+	0x5b, 0x3a, 0x8e, 0x4c, 0x89, 0xa8, 0x80, 0x11, 0x43, 0x42, 0x25, 0x8e, 0x77, 0xd1, 0xc2, 0x76,
+	0xdf, 0xeb, 0x3e, 0xed, 0x74, 0x77, 0x47, 0xee, 0x53, 0xc7, 0xed, 0x15, 0xf3, 0x10, 0xf7, 0xd7,
+	0x93, 0x18, 0xac, 0x70, 0x79, 0x55, 0x8a, 0x45, 0x0e, 0xda, 0xd6, 0x21, 0x95, 0x83, 0x52, 0x28,
+	0xa1, 0x69, 0x16, 0x0f, 0x73, 0x91, 0x27, 0x83, 0x62, 0x61, 0x3c, 0xcc, 0x6b, 0x20, 0x48, 0xc2,
+	0x5c, 0x12, 0xd5, 0xac, 0xc5, 0x98, 0xd0, 0x58, 0x40, 0xfe, 0x7e, 0x0a, 0x4d, 0x09, 0x25, 0x5c,
+	0x51, 0x61, 0x3a, 0x57, 0xb9, 0xc7, 0x0d, 0xfc, 0x6b, 0x64, 0xe6, 0x85, 0xac, 0x51, 0x3b, 0x2f,
+	0x6c, 0x7f, 0xfa, 0xfc, 0xb6, 0xa1, 0x85, 0xee, 0x32, 0xca, 0x6a, 0xe9, 0x1a, 0xd2, 0x84, 0x6b,
+	0x0d, 0x92, 0x34, 0xe1, 0x42, 0x8a, 0x06, 0x0c, 0xdf, 0x47, 0x33, 0x96, 0x6d, 0xf3, 0xed, 0xcc,
+	0x82, 0x62, 0x06, 0x12, 0x16, 0x0f, 0xdb, 0x04, 0x54, 0x19, 0x4b, 0x22, 0x84, 0x26, 0x32, 0xfc,
+	0xbb, 0xe9, 0x24, 0x93, 0x1d, 0x4f, 0x57, 0xaf, 0x96, 0x5d, 0xf8, 0x9e, 0xea, 0x32, 0x5f, 0x16,
+	0x9f, 0x9c, 0xd8, 0xba, 0x7c, 0x4f, 0x71, 0x50, 0x96, 0x1e, 0xb1, 0xa7, 0x62, 0x80, 0x50, 0x25,
+	0xc3, 0x6b, 0x68, 0x6e, 0x60, 0xed, 0x77, 0x02, 0xf6, 0x7b, 0x23, 0xe6, 0x76, 0x19, 0x44, 0x67,
+	0x46, 0xcc, 0x62, 0x60, 0xed, 0xb7, 0x25, 0xac, 0x66, 0xa1, 0x61, 0x84, 0xea, 0x0c, 0x5c, 0x41,
+	0xc8, 0x71, 0x43, 0xdf, 0xb3, 0x47, 0x5d, 0xe6, 0xcb, 0x60, 0x84, 0x1a, 0x98, 0xa0, 0xaa, 0x06,
+	0x26, 0x10, 0xa1, 0x9a, 0x1c, 0xf7, 0x50, 0x1e, 0x76, 0x49, 0xc7, 0xb1, 0x21, 0x24, 0xb3, 0x95,
+	0x75, 0xe9, 0xdc, 0x69, 0x88, 0x77, 0xf0, 0x6d, 0xfc, 0x93, 0xc7, 0x0c, 0xb0, 0x1b, 0xb6, 0x5a,
+	0x7d, 0x39, 0xe6, 0x19, 0x2a, 0xa6, 0xfd, 0x69, 0xf2, 0x93, 0xc6, 0x7c, 0xfc, 0xfb, 0xa8, 0x14,
+	0x3c, 0x75, 0x86, 0x9d, 0xf8, 0xd9, 0xbc, 0xaa, 0x75, 0x7c, 0x36, 0xf0, 0x9e, 0x59, 0xfd, 0xa0,
+	0x38, 0x03, 0x93, 0xff, 0xf0, 0x38, 0x32, 0x8b, 0x9c, 0xd5, 0xd0, 0x48, 0x54, 0x72, 0x4e, 0x22,
+	0x73, 0x49, 0x64, 0xd4, 0x73, 0x08, 0x84, 0x9e, 0xab, 0x8b, 0xf7, 0xd1, 0x6b, 0xcc, 0xed, 0xfa,
+	0x07, 0x43, 0x78, 0xec, 0xd0, 0x0a, 0x82, 0x3d, 0xcf, 0xb7, 0x3b, 0xa1, 0xf7, 0x94, 0xb9, 0x45,
+	0x04, 0x41, 0x7d, 0xff, 0x38, 0x32, 0xaf, 0x27, 0xa4, 0x0d, 0xc9, 0xd9, 0xe4, 0x94, 0x93, 0xc8,
+	0xbc, 0x05, 0xcf, 0x3e, 0x47, 0x4e, 0xe8, 0x79, 0x9a, 0xe4, 0x0f, 0x0d, 0x94, 0x83, 0xc5, 0xe0,
+	0x79, 0x43, 0x94, 0x0f, 0x99, 0xec, 0x21, 0x6f, 0x08, 0xe4, 0x54, 0xa1, 0x91, 0x38, 0xae, 0xa3,
+	0xdc, 0x8e, 0xd3, 0x67, 0x41, 0x71, 0x12, 0xf6, 0x32, 0xd6, 0x4a, 0x96, 0xd3, 0x67, 0x0d, 0x77,
+	0xc7, 0xab, 0xdc, 0x90, 0xbb, 0x59, 0x10, 0xd5, 0x5e, 0xe2, 0x23, 0x42, 0x05, 0x48, 0x7e, 0x6a,
+	0xa0, 0x59, 0x98, 0xc4, 0xd6, 0xd0, 0xb6, 0x42, 0xf6, 0x5d, 0x4e, 0xe5, 0xbf, 0xe6, 0x51, 0x3e,
+	0x56, 0x50, 0x09, 0xc1, 0xb8, 0x40, 0x42, 0x58, 0x46, 0xd9, 0xc0, 0xf9, 0x09, 0x83, 0x12, 0x96,
+	0x11, 0x5c, 0x3e, 0x56, 0x5c, 0x3e, 0x20, 0x14, 0x30, 0xfc, 0x11, 0x42, 0x03, 0xcf, 0x76, 0x76,
+	0x1c, 0x66, 0x77, 0x02, 0xbd, 0x5b, 0x8a, 0xd1, 0xb6, 0xaa, 0xcf, 0x0a, 0x21, 0x34, 0x91, 0xf2,
+	0xfc, 0xa1, 0x0c, 0x6c, 0x1f, 0x14, 0xe7, 0x60, 0x67, 0xdc, 0x8f, 0x77, 0x46, 0x7b, 0xd7, 0xf3,
+	0x43, 0xd8, 0x0e, 0xea, 0x31, 0x95, 0x03, 0xb5, 0xd5, 0x12, 0x88, 0xf0, 0x9d, 0x20, 0xc9, 0x54,
+	0xa3, 0xe2, 0x75, 0x34, 0x1d, 0xb7, 0x9c, 0x3c, 0xf2, 0x53, 0x49, 0xfa, 0x31, 0xeb, 0x86, 0x9e,
+	0x5f, 0x29, 0xc7, 0x49, 0xfa, 0x99, 0x6a, 0x41, 0xc5, 0x86, 0x7b, 0x16, 0x37, 0x9f, 0xb1, 0x04,
+	0xbf, 0x8f, 0xf2, 0x2a, 0x99, 0x20, 0x78, 0x57, 0x48, 0x46, 0x41, 0x92, 0x49, 0x16, 0x64, 0x2b,
+	0x12, 0xa7, 0x11, 0x25, 0xc3, 0x1f, 0xa3, 0x29, 0xa8, 0x1f, 0x71, 0xb5, 0xb8, 0x3c, 0x56, 0x90,
+	0xc0, 0xaf, 0xb7, 0xe4, 0x5c, 0x24, 0x55, 0x35, 0x1a, 0x30, 0x24, 0x54, 0xc2, 0xbc, 0x9f, 0x0e,
+	0x0e, 0x06, 0x7d, 0xc7, 0x7d, 0xda, 0x09, 0x2d, 0xbf, 0xc7, 0xc2, 0xe2, 0x62, 0xd2, 0x4f, 0x4b,
+	0xc9, 0x26, 0x08, 0x54, 0x2d, 0x4b, 0xa1, 0x84, 0xa6, 0x59, 0xbc, 0xcb, 0x17, 0xa6, 0x3b, 0xbb,
+	0x56, 0xb0, 0x5b, 0xc4, 0xb0, 0x4f, 0x21, 0xc3, 0x09, 0xf8, 0x81, 0x15, 0xec, 0xaa, 0x65, 0x4f,
+	0x20, 0x42, 0x35, 0x39, 0x6f, 0xd5, 0xe4, 0xde, 0x64, 0x76, 0xf1, 0x32, 0x98, 0x80, 0x50, 0x50,
+	0xa0, 0x0a, 0x05, 0x85, 0x10, 0x9a, 0x48, 0x71, 0x45, 0xb6, 0xbc, 0xa2, 0x51, 0xbd, 0x76, 0x3a,
+	0xec, 0x2f, 0xd0, 0xf3, 0xae, 0xa2, 0xd9, 0xf1, 0xfe, 0x69, 0x5e, 0x64, 0xfc, 0x61, 0xaa, 0x73,
+	0x12, 0x19, 0x7f, 0xa8, 0xf7, 0x4c, 0x3a, 0x03, 0x7f, 0xac, 0x85, 0xa5, 0x1b, 0x14, 0x67, 0xe1,
+	0x70, 0xf1, 0xa6, 0x1e, 0x87, 0xcd, 0xe0, 0x54, 0x1c, 0x36, 0x93, 0x43, 0x85, 0x46, 0xc3, 0x3b,
+	0x48, 0xac, 0x52, 0x07, 0x76, 0xd5, 0x3c, 0x98, 0x5a, 0x7b, 0x11, 0x99, 0x73, 0xd4, 0xda, 0x03,
+	0xd7, 0xb7, 0x9d, 0x9f, 0x30, 0xbe, 0x50, 0xdb, 0xf1, 0x40, 0x2d, 0x94, 0x42, 0x62, 0xc3, 0x3f,
+	0x7f, 0x7e, 0x3b, 0xa5, 0x46, 0x13, 0x25, 0xfc, 0x18, 0xe5, 0x87, 0x7d, 0x2b, 0xdc, 0xf1, 0xfc,
+	0x41, 0x71, 0x01, 0x82, 0x5d, 0x5b, 0xc3, 0x0d, 0x29, 0xa9, 0x59, 0xa1, 0x55, 0x21, 0x32, 0xcc,
+	0x14, 0x5f, 0x45, 0x6e, 0x0c, 0x10, 0xaa, 0x64, 0x67, 0xb4, 0x54, 0x57, 0xfe, 0x8f, 0x5a, 0xaa,
+	0x1a, 0x9a, 0xed, 0x7b, 0x5d, 0xab, 0xdf, 0xd9, 0xe9, 0x5b, 0xbd, 0xa0, 0xf8, 0x6f, 0xd3, 0xe0,
+	0x3e, 0x88, 0x43, 0xc0, 0x57, 0x39, 0xac, 0x96, 0x3d, 0x81, 0x08, 0xd5, 0xe4, 0xf8, 0x01, 0x9a,
+	0x93, 0x1b, 0x56, 0x44, 0xf3, 0xbf, 0x4f, 0x43, 0x2c, 0x42, 0x14, 0x48, 0x81, 0x8c, 0xe7, 0x45,
+	0x7d, 0x9f, 0x8b, 0x80, 0xd6, 0x19, 0xf8, 0x13, 0x74, 0xc9, 0x71, 0x3d, 0x9b, 0x75, 0xba, 0xbb,
+	0x96, 0xdb, 0x63, 0x3c, 0x12, 0x8e, 0xa7, 0x61, 0xdf, 0xc3, 0x2b, 0x82, 0xac, 0x0a, 0xa2, 0x66,
+	0xa0, 0x5e, 0x31, 0x85, 0x12, 0x9a, 0x66, 0xe1, 0x7d, 0xa4, 0x15, 0xb0, 0x4e, 0xe8, 0x5b, 0x4e,
+	0x9f, 0xf9, 0x22, 0x32, 0xfe, 0x63, 0x1a, 0x42, 0xe3, 0xa3, 0xe3, 0xc8, 0xbc, 0x9a, 0x70, 0x36,
+	0x05, 0x45, 0x86, 0xc5, 0x8d, 0xb1, 0xe2, 0xa8, 0x49, 0x55, 0xec, 0x9d, 0xad, 0x8c, 0xdf, 0xe5,
+	0xfd, 0x2a, 0xef, 0xde, 0x6d, 0xd9, 0xa6, 0xdf, 0x14, 0x9d, 0x29, 0x40, 0x2a, 0xe9, 0xc9, 0x31,
+	0xb4, 0xa6, 0xf0, 0x0b, 0x53, 0x34, 0xed, 0xb8, 0xcf, 0xac, 0xbe, 0x13, 0xb7, 0xe1, 0xef, 0xbd,
+	0x88, 0x4c, 0x44, 0xad, 0xbd, 0x86, 0x40, 0x45, 0xaf, 0x02, 0x3f, 0xb5, 0x5e, 0x05, 0xc6, 0xbc,
+	0x57, 0xd1, 0x98, 0x34, 0xe6, 0xf1, 0x04, 0xe6, 0x7a, 0xa9, 0x93, 0x4e, 0x1e, 0x4c, 0xc3, 0xb2,
+	0xba, 0x5e, 0xfa, 0x94, 0x23, 0x96, 0x35, 0x85, 0x12, 0x9a, 0x66, 0xbd, 0x9f, 0xfd, 0x93, 0x5f,
+	0x9a, 0x13, 0xe4, 0x6b, 0x03, 0xcd, 0xa8, 0x64, 0xca, 0xeb, 0x18, 0xf8, 0x3f, 0x03, 0xee, 0x87,
+	0xbc, 0xb1, 0x2b, 0xfc, 0x2e, 0xf2, 0xc6, 0x2e, 0x38, 0x1c, 0x30, 0x5e, 0xa7, 0xbd, 0x9d, 0x9d,
+	0x80, 0x85, 0x50, 0x21, 0x33, 0xa2, 0x4e, 0x0b, 0x44, 0xd5, 0x69, 0x31, 0x24, 0x54, 0xe2, 0xf8,
+	0x6d, 0x59, 0x27, 0x27, 0xc1, 0x6d, 0xb7, 0xce, 0xae, 0x93, 0xb1, 0x53, 0x40, 0xc4, 0xdb, 0xd9,
+	0x3d, 0x66, 0x3d, 0x15, 0x71, 0x29, 0x92, 0x13, 0x54, 0x10, 0x0e, 0xca, 0x98, 0x14, 0xfb, 0x30,
+	0x06, 0x08, 0x55, 0x32, 0xf9, 0x8e, 0x4f, 0xd0, 0x94, 0x28, 0x5c, 0x78, 0x03, 0xe5, 0xbb, 0xde,
+	0xc8, 0x0d, 0x93, 0x83, 0xf6, 0xa2, 0xde, 0x77, 0x83, 0xa4, 0xf2, 0x6b, 0xf1, 0x56, 0x8f, 0xa9,
+	0xca, 0x47, 0x12, 0xe0, 0x0d, 0xb3, 0x14, 0x91, 0x2f, 0x0c, 0x34, 0x2d, 0x15, 0xf1, 0x03, 0x75,
+	0x0c, 0xc9, 0x56, 0xde, 0x1b, 0xab, 0xc7, 0xdf, 0x7e, 0x78, 0xd6, 0x6b, 0xb1, 0x3c, 0x47, 0x3f,
+	0xb3, 0xfa, 0x23, 0xb1, 0x50, 0x59, 0x71, 0x8e, 0x06, 0x40, 0x95, 0x37, 0x18, 0x11, 0x2a, 0x50,
+	0xf2, 0x45, 0x16, 0xcd, 0xe9, 0xe9, 0x8a, 0x17, 0x86, 0x91, 0xeb, 0xec, 0xc3, 0x64, 0x52, 0xfd,
+	0xd0, 0x96, 0xeb, 0xec, 0x43, 0x42, 0x2b, 0x7d, 0x19, 0x99, 0x06, 0x77, 0x00, 0xe7, 0x29, 0x07,
+	0xf0, 0x01, 0xa1, 0x80, 0xe1, 0x4f, 0xd0, 0xf4, 0x9e, 0xe3, 0xda, 0xde, 0x5e, 0x00, 0xd3, 0x98,
+	0xd5, 0xcf, 0x28, 0x9f, 0x0a, 0x01, 0x58, 0x2a, 0x4b, 0x4b, 0x31, 0x5b, 0x2d, 0x97, 0x1c, 0x13,
+	0x1a, 0x4b, 0xf0, 0x1a, 0xca, 0xf5, 0x1d, 0x77, 0xb4, 0x0f, 0x01, 0x96, 0x2a, 0xe8, 0x9f, 0x59,
+	0x61, 0xe8, 0x83, 0xb9, 0x9b, 0xd2, 0x9c, 0x60, 0xaa, 0x17, 0x86, 0x11, 0xbf, 0x38, 0xe0, 0x7f,
+	0xf1, 0x43, 0x34, 0x65, 0x5b, 0xfe, 0x9e, 0x23, 0x8e, 0x4f, 0xe7, 0x58, 0x5a, 0x92, 0x96, 0x24,
+	0x35, 0x39, 0x4a, 0xc2, 0x90, 0x50, 0x89, 0x63, 0x86, 0xa6, 0x77, 0x7c, 0xc6, 0xb6, 0x03, 0xbb,
+	0x98, 0x3b, 0xdf, 0xda, 0xbb, 0xdc, 0x1a, 0x3f, 0x70, 0xac, 0xfa, 0x8c, 0x55, 0xda, 0x70, 0xe0,
+	0x90, 0x6a, 0xc9, 0x05, 0x95, 0x18, 0xc3, 0x81, 0x43, 0xd2, 0x68, 0x4c, 0xc2, 0x1d, 0x34, 0xe5,
+	0xb2, 0x70, 0x3b, 0x10, 0xc9, 0xe4, 0x9c, 0xa7, 0xdc, 0x93, 0x4f, 0x99, 0x6a, 0xb2, 0x50, 0x3c,
+	0x44, 0x2a, 0xa9, 0xd9, 0x8b, 0x21, 0x7f, 0x84, 0xe4, 0x50, 0xc9, 0x20, 0x7f, 0x34, 0x89, 0xf2,
+	0xb1, 0x7f, 0x79, 0x9b, 0xe9, 0xed, 0xb9, 0xcc, 0xd7, 0x6f, 0x32, 0xa1, 0xb7, 0x00, 0x54, 0x1e,
+	0x04, 0x45, 0xc9, 0x54, 0x08, 0xa1, 0x89, 0x94, 0x1b, 0xe8, 0xf9, 0xde, 0x68, 0xa8, 0xdf, 0x62,
+	0x82, 0x01, 0x40, 0x53, 0x06, 0x14, 0x42, 0x68, 0x22, 0xc5, 0x1f, 0xa0, 0xcc, 0xc8, 0xb1, 0xc1,
+	0xd5, 0xb9, 0xca, 0x9b, 0x2f, 0x22, 0x33, 0xb3, 0x05, 0x3b, 0x80, 0xa3, 0x27, 0x91, 0x39, 0x23,
+	0x02, 0xce, 0xb1, 0xb5, 0x42, 0xcd, 0x19, 0x94, 0xcb, 0xb9, 0x72, 0xcf, 0xb1, 0x8b, 0xd9, 0x44,
+	0x79, 0x4d, 0x28, 0xf7, 0x34, 0xe5, 0x5e, 0x5a, 0x79, 0x8d, 0x2b, 0x73, 0xec, 0x17, 0x06, 0x9a,
+	0xd5, 0x22, 0xf4, 0xd5, 0xd7, 0x62, 0x1d, 0x2d, 0x08, 0x03, 0x4e, 0xd0, 0x81, 0x17, 0x2c, 0x4e,
+	0x26, 0x57, 0x41, 0x20, 0x69, 0x04, 0x6b, 0x1c, 0x57, 0x57, 0x41, 0x3a, 0x48, 0x68, 0x8a, 0x43,
+	0xda, 0x68, 0x46, 0x39, 0x1c, 0xaf, 0xa2, 0xa9, 0x7d, 0x3e, 0x88, 0x13, 0xd2, 0xa5, 0xb1, 0xa8,
+	0x48, 0x1a, 0x5c, 0x41, 0x53, 0x1b, 0x02, 0x86, 0x84, 0x4a, 0x98, 0x74, 0x51, 0x0e, 0xf8, 0x2f,
+	0x75, 0x6e, 0x49, 0xe5, 0x99, 0xb9, 0xff, 0x3d, 0xcf, 0xfc, 0x41, 0x16, 0x4d, 0x53, 0xde, 0x9e,
+	0x07, 0x21, 0xfe, 0x91, 0xca, 0x76, 0xb9, 0xca, 0x1b, 0xe7, 0xa5, 0xb7, 0xc4, 0x3b, 0xf1, 0x3d,
+	0x4b, 0x72, 0xbc, 0x9b, 0xbc, 0xf0, 0xf1, 0x2e, 0x7e, 0xa5, 0xcc, 0x05, 0x5e, 0x29, 0x29, 0x4b,
+	0xd9, 0x97, 0x2e, 0x4b, 0xb9, 0x8b, 0x97, 0xa5, 0xb8, 0x52, 0x4e, 0x5d, 0xa0, 0x52, 0xb6, 0xd0,
+	0xc2, 0x8e, 0xef, 0x0d, 0xe0, 0xde, 0xcf, 0xf3, 0xf9, 0xad, 0xec, 0x74, 0x52, 0xba, 0xb9, 0x64,
+	0x33, 0x16, 0xa8, 0xd2, 0x9d, 0x42, 0x09, 0x4d, 0xb3, 0xd2, 0x35, 0x31, 0xff, 0x72, 0x35, 0x11,
+	0x7f, 0x88, 0xf2, 0xa2, 0x37, 0x75, 0x3d, 0x38, 0xe0, 0xe5, 0x2a, 0xaf, 0xf3, 0x54, 0x06, 0x58,
+	0xd3, 0x53, 0xa9, 0x4c, 0x8e, 0xd5, 0x6b, 0xc7, 0x04, 0xf2, 0x57, 0x06, 0xca, 0x53, 0x16, 0x0c,
+	0x3d, 0x37, 0x60, 0xbf, 0x6a, 0x10, 0x2c, 0xa3, 0xac, 0x6d, 0x85, 0x56, 0x71, 0x32, 0x59, 0x3d,
+	0x3e, 0x56, 0xab, 0xc7, 0x07, 0x84, 0x02, 0x86, 0x3f, 0x42, 0xd9, 0xae, 0x67, 0x0b, 0xe7, 0x2f,
+	0xe8, 0x49, 0xb3, 0xee, 0xfb, 0x9e, 0x5f, 0xf5, 0x6c, 0x79, 0xc0, 0xe1, 0x24, 0x65, 0x80, 0x0f,
+	0x08, 0x05, 0x8c, 0xfc, 0x67, 0x16, 0xcd, 0xd5, 0x58, 0x3f, 0xb4, 0xfe, 0x3f, 0x72, 0xbf, 0xf3,
+	0xc8, 0xd5, 0x83, 0x2f, 0xff, 0xf2, 0xc1, 0x87, 0x57, 0x11, 0x82, 0x23, 0x95, 0x68, 0xff, 0x45,
+	0xf8, 0xfe, 0x06, 0xcf, 0xe4, 0x80, 0xa6, 0x0e, 0x82, 0x0a, 0x51, 0x56, 0x12, 0x12, 0x7e, 0x82,
+	0x66, 0xba, 0xbb, 0xac, 0xfb, 0x34, 0x18, 0x0d, 0x82, 0x22, 0x82, 0xc4, 0x7b, 0x5d, 0xbf, 0x8b,
+	0xee, 0x87, 0x56, 0x55, 0xca, 0x2b, 0xaf, 0xcb, 0x04, 0x9c, 0x68, 0x24, 0x57, 0xa8, 0x12, 0x21,
+	0x34, 0x11, 0x12, 0x0f, 0xcd, 0xa7, 0x0c, 0xf0, 0x15, 0xe7, 0xbb, 0x0f, 0x22, 0x6e, 0x5e, 0xac,
+	0x38, 0x1f, 0xab, 0x15, 0xe7, 0x03, 0x42, 0x01, 0xe3, 0x41, 0x10, 0x84, 0xbe, 0xe7, 0xf6, 0xe4,
+	0xde, 0x80, 0x20, 0x10, 0x88, 0x0a, 0x02, 0x31, 0x24, 0x54, 0xe2, 0xe4, 0x1f, 0x0c, 0xf9, 0xc4,
+	0x57, 0xdd, 0x96, 0xf7, 0x51, 0xc6, 0x1b, 0xc6, 0x97, 0x68, 0x8b, 0x63, 0xeb, 0xd1, 0x1a, 0x56,
+	0xae, 0xcb, 0x95, 0xe0, 0x2c, 0x65, 0xc9, 0x1b, 0x12, 0xca, 0x81, 0x57, 0xdf, 0xa8, 0xff, 0x68,
+	0xa0, 0x69, 0xf9, 0x28, 0x95, 0x21, 0x8c, 0x0b, 0x64, 0x88, 0x8f, 0xd1, 0xac, 0x08, 0x0a, 0xb8,
+	0xcf, 0x2d, 0x4e, 0x26, 0x37, 0x0f, 0x00, 0xc3, 0xe5, 0x62, 0xf2, 0xc1, 0x55, 0x41, 0xc9, 0xcd,
+	0x43, 0x82, 0x25, 0xb6, 0xa0, 0xc3, 0x2f, 0x66, 0xc6, 0x6c, 0x41, 0xab, 0x9f, 0xb6, 0x05, 0xd0,
+	0x98, 0x2d, 0x81, 0xfd, 0x85, 0x81, 0x0a, 0x35, 0x6f, 0xcf, 0xed, 0x7b, 0x96, 0xbd, 0xe1, 0x7b,
+	0x3d, 0x7e, 0x43, 0xff, 0x2b, 0x5d, 0x6f, 0x76, 0xd0, 0xf4, 0x08, 0x2e, 0x47, 0x63, 0xdf, 0xdc,
+	0x4e, 0xdf, 0xf4, 0x8c, 0x3f, 0x44, 0xdc, 0xa4, 0x26, 0xdf, 0x52, 0xa4, 0xb2, 0xb2, 0x2f, 0xc6,
+	0x84, 0xc6, 0x02, 0xf2, 0x67, 0x19, 0x54, 0x3a, 0xdf, 0x10, 0x1e, 0xa0, 0x59, 0xc1, 0xec, 0x68,
+	0x1f, 0x58, 0xef, 0x5c, 0x64, 0x0e, 0x70, 0xff, 0x04, 0xb7, 0x11, 0x23, 0x35, 0x56, 0xcb, 0x97,
+	0x40, 0x84, 0x6a, 0xf2, 0x97, 0xfa, 0x14, 0xa3, 0xdd, 0x56, 0x66, 0x5e, 0xfd, 0xb6, 0xb2, 0x8d,
+	0xc4, 0xf5, 0x8a, 0xfa, 0x3a, 0x97, 0x2d, 0x67, 0xee, 0xe4, 0x2a, 0x77, 0x79, 0x9b, 0xb7, 0x2d,
+	0x4e, 0xc9, 0xf1, 0x77, 0xb9, 0xc5, 0x24, 0x51, 0x09, 0x30, 0x0e, 0x81, 0xc2, 0x04, 0x4d, 0x71,
+	0x79, 0xce, 0xd2, 0x2e, 0xb3, 0x72, 0x49, 0xce, 0xba, 0xc0, 0xe5, 0x95, 0x76, 0x59, 0x45, 0xa6,
+	0x50, 0x76, 0x83, 0x5f, 0x01, 0x7d, 0x80, 0x72, 0xd5, 0xbe, 0x17, 0x40, 0xc1, 0xf0, 0x99, 0x15,
+	0x78, 0xae, 0x1e, 0x4a, 0x02, 0x51, 0xae, 0x16, 0x43, 0x42, 0x25, 0xbe, 0xfc, 0xb7, 0x59, 0x34,
+	0xab, 0x7d, 0x0f, 0xc7, 0xbf, 0x8d, 0x6e, 0x3c, 0xaa, 0xb7, 0xdb, 0x2b, 0x6b, 0xf5, 0xce, 0xe6,
+	0xe7, 0x1b, 0xf5, 0x4e, 0x75, 0x7d, 0xab, 0xbd, 0x59, 0xa7, 0x9d, 0x6a, 0xab, 0xb9, 0xda, 0x58,
+	0x2b, 0x4c, 0x94, 0x6e, 0x1e, 0x1e, 0x95, 0x8b, 0x9a, 0x46, 0xfa, 0xc3, 0xf5, 0x6f, 0x22, 0x9c,
+	0x52, 0x6f, 0x34, 0x6b, 0xf5, 0xcf, 0x0a, 0x46, 0xe9, 0xca, 0xe1, 0x51, 0xb9, 0xa0, 0x69, 0x89,
+	0xcd, 0xf5, 0x63, 0xf4, 0xda, 0x69, 0x76, 0x67, 0x6b, 0xa3, 0xb6, 0xb2, 0x59, 0x2f, 0x4c, 0x96,
+	0x4a, 0x87, 0x47, 0xe5, 0x6b, 0xe3, 0x4a, 0x32, 0x04, 0x7f, 0x80, 0xae, 0xa4, 0x54, 0x69, 0xfd,
+	0x93, 0xad, 0x7a, 0x7b, 0xb3, 0x90, 0x29, 0x5d, 0x3b, 0x3c, 0x2a, 0x63, 0x4d, 0x2b, 0xae, 0xf2,
+	0xf7, 0xd0, 0xd5, 0x31, 0x8d, 0xf6, 0x46, 0xab, 0xd9, 0xae, 0x17, 0xb2, 0xa5, 0xeb, 0x87, 0x47,
+	0xe5, 0xcb, 0x29, 0x15, 0x99, 0x37, 0xab, 0x68, 0x29, 0xa5, 0x53, 0x6b, 0x7d, 0xda, 0x5c, 0x6f,
+	0xad, 0xd4, 0x3a, 0x1b, 0xb4, 0xb5, 0x46, 0xeb, 0xed, 0x76, 0x21, 0x57, 0x32, 0x0f, 0x8f, 0xca,
+	0x37, 0x34, 0xe5, 0x53, 0x3b, 0x7c, 0x19, 0x2d, 0xa6, 0x8c, 0x6c, 0x34, 0x9a, 0x6b, 0x85, 0xa9,
+	0xd2, 0xe5, 0xc3, 0xa3, 0xf2, 0x25, 0x4d, 0x8f, 0xfb, 0xf2, 0xd4, 0xfa, 0x55, 0xd7, 0x5b, 0xed,
+	0x7a, 0x61, 0xfa, 0xd4, 0xfa, 0x09, 0x87, 0x7f, 0x80, 0x4a, 0xe9, 0xe9, 0xd5, 0xd7, 0x37, 0x57,
+	0xd4, 0x52, 0xe4, 0x4b, 0x37, 0x0e, 0x8f, 0xca, 0xd7, 0xf5, 0xa9, 0xe9, 0x5d, 0xcf, 0xb8, 0xa7,
+	0x63, 0x65, 0xb9, 0x2a, 0x33, 0xa7, 0x3c, 0x9d, 0x2a, 0x29, 0xcb, 0xff, 0x62, 0x20, 0x7c, 0xfa,
+	0xdf, 0x1f, 0xf0, 0x7b, 0xa8, 0x18, 0x5b, 0xad, 0xb6, 0x1e, 0x6d, 0xf0, 0x35, 0x6a, 0xb4, 0x9a,
+	0x9d, 0x66, 0xab, 0x59, 0x2f, 0x4c, 0xa4, 0x3c, 0xaa, 0x69, 0x35, 0x3d, 0x97, 0xff, 0x87, 0xcb,
+	0xf5, 0xb3, 0x34, 0xd7, 0x9f, 0xbc, 0x53, 0x30, 0x4a, 0xf7, 0x0e, 0x8f, 0xca, 0x57, 0x4f, 0x2b,
+	0xae, 0x3f, 0x79, 0xe7, 0xab, 0x9f, 0xbd, 0x71, 0xb6, 0xe0, 0xbc, 0xa9, 0x3c, 0x69, 0x6f, 0xd6,
+	0xc6, 0x82, 0x4b, 0x53, 0x7c, 0x12, 0x84, 0xf6, 0xf2, 0x17, 0x06, 0x9a, 0x4f, 0xdd, 0xc7, 0xf2,
+	0x70, 0xab, 0xac, 0xb7, 0xaa, 0x0f, 0x3b, 0xd5, 0x07, 0x5b, 0xcd, 0x87, 0x8d, 0xe6, 0x5a, 0x67,
+	0xb5, 0xf1, 0x59, 0xbd, 0x56, 0x98, 0x10, 0xe1, 0x96, 0x22, 0xaf, 0x3a, 0xfb, 0xcc, 0xc6, 0xbf,
+	0x83, 0xf0, 0x98, 0x46, 0xb5, 0x56, 0x2d, 0x18, 0xa5, 0x3b, 0xdc, 0x93, 0x29, 0x7e, 0xb5, 0x56,
+	0xfd, 0xea, 0x67, 0x6f, 0x9c, 0xc2, 0x96, 0xf9, 0xa9, 0x55, 0x5f, 0xda, 0xb7, 0xd1, 0x15, 0xfd,
+	0x3d, 0x1e, 0xd5, 0x37, 0x57, 0x6a, 0x2b, 0x9b, 0x2b, 0x85, 0x09, 0x11, 0xbf, 0x1a, 0xf5, 0x11,
+	0x0b, 0x2d, 0xa8, 0x84, 0xdf, 0x43, 0x8b, 0x29, 0x2f, 0xd4, 0x1f, 0xd7, 0x69, 0xbc, 0x1b, 0xf5,
+	0xf5, 0x67, 0xcf, 0x98, 0x8f, 0xbf, 0x8f, 0xb0, 0x4e, 0x5e, 0x59, 0xff, 0x74, 0xe5, 0xf3, 0x76,
+	0x61, 0xb2, 0x74, 0xf5, 0xf0, 0xa8, 0xbc, 0xa8, 0xb1, 0x57, 0xfa, 0x7b, 0xd6, 0x41, 0xb0, 0xfc,
+	0x97, 0x06, 0xba, 0x92, 0x42, 0x7b, 0x9e, 0xef, 0x84, 0xbb, 0x03, 0xbc, 0x85, 0x5e, 0x4b, 0xdb,
+	0x59, 0x6b, 0xd1, 0xc6, 0xe6, 0x83, 0x47, 0xe0, 0xca, 0x89, 0xd2, 0xbb, 0x3c, 0x28, 0xcf, 0x52,
+	0x14, 0xce, 0x3c, 0x4f, 0x84, 0xef, 0xa3, 0xd2, 0xd9, 0x66, 0xc1, 0xa1, 0x86, 0x08, 0xd7, 0xb3,
+	0x94, 0xc1, 0xa5, 0x7f, 0x33, 0x89, 0xe6, 0xf4, 0x8f, 0x20, 0xf8, 0xfb, 0xe8, 0xf2, 0x6a, 0x63,
+	0x9d, 0xe7, 0x9c, 0xd5, 0x96, 0xd8, 0x00, 0x7c, 0x58, 0x98, 0x10, 0x8b, 0xa3, 0x53, 0xf9, 0x6f,
+	0xfc, 0x5b, 0xa8, 0x38, 0x46, 0xaf, 0x35, 0x68, 0xbd, 0xba, 0xd9, 0xa2, 0x9f, 0x17, 0x8c, 0xd2,
+	0x6b, 0x3c, 0x3c, 0x75, 0x9d, 0x9a, 0xe3, 0x43, 0xb1, 0xe1, 0x1d, 0xee, 0x8d, 0x31, 0xc5, 0xf6,
+	0xe7, 0x8f, 0xd6, 0x1b, 0xcd, 0x87, 0xe2, 0x79, 0x93, 0xa5, 0x5b, 0x7c, 0x3d, 0x74, 0xdd, 0xb6,
+	0xf8, 0xae, 0xc4, 0xa1, 0xbc, 0x81, 0x1f, 0xa0, 0xf2, 0x39, 0xfa, 0xc9, 0x04, 0x32, 0x25, 0x72,
+	0x78, 0x54, 0xbe, 0x79, 0x86, 0x11, 0x35, 0x8f, 0xbc, 0x81, 0x7f, 0x88, 0xae, 0x9d, 0x6d, 0x29,
+	0xce, 0x80, 0x67, 0xe8, 0x2f, 0xff, 0x93, 0x81, 0x66, 0x54, 0xbf, 0xc6, 0x17, 0xad, 0x4e, 0x69,
+	0x8b, 0x97, 0x83, 0x5a, 0xbd, 0xd3, 0x6c, 0x75, 0x60, 0x14, 0x2f, 0x9a, 0xe2, 0x35, 0x3d, 0xf8,
+	0xc9, 0xb3, 0x99, 0x46, 0x5f, 0xab, 0x37, 0xeb, 0xb4, 0x51, 0x8d, 0xe3, 0x4f, 0xb1, 0xd7, 0x98,
+	0xcb, 0x7c, 0xa7, 0x8b, 0xdf, 0x41, 0xd7, 0xd3, 0xc6, 0xdb, 0x5b, 0xd5, 0x07, 0xf1, 0x2a, 0xc1,
+	0x04, 0xb5, 0x07, 0xb4, 0x47, 0xdd, 0x5d, 0x70, 0xcc, 0x8f, 0x52, 0x5a, 0x8d, 0xe6, 0xe3, 0x95,
+	0xf5, 0x46, 0x4d, 0x68, 0x65, 0x4a, 0xc5, 0xc3, 0xa3, 0xf2, 0x15, 0xa5, 0x25, 0xef, 0xd0, 0xb9,
+	0xda, 0xf2, 0x57, 0x06, 0x5a, 0xfa, 0xf6, 0x36, 0x05, 0x7f, 0x8a, 0xde, 0x84, 0xf5, 0x3a, 0x95,
+	0xf4, 0x65, 0x85, 0x12, 0x6b, 0xb8, 0xb2, 0xb1, 0x51, 0x6f, 0xf2, 0x44, 0xc0, 0x37, 0xf6, 0xed,
+	0x6f, 0x37, 0xb9, 0x32, 0x1c, 0x32, 0xd7, 0xbe, 0xa0, 0xe1, 0xd5, 0x16, 0x5d, 0xab, 0x6f, 0x16,
+	0x8c, 0x8b, 0x18, 0x5e, 0xf5, 0xf8, 0x37, 0xc8, 0xca, 0xa3, 0x2f, 0xbf, 0x5e, 0x9a, 0x78, 0xfe,
+	0xf5, 0xd2, 0xc4, 0x97, 0x2f, 0x96, 0x8c, 0xe7, 0x2f, 0x96, 0x8c, 0x3f, 0xfe, 0x66, 0x69, 0xe2,
+	0x97, 0xdf, 0x2c, 0x19, 0xcf, 0xbf, 0x59, 0x9a, 0xf8, 0xe7, 0x6f, 0x96, 0x26, 0x9e, 0x7c, 0xaf,
+	0xe7, 0x84, 0xbb, 0xa3, 0xed, 0xbb, 0x5d, 0x6f, 0xf0, 0x56, 0x70, 0xe0, 0x76, 0xc3, 0x5d, 0xc7,
+	0xed, 0x69, 0xbf, 0xf4, 0xff, 0xa5, 0xdc, 0x9e, 0x82, 0x5f, 0x3f, 0xfc, 0x9f, 0x01, 0x00, 0x95,
+	0x19, 0xa5, 0x77, 0x62, 0x29, 0x00, 0x00,
 }
 
 func (m *Hello) Marshal() (dAtA []byte, err error) {
@@ -1372,6 +1641,15 @@ func (m *Hello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintBep(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintBep(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -1522,6 +1800,11 @@ func (m *Folder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x82
 		}
 	}
+	if m.BlockChunking != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.BlockChunking))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Paused {
 		i--
 		if m.Paused {
@@ -1825,6 +2108,13 @@ func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.BlockChunking != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.BlockChunking))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if len(m.Encrypted) > 0 {
 		i -= len(m.Encrypted)
 		copy(dAtA[i:], m.Encrypted)
@@ -2458,7 +2748,7 @@ func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DownloadProgress) Marshal() (dAtA []byte, err error) {
+func (m *DeltaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2468,20 +2758,20 @@ func (m *DownloadProgress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DownloadProgress) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeltaRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DownloadProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeltaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Checksums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2489,20 +2779,69 @@ func (m *DownloadProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintBep(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ChunkSize != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BlockNo != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.BlockNo))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FromTemporary {
+		i--
+		if m.FromTemporary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Size != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.Size))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Offset != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Folder) > 0 {
 		i -= len(m.Folder)
 		copy(dAtA[i:], m.Folder)
 		i = encodeVarintBep(dAtA, i, uint64(len(m.Folder)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FileDownloadProgressUpdate) Marshal() (dAtA []byte, err error) {
+func (m *DeltaChecksum) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2512,54 +2851,32 @@ func (m *FileDownloadProgressUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FileDownloadProgressUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeltaChecksum) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FileDownloadProgressUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeltaChecksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockSize != 0 {
-		i = encodeVarintBep(dAtA, i, uint64(m.BlockSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.BlockIndexes) > 0 {
-		for iNdEx := len(m.BlockIndexes) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarintBep(dAtA, i, uint64(m.BlockIndexes[iNdEx]))
-			i--
-			dAtA[i] = 0x20
-		}
-	}
-	{
-		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintBep(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintBep(dAtA, i, uint64(len(m.Name)))
+	if len(m.Strong) > 0 {
+		i -= len(m.Strong)
+		copy(dAtA[i:], m.Strong)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Strong)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.UpdateType != 0 {
-		i = encodeVarintBep(dAtA, i, uint64(m.UpdateType))
+	if m.Weak != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.Weak))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Ping) Marshal() (dAtA []byte, err error) {
+func (m *DeltaResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2569,20 +2886,44 @@ func (m *Ping) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Ping) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeltaResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Ping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Code != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBep(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ID != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Close) Marshal() (dAtA []byte, err error) {
+func (m *DeltaOp) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2592,22 +2933,186 @@ func (m *Close) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Close) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeltaOp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Close) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeltaOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintBep(dAtA, i, uint64(len(m.Reason)))
+	if m.ChunkCount != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.ChunkCount))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DownloadProgress) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownloadProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownloadProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBep(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Folder) > 0 {
+		i -= len(m.Folder)
+		copy(dAtA[i:], m.Folder)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Folder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FileDownloadProgressUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileDownloadProgressUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileDownloadProgressUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockSize != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.BlockSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BlockIndexes) > 0 {
+		for iNdEx := len(m.BlockIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintBep(dAtA, i, uint64(m.BlockIndexes[iNdEx]))
+			i--
+			dAtA[i] = 0x20
+		}
+	}
+	{
+		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBep(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UpdateType != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.UpdateType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Ping) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Close) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Close) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Close) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
@@ -2647,6 +3152,12 @@ func (m *Hello) ProtoSize() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovBep(uint64(m.Timestamp))
 	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2712,6 +3223,9 @@ func (m *Folder) ProtoSize() (n int) {
 	if m.Paused {
 		n += 2
 	}
+	if m.BlockChunking != 0 {
+		n += 1 + sovBep(uint64(m.BlockChunking))
+	}
 	if len(m.Devices) > 0 {
 		for _, e := range m.Devices {
 			l = e.ProtoSize()
@@ -2868,6 +3382,9 @@ func (m *FileInfo) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovBep(uint64(l))
 	}
+	if m.BlockChunking != 0 {
+		n += 2 + sovBep(uint64(m.BlockChunking))
+	}
 	if m.LocalFlags != 0 {
 		n += 2 + sovBep(uint64(m.LocalFlags))
 	}
@@ -3098,6 +3615,107 @@ func (m *Response) ProtoSize() (n int) {
 	return n
 }
 
+func (m *DeltaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBep(uint64(m.ID))
+	}
+	l = len(m.Folder)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovBep(uint64(m.Offset))
+	}
+	if m.Size != 0 {
+		n += 1 + sovBep(uint64(m.Size))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	if m.FromTemporary {
+		n += 2
+	}
+	if m.BlockNo != 0 {
+		n += 1 + sovBep(uint64(m.BlockNo))
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovBep(uint64(m.ChunkSize))
+	}
+	if len(m.Checksums) > 0 {
+		for _, e := range m.Checksums {
+			l = e.ProtoSize()
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeltaChecksum) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Weak != 0 {
+		n += 1 + sovBep(uint64(m.Weak))
+	}
+	l = len(m.Strong)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	return n
+}
+
+func (m *DeltaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBep(uint64(m.ID))
+	}
+	if len(m.Ops) > 0 {
+		for _, e := range m.Ops {
+			l = e.ProtoSize()
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
+	if m.Code != 0 {
+		n += 1 + sovBep(uint64(m.Code))
+	}
+	return n
+}
+
+func (m *DeltaOp) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	if m.ChunkIndex != 0 {
+		n += 1 + sovBep(uint64(m.ChunkIndex))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovBep(uint64(m.ChunkCount))
+	}
+	return n
+}
+
 func (m *DownloadProgress) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3334,8 +3952,40 @@ func (m *Hello) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipBep(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -3740,6 +4390,25 @@ func (m *Folder) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Paused = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockChunking", wireType)
+			}
+			m.BlockChunking = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockChunking |= BlockChunking(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 16:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
@@ -4810,6 +5479,25 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				m.Encrypted = []byte{}
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockChunking", wireType)
+			}
+			m.BlockChunking = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockChunking |= BlockChunking(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 1000:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LocalFlags", wireType)
@@ -6340,6 +7028,650 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeltaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeltaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeltaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size", wireType)
+			}
+			m.Size = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTemporary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FromTemporary = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNo", wireType)
+			}
+			m.BlockNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNo |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksums = append(m.Checksums, DeltaChecksum{})
+			if err := m.Checksums[len(m.Checksums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeltaChecksum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeltaChecksum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeltaChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weak", wireType)
+			}
+			m.Weak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weak |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strong", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strong = append(m.Strong[:0], dAtA[iNdEx:postIndex]...)
+			if m.Strong == nil {
+				m.Strong = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeltaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeltaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeltaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ops = append(m.Ops, DeltaOp{})
+			if err := m.Ops[len(m.Ops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= ErrorCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeltaOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeltaOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeltaOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+			}
+			m.ChunkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkIndex |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DownloadProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
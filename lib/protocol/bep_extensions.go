@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/build"
@@ -131,6 +132,17 @@ func (f FileInfo) BlockSize() int {
 	return f.RawBlockSize
 }
 
+// BlockIndex returns the index of the block starting at the given offset.
+func (f FileInfo) BlockIndex(offset int64) int {
+	if f.BlockChunking != BlockChunkingCDC {
+		return int(offset / int64(f.BlockSize()))
+	}
+	// Blocks vary in size, so we need to find it.
+	return sort.Search(len(f.Blocks), func(i int) bool {
+		return f.Blocks[i].Offset >= offset
+	})
+}
+
 func (f FileInfo) FileName() string {
 	return f.Name
 }
@@ -537,6 +549,25 @@ func (x *FileInfoType) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (c BlockChunking) MarshalText() ([]byte, error) {
+	switch c {
+	case BlockChunkingCDC:
+		return []byte("cdc"), nil
+	default:
+		return []byte("fixed"), nil
+	}
+}
+
+func (c *BlockChunking) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "cdc":
+		*c = BlockChunkingCDC
+	default:
+		*c = BlockChunkingFixed
+	}
+	return nil
+}
+
 func xattrsEqual(a, b *XattrData) bool {
 	aEmpty := a == nil || len(a.Xattrs) == 0
 	bEmpty := b == nil || len(b.Xattrs) == 0
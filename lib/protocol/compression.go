@@ -2,7 +2,13 @@
 
 package protocol
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
 
 const (
 	compressionThreshold = 128 // don't bother compressing messages smaller than this many bytes
@@ -37,3 +43,93 @@ func (c *Compression) UnmarshalText(bs []byte) error {
 	*c = compressionUnmarshal[string(bs)]
 	return nil
 }
+
+// MaxZstdLevel is the highest Zstandard compression level. Levels range
+// from 1 (fastest) to this, with zero selecting the default level.
+const MaxZstdLevel = 22
+
+// FeatureCompressionZstd is announced in the Hello message by devices that
+// can decompress Zstandard compressed messages.
+const FeatureCompressionZstd = "compression-zstd"
+
+var compressionAlgorithmMarshal = map[CompressionAlgorithm]string{
+	CompressionAlgorithmZstd: "zstd",
+	CompressionAlgorithmLZ4:  "lz4",
+}
+
+var compressionAlgorithmUnmarshal = map[string]CompressionAlgorithm{
+	"zstd": CompressionAlgorithmZstd,
+	"lz4":  CompressionAlgorithmLZ4,
+}
+
+func (c CompressionAlgorithm) MarshalText() ([]byte, error) {
+	return []byte(compressionAlgorithmMarshal[c]), nil
+}
+
+func (c *CompressionAlgorithm) UnmarshalText(bs []byte) error {
+	*c = compressionAlgorithmUnmarshal[string(bs)]
+	return nil
+}
+
+var (
+	errZstdTooLarge = errors.New("compressed data larger than buffer")
+	errZstdNoSize   = errors.New("missing or invalid content size")
+
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(MaxMessageLen))
+
+	// Encoders are safe for concurrent use and expensive to create, so
+	// there is one per level shared between all connections.
+	zstdEncodersMut sync.Mutex
+	zstdEncoders    = make(map[zstd.EncoderLevel]*zstd.Encoder)
+)
+
+// zstdEncoder returns the encoder for the given Zstandard compression
+// level, from 1 (fastest) to MaxZstdLevel (best compression). Zero selects
+// the default level.
+func zstdEncoder(level int) *zstd.Encoder {
+	encLevel := zstd.SpeedDefault
+	if level != 0 {
+		encLevel = zstd.EncoderLevelFromZstd(level)
+	}
+
+	zstdEncodersMut.Lock()
+	defer zstdEncodersMut.Unlock()
+	enc, ok := zstdEncoders[encLevel]
+	if !ok {
+		// Single segment frames always carry the uncompressed size,
+		// which zstdDecompress needs.
+		enc, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(encLevel), zstd.WithSingleSegment(true))
+		zstdEncoders[encLevel] = enc
+	}
+	return enc
+}
+
+func zstdCompress(enc *zstd.Encoder, src, buf []byte) (int, error) {
+	res := enc.EncodeAll(src, buf[:0])
+	if len(res) > len(buf) {
+		return 0, errZstdTooLarge
+	}
+	return len(res), nil
+}
+
+func zstdDecompress(src []byte) ([]byte, error) {
+	var hdr zstd.Header
+	if err := hdr.Decode(src); err != nil {
+		return nil, err
+	}
+	if !hdr.HasFCS || hdr.FrameContentSize > MaxMessageLen {
+		return nil, errZstdNoSize
+	}
+
+	buf := BufferPool.Get(int(hdr.FrameContentSize))
+	res, err := zstdDecoder.DecodeAll(src, buf[:0])
+	if err != nil {
+		BufferPool.Put(buf)
+		return nil, err
+	}
+	if len(res) != len(buf) {
+		BufferPool.Put(buf)
+		return nil, errZstdNoSize
+	}
+	return res, nil
+}
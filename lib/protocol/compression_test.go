@@ -47,3 +47,28 @@ func TestCompressionMarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestCompressionAlgorithmMarshal(t *testing.T) {
+	for _, tc := range []struct {
+		s string
+		c CompressionAlgorithm
+	}{
+		{"zstd", CompressionAlgorithmZstd},
+		{"lz4", CompressionAlgorithmLZ4},
+	} {
+		var c CompressionAlgorithm
+		if err := c.UnmarshalText([]byte(tc.s)); err != nil {
+			t.Error(err)
+		}
+		if c != tc.c {
+			t.Errorf("%s unmarshalled to %d, not %d", tc.s, c, tc.c)
+		}
+		bs, err := tc.c.MarshalText()
+		if err != nil {
+			t.Error(err)
+		}
+		if s := string(bs); s != tc.s {
+			t.Errorf("%d marshalled to %q, not %q", tc.c, s, tc.s)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/chmduquesne/rollinghash/adler32"
+
+	"github.com/syncthing/syncthing/lib/sha256"
+)
+
+// FeatureDeltaRequest is announced in the Hello message by devices that
+// understand DeltaRequest messages.
+const FeatureDeltaRequest = "delta-request"
+
+const (
+	// MinDeltaChunkSize and MaxDeltaChunkSize are the bounds for the size
+	// of the chunks a block is compared in. Requests with smaller chunks
+	// are answered with the literal block data.
+	MinDeltaChunkSize = 2 << KiB
+	MaxDeltaChunkSize = 64 << KiB
+
+	// deltaStrongSize is the number of bytes of the SHA-256 of a chunk
+	// that is sent along with the weak hash.
+	deltaStrongSize = 8
+)
+
+var errDeltaChunk = errors.New("delta refers to nonexistent chunk")
+
+// HasFeature returns true if the Hello announces the given feature.
+func (h Hello) HasFeature(feature string) bool {
+	for _, f := range h.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// DeltaChunkSize returns the chunk size to use for delta requests for a
+// block of the given size.
+func DeltaChunkSize(blockSize int) int {
+	size := blockSize / 64
+	if size < MinDeltaChunkSize {
+		return MinDeltaChunkSize
+	}
+	if size > MaxDeltaChunkSize {
+		return MaxDeltaChunkSize
+	}
+	return size
+}
+
+// deltaChecksums returns the checksums of the consecutive, full size chunks
+// of data. A trailing partial chunk is ignored.
+func deltaChecksums(data []byte, chunkSize int) []DeltaChecksum {
+	sums := make([]DeltaChecksum, 0, len(data)/chunkSize)
+	hf := adler32.New()
+	for off := 0; off+chunkSize <= len(data); off += chunkSize {
+		chunk := data[off : off+chunkSize]
+		hf.Reset()
+		hf.Write(chunk)
+		strong := sha256.Sum256(chunk)
+		sums = append(sums, DeltaChecksum{
+			Weak:   hf.Sum32(),
+			Strong: strong[:deltaStrongSize],
+		})
+	}
+	return sums
+}
+
+// deltaOps describes data in terms of the chunks given by sums, by rolling
+// a weak hash over data and confirming matches with the strong hash. Data
+// that matches no chunk is sent as is.
+func deltaOps(data []byte, chunkSize int, sums []DeltaChecksum) []DeltaOp {
+	if chunkSize < MinDeltaChunkSize || chunkSize > MaxDeltaChunkSize || len(sums) == 0 || len(data) < chunkSize {
+		return []DeltaOp{{Data: data}}
+	}
+
+	weak := make(map[uint32][]int, len(sums))
+	for i, s := range sums {
+		weak[s.Weak] = append(weak[s.Weak], i)
+	}
+
+	var ops []DeltaOp
+	literal := 0 // start of the pending literal data
+	addCopy := func(idx int) {
+		if last := len(ops) - 1; last >= 0 && ops[last].ChunkCount > 0 && ops[last].ChunkIndex+ops[last].ChunkCount == idx {
+			ops[last].ChunkCount++
+			return
+		}
+		ops = append(ops, DeltaOp{ChunkIndex: idx, ChunkCount: 1})
+	}
+
+	hf := adler32.New()
+	hf.Write(data[:chunkSize])
+	for off := 0; ; {
+		match := -1
+		if cands, ok := weak[hf.Sum32()]; ok {
+			strong := sha256.Sum256(data[off : off+chunkSize])
+			for _, idx := range cands {
+				if bytes.Equal(sums[idx].Strong, strong[:deltaStrongSize]) {
+					match = idx
+					break
+				}
+			}
+		}
+
+		if match >= 0 {
+			if literal < off {
+				ops = append(ops, DeltaOp{Data: data[literal:off]})
+			}
+			addCopy(match)
+			off += chunkSize
+			literal = off
+			if off+chunkSize > len(data) {
+				break
+			}
+			hf.Reset()
+			hf.Write(data[off : off+chunkSize])
+			continue
+		}
+
+		if off+chunkSize >= len(data) {
+			break
+		}
+		hf.Roll(data[off+chunkSize])
+		off++
+	}
+	if literal < len(data) {
+		ops = append(ops, DeltaOp{Data: data[literal:]})
+	}
+	return ops
+}
+
+// applyDelta reconstructs a block of the given size from the ops and the
+// data the chunk checksums were calculated from.
+func applyDelta(old []byte, chunkSize int, ops []DeltaOp, size int) ([]byte, error) {
+	numChunks := len(old) / chunkSize
+	buf := make([]byte, 0, size)
+	for _, op := range ops {
+		if op.ChunkCount == 0 {
+			buf = append(buf, op.Data...)
+		} else {
+			if op.ChunkIndex < 0 || op.ChunkCount < 0 || op.ChunkIndex+op.ChunkCount > numChunks {
+				return nil, errDeltaChunk
+			}
+			buf = append(buf, old[op.ChunkIndex*chunkSize:(op.ChunkIndex+op.ChunkCount)*chunkSize]...)
+		}
+		if len(buf) > size {
+			break
+		}
+	}
+	if len(buf) != size {
+		return nil, fmt.Errorf("delta resulted in %d bytes, expected %d", len(buf), size)
+	}
+	return buf, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"io"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/testutil"
+)
+
+func TestDeltaOps(t *testing.T) {
+	const chunkSize = MinDeltaChunkSize
+	old := make([]byte, 64*chunkSize)
+	mrand.New(mrand.NewSource(42)).Read(old)
+
+	cases := map[string]func([]byte) []byte{
+		"identical": func(d []byte) []byte {
+			return d
+		},
+		"modified": func(d []byte) []byte {
+			d = append([]byte(nil), d...)
+			copy(d[10*chunkSize+17:], "some modification")
+			return d
+		},
+		"inserted": func(d []byte) []byte {
+			return append(append(append([]byte(nil), d[:1234]...), "an insertion"...), d[1234:len(d)-12]...)
+		},
+		"removed": func(d []byte) []byte {
+			return append(append([]byte(nil), d[:5*chunkSize+3]...), d[7*chunkSize:]...)
+		},
+		"unrelated": func(d []byte) []byte {
+			d = make([]byte, len(d))
+			mrand.New(mrand.NewSource(43)).Read(d)
+			return d
+		},
+	}
+
+	sums := deltaChecksums(old, chunkSize)
+	if len(sums) != 64 {
+		t.Fatalf("got %d checksums, expected 64", len(sums))
+	}
+
+	for name, fn := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := fn(old)
+			ops := deltaOps(data, chunkSize, sums)
+
+			literal := 0
+			for _, op := range ops {
+				literal += len(op.Data)
+			}
+			if name == "unrelated" {
+				if literal != len(data) {
+					t.Errorf("%d literal bytes for unrelated data of %d bytes", literal, len(data))
+				}
+			} else if literal > 3*chunkSize {
+				t.Errorf("%d literal bytes, expected at most %d", literal, 3*chunkSize)
+			}
+
+			res, err := applyDelta(old, chunkSize, ops, len(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(res, data) {
+				t.Error("reconstructed data differs")
+			}
+		})
+	}
+}
+
+func TestApplyDeltaInvalid(t *testing.T) {
+	old := make([]byte, 4*MinDeltaChunkSize)
+	ops := []DeltaOp{{ChunkIndex: 3, ChunkCount: 2}}
+	if _, err := applyDelta(old, MinDeltaChunkSize, ops, 2*MinDeltaChunkSize); err == nil {
+		t.Error("expected error for out of range chunk")
+	}
+	ops = []DeltaOp{{ChunkIndex: 0, ChunkCount: 1}}
+	if _, err := applyDelta(old, MinDeltaChunkSize, ops, 2*MinDeltaChunkSize); err == nil {
+		t.Error("expected error for short result")
+	}
+}
+
+func TestDeltaRequest(t *testing.T) {
+	m0 := newTestModel()
+	m1 := newTestModel()
+
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
+	c0.Start()
+	defer closeAndWait(c0, ar, bw)
+	c1 := getRawConnection(NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
+	c1.Start()
+	defer closeAndWait(c1, ar, bw)
+	c0.ClusterConfig(ClusterConfig{})
+	c1.ClusterConfig(ClusterConfig{})
+
+	old := make([]byte, MinBlockSize)
+	mrand.New(mrand.NewSource(42)).Read(old)
+	m1.data = append([]byte(nil), old...)
+	copy(m1.data[1000:], "changed")
+
+	data, err := c0.DeltaRequest(context.Background(), "default", "foo", 2, 2*MinBlockSize, MinBlockSize, []byte("hash"), true, old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, m1.data) {
+		t.Error("data differs")
+	}
+	if m1.folder != "default" || m1.name != "foo" || m1.offset != 2*MinBlockSize || m1.size != MinBlockSize || !m1.fromTemporary {
+		t.Error("unexpected request parameters", m1.folder, m1.name, m1.offset, m1.size, m1.fromTemporary)
+	}
+}
@@ -238,6 +238,15 @@ func (e encryptedConnection) Request(ctx context.Context, folder string, name st
 	return bs[:origSize], nil
 }
 
+func (e encryptedConnection) DeltaRequest(ctx context.Context, folder string, name string, blockNo int, offset int64, size int, hash []byte, fromTemporary bool, old []byte) ([]byte, error) {
+	if _, ok := e.folderKeys.get(folder); ok {
+		// The encrypted data has nothing in common with what we have, so
+		// there is no point in a delta.
+		return e.Request(ctx, folder, name, blockNo, offset, size, hash, 0, fromTemporary)
+	}
+	return e.conn.DeltaRequest(ctx, folder, name, blockNo, offset, size, hash, fromTemporary, old)
+}
+
 func (e encryptedConnection) DownloadProgress(ctx context.Context, folder string, updates []FileDownloadProgressUpdate) {
 	if _, ok := e.folderKeys.get(folder); !ok {
 		e.conn.DownloadProgress(ctx, folder, updates)
@@ -361,6 +370,7 @@ func encryptFileInfo(keyGen *KeyGenerator, fi FileInfo, folderKey *[keySize]byte
 		enc.Size = offset // new total file size
 		enc.Blocks = blocks
 		enc.RawBlockSize = fi.BlockSize() + blockOverhead
+		enc.BlockChunking = fi.BlockChunking
 	}
 
 	return enc
@@ -51,6 +51,27 @@ type Connection struct {
 	cryptoReturnsOnCall map[int]struct {
 		result1 string
 	}
+	DeltaRequestStub        func(context.Context, string, string, int, int64, int, []byte, bool, []byte) ([]byte, error)
+	deltaRequestMutex       sync.RWMutex
+	deltaRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 int
+		arg5 int64
+		arg6 int
+		arg7 []byte
+		arg8 bool
+		arg9 []byte
+	}
+	deltaRequestReturns struct {
+		result1 []byte
+		result2 error
+	}
+	deltaRequestReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
 	DeviceIDStub        func() protocol.DeviceID
 	deviceIDMutex       sync.RWMutex
 	deviceIDArgsForCall []struct {
@@ -431,6 +452,88 @@ func (fake *Connection) CryptoReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *Connection) DeltaRequest(arg1 context.Context, arg2 string, arg3 string, arg4 int, arg5 int64, arg6 int, arg7 []byte, arg8 bool, arg9 []byte) ([]byte, error) {
+	var arg7Copy []byte
+	if arg7 != nil {
+		arg7Copy = make([]byte, len(arg7))
+		copy(arg7Copy, arg7)
+	}
+	var arg9Copy []byte
+	if arg9 != nil {
+		arg9Copy = make([]byte, len(arg9))
+		copy(arg9Copy, arg9)
+	}
+	fake.deltaRequestMutex.Lock()
+	ret, specificReturn := fake.deltaRequestReturnsOnCall[len(fake.deltaRequestArgsForCall)]
+	fake.deltaRequestArgsForCall = append(fake.deltaRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 int
+		arg5 int64
+		arg6 int
+		arg7 []byte
+		arg8 bool
+		arg9 []byte
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7Copy, arg8, arg9Copy})
+	stub := fake.DeltaRequestStub
+	fakeReturns := fake.deltaRequestReturns
+	fake.recordInvocation("DeltaRequest", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7Copy, arg8, arg9Copy})
+	fake.deltaRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Connection) DeltaRequestCallCount() int {
+	fake.deltaRequestMutex.RLock()
+	defer fake.deltaRequestMutex.RUnlock()
+	return len(fake.deltaRequestArgsForCall)
+}
+
+func (fake *Connection) DeltaRequestCalls(stub func(context.Context, string, string, int, int64, int, []byte, bool, []byte) ([]byte, error)) {
+	fake.deltaRequestMutex.Lock()
+	defer fake.deltaRequestMutex.Unlock()
+	fake.DeltaRequestStub = stub
+}
+
+func (fake *Connection) DeltaRequestArgsForCall(i int) (context.Context, string, string, int, int64, int, []byte, bool, []byte) {
+	fake.deltaRequestMutex.RLock()
+	defer fake.deltaRequestMutex.RUnlock()
+	argsForCall := fake.deltaRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9
+}
+
+func (fake *Connection) DeltaRequestReturns(result1 []byte, result2 error) {
+	fake.deltaRequestMutex.Lock()
+	defer fake.deltaRequestMutex.Unlock()
+	fake.DeltaRequestStub = nil
+	fake.deltaRequestReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Connection) DeltaRequestReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.deltaRequestMutex.Lock()
+	defer fake.deltaRequestMutex.Unlock()
+	fake.DeltaRequestStub = nil
+	if fake.deltaRequestReturnsOnCall == nil {
+		fake.deltaRequestReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.deltaRequestReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Connection) DeviceID() protocol.DeviceID {
 	fake.deviceIDMutex.Lock()
 	ret, specificReturn := fake.deviceIDReturnsOnCall[len(fake.deviceIDArgsForCall)]
@@ -1229,6 +1332,8 @@ func (fake *Connection) Invocations() map[string][][]interface{} {
 	defer fake.connectionIDMutex.RUnlock()
 	fake.cryptoMutex.RLock()
 	defer fake.cryptoMutex.RUnlock()
+	fake.deltaRequestMutex.RLock()
+	defer fake.deltaRequestMutex.RUnlock()
 	fake.deviceIDMutex.RLock()
 	defer fake.deviceIDMutex.RUnlock()
 	fake.downloadProgressMutex.RLock()
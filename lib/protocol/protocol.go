@@ -24,6 +24,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	lz4 "github.com/pierrec/lz4/v4"
 )
 
@@ -161,6 +162,7 @@ type Connection interface {
 	Index(ctx context.Context, folder string, files []FileInfo) error
 	IndexUpdate(ctx context.Context, folder string, files []FileInfo) error
 	Request(ctx context.Context, folder string, name string, blockNo int, offset int64, size int, hash []byte, weakHash uint32, fromTemporary bool) ([]byte, error)
+	DeltaRequest(ctx context.Context, folder string, name string, blockNo int, offset int64, size int, hash []byte, fromTemporary bool, old []byte) ([]byte, error)
 	ClusterConfig(config ClusterConfig)
 	DownloadProgress(ctx context.Context, folder string, updates []FileDownloadProgressUpdate)
 	Statistics() Statistics
@@ -208,13 +210,15 @@ type rawConnection struct {
 	closeOnce             sync.Once
 	sendCloseOnce         sync.Once
 	compression           Compression
-	startStopMut          sync.Mutex // start and stop must be serialized
+	zstdEncoder           *zstd.Encoder // nil unless compressing with zstd
+	startStopMut          sync.Mutex    // start and stop must be serialized
 
 	loopWG sync.WaitGroup // Need to ensure no leftover routines in testing
 }
 
 type asyncResult struct {
 	val []byte
+	ops []DeltaOp
 	err error
 }
 
@@ -244,7 +248,10 @@ const (
 // Should not be modified in production code, just for testing.
 var CloseTimeout = 10 * time.Second
 
-func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, model Model, connInfo ConnectionInfo, compress Compression, passwords map[string]string, keyGen *KeyGenerator) Connection {
+// NewConnection returns a Connection speaking BEP. The compression
+// algorithm must only be CompressionAlgorithmZstd if the other side
+// announced FeatureCompressionZstd.
+func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, model Model, connInfo ConnectionInfo, compress Compression, algo CompressionAlgorithm, level int, passwords map[string]string, keyGen *KeyGenerator) Connection {
 	// We create the wrapper for the model first, as it needs to be passed
 	// in at the lowest level in the stack. At the end of construction,
 	// before returning, we add the connection to cwm so that it can be used
@@ -258,7 +265,7 @@ func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer
 
 	// We do the wire format conversion first (outermost) so that the
 	// metadata is in wire format when it reaches the encryption step.
-	rc := newRawConnection(deviceID, reader, writer, closer, em, connInfo, compress)
+	rc := newRawConnection(deviceID, reader, writer, closer, em, connInfo, compress, algo, level)
 	ec := newEncryptedConnection(rc, rc, em.folderKeys, keyGen)
 	wc := wireFormatConnection{ec}
 
@@ -266,12 +273,17 @@ func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer
 	return wc
 }
 
-func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver rawModel, connInfo ConnectionInfo, compress Compression) *rawConnection {
+func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver rawModel, connInfo ConnectionInfo, compress Compression, algo CompressionAlgorithm, level int) *rawConnection {
 	idString := deviceID.String()
 	cr := &countingReader{Reader: reader, idString: idString}
 	cw := &countingWriter{Writer: writer, idString: idString}
 	registerDeviceMetrics(idString)
 
+	var zstdEnc *zstd.Encoder
+	if algo == CompressionAlgorithmZstd {
+		zstdEnc = zstdEncoder(level)
+	}
+
 	return &rawConnection{
 		ConnectionInfo:        connInfo,
 		deviceID:              deviceID,
@@ -289,6 +301,7 @@ func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, clo
 		dispatcherLoopStopped: make(chan struct{}),
 		closed:                make(chan struct{}),
 		compression:           compress,
+		zstdEncoder:           zstdEnc,
 		loopWG:                sync.WaitGroup{},
 	}
 }
@@ -400,6 +413,54 @@ func (c *rawConnection) Request(ctx context.Context, folder string, name string,
 	}
 }
 
+// DeltaRequest returns the bytes for the specified block, like Request.
+// Instead of transferring the whole block, the peer only sends what differs
+// from old, which is what we already have at or around the block's offset.
+// The other side must have announced FeatureDeltaRequest.
+func (c *rawConnection) DeltaRequest(ctx context.Context, folder string, name string, blockNo int, offset int64, size int, hash []byte, fromTemporary bool, old []byte) ([]byte, error) {
+	rc := make(chan asyncResult, 1)
+
+	c.awaitingMut.Lock()
+	id := c.nextID
+	c.nextID++
+	if _, ok := c.awaiting[id]; ok {
+		c.awaitingMut.Unlock()
+		panic("id taken")
+	}
+	c.awaiting[id] = rc
+	c.awaitingMut.Unlock()
+
+	chunkSize := DeltaChunkSize(size)
+	ok := c.send(ctx, &DeltaRequest{
+		ID:            id,
+		Folder:        folder,
+		Name:          name,
+		Offset:        offset,
+		Size:          size,
+		BlockNo:       blockNo,
+		Hash:          hash,
+		FromTemporary: fromTemporary,
+		ChunkSize:     chunkSize,
+		Checksums:     deltaChecksums(old, chunkSize),
+	}, nil)
+	if !ok {
+		return nil, ErrClosed
+	}
+
+	select {
+	case res, ok := <-rc:
+		if !ok {
+			return nil, ErrClosed
+		}
+		if res.err != nil {
+			return nil, res.err
+		}
+		return applyDelta(old, chunkSize, res.ops, size)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 // ClusterConfig sends the cluster configuration message to the peer.
 func (c *rawConnection) ClusterConfig(config ClusterConfig) {
 	select {
@@ -486,6 +547,9 @@ func (c *rawConnection) dispatcherLoop() (err error) {
 
 		case *Request:
 			err = checkFilename(msg.Name)
+
+		case *DeltaRequest:
+			err = checkFilename(msg.Name)
 		}
 		if err != nil {
 			return newProtocolError(err, msgContext)
@@ -507,6 +571,12 @@ func (c *rawConnection) dispatcherLoop() (err error) {
 		case *Response:
 			c.handleResponse(*msg)
 
+		case *DeltaRequest:
+			go c.handleDeltaRequest(*msg)
+
+		case *DeltaResponse:
+			c.handleDeltaResponse(*msg)
+
 		case *DownloadProgress:
 			err = c.model.DownloadProgress(msg.Folder, msg.Updates)
 		}
@@ -560,6 +630,14 @@ func (c *rawConnection) readMessageAfterHeader(hdr Header, fourByteBuf []byte) (
 		}
 		buf = decomp
 
+	case MessageCompressionZstd:
+		decomp, err := zstdDecompress(buf)
+		BufferPool.Put(buf)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing message: %w", err)
+		}
+		buf = decomp
+
 	default:
 		return nil, fmt.Errorf("unknown message compression %d", hdr.Compression)
 	}
@@ -708,7 +786,36 @@ func (c *rawConnection) handleResponse(resp Response) {
 	c.awaitingMut.Lock()
 	if rc := c.awaiting[resp.ID]; rc != nil {
 		delete(c.awaiting, resp.ID)
-		rc <- asyncResult{resp.Data, codeToError(resp.Code)}
+		rc <- asyncResult{val: resp.Data, err: codeToError(resp.Code)}
+		close(rc)
+	}
+	c.awaitingMut.Unlock()
+}
+
+func (c *rawConnection) handleDeltaRequest(req DeltaRequest) {
+	res, err := c.model.Request(req.Folder, req.Name, int32(req.BlockNo), int32(req.Size), req.Offset, req.Hash, 0, req.FromTemporary)
+	if err != nil {
+		c.send(context.Background(), &DeltaResponse{
+			ID:   req.ID,
+			Code: errorToCode(err),
+		}, nil)
+		return
+	}
+	done := make(chan struct{})
+	c.send(context.Background(), &DeltaResponse{
+		ID:   req.ID,
+		Ops:  deltaOps(res.Data(), req.ChunkSize, req.Checksums),
+		Code: errorToCode(nil),
+	}, done)
+	<-done
+	res.Close()
+}
+
+func (c *rawConnection) handleDeltaResponse(resp DeltaResponse) {
+	c.awaitingMut.Lock()
+	if rc := c.awaiting[resp.ID]; rc != nil {
+		delete(c.awaiting, resp.ID)
+		rc <- asyncResult{ops: resp.Ops, err: codeToError(resp.Code)}
 		close(rc)
 	}
 	c.awaitingMut.Unlock()
@@ -834,6 +941,9 @@ func (c *rawConnection) writeCompressedMessage(msg message, marshaled []byte) (o
 		Type:        typeOf(msg),
 		Compression: MessageCompressionLZ4,
 	}
+	if c.zstdEncoder != nil {
+		hdr.Compression = MessageCompressionZstd
+	}
 	hdrSize := hdr.ProtoSize()
 	if hdrSize > 1<<16-1 {
 		panic("impossibly large header")
@@ -850,7 +960,12 @@ func (c *rawConnection) writeCompressedMessage(msg message, marshaled []byte) (o
 	buf := BufferPool.Get(maxCompressed)
 	defer BufferPool.Put(buf)
 
-	compressedSize, err := lz4Compress(marshaled, buf[cOverhead:])
+	var compressedSize int
+	if c.zstdEncoder != nil {
+		compressedSize, err = zstdCompress(c.zstdEncoder, marshaled, buf[cOverhead:])
+	} else {
+		compressedSize, err = lz4Compress(marshaled, buf[cOverhead:])
+	}
 	totSize := compressedSize + cOverhead
 	if err != nil {
 		return false, nil
@@ -885,6 +1000,10 @@ func typeOf(msg message) MessageType {
 		return MessageTypeRequest
 	case *Response:
 		return MessageTypeResponse
+	case *DeltaRequest:
+		return MessageTypeDeltaRequest
+	case *DeltaResponse:
+		return MessageTypeDeltaResponse
 	case *DownloadProgress:
 		return MessageTypeDownloadProgress
 	case *Ping:
@@ -908,6 +1027,10 @@ func newMessage(t MessageType) (message, error) {
 		return new(Request), nil
 	case MessageTypeResponse:
 		return new(Response), nil
+	case MessageTypeDeltaRequest:
+		return new(DeltaRequest), nil
+	case MessageTypeDeltaResponse:
+		return new(DeltaResponse), nil
 	case MessageTypeDownloadProgress:
 		return new(DownloadProgress), nil
 	case MessageTypePing:
@@ -929,9 +1052,13 @@ func (c *rawConnection) shouldCompressMessage(msg message) bool {
 		return msg.ProtoSize() >= compressionThreshold
 
 	case CompressionMetadata:
-		_, isResponse := msg.(*Response)
-		// Compress if it's large enough and not a response message
-		return !isResponse && msg.ProtoSize() >= compressionThreshold
+		switch msg.(type) {
+		case *Response, *DeltaResponse:
+			// Don't compress response messages
+			return false
+		}
+		// Compress if it's large enough
+		return msg.ProtoSize() >= compressionThreshold
 
 	default:
 		panic("unknown compression setting")
@@ -1108,6 +1235,10 @@ func messageContext(msg message) (string, error) {
 		return fmt.Sprintf(`request for "%v" in %v`, msg.Name, msg.Folder), nil
 	case *Response:
 		return "response", nil
+	case *DeltaRequest:
+		return fmt.Sprintf(`delta request for "%v" in %v`, msg.Name, msg.Folder), nil
+	case *DeltaResponse:
+		return "delta response", nil
 	case *DownloadProgress:
 		return fmt.Sprintf("download-progress for %v", msg.Folder), nil
 	case *Ping:
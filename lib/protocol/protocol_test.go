@@ -32,10 +32,10 @@ func TestPing(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := getRawConnection(NewConnection(c1ID, br, aw, testutil.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c1 := getRawConnection(NewConnection(c1ID, br, aw, testutil.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(ClusterConfig{})
@@ -58,10 +58,10 @@ func TestClose(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen)
+	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen)
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(ClusterConfig{})
@@ -103,7 +103,7 @@ func TestCloseOnBlockingSend(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutil.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -154,10 +154,10 @@ func TestCloseRace(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, nil, testKeyGen))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutil.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, nil, testKeyGen)
+	c1 := NewConnection(c1ID, br, aw, testutil.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, CompressionAlgorithmLZ4, 0, nil, testKeyGen)
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(ClusterConfig{})
@@ -194,7 +194,7 @@ func TestClusterConfigFirst(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutil.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, &testutil.NoopRW{}, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c := getRawConnection(NewConnection(c0ID, rw, &testutil.NoopRW{}, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -246,7 +246,7 @@ func TestCloseTimeout(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutil.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -441,37 +441,48 @@ func testMarshal(t *testing.T, prefix string, m1, m2 message) bool {
 }
 
 func TestWriteCompressed(t *testing.T) {
-	for _, random := range []bool{false, true} {
-		buf := new(bytes.Buffer)
-		c := &rawConnection{
-			cr:          &countingReader{Reader: buf},
-			cw:          &countingWriter{Writer: buf},
-			compression: CompressionAlways,
+	for _, algo := range []CompressionAlgorithm{CompressionAlgorithmLZ4, CompressionAlgorithmZstd} {
+		for _, random := range []bool{false, true} {
+			testWriteCompressed(t, algo, random)
 		}
+	}
+}
 
-		msg := &Response{Data: make([]byte, 10240)}
-		if random {
-			// This should make the message uncompressible.
-			rand.Read(msg.Data)
-		}
+func testWriteCompressed(t *testing.T, algo CompressionAlgorithm, random bool) {
+	t.Helper()
 
-		if err := c.writeMessage(msg); err != nil {
-			t.Fatal(err)
-		}
-		got, err := c.readMessage(make([]byte, 4))
-		if err != nil {
-			t.Fatal(err)
-		}
-		if !bytes.Equal(got.(*Response).Data, msg.Data) {
-			t.Error("received the wrong message")
-		}
+	buf := new(bytes.Buffer)
+	c := &rawConnection{
+		cr:          &countingReader{Reader: buf},
+		cw:          &countingWriter{Writer: buf},
+		compression: CompressionAlways,
+	}
+	if algo == CompressionAlgorithmZstd {
+		c.zstdEncoder = zstdEncoder(0)
+	}
 
-		hdr := Header{Type: typeOf(msg)}
-		size := int64(2 + hdr.ProtoSize() + 4 + msg.ProtoSize())
-		if c.cr.Tot() > size {
-			t.Errorf("compression enlarged message from %d to %d",
-				size, c.cr.Tot())
-		}
+	msg := &Response{Data: make([]byte, 10240)}
+	if random {
+		// This should make the message uncompressible.
+		rand.Read(msg.Data)
+	}
+
+	if err := c.writeMessage(msg); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.readMessage(make([]byte, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.(*Response).Data, msg.Data) {
+		t.Error("received the wrong message")
+	}
+
+	hdr := Header{Type: typeOf(msg)}
+	size := int64(2 + hdr.ProtoSize() + 4 + msg.ProtoSize())
+	if c.cr.Tot() > size {
+		t.Errorf("compression enlarged message from %d to %d",
+			size, c.cr.Tot())
 	}
 }
 
@@ -506,6 +517,44 @@ func TestLZ4Compression(t *testing.T) {
 	}
 }
 
+func TestZstdCompression(t *testing.T) {
+	for _, level := range []int{0, 1, 9, 19} {
+		enc := zstdEncoder(level)
+		for i := 0; i < 10; i++ {
+			dataLen := 150 + rand.Intn(150)
+			data := make([]byte, dataLen)
+			_, err := io.ReadFull(rand.Reader, data[100:])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			comp := make([]byte, 2*dataLen)
+			compLen, err := zstdCompress(enc, data, comp)
+			if err != nil {
+				t.Errorf("compressing %d bytes: %v", dataLen, err)
+				continue
+			}
+
+			res, err := zstdDecompress(comp[:compLen])
+			if err != nil {
+				t.Errorf("decompressing %d bytes to %d: %v", compLen, dataLen, err)
+				continue
+			}
+			if !bytes.Equal(data, res) {
+				t.Error("Incorrect decompressed data")
+			}
+		}
+	}
+
+	// Data that doesn't compress doesn't fit in a buffer the size of the
+	// input.
+	data := make([]byte, 1000)
+	rand.Read(data)
+	if _, err := zstdCompress(zstdEncoder(0), data, make([]byte, len(data))); err == nil {
+		t.Error("expected error for too small buffer")
+	}
+}
+
 func TestLZ4CompressionUpdate(t *testing.T) {
 	uncompressed := []byte("this is some arbitrary yet fairly compressible data")
 
@@ -899,7 +948,7 @@ func TestClusterConfigAfterClose(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutil.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -923,7 +972,7 @@ func TestDispatcherToCloseDeadlock(t *testing.T) {
 	// the model callbacks (ClusterConfig).
 	m := newTestModel()
 	rw := testutil.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, &testutil.NoopRW{}, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil, testKeyGen))
+	c := getRawConnection(NewConnection(c0ID, rw, &testutil.NoopRW{}, testutil.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, 0, nil, testKeyGen))
 	m.ccFn = func(ClusterConfig) {
 		c.Close(errManual)
 	}
@@ -939,6 +988,28 @@ func TestDispatcherToCloseDeadlock(t *testing.T) {
 	}
 }
 
+func TestBlockIndex(t *testing.T) {
+	fixed := FileInfo{RawBlockSize: MinBlockSize}
+	if i := fixed.BlockIndex(3 * MinBlockSize); i != 3 {
+		t.Errorf("fixed: got index %d, expected 3", i)
+	}
+
+	cdc := FileInfo{
+		RawBlockSize:  MinBlockSize,
+		BlockChunking: BlockChunkingCDC,
+		Blocks: []BlockInfo{
+			{Offset: 0, Size: 1000},
+			{Offset: 1000, Size: 300000},
+			{Offset: 301000, Size: 5},
+		},
+	}
+	for i, b := range cdc.Blocks {
+		if got := cdc.BlockIndex(b.Offset); got != i {
+			t.Errorf("cdc: got index %d for offset %d, expected %d", got, b.Offset, i)
+		}
+	}
+}
+
 func TestBlocksEqual(t *testing.T) {
 	blocksOne := []BlockInfo{{Hash: []byte{1, 2, 3, 4}}}
 	blocksTwo := []BlockInfo{{Hash: []byte{5, 6, 7, 8}}}
@@ -39,3 +39,8 @@ func (c wireFormatConnection) Request(ctx context.Context, folder string, name s
 	name = norm.NFC.String(filepath.ToSlash(name))
 	return c.Connection.Request(ctx, folder, name, blockNo, offset, size, hash, weakHash, fromTemporary)
 }
+
+func (c wireFormatConnection) DeltaRequest(ctx context.Context, folder string, name string, blockNo int, offset int64, size int, hash []byte, fromTemporary bool, old []byte) ([]byte, error) {
+	name = norm.NFC.String(filepath.ToSlash(name))
+	return c.Connection.DeltaRequest(ctx, folder, name, blockNo, offset, size, hash, fromTemporary, old)
+}
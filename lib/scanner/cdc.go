@@ -0,0 +1,187 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package scanner
+
+import (
+	"context"
+	"errors"
+	"hash"
+	"hash/adler32"
+	"io"
+	"math/bits"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sha256"
+)
+
+// The chunk boundaries must be the same on all devices for identical
+// content to end up in identical blocks, hence the gear table is generated
+// from a fixed seed and must never change.
+const cdcGearSeed = 0x5379_6e63_7468_696e // "Syncthin"
+
+var cdcGear [256]uint64
+
+func init() {
+	// splitmix64
+	x := uint64(cdcGearSeed)
+	for i := range cdcGear {
+		x += 0x9e3779b97f4a7c15
+		z := x
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		cdcGear[i] = z ^ (z >> 31)
+	}
+}
+
+// cdcChunker finds content defined chunk boundaries using the FastCDC
+// algorithm with normalized chunking: a gear based rolling hash is
+// compared against a stricter mask before the average chunk size is
+// reached and a looser one after, which keeps the chunk sizes close to the
+// average.
+type cdcChunker struct {
+	minSize, avgSize, maxSize int
+	maskS, maskL              uint64
+}
+
+// newCDCChunker returns a chunker aiming for chunks of avgSize bytes, which
+// must be a power of two. Chunks are between a quarter of and four times
+// that, but never larger than protocol.MaxBlockSize.
+func newCDCChunker(avgSize int) cdcChunker {
+	maxSize := 4 * avgSize
+	if maxSize > protocol.MaxBlockSize {
+		maxSize = protocol.MaxBlockSize
+	}
+	n := bits.TrailingZeros(uint(avgSize))
+	return cdcChunker{
+		minSize: avgSize / 4,
+		avgSize: avgSize,
+		maxSize: maxSize,
+		// The high bits of the gear hash depend on the most input bytes,
+		// so those are what we look at.
+		maskS: ^uint64(0) << (64 - (n + 1)),
+		maskL: ^uint64(0) << (64 - (n - 1)),
+	}
+}
+
+// cut returns the length of the first chunk in data. Unless data is the
+// tail end of the input, it must be at least maxSize long.
+func (c cdcChunker) cut(data []byte) int {
+	n := len(data)
+	if n <= c.minSize {
+		return n
+	}
+	if n > c.maxSize {
+		n = c.maxSize
+	}
+	normal := c.avgSize
+	if normal > n {
+		normal = n
+	}
+
+	var h uint64
+	i := c.minSize
+	for ; i < normal; i++ {
+		h = (h << 1) + cdcGear[data[i]]
+		if h&c.maskS == 0 {
+			return i + 1
+		}
+	}
+	for ; i < n; i++ {
+		h = (h << 1) + cdcGear[data[i]]
+		if h&c.maskL == 0 {
+			return i + 1
+		}
+	}
+	return n
+}
+
+// CDCBlocks returns the blockwise hash of the reader, using content defined
+// chunking with the given average block size. As opposed to Blocks, an
+// insertion or deletion only affects the blocks around it, the blocks after
+// it are the same as before only at a different offset.
+func CDCBlocks(ctx context.Context, r io.Reader, avgSize int, sizehint int64, counter Counter, useWeakHashes bool) ([]protocol.BlockInfo, error) {
+	if counter == nil {
+		counter = &noopCounter{}
+	}
+
+	hf := sha256.New()
+	var weakHf hash.Hash32 = noopHash{}
+	if useWeakHashes {
+		weakHf = adler32.New()
+	}
+
+	chunker := newCDCChunker(avgSize)
+	bufSize := chunker.maxSize
+	var blocks []protocol.BlockInfo
+	if sizehint >= 0 {
+		r = io.LimitReader(r, sizehint)
+		if sizehint < int64(bufSize) {
+			bufSize = int(sizehint)
+		}
+		blocks = make([]protocol.BlockInfo, 0, sizehint/int64(avgSize)+1)
+	}
+	buf := protocol.BufferPool.Get(bufSize)
+	defer protocol.BufferPool.Put(buf)
+
+	var offset int64
+	filled := 0
+	eof := false
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		if !eof && filled < len(buf) {
+			n, err := io.ReadFull(r, buf[filled:])
+			filled += n
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				eof = true
+			} else if err != nil {
+				return nil, err
+			}
+			if filled == len(buf) && len(buf) < chunker.maxSize {
+				// The buffer was sized to the limited reader, so there is
+				// nothing more to read.
+				eof = true
+			}
+		}
+		if filled == 0 {
+			break
+		}
+
+		n := chunker.cut(buf[:filled])
+		chunk := buf[:n]
+		counter.Update(int64(n))
+
+		hf.Write(chunk)
+		weakHf.Write(chunk)
+		blocks = append(blocks, protocol.BlockInfo{
+			Size:     n,
+			Offset:   offset,
+			Hash:     hf.Sum(nil),
+			WeakHash: weakHf.Sum32(),
+		})
+		hf.Reset()
+		weakHf.Reset()
+
+		offset += int64(n)
+		filled = copy(buf, buf[n:filled])
+	}
+
+	if len(blocks) == 0 {
+		// Empty file
+		blocks = append(blocks, protocol.BlockInfo{
+			Offset: 0,
+			Size:   0,
+			Hash:   SHA256OfNothing,
+		})
+	}
+
+	return blocks, nil
+}
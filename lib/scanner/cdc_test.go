@@ -0,0 +1,114 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package scanner
+
+import (
+	"bytes"
+	"context"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestCDCBlocksEmpty(t *testing.T) {
+	blocks, err := CDCBlocks(context.TODO(), bytes.NewReader(nil), protocol.MinBlockSize, 0, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocks) != 1 || blocks[0].Size != 0 || !bytes.Equal(blocks[0].Hash, SHA256OfNothing) {
+		t.Errorf("unexpected blocks for empty file: %v", blocks)
+	}
+}
+
+func TestCDCBlocksSizes(t *testing.T) {
+	data := make([]byte, 8<<20)
+	mrand.New(mrand.NewSource(42)).Read(data)
+
+	for _, sizehint := range []int64{-1, int64(len(data))} {
+		blocks, err := CDCBlocks(context.TODO(), bytes.NewReader(data), protocol.MinBlockSize, sizehint, nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		c := newCDCChunker(protocol.MinBlockSize)
+		var offset int64
+		for i, b := range blocks {
+			if b.Offset != offset {
+				t.Fatalf("block %d at offset %d, expected %d", i, b.Offset, offset)
+			}
+			if b.Size > c.maxSize || b.Size < c.minSize && i != len(blocks)-1 {
+				t.Errorf("block %d has size %d outside of [%d, %d]", i, b.Size, c.minSize, c.maxSize)
+			}
+			if !Validate(data[b.Offset:b.Offset+int64(b.Size)], b.Hash, b.WeakHash) {
+				t.Errorf("block %d doesn't validate", i)
+			}
+			offset += int64(b.Size)
+		}
+		if offset != int64(len(data)) {
+			t.Errorf("blocks cover %d bytes, expected %d", offset, len(data))
+		}
+
+		// With normalized chunking the average should be in the vicinity
+		// of the target size.
+		if avg := len(data) / len(blocks); avg < c.avgSize/2 || avg > 2*c.avgSize {
+			t.Errorf("average block size %d too far from %d", avg, c.avgSize)
+		}
+	}
+}
+
+func TestCDCBlocksShifted(t *testing.T) {
+	data := make([]byte, 8<<20)
+	mrand.New(mrand.NewSource(42)).Read(data)
+	shifted := append([]byte("an insertion at the start"), data...)
+
+	orig, err := CDCBlocks(context.TODO(), bytes.NewReader(data), protocol.MinBlockSize, -1, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks, err := CDCBlocks(context.TODO(), bytes.NewReader(shifted), protocol.MinBlockSize, -1, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hashes := make(map[string]struct{}, len(orig))
+	for _, b := range orig {
+		hashes[string(b.Hash)] = struct{}{}
+	}
+	changed := 0
+	for _, b := range blocks {
+		if _, ok := hashes[string(b.Hash)]; !ok {
+			changed++
+		}
+	}
+	// Only the block(s) around the insertion should differ.
+	if changed > 2 {
+		t.Errorf("%d of %d blocks changed by an insertion", changed, len(blocks))
+	}
+}
+
+func TestCDCBoundariesStable(t *testing.T) {
+	// The chunk boundaries are part of what makes devices agree on
+	// blocks. If this test fails, the chunker has changed in an
+	// incompatible manner.
+	data := make([]byte, 1<<20)
+	mrand.New(mrand.NewSource(1)).Read(data)
+
+	blocks, err := CDCBlocks(context.TODO(), bytes.NewReader(data), protocol.MinBlockSize, -1, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []int{189254, 184782, 86593, 347231, 199168, 41548}
+	if len(blocks) != len(expected) {
+		t.Fatalf("got %d blocks, expected %d", len(blocks), len(expected))
+	}
+	for i, b := range blocks {
+		if b.Size != expected[i] {
+			t.Errorf("block %d has size %d, expected %d", i, b.Size, expected[i])
+		}
+	}
+}
@@ -64,6 +64,8 @@ type Config struct {
 	ScanXattrs bool
 	// Filter for extended attributes
 	XattrFilter XattrFilter
+	// How to split newly hashed files into blocks
+	BlockChunking protocol.BlockChunking
 }
 
 type CurrentFiler interface {
@@ -402,6 +404,7 @@ func (w *walker) walkRegular(ctx context.Context, relPath string, info fs.FileIn
 	f = w.updateFileInfo(f, curFile)
 	f.NoPermissions = w.IgnorePerms
 	f.RawBlockSize = blockSize
+	f.BlockChunking = w.BlockChunking
 	l.Debugln(w, "checking:", f)
 
 	if hasCurFile {
@@ -0,0 +1,180 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package semaphore
+
+import (
+	"context"
+	"sync"
+)
+
+// Fair is a semaphore that shares its capacity between competing keys
+// (such as folders) according to their weights. When there is contention,
+// a key with weight two gets twice the share of a key with weight one,
+// regardless of how much either one asks for. Waiting takers are served
+// in start time fair queueing order, so no key can starve another.
+type Fair struct {
+	max       int
+	available int
+	mut       sync.Mutex
+	vtime     float64            // start tag of the last take let through
+	finish    map[string]float64 // finish tag of the last take per key
+	waiting   []*fairWaiter
+}
+
+type fairWaiter struct {
+	size   int
+	start  float64
+	finish float64
+	ready  chan struct{}
+}
+
+func NewFair(max int) *Fair {
+	if max < 0 {
+		max = 0
+	}
+	return &Fair{
+		max:       max,
+		available: max,
+		finish:    make(map[string]float64),
+	}
+}
+
+// TakeWithContext takes size from the semaphore on behalf of key, which
+// has the given weight. A weight less than one is treated as one.
+func (s *Fair) TakeWithContext(ctx context.Context, key string, weight, size int) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	s.mut.Lock()
+	if size > s.max {
+		size = s.max
+	}
+	if weight < 1 {
+		weight = 1
+	}
+	start := s.vtime
+	if prev := s.finish[key]; prev > start {
+		start = prev
+	}
+	w := &fairWaiter{
+		size:   size,
+		start:  start,
+		finish: start + float64(size)/float64(weight),
+		ready:  make(chan struct{}),
+	}
+	s.finish[key] = w.finish
+	s.waiting = append(s.waiting, w)
+	s.dispatchLocked()
+	s.mut.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	select {
+	case <-w.ready:
+		// We got it after all, but the caller won't know to give it back.
+		s.giveLocked(w.size)
+	default:
+		for i, o := range s.waiting {
+			if o == w {
+				s.waiting = append(s.waiting[:i], s.waiting[i+1:]...)
+				break
+			}
+		}
+		// Someone else may fit now that we aren't in the way.
+		s.dispatchLocked()
+	}
+	return ctx.Err()
+}
+
+func (s *Fair) Take(key string, weight, size int) {
+	_ = s.TakeWithContext(context.Background(), key, weight, size)
+}
+
+func (s *Fair) Give(size int) {
+	s.mut.Lock()
+	if size > s.max {
+		size = s.max
+	}
+	s.giveLocked(size)
+	s.mut.Unlock()
+}
+
+func (s *Fair) giveLocked(size int) {
+	if s.available+size > s.max {
+		s.available = s.max
+	} else {
+		s.available += size
+	}
+	if len(s.waiting) == 0 && s.available == s.max {
+		// Idle; there is no history worth keeping.
+		s.vtime = 0
+		s.finish = make(map[string]float64)
+		return
+	}
+	s.dispatchLocked()
+}
+
+func (s *Fair) SetCapacity(capacity int) {
+	if capacity < 0 {
+		capacity = 0
+	}
+	s.mut.Lock()
+	diff := capacity - s.max
+	s.max = capacity
+	s.available += diff
+	if s.available < 0 {
+		s.available = 0
+	} else if s.available > s.max {
+		s.available = s.max
+	}
+	for _, w := range s.waiting {
+		if w.size > s.max {
+			w.size = s.max
+		}
+	}
+	s.dispatchLocked()
+	s.mut.Unlock()
+}
+
+func (s *Fair) Available() int {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return s.available
+}
+
+// dispatchLocked lets through waiters in order of their finish tags, for as
+// long as they fit. The first waiter that doesn't fit blocks those behind
+// it, so that large takes aren't starved by smaller ones.
+func (s *Fair) dispatchLocked() {
+	for len(s.waiting) > 0 {
+		next := 0
+		for i, w := range s.waiting {
+			if w.finish < s.waiting[next].finish {
+				next = i
+			}
+		}
+		w := s.waiting[next]
+		if w.size > s.available {
+			return
+		}
+		s.available -= w.size
+		if w.start > s.vtime {
+			s.vtime = w.start
+		}
+		s.waiting = append(s.waiting[:next], s.waiting[next+1:]...)
+		close(w.ready)
+	}
+}
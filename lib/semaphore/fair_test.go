@@ -0,0 +1,108 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package semaphore
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFairWeights(t *testing.T) {
+	t.Parallel()
+
+	// Released capacity should go to the key with the higher weight first,
+	// even if the other key has been waiting longer.
+
+	s := NewFair(10)
+	s.Take("hold", 1, 10)
+
+	got := make(chan string, 12)
+	enqueue := func(key string, weight int) {
+		n := s.numWaiting()
+		go func() {
+			s.Take(key, weight, 10)
+			got <- key
+		}()
+		for s.numWaiting() == n {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	for i := 0; i < 8; i++ {
+		enqueue("bulk", 1)
+	}
+	for i := 0; i < 4; i++ {
+		enqueue("docs", 4)
+	}
+
+	var order []string
+	for i := 0; i < 12; i++ {
+		s.Give(10)
+		select {
+		case key := <-got:
+			order = append(order, key)
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for take")
+		}
+	}
+
+	docs := 0
+	for _, key := range order[:5] {
+		if key == "docs" {
+			docs++
+		}
+	}
+	if docs != 4 {
+		t.Errorf("expected docs to be served first, got order %v", order)
+	}
+}
+
+func TestFairZero(t *testing.T) {
+	t.Parallel()
+
+	// A semaphore with zero capacity is just a no-op.
+
+	s := NewFair(0)
+	s.Take("a", 1, 123)
+	s.Take("b", 0, 456)
+	s.Give(1 << 30)
+}
+
+func TestFairCancel(t *testing.T) {
+	t.Parallel()
+
+	s := NewFair(100)
+	s.Take("a", 1, 100)
+
+	// A cancelled take shouldn't block those behind it.
+	ctx, cancel := context.WithCancel(context.Background())
+	errC := make(chan error)
+	go func() {
+		errC <- s.TakeWithContext(ctx, "a", 1, 100)
+	}()
+	for s.numWaiting() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-errC; err == nil {
+		t.Fatal("expected error from cancelled take")
+	}
+
+	s.Give(50)
+	if err := s.TakeWithContext(context.Background(), "b", 1, 50); err != nil {
+		t.Fatal(err)
+	}
+	if s.Available() != 0 {
+		t.Errorf("expected nothing available, got %d", s.Available())
+	}
+}
+
+func (s *Fair) numWaiting() int {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return len(s.waiting)
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/osutil"
+)
+
+func init() {
+	// Register the constructor for this type of snapshotter with the name "command"
+	factories["command"] = newCommand
+}
+
+// command creates and releases snapshots by running external commands,
+// e.g. to take a file system snapshot, bind mount one, or simply copy the
+// folder.
+type command struct {
+	createCommand  string
+	releaseCommand string
+	timeout        time.Duration
+	placeholders   map[string]string
+}
+
+func newCommand(cfg config.FolderConfiguration) Snapshotter {
+	c := command{
+		createCommand:  cfg.Snapshot.CreateCommand,
+		releaseCommand: cfg.Snapshot.ReleaseCommand,
+		timeout:        time.Duration(cfg.Snapshot.TimeoutS) * time.Second,
+		placeholders: map[string]string{
+			"%FOLDER_ID%":   cfg.ID,
+			"%FOLDER_PATH%": cfg.Path,
+		},
+	}
+
+	l.Debugf("instantiated %#v", c)
+	return c
+}
+
+func (c command) Create(ctx context.Context) (string, error) {
+	if c.createCommand == "" {
+		return "", errors.New("create command is empty, please enter a valid command")
+	}
+	out, err := c.run(ctx, c.createCommand, nil)
+	if err != nil {
+		return "", fmt.Errorf("create command: %w", err)
+	}
+
+	// The path is the last line of the output.
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	path := strings.TrimSpace(lines[len(lines)-1])
+	if path == "" {
+		return "", errors.New("create command didn't output the snapshot path")
+	}
+	return path, nil
+}
+
+func (c command) Release(ctx context.Context, path string) error {
+	if c.releaseCommand == "" {
+		return nil
+	}
+	if _, err := c.run(ctx, c.releaseCommand, map[string]string{"%SNAPSHOT_PATH%": path}); err != nil {
+		return fmt.Errorf("release command: %w", err)
+	}
+	return nil
+}
+
+// run runs the command with the placeholders replaced and returns its
+// standard output.
+func (c command) run(ctx context.Context, cmdline string, extra map[string]string) ([]byte, error) {
+	placeholders := make(map[string]string, len(c.placeholders)+len(extra))
+	for key, val := range c.placeholders {
+		placeholders[key] = val
+	}
+	for key, val := range extra {
+		placeholders[key] = val
+	}
+	cmd := osutil.Command{
+		Line:         cmdline,
+		Placeholders: placeholders,
+		Timeout:      c.timeout,
+	}
+	out, err := cmd.Run(ctx)
+	l.Debugf("command %q output: %q", cmdline, out)
+	return out, err
+}
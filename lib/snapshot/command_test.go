@@ -0,0 +1,89 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package snapshot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+func TestCommandCopy(t *testing.T) {
+	if build.IsWindows {
+		t.Skip("uses shell commands")
+	}
+
+	dir := t.TempDir()
+	folder := filepath.Join(dir, "folder")
+	if err := os.Mkdir(folder, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(folder, "file"), []byte("data"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// A plain copy of the folder serves as the snapshot.
+	snap := filepath.Join(dir, "snapshot")
+	s, err := New(config.FolderConfiguration{
+		ID:   "default",
+		Path: folder,
+		Snapshot: config.SnapshotConfiguration{
+			Type:           "command",
+			CreateCommand:  `sh -c 'echo creating; cp -R "$1" "$2" && echo "$2"' - %FOLDER_PATH% ` + snap,
+			ReleaseCommand: "rm -r %SNAPSHOT_PATH%",
+			TimeoutS:       10,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := s.Create(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != snap {
+		t.Errorf("got snapshot path %q, expected %q", path, snap)
+	}
+	if data, err := os.ReadFile(filepath.Join(path, "file")); err != nil || string(data) != "data" {
+		t.Errorf("unexpected snapshot contents %q, %v", data, err)
+	}
+
+	if err := s.Release(context.Background(), path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(snap); !os.IsNotExist(err) {
+		t.Error("snapshot still exists after release")
+	}
+}
+
+func TestCommandFailure(t *testing.T) {
+	if build.IsWindows {
+		t.Skip("uses shell commands")
+	}
+
+	s, err := New(config.FolderConfiguration{
+		Snapshot: config.SnapshotConfiguration{
+			Type:          "command",
+			CreateCommand: "sh -c 'echo nope >&2; exit 1'",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Create(context.Background()); err == nil {
+		t.Error("expected an error from a failing command")
+	}
+
+	if _, err := New(config.FolderConfiguration{Snapshot: config.SnapshotConfiguration{Type: "nonexistent"}}); err == nil {
+		t.Error("expected an error for an unknown type")
+	}
+}
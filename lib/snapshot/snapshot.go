@@ -0,0 +1,43 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package snapshot provides read-only snapshots of folders, so that scans
+// see a consistent state of the files instead of one that changes while
+// they run.
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+// A Snapshotter creates snapshots of a folder. The files in a snapshot
+// have the same names, relative to its path, as in the folder.
+type Snapshotter interface {
+	// Create creates a snapshot and returns its path.
+	Create(ctx context.Context) (string, error)
+	// Release removes a snapshot once it's no longer used.
+	Release(ctx context.Context, path string) error
+}
+
+type factory func(cfg config.FolderConfiguration) Snapshotter
+
+var factories = make(map[string]factory)
+
+// New returns the snapshotter configured for the folder, or nil if it
+// doesn't use snapshots.
+func New(cfg config.FolderConfiguration) (Snapshotter, error) {
+	if cfg.Snapshot.Type == "" {
+		return nil, nil
+	}
+	fac, ok := factories[cfg.Snapshot.Type]
+	if !ok {
+		return nil, fmt.Errorf("requested snapshot type %q does not exist", cfg.Snapshot.Type)
+	}
+	return fac(cfg), nil
+}
@@ -148,6 +148,17 @@ func copyFile(src, dst string) error {
 	return nil
 }
 
-func OpenDBBackend(path string, tuning config.Tuning) (backend.Backend, error) {
-	return backend.Open(path, backend.Tuning(tuning))
+// OpenDBBackend opens the database of the given kind at its usual location.
+func OpenDBBackend(kind config.DatabaseBackend, tuning config.Tuning) (backend.Backend, error) {
+	if kind != config.DatabaseBackendSQLite {
+		return backend.Open(locations.Get(locations.Database), backend.Tuning(tuning))
+	}
+
+	path := locations.Get(locations.DatabaseSQLite)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if _, err := os.Stat(locations.Get(locations.Database)); err == nil {
+			l.Infoln(`Creating a new SQLite database. Stop Syncthing and run "syncthing cli debug index migrate" to copy the existing LevelDB database into it instead.`)
+		}
+	}
+	return backend.OpenSQLite(path)
 }
@@ -150,6 +150,8 @@ func (s *Service) reportData(ctx context.Context, urVersion int, preview bool) (
 			report.FolderUses.ExternalVersioning++
 		case "trashcan":
 			report.FolderUses.TrashcanVersioning++
+		case "dedup":
+			// Not yet part of the usage report
 		default:
 			l.Warnf("Unhandled versioning type for usage reports: %s", cfg.Versioning.Type)
 		}
@@ -0,0 +1,634 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sha256"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+func init() {
+	// Register the constructor for this type of versioner with the name "dedup"
+	factories["dedup"] = newDedup
+}
+
+const (
+	dedupManifestsDir = "manifests"
+	dedupBlocksDir    = "blocks"
+)
+
+// dedup keeps versions as manifests listing the hashes of the file's
+// blocks, with the blocks stored once by hash. The blocks are the ones the
+// file was scanned with when archived through ArchiveBlocks, or otherwise
+// fixed size blocks like the scanner's, so unchanged parts of a file are
+// shared between its versions, and with other files. Versions are expired like for the simple
+// versioner, and blocks no longer used by any version are removed when
+// cleaning.
+type dedup struct {
+	keep         int
+	cleanoutDays int
+	folderFs     fs.Filesystem
+	versionsFs   fs.Filesystem
+	manifestsFs  fs.Filesystem
+	blocksFs     fs.Filesystem
+	retention    retention
+	mut          sync.Mutex
+}
+
+// dedupManifest describes an archived version of a file.
+type dedupManifest struct {
+	Size      int64     `json:"size"`
+	ModTime   time.Time `json:"modTime"`
+	BlockSize int       `json:"blockSize"`
+	Blocks    [][]byte  `json:"blocks"`
+}
+
+func newDedup(cfg config.FolderConfiguration) Versioner {
+	keep, err := strconv.Atoi(cfg.Versioning.Params["keep"])
+	if err != nil {
+		keep = 5 // A reasonable default
+	}
+	cleanoutDays, _ := strconv.Atoi(cfg.Versioning.Params["cleanoutDays"])
+
+	folderFs := cfg.Filesystem(nil)
+	versionsFs := versionerFsFromFolderCfg(cfg)
+	subFs := func(name string) fs.Filesystem {
+		return fs.NewFilesystem(versionsFs.Type(), filepath.Join(versionsFs.URI(), name), connOptions(folderFs)...)
+	}
+
+	v := &dedup{
+		keep:         keep,
+		cleanoutDays: cleanoutDays,
+		folderFs:     folderFs,
+		versionsFs:   versionsFs,
+		manifestsFs:  subFs(dedupManifestsDir),
+		blocksFs:     subFs(dedupBlocksDir),
+		retention:    retention{cfg.Versioning.Retention},
+		mut:          sync.NewMutex(),
+	}
+
+	l.Debugf("instantiated %#v", v)
+	return v
+}
+
+// Archive stores the named file as a version and removes it. If this
+// function returns nil, the named file does not exist any more (has been
+// archived).
+func (v *dedup) Archive(filePath string) error {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+	return v.archive(filePath, nil)
+}
+
+// ArchiveBlocks archives the named file like Archive, using the blocks of
+// the given file info if the file is unchanged since it was scanned. This
+// keeps the blocks of versions the same as the folder's, also when those
+// are content defined.
+func (v *dedup) ArchiveBlocks(filePath string, file protocol.FileInfo) error {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+	return v.archive(filePath, &file)
+}
+
+func (v *dedup) archive(filePath string, scanned *protocol.FileInfo) error {
+	filePath = osutil.NativeFilename(filePath)
+	info, err := v.folderFs.Lstat(filePath)
+	if fs.IsNotExist(err) {
+		l.Debugln("not archiving nonexistent file", filePath)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if info.IsSymlink() {
+		panic("bug: attempting to version a symlink")
+	}
+
+	if _, err := v.versionsFs.Stat("."); fs.IsNotExist(err) {
+		l.Debugln("creating versions dir")
+		if err := v.versionsFs.MkdirAll(".", 0o755); err != nil {
+			return err
+		}
+		_ = v.versionsFs.Hide(".")
+	} else if err != nil {
+		return err
+	}
+
+	var m dedupManifest
+	if scanned != nil && scannedBlocksMatch(info, *scanned) {
+		m, err = v.storeScannedBlocks(filePath, info, *scanned)
+	} else {
+		m, err = v.storeBlocks(filePath, info)
+	}
+	if err != nil {
+		return fmt.Errorf("storing blocks: %w", err)
+	}
+
+	name := TagFilename(filePath, time.Now().Format(TimeFormat))
+	l.Debugln("archiving", filePath, "as", name)
+	if err := v.writeManifest(name, m); err != nil {
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+	if err := v.folderFs.Remove(filePath); err != nil {
+		return err
+	}
+
+	cleanVersions(v.manifestsFs, findAllVersions(v.manifestsFs, filePath), v.toRemove)
+
+	return nil
+}
+
+// storeBlocks stores the blocks of the file that aren't stored already,
+// and returns the manifest listing them.
+func (v *dedup) storeBlocks(filePath string, info fs.FileInfo) (dedupManifest, error) {
+	fd, err := v.folderFs.Open(filePath)
+	if err != nil {
+		return dedupManifest{}, err
+	}
+	defer fd.Close()
+
+	m := dedupManifest{
+		Size:      info.Size(),
+		ModTime:   info.ModTime(),
+		BlockSize: protocol.BlockSize(info.Size()),
+	}
+	buf := make([]byte, m.BlockSize)
+	for {
+		n, err := io.ReadFull(fd, buf)
+		if n > 0 {
+			hash := sha256.Sum256(buf[:n])
+			if err := v.storeBlock(hash[:], buf[:n]); err != nil {
+				return dedupManifest{}, err
+			}
+			m.Blocks = append(m.Blocks, hash[:])
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return m, nil
+		} else if err != nil {
+			return dedupManifest{}, err
+		}
+	}
+}
+
+// storeScannedBlocks stores the blocks of the file as listed when it was
+// scanned, reading only the ones that aren't stored already, and returns
+// the manifest listing them.
+func (v *dedup) storeScannedBlocks(filePath string, info fs.FileInfo, file protocol.FileInfo) (dedupManifest, error) {
+	fd, err := v.folderFs.Open(filePath)
+	if err != nil {
+		return dedupManifest{}, err
+	}
+	defer fd.Close()
+
+	m := dedupManifest{
+		Size:      info.Size(),
+		ModTime:   info.ModTime(),
+		BlockSize: file.BlockSize(),
+	}
+	for _, b := range file.Blocks {
+		hash := b.Hash
+		if _, err := v.blocksFs.Lstat(dedupBlockName(hash)); err != nil {
+			buf := make([]byte, b.Size)
+			if _, err := fd.ReadAt(buf, b.Offset); err != nil {
+				return dedupManifest{}, err
+			}
+			if sum := sha256.Sum256(buf); !bytes.Equal(sum[:], hash) {
+				// Changed without changing size or modification time;
+				// store what's there now.
+				hash = sum[:]
+			}
+			if err := v.storeBlock(hash, buf); err != nil {
+				return dedupManifest{}, err
+			}
+		}
+		m.Blocks = append(m.Blocks, hash)
+	}
+	return m, nil
+}
+
+// scannedBlocksMatch returns whether the file looks unchanged since it was
+// scanned, with the blocks covering all of it.
+func scannedBlocksMatch(info fs.FileInfo, file protocol.FileInfo) bool {
+	if info.Size() != file.Size || !info.ModTime().Equal(file.ModTime()) {
+		return false
+	}
+	var offset int64
+	for _, b := range file.Blocks {
+		if b.Offset != offset || len(b.Hash) != sha256.Size {
+			return false
+		}
+		offset += int64(b.Size)
+	}
+	return offset == file.Size
+}
+
+func (v *dedup) storeBlock(hash, data []byte) error {
+	name := dedupBlockName(hash)
+	if _, err := v.blocksFs.Lstat(name); err == nil {
+		return nil
+	}
+	if err := v.blocksFs.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	return writeFileAtomic(v.blocksFs, name, data)
+}
+
+func (v *dedup) writeManifest(name string, m dedupManifest) error {
+	bs, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if err := v.manifestsFs.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	return writeFileAtomic(v.manifestsFs, name, bs)
+}
+
+func (v *dedup) readManifest(name string) (dedupManifest, error) {
+	fd, err := v.manifestsFs.Open(name)
+	if err != nil {
+		return dedupManifest{}, err
+	}
+	defer fd.Close()
+	var m dedupManifest
+	if err := json.NewDecoder(fd).Decode(&m); err != nil {
+		return dedupManifest{}, fmt.Errorf("%s: %w", name, err)
+	}
+	return m, nil
+}
+
+// assemble writes the contents of the version to the named file.
+func (v *dedup) assemble(m dedupManifest, dstFs fs.Filesystem, name string) error {
+	fd, err := dstFs.Create(name)
+	if err != nil {
+		return err
+	}
+	for _, hash := range m.Blocks {
+		bs, err := v.readBlock(hash)
+		if err == nil {
+			_, err = fd.Write(bs)
+		}
+		if err != nil {
+			fd.Close()
+			dstFs.Remove(name)
+			return err
+		}
+	}
+	if err := fd.Close(); err != nil {
+		dstFs.Remove(name)
+		return err
+	}
+	_ = dstFs.Chtimes(name, m.ModTime, m.ModTime)
+	return nil
+}
+
+func (v *dedup) readBlock(hash []byte) ([]byte, error) {
+	fd, err := v.blocksFs.Open(dedupBlockName(hash))
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	bs, err := io.ReadAll(fd)
+	if err != nil {
+		return nil, err
+	}
+	if sum := sha256.Sum256(bs); !bytes.Equal(sum[:], hash) {
+		return nil, fmt.Errorf("block %x is corrupt", hash)
+	}
+	return bs, nil
+}
+
+func (v *dedup) GetVersions() (map[string][]FileVersion, error) {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	files := make(map[string][]FileVersion)
+	if _, err := v.manifestsFs.Stat("."); fs.IsNotExist(err) {
+		return files, nil
+	}
+	err := v.manifestsFs.Walk(".", func(path string, f fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !f.IsRegular() {
+			return nil
+		}
+		name, tag := UntagFilename(path)
+		if name == "" {
+			return nil
+		}
+		versionTime, err := time.ParseInLocation(TimeFormat, tag, time.Local)
+		if err != nil {
+			return nil
+		}
+		m, err := v.readManifest(path)
+		if err != nil {
+			l.Debugln("dedup versioner:", err)
+			return nil
+		}
+		name = osutil.NormalizedFilename(name)
+		files[name] = append(files[name], FileVersion{
+			VersionTime: versionTime,
+			ModTime:     m.ModTime.Truncate(time.Second),
+			Size:        m.Size,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (v *dedup) Restore(filePath string, versionTime time.Time) error {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	filePath = osutil.NativeFilename(filePath)
+	name := TagFilename(filePath, versionTime.In(time.Local).Truncate(time.Second).Format(TimeFormat))
+	m, err := v.readManifest(name)
+	if fs.IsNotExist(err) {
+		return errNotFound
+	} else if err != nil {
+		return err
+	}
+
+	// If the something already exists where we are restoring to, archive existing file for versioning
+	// remove if it's a symlink, or fail if it's a directory
+	if info, err := v.folderFs.Lstat(filePath); err == nil {
+		switch {
+		case info.IsDir():
+			return ErrDirectory
+		case info.IsSymlink():
+			if err := v.folderFs.Remove(filePath); err != nil {
+				return fmt.Errorf("removing existing symlink: %w", err)
+			}
+		case info.IsRegular():
+			if err := v.archive(filePath, nil); err != nil {
+				return fmt.Errorf("archiving existing file: %w", err)
+			}
+		}
+	} else if !fs.IsNotExist(err) {
+		return err
+	}
+
+	_ = v.folderFs.MkdirAll(filepath.Dir(filePath), 0o755)
+	tempName := fs.TempName(filePath)
+	if err := v.assemble(m, v.folderFs, tempName); err != nil {
+		return err
+	}
+	if err := v.folderFs.Rename(tempName, filePath); err != nil {
+		return err
+	}
+	return v.manifestsFs.Remove(name)
+}
+
+// Open assembles the version in a temporary file in the versions
+// directory, which is removed when closed.
+func (v *dedup) Open(filePath string, versionTime time.Time) (fs.File, error) {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	filePath = osutil.NativeFilename(filePath)
+	m, err := v.readManifest(TagFilename(filePath, versionTime.In(time.Local).Truncate(time.Second).Format(TimeFormat)))
+	if fs.IsNotExist(err) {
+		return nil, errNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	tempName := fs.TempName(rand.String(16))
+	if err := v.assemble(m, v.versionsFs, tempName); err != nil {
+		return nil, err
+	}
+	fd, err := v.versionsFs.Open(tempName)
+	if err != nil {
+		v.versionsFs.Remove(tempName)
+		return nil, err
+	}
+	return &removeOnClose{File: fd, fs: v.versionsFs, name: tempName}, nil
+}
+
+// Clean expires old versions and removes the blocks that are no longer
+// used by any version.
+func (v *dedup) Clean(ctx context.Context) error {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	if err := clean(ctx, v.manifestsFs, v.toRemove); err != nil {
+		return err
+	}
+	if err := v.limitSize(ctx); err != nil {
+		return err
+	}
+	return v.removeUnusedBlocks(ctx)
+}
+
+func (v *dedup) removeUnusedBlocks(ctx context.Context) error {
+	if _, err := v.blocksFs.Stat("."); fs.IsNotExist(err) {
+		return nil
+	}
+
+	used := make(map[string]struct{})
+	if _, err := v.manifestsFs.Stat("."); err == nil {
+		err := v.manifestsFs.Walk(".", func(path string, f fs.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !f.IsRegular() {
+				return nil
+			}
+			m, err := v.readManifest(path)
+			if err != nil {
+				// Rather keep blocks than lose ones that are used.
+				return err
+			}
+			for _, hash := range m.Blocks {
+				used[dedupBlockName(hash)] = struct{}{}
+			}
+			return ctx.Err()
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return v.blocksFs.Walk(".", func(path string, f fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !f.IsRegular() {
+			return nil
+		}
+		if _, ok := used[path]; !ok {
+			l.Debugln("dedup versioner: removing unused block", path)
+			if err := v.blocksFs.Remove(path); err != nil {
+				l.Warnf("Versioner: can't remove %q: %v", path, err)
+			}
+		}
+		return ctx.Err()
+	})
+}
+
+func (v *dedup) toRemove(versions []string, now time.Time) []string {
+	return simple{keep: v.keep, cleanoutDays: v.cleanoutDays, retention: v.retention}.toRemove(versions, now)
+}
+
+// limitSize removes the oldest versions while the blocks they use exceed
+// the maximum total size, as that's what the archive takes up. Removing a
+// version only frees the blocks no other version uses, which are then
+// removed along with the other unused blocks.
+func (v *dedup) limitSize(ctx context.Context) error {
+	if _, err := v.manifestsFs.Lstat("."); fs.IsNotExist(err) {
+		return nil
+	}
+	maxSize, err := v.retention.maxSize(v.versionsFs)
+	if err != nil || maxSize <= 0 {
+		return err
+	}
+
+	type version struct {
+		path   string
+		time   time.Time
+		blocks []string
+	}
+	var versions []version
+	users := make(map[string]int) // block name -> number of uses
+	sizes := make(map[string]int64)
+	var total int64
+	dirTracker := make(emptyDirTracker)
+	err = v.manifestsFs.Walk(".", func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if info.IsDir() && !info.IsSymlink() {
+			dirTracker.addDir(path)
+			return nil
+		}
+		dirTracker.addFile(path)
+		if !info.IsRegular() || fs.IsTemporary(path) {
+			return nil
+		}
+		t, ok := taggedVersionTime(path)
+		if !ok {
+			return nil
+		}
+		m, err := v.readManifest(path)
+		if err != nil {
+			l.Debugln("dedup versioner:", err)
+			return nil
+		}
+		ver := version{path: path, time: t}
+		for _, hash := range m.Blocks {
+			name := dedupBlockName(hash)
+			if _, ok := sizes[name]; !ok {
+				if info, err := v.blocksFs.Lstat(name); err == nil {
+					sizes[name] = info.Size()
+					total += info.Size()
+				}
+			}
+			users[name]++
+			ver.blocks = append(ver.blocks, name)
+		}
+		versions = append(versions, ver)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if total <= maxSize {
+		return nil
+	}
+
+	// Oldest first
+	sort.Slice(versions, func(a, b int) bool {
+		return versions[a].time.Before(versions[b].time)
+	})
+	for _, ver := range versions {
+		if total <= maxSize {
+			break
+		}
+		l.Debugln("Versioner: total size over maximum -> delete", ver.path)
+		if err := v.manifestsFs.Remove(ver.path); err != nil {
+			l.Warnf("Versioner: can't remove %q: %v", ver.path, err)
+			continue
+		}
+		for _, name := range ver.blocks {
+			if users[name]--; users[name] == 0 {
+				total -= sizes[name]
+			}
+		}
+	}
+
+	dirTracker.deleteEmptyDirs(v.manifestsFs)
+	return nil
+}
+
+func (v *dedup) String() string {
+	return fmt.Sprintf("dedup@%p", v)
+}
+
+// dedupBlockName returns the name a block is stored under, spread over
+// directories by the first byte of the hash.
+func dedupBlockName(hash []byte) string {
+	h := hex.EncodeToString(hash)
+	return filepath.Join(h[:2], h)
+}
+
+// writeFileAtomic writes the data to a temporary file which is then
+// renamed into place.
+func writeFileAtomic(filesystem fs.Filesystem, name string, data []byte) error {
+	tempName := fs.TempName(name)
+	fd, err := filesystem.Create(tempName)
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(data); err != nil {
+		fd.Close()
+		filesystem.Remove(tempName)
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		filesystem.Remove(tempName)
+		return err
+	}
+	return filesystem.Rename(tempName, name)
+}
+
+// removeOnClose is a file that is removed when closed.
+type removeOnClose struct {
+	fs.File
+	fs   fs.Filesystem
+	name string
+}
+
+func (f *removeOnClose) Close() error {
+	err := f.File.Close()
+	if rerr := f.fs.Remove(f.name); err == nil {
+		err = rerr
+	}
+	return err
+}
@@ -0,0 +1,242 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sha256"
+)
+
+func TestDedupVersioning(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           dir,
+		Versioning: config.VersioningConfiguration{
+			Params: map[string]string{
+				"keep": "2",
+			},
+		},
+	}
+	folderFs := cfg.Filesystem(nil)
+	v := newDedup(cfg).(*dedup)
+
+	countBlocks := func() int {
+		n := 0
+		_ = v.blocksFs.Walk(".", func(_ string, f fs.FileInfo, err error) error {
+			if err == nil && f.IsRegular() {
+				n++
+			}
+			return err
+		})
+		return n
+	}
+
+	// Three blocks, of which the last one changes between versions.
+	data := make([]byte, 3*protocol.MinBlockSize)
+	rand.Read(data)
+	writeFile(t, folderFs, "file", string(data))
+	if err := v.Archive("file"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := folderFs.Lstat("file"); !fs.IsNotExist(err) {
+		t.Fatal("file still exists after archiving")
+	}
+
+	// Move the first version back in time, so that the next one doesn't
+	// end up with the same name.
+	first := time.Now().Add(-time.Hour).Truncate(time.Second)
+	manifests := findAllVersions(v.manifestsFs, "file")
+	if len(manifests) != 1 {
+		t.Fatalf("unexpected manifests %v", manifests)
+	}
+	if err := v.manifestsFs.Rename(manifests[0], TagFilename("file", first.Format(TimeFormat))); err != nil {
+		t.Fatal(err)
+	}
+
+	changed := append([]byte(nil), data...)
+	changed[len(changed)-1]++
+	writeFile(t, folderFs, "file", string(changed))
+	if err := v.Archive("file"); err != nil {
+		t.Fatal(err)
+	}
+	if n := countBlocks(); n != 4 {
+		t.Errorf("got %d stored blocks, expected 4", n)
+	}
+
+	versions, err := v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions["file"]) != 2 {
+		t.Fatalf("unexpected number of versions: %d != 2", len(versions["file"]))
+	}
+	for _, fv := range versions["file"] {
+		if fv.Size != int64(len(data)) {
+			t.Errorf("unexpected version size %d", fv.Size)
+		}
+	}
+
+	fd, err := v.Open("file", first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(fd)
+	fd.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(content, data) {
+		t.Error("opened version has unexpected content")
+	}
+
+	if err := v.Restore("file", first); err != nil {
+		t.Fatal(err)
+	}
+	fd, err = folderFs.Open("file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err = io.ReadAll(fd)
+	fd.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(content, data) {
+		t.Error("restored file has unexpected content")
+	}
+
+	// The restored version is gone, and the remaining one no longer uses
+	// the original last block.
+	if err := v.Clean(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if n := countBlocks(); n != 3 {
+		t.Errorf("got %d stored blocks after cleaning, expected 3", n)
+	}
+}
+
+func TestDedupArchiveBlocks(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           dir,
+	}
+	folderFs := cfg.Filesystem(nil)
+	v := newDedup(cfg).(*dedup)
+
+	// Blocks of uneven size, as with content defined chunking, are kept
+	// as scanned.
+	data := []byte("some content defined blocks")
+	writeFile(t, folderFs, "file", string(data))
+	info, err := folderFs.Lstat("file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := protocol.FileInfo{
+		Name:       "file",
+		Size:       info.Size(),
+		ModifiedS:  info.ModTime().Unix(),
+		ModifiedNs: info.ModTime().Nanosecond(),
+	}
+	for _, b := range [][2]int{{0, 5}, {5, 13}, {13, len(data)}} {
+		hash := sha256.Sum256(data[b[0]:b[1]])
+		file.Blocks = append(file.Blocks, protocol.BlockInfo{Offset: int64(b[0]), Size: b[1] - b[0], Hash: hash[:]})
+	}
+	if err := v.ArchiveBlocks("file", file); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, b := range file.Blocks {
+		if _, err := v.blocksFs.Lstat(dedupBlockName(b.Hash)); err != nil {
+			t.Errorf("block at %d not stored: %v", b.Offset, err)
+		}
+	}
+	versions, err := v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions["file"]) != 1 {
+		t.Fatalf("unexpected versions %v", versions)
+	}
+	fd, err := v.Open("file", versions["file"][0].VersionTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(fd)
+	fd.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(content, data) {
+		t.Errorf("opened version has content %q, expected %q", content, data)
+	}
+}
+
+func TestDedupLimitSize(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           dir,
+		Versioning: config.VersioningConfiguration{
+			Retention: config.RetentionPolicy{MaxTotalSize: config.Size{Value: 500 << 10, Unit: "B"}},
+		},
+	}
+	v := newDedup(cfg).(*dedup)
+
+	// Four versions sharing their first block, storing five blocks in
+	// total, which is what counts against the maximum.
+	shared := make([]byte, protocol.MinBlockSize)
+	rand.Read(shared)
+	sharedHash := sha256.Sum256(shared)
+	if err := v.storeBlock(sharedHash[:], shared); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().Truncate(time.Second)
+	var names []string
+	for i := 0; i < 4; i++ {
+		unique := make([]byte, protocol.MinBlockSize)
+		rand.Read(unique)
+		hash := sha256.Sum256(unique)
+		if err := v.storeBlock(hash[:], unique); err != nil {
+			t.Fatal(err)
+		}
+		name := TagFilename("file", now.Add(-time.Duration(i)*time.Hour).Format(TimeFormat))
+		m := dedupManifest{
+			Size:      2 * protocol.MinBlockSize,
+			BlockSize: protocol.MinBlockSize,
+			Blocks:    [][]byte{sharedHash[:], hash[:]},
+		}
+		if err := v.writeManifest(name, m); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+	}
+
+	if err := v.Clean(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	remaining := findAllVersions(v.manifestsFs, "file")
+	sort.Strings(remaining)
+	expected := []string{names[1], names[0]}
+	if len(remaining) != len(expected) || remaining[0] != expected[0] || remaining[1] != expected[1] {
+		t.Errorf("got %v, expected %v", remaining, expected)
+	}
+}
@@ -9,17 +9,11 @@ package versioner
 import (
 	"context"
 	"errors"
-	"fmt"
-	"os"
-	"os/exec"
-	"strings"
 	"time"
 
-	"github.com/syncthing/syncthing/lib/build"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/fs"
-
-	"github.com/kballard/go-shellquote"
+	"github.com/syncthing/syncthing/lib/osutil"
 )
 
 func init() {
@@ -33,14 +27,8 @@ type external struct {
 }
 
 func newExternal(cfg config.FolderConfiguration) Versioner {
-	command := cfg.Versioning.Params["command"]
-
-	if build.IsWindows {
-		command = strings.ReplaceAll(command, `\`, `\\`)
-	}
-
 	s := external{
-		command:    command,
+		command:    cfg.Versioning.Params["command"],
 		filesystem: cfg.Filesystem(nil),
 	}
 
@@ -68,42 +56,17 @@ func (v external) Archive(filePath string) error {
 		return errors.New("command is empty, please enter a valid command")
 	}
 
-	words, err := shellquote.Split(v.command)
-	if err != nil {
-		return fmt.Errorf("command is invalid: %w", err)
-	}
-
-	context := map[string]string{
-		"%FOLDER_FILESYSTEM%": v.filesystem.Type().String(),
-		"%FOLDER_PATH%":       v.filesystem.URI(),
-		"%FILE_PATH%":         filePath,
-	}
-
-	for i, word := range words {
-		for key, val := range context {
-			word = strings.ReplaceAll(word, key, val)
-		}
-
-		words[i] = word
-	}
-
-	cmd := exec.Command(words[0], words[1:]...)
-	env := os.Environ()
-	// filter STGUIAUTH and STGUIAPIKEY from environment variables
-	var filteredEnv []string
-
-	for _, x := range env {
-		if !strings.HasPrefix(x, "STGUIAUTH=") && !strings.HasPrefix(x, "STGUIAPIKEY=") {
-			filteredEnv = append(filteredEnv, x)
-		}
+	cmd := osutil.Command{
+		Line: v.command,
+		Placeholders: map[string]string{
+			"%FOLDER_FILESYSTEM%": v.filesystem.Type().String(),
+			"%FOLDER_PATH%":       v.filesystem.URI(),
+			"%FILE_PATH%":         filePath,
+		},
 	}
-	cmd.Env = filteredEnv
-	combinedOutput, err := cmd.CombinedOutput()
-	l.Debugln("external command output:", string(combinedOutput))
+	out, err := cmd.Run(context.Background())
+	l.Debugln("external command output:", string(out))
 	if err != nil {
-		if eerr, ok := err.(*exec.ExitError); ok && len(eerr.Stderr) > 0 {
-			return fmt.Errorf("%v: %v", err, string(eerr.Stderr))
-		}
 		return err
 	}
 
@@ -122,6 +85,10 @@ func (external) Restore(_ string, _ time.Time) error {
 	return ErrRestorationNotSupported
 }
 
+func (external) Open(_ string, _ time.Time) (fs.File, error) {
+	return nil, ErrRestorationNotSupported
+}
+
 func (external) Clean(_ context.Context) error {
 	return nil
 }
@@ -0,0 +1,203 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+// retention applies a retention policy, which versioners use in place of
+// their own parameters when it's enabled.
+type retention struct {
+	config.RetentionPolicy
+}
+
+// toRemove returns the versions of a single file which none of the rules
+// keep, given the time of each version.
+func (r retention) toRemove(versions []string, now time.Time, versionTime func(string) (time.Time, bool)) []string {
+	type version struct {
+		name string
+		time time.Time
+	}
+	sorted := make([]version, 0, len(versions))
+	for _, name := range versions {
+		t, ok := versionTime(name)
+		if !ok {
+			l.Debugf("Versioner: file name %q is invalid", name)
+			continue
+		}
+		sorted = append(sorted, version{name, t})
+	}
+	// Newest first
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].time.After(sorted[b].time)
+	})
+
+	keep := make([]bool, len(sorted))
+	for i := 0; i < r.KeepLast && i < len(sorted); i++ {
+		keep[i] = true
+	}
+	// Keep the newest version of each of the last n periods, counting the
+	// current one.
+	keepPeriods := func(n int, period func(time.Time) int) {
+		if n <= 0 {
+			return
+		}
+		current := period(now)
+		seen := make(map[int]struct{})
+		for i, v := range sorted {
+			p := period(v.time)
+			if current-p >= n {
+				break
+			}
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+			keep[i] = true
+		}
+	}
+	keepPeriods(r.KeepDaily, dayNumber)
+	keepPeriods(r.KeepWeekly, weekNumber)
+	keepPeriods(r.KeepMonthly, monthNumber)
+
+	var remove []string
+	for i, v := range sorted {
+		if !keep[i] {
+			remove = append(remove, v.name)
+		}
+	}
+	return remove
+}
+
+// rulesEnabled returns whether there are rules for which versions to keep,
+// as opposed to only a maximum size.
+func (r retention) rulesEnabled() bool {
+	return r.KeepLast > 0 || r.KeepDaily > 0 || r.KeepWeekly > 0 || r.KeepMonthly > 0
+}
+
+// maxSize returns the maximum total size of the versions in bytes, which
+// when given as a percentage is of the size of the filesystem holding them,
+// or zero when there is no maximum.
+func (r retention) maxSize(versionsFs fs.Filesystem) (int64, error) {
+	val := r.MaxTotalSize.BaseValue()
+	if val <= 0 {
+		return 0, nil
+	}
+	if !r.MaxTotalSize.Percentage() {
+		return int64(val), nil
+	}
+	usage, err := versionsFs.Usage(".")
+	if err != nil {
+		return 0, err
+	}
+	return int64(float64(usage.Total) * val / 100), nil
+}
+
+// limitSize removes the oldest versions of all files while their total
+// size exceeds the maximum. The time and size of each version are given by
+// versionInfo.
+func (r retention) limitSize(ctx context.Context, versionsFs fs.Filesystem, versionInfo func(string, fs.FileInfo) (time.Time, int64, bool)) error {
+	if _, err := versionsFs.Lstat("."); fs.IsNotExist(err) {
+		return nil
+	}
+	maxSize, err := r.maxSize(versionsFs)
+	if err != nil || maxSize <= 0 {
+		return err
+	}
+
+	type version struct {
+		path string
+		time time.Time
+		size int64
+	}
+	var versions []version
+	var total int64
+	dirTracker := make(emptyDirTracker)
+	err = versionsFs.Walk(".", func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if info.IsDir() && !info.IsSymlink() {
+			dirTracker.addDir(path)
+			return nil
+		}
+		dirTracker.addFile(path)
+		if !info.IsRegular() || fs.IsTemporary(path) {
+			return nil
+		}
+		t, size, ok := versionInfo(path, info)
+		if !ok {
+			return nil
+		}
+		versions = append(versions, version{path, t, size})
+		total += size
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if total <= maxSize {
+		return nil
+	}
+
+	// Oldest first
+	sort.Slice(versions, func(a, b int) bool {
+		return versions[a].time.Before(versions[b].time)
+	})
+	for _, v := range versions {
+		if total <= maxSize {
+			break
+		}
+		l.Debugln("Versioner: total size over maximum -> delete", v.path)
+		if err := versionsFs.Remove(v.path); err != nil {
+			l.Warnf("Versioner: can't remove %q: %v", v.path, err)
+			continue
+		}
+		total -= v.size
+	}
+
+	dirTracker.deleteEmptyDirs(versionsFs)
+	return nil
+}
+
+// taggedVersionTime returns the version time from the tag of a version's
+// file name.
+func taggedVersionTime(name string) (time.Time, bool) {
+	t, err := time.ParseInLocation(TimeFormat, extractTag(name), time.Local)
+	return t, err == nil
+}
+
+// taggedVersionInfo returns the version time and size of a version stored
+// as a tagged copy of the file.
+func taggedVersionInfo(name string, info fs.FileInfo) (time.Time, int64, bool) {
+	t, ok := taggedVersionTime(name)
+	return t, info.Size(), ok
+}
+
+func dayNumber(t time.Time) int {
+	y, m, d := t.In(time.Local).Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60))
+}
+
+// weekNumber counts weeks starting on Mondays. The epoch was a Thursday.
+func weekNumber(t time.Time) int {
+	return (dayNumber(t) + 3) / 7
+}
+
+func monthNumber(t time.Time) int {
+	y, m, _ := t.In(time.Local).Date()
+	return y*12 + int(m) - 1
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestRetentionToRemove(t *testing.T) {
+	// A Wednesday, at noon
+	now := time.Date(2024, time.May, 15, 12, 0, 0, 0, time.Local)
+	versionAt := func(t time.Time) string {
+		return TagFilename("file", t.Format(TimeFormat))
+	}
+	ago := func(d time.Duration) string {
+		return versionAt(now.Add(-d))
+	}
+
+	versions := []string{
+		ago(time.Hour),                       // today
+		ago(2 * time.Hour),                   // today
+		ago(24 * time.Hour),                  // yesterday
+		ago(26 * time.Hour),                  // yesterday
+		ago(3 * 24 * time.Hour),              // Sunday, last week
+		ago(4 * 24 * time.Hour),              // Saturday, last week
+		ago(10 * 24 * time.Hour),             // the week before
+		versionAt(now.AddDate(0, -1, 0)),     // last month
+		versionAt(now.AddDate(0, -1, -1)),    // last month
+		versionAt(now.AddDate(0, -3, 0)),     // three months ago
+		versionAt(now.AddDate(-1, 0, 0)),     // a year ago
+		versionAt(now.AddDate(-1, 0, -1)),    // a year ago
+		versionAt(now.AddDate(-2, 0, 0)),     // two years ago
+		versionAt(now.AddDate(-2, 0, 0))[:5], // invalid
+	}
+
+	cases := []struct {
+		name   string
+		policy config.RetentionPolicy
+		keep   []string
+	}{
+		{
+			name:   "last",
+			policy: config.RetentionPolicy{KeepLast: 3},
+			keep:   versions[:3],
+		},
+		{
+			name:   "daily",
+			policy: config.RetentionPolicy{KeepDaily: 2},
+			keep:   []string{versions[0], versions[2]},
+		},
+		{
+			name:   "weekly",
+			policy: config.RetentionPolicy{KeepWeekly: 2},
+			keep:   []string{versions[0], versions[4]},
+		},
+		{
+			name:   "monthly",
+			policy: config.RetentionPolicy{KeepMonthly: 4},
+			keep:   []string{versions[0], versions[7], versions[9]},
+		},
+		{
+			name:   "combined",
+			policy: config.RetentionPolicy{KeepLast: 1, KeepDaily: 2, KeepMonthly: 13},
+			keep:   []string{versions[0], versions[2], versions[7], versions[9], versions[10]},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := retention{tc.policy}
+			if !r.rulesEnabled() {
+				t.Fatal("expected rules to be enabled")
+			}
+			remove := r.toRemove(versions, now, taggedVersionTime)
+
+			removed := make(map[string]bool)
+			for _, v := range remove {
+				removed[v] = true
+			}
+			for _, v := range tc.keep {
+				if removed[v] {
+					t.Errorf("%s was removed, expected it to be kept", v)
+				}
+			}
+			// Everything valid is either kept or removed.
+			if len(remove)+len(tc.keep) != len(versions)-1 {
+				t.Errorf("removed %d versions, expected %d", len(remove), len(versions)-1-len(tc.keep))
+			}
+		})
+	}
+}
+
+func TestRetentionLimitSize(t *testing.T) {
+	versionsFs := fs.NewFilesystem(fs.FilesystemTypeBasic, t.TempDir())
+
+	if err := versionsFs.MkdirAll("dir", 0o755); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().Truncate(time.Second)
+	var names []string
+	for i := 0; i < 4; i++ {
+		name := TagFilename("dir/file", now.Add(-time.Duration(i)*time.Hour).Format(TimeFormat))
+		writeFile(t, versionsFs, name, strings.Repeat("x", 1000))
+		names = append(names, name)
+	}
+	writeFile(t, versionsFs, "other", strings.Repeat("x", 1000))
+
+	r := retention{config.RetentionPolicy{MaxTotalSize: config.Size{Value: 2500, Unit: "B"}}}
+	if r.rulesEnabled() {
+		t.Fatal("expected rules to be disabled")
+	}
+	if err := r.limitSize(context.Background(), versionsFs, taggedVersionInfo); err != nil {
+		t.Fatal(err)
+	}
+
+	// The untagged file isn't a version and doesn't count; of the rest, the
+	// two oldest must go.
+	remaining := findAllVersions(versionsFs, "dir/file")
+	sort.Strings(remaining)
+	expected := []string{names[1], names[0]}
+	if len(remaining) != len(expected) || remaining[0] != expected[0] || remaining[1] != expected[1] {
+		t.Errorf("got %v, expected %v", remaining, expected)
+	}
+	if _, err := versionsFs.Lstat("other"); err != nil {
+		t.Error("untagged file was removed:", err)
+	}
+}
+
+func TestRetentionMaxSizePercentage(t *testing.T) {
+	versionsFs := fs.NewFilesystem(fs.FilesystemTypeBasic, t.TempDir())
+	usage, err := versionsFs.Usage(".")
+	if err != nil {
+		t.Skip("no usage information:", err)
+	}
+
+	r := retention{config.RetentionPolicy{MaxTotalSize: config.Size{Value: 10, Unit: "%"}}}
+	maxSize, err := r.maxSize(versionsFs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := int64(usage.Total / 10); maxSize < expected-1 || maxSize > expected+1 {
+		t.Errorf("got maximum size %d, expected %d", maxSize, expected)
+	}
+}
@@ -27,6 +27,7 @@ type simple struct {
 	folderFs        fs.Filesystem
 	versionsFs      fs.Filesystem
 	copyRangeMethod fs.CopyRangeMethod
+	retention       retention
 }
 
 func newSimple(cfg config.FolderConfiguration) Versioner {
@@ -44,6 +45,7 @@ func newSimple(cfg config.FolderConfiguration) Versioner {
 		folderFs:        cfg.Filesystem(nil),
 		versionsFs:      versionerFsFromFolderCfg(cfg),
 		copyRangeMethod: cfg.CopyRangeMethod,
+		retention:       retention{cfg.Versioning.Retention},
 	}
 
 	l.Debugf("instantiated %#v", s)
@@ -71,11 +73,22 @@ func (v simple) Restore(filepath string, versionTime time.Time) error {
 	return restoreFile(v.copyRangeMethod, v.versionsFs, v.folderFs, filepath, versionTime, TagFilename)
 }
 
+func (v simple) Open(filepath string, versionTime time.Time) (fs.File, error) {
+	return openVersion(v.versionsFs, filepath, versionTime, TagFilename)
+}
+
 func (v simple) Clean(ctx context.Context) error {
-	return clean(ctx, v.versionsFs, v.toRemove)
+	if err := clean(ctx, v.versionsFs, v.toRemove); err != nil {
+		return err
+	}
+	return v.retention.limitSize(ctx, v.versionsFs, taggedVersionInfo)
 }
 
 func (v simple) toRemove(versions []string, now time.Time) []string {
+	if v.retention.rulesEnabled() {
+		return v.retention.toRemove(versions, now, taggedVersionTime)
+	}
+
 	var remove []string
 
 	// The list of versions may or may not be properly sorted.
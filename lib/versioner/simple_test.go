@@ -7,6 +7,7 @@
 package versioner
 
 import (
+	"io"
 	"math"
 	"path/filepath"
 	"testing"
@@ -93,3 +94,45 @@ func TestSimpleVersioningVersionCount(t *testing.T) {
 		time.Sleep(time.Second)
 	}
 }
+
+func TestSimpleVersioningOpen(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           dir,
+	}
+	folderFs := cfg.Filesystem(nil)
+
+	v := newSimple(cfg)
+
+	writeFile(t, folderFs, "file", "Some content")
+	if err := v.Archive("file"); err != nil {
+		t.Fatal(err)
+	}
+
+	versions, err := v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions["file"]) != 1 {
+		t.Fatalf("unexpected number of versions: %d != 1", len(versions["file"]))
+	}
+
+	fd, err := v.Open("file", versions["file"][0].VersionTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	content, err := io.ReadAll(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "Some content" {
+		t.Errorf("unexpected content %q", content)
+	}
+
+	if _, err := v.Open("file", time.Unix(0, 0)); err == nil {
+		t.Error("expected an error opening a nonexistent version")
+	}
+}
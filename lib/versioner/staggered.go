@@ -32,6 +32,7 @@ type staggered struct {
 	versionsFs      fs.Filesystem
 	interval        [4]interval
 	copyRangeMethod fs.CopyRangeMethod
+	retention       retention
 }
 
 func newStaggered(cfg config.FolderConfiguration) Versioner {
@@ -53,6 +54,7 @@ func newStaggered(cfg config.FolderConfiguration) Versioner {
 			{7 * 24 * 60 * 60, maxAge},        // next year -> 1 week between versions
 		},
 		copyRangeMethod: cfg.CopyRangeMethod,
+		retention:       retention{cfg.Versioning.Retention},
 	}
 
 	l.Debugf("instantiated %#v", s)
@@ -60,10 +62,17 @@ func newStaggered(cfg config.FolderConfiguration) Versioner {
 }
 
 func (v *staggered) Clean(ctx context.Context) error {
-	return clean(ctx, v.versionsFs, v.toRemove)
+	if err := clean(ctx, v.versionsFs, v.toRemove); err != nil {
+		return err
+	}
+	return v.retention.limitSize(ctx, v.versionsFs, taggedVersionInfo)
 }
 
 func (v *staggered) toRemove(versions []string, now time.Time) []string {
+	if v.retention.rulesEnabled() {
+		return v.retention.toRemove(versions, now, taggedVersionTime)
+	}
+
 	var prevAge int64
 	firstFile := true
 	var remove []string
@@ -133,6 +142,10 @@ func (v *staggered) Restore(filepath string, versionTime time.Time) error {
 	return restoreFile(v.copyRangeMethod, v.versionsFs, v.folderFs, filepath, versionTime, TagFilename)
 }
 
+func (v *staggered) Open(filepath string, versionTime time.Time) (fs.File, error) {
+	return openVersion(v.versionsFs, filepath, versionTime, TagFilename)
+}
+
 func (v *staggered) String() string {
 	return fmt.Sprintf("Staggered/@%p", v)
 }
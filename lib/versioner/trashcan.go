@@ -26,6 +26,7 @@ type trashcan struct {
 	versionsFs      fs.Filesystem
 	cleanoutDays    int
 	copyRangeMethod fs.CopyRangeMethod
+	retention       retention
 }
 
 func newTrashcan(cfg config.FolderConfiguration) Versioner {
@@ -37,6 +38,7 @@ func newTrashcan(cfg config.FolderConfiguration) Versioner {
 		versionsFs:      versionerFsFromFolderCfg(cfg),
 		cleanoutDays:    cleanoutDays,
 		copyRangeMethod: cfg.CopyRangeMethod,
+		retention:       retention{cfg.Versioning.Retention},
 	}
 
 	l.Debugf("instantiated %#v", s)
@@ -56,7 +58,19 @@ func (t *trashcan) String() string {
 }
 
 func (t *trashcan) Clean(ctx context.Context) error {
-	if t.cleanoutDays <= 0 {
+	if err := t.cleanExpired(ctx); err != nil {
+		return err
+	}
+	return t.retention.limitSize(ctx, t.versionsFs, func(_ string, info fs.FileInfo) (time.Time, int64, bool) {
+		return info.ModTime(), info.Size(), true
+	})
+}
+
+// cleanExpired removes the files which have been in the trash can for
+// longer than the cleanout days, or which the retention rules don't keep.
+func (t *trashcan) cleanExpired(ctx context.Context) error {
+	rules := t.retention.rulesEnabled()
+	if t.cleanoutDays <= 0 && !rules {
 		return nil
 	}
 
@@ -64,7 +78,18 @@ func (t *trashcan) Clean(ctx context.Context) error {
 		return nil
 	}
 
-	cutoff := time.Now().Add(time.Duration(-24*t.cleanoutDays) * time.Hour)
+	now := time.Now()
+	cutoff := now.Add(time.Duration(-24*t.cleanoutDays) * time.Hour)
+	expired := func(path string, info fs.FileInfo) bool {
+		if !rules {
+			return info.ModTime().Before(cutoff)
+		}
+		// The trash can keeps at most one version of each file, with the
+		// modification time as the version time.
+		return len(t.retention.toRemove([]string{path}, now, func(string) (time.Time, bool) {
+			return info.ModTime(), true
+		})) > 0
+	}
 	dirTracker := make(emptyDirTracker)
 
 	walkFn := func(path string, info fs.FileInfo, err error) error {
@@ -83,7 +108,7 @@ func (t *trashcan) Clean(ctx context.Context) error {
 			return nil
 		}
 
-		if info.ModTime().Before(cutoff) {
+		if expired(path, info) {
 			// The file is too old; remove it.
 			err = t.versionsFs.Remove(path)
 		} else {
@@ -146,3 +171,9 @@ func (t *trashcan) Restore(filepath string, versionTime time.Time) error {
 
 	return t.versionsFs.Rename(taggedName, filepath)
 }
+
+func (t *trashcan) Open(filepath string, versionTime time.Time) (fs.File, error) {
+	// Versions are kept under their original name.
+	untagged := func(name, _ string) string { return name }
+	return openVersion(t.versionsFs, filepath, versionTime, untagged)
+}
@@ -190,6 +190,40 @@ func archiveFile(method fs.CopyRangeMethod, srcFs, dstFs fs.Filesystem, filePath
 	return err
 }
 
+// findVersion returns the name and modification time of the version of the
+// file archived at the given time, which is either the tagged name or the
+// untagged file with a matching modification time.
+func findVersion(src fs.Filesystem, filePath string, versionTime time.Time, taggedFilePath string) (string, time.Time, error) {
+	// Try and find a file that has the correct mtime
+	if info, err := src.Lstat(taggedFilePath); err == nil && info.IsRegular() {
+		return taggedFilePath, info.ModTime(), nil
+	} else if err == nil {
+		l.Debugln("restore:", taggedFilePath, "not regular")
+	} else {
+		l.Debugln("restore:", taggedFilePath, err.Error())
+	}
+
+	// Check for untagged file
+	info, err := src.Lstat(filePath)
+	if err == nil && info.IsRegular() && info.ModTime().Truncate(time.Second).Equal(versionTime) {
+		return filePath, info.ModTime(), nil
+	}
+
+	return "", time.Time{}, errNotFound
+}
+
+// openVersion opens the version of the file archived at the given time for
+// reading.
+func openVersion(src fs.Filesystem, filePath string, versionTime time.Time, tagger fileTagger) (fs.File, error) {
+	tag := versionTime.In(time.Local).Truncate(time.Second).Format(TimeFormat)
+	filePath = osutil.NativeFilename(filePath)
+	sourceFile, _, err := findVersion(src, filePath, versionTime, tagger(filePath, tag))
+	if err != nil {
+		return nil, err
+	}
+	return src.Open(sourceFile)
+}
+
 func restoreFile(method fs.CopyRangeMethod, src, dst fs.Filesystem, filePath string, versionTime time.Time, tagger fileTagger) error {
 	tag := versionTime.In(time.Local).Truncate(time.Second).Format(TimeFormat)
 	taggedFilePath := tagger(filePath, tag)
@@ -218,30 +252,9 @@ func restoreFile(method fs.CopyRangeMethod, src, dst fs.Filesystem, filePath str
 
 	filePath = osutil.NativeFilename(filePath)
 
-	// Try and find a file that has the correct mtime
-	sourceFile := ""
-	sourceMtime := time.Time{}
-	if info, err := src.Lstat(taggedFilePath); err == nil && info.IsRegular() {
-		sourceFile = taggedFilePath
-		sourceMtime = info.ModTime()
-	} else if err == nil {
-		l.Debugln("restore:", taggedFilePath, "not regular")
-	} else {
-		l.Debugln("restore:", taggedFilePath, err.Error())
-	}
-
-	// Check for untagged file
-	if sourceFile == "" {
-		info, err := src.Lstat(filePath)
-		if err == nil && info.IsRegular() && info.ModTime().Truncate(time.Second).Equal(versionTime) {
-			sourceFile = filePath
-			sourceMtime = info.ModTime()
-		}
-
-	}
-
-	if sourceFile == "" {
-		return errNotFound
+	sourceFile, sourceMtime, err := findVersion(src, filePath, versionTime, taggedFilePath)
+	if err != nil {
+		return err
 	}
 
 	// Check that the target location of where we are supposed to restore does not exist.
@@ -253,7 +266,7 @@ func restoreFile(method fs.CopyRangeMethod, src, dst fs.Filesystem, filePath str
 	}
 
 	_ = dst.MkdirAll(filepath.Dir(filePath), 0o755)
-	err := osutil.RenameOrCopy(method, src, dst, sourceFile, filePath)
+	err = osutil.RenameOrCopy(method, src, dst, sourceFile, filePath)
 	_ = dst.Chtimes(filePath, sourceMtime, sourceMtime)
 	return err
 }
@@ -261,7 +274,7 @@ func restoreFile(method fs.CopyRangeMethod, src, dst fs.Filesystem, filePath str
 func versionerFsFromFolderCfg(cfg config.FolderConfiguration) (versionsFs fs.Filesystem) {
 	folderFs := cfg.Filesystem(nil)
 	if cfg.Versioning.FSPath == "" {
-		versionsFs = fs.NewFilesystem(folderFs.Type(), filepath.Join(folderFs.URI(), DefaultPath))
+		versionsFs = fs.NewFilesystem(folderFs.Type(), filepath.Join(folderFs.URI(), DefaultPath), connOptions(folderFs)...)
 	} else if cfg.Versioning.FSType == fs.FilesystemTypeBasic && !filepath.IsAbs(cfg.Versioning.FSPath) {
 		// We only know how to deal with relative folders for basic filesystems, as that's the only one we know
 		// how to check if it's absolute or relative.
@@ -359,3 +372,17 @@ func cleanVersions(versionsFs fs.Filesystem, versions []string, toRemove func([]
 		}
 	}
 }
+
+// connOptions returns the connection options of an SFTP or S3 folder
+// filesystem, so that a versions directory below it can be reached the same
+// way.
+func connOptions(folderFs fs.Filesystem) []fs.Option {
+	var opts []fs.Option
+	for _, opt := range folderFs.Options() {
+		switch opt.(type) {
+		case *fs.OptionSFTP, *fs.OptionS3Credentials:
+			opts = append(opts, opt)
+		}
+	}
+	return opts
+}
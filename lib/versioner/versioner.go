@@ -15,15 +15,25 @@ import (
 	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
 )
 
 type Versioner interface {
 	Archive(filePath string) error
 	GetVersions() (map[string][]FileVersion, error)
 	Restore(filePath string, versionTime time.Time) error
+	Open(filePath string, versionTime time.Time) (fs.File, error)
 	Clean(context.Context) error
 }
 
+// BlockArchiver is implemented by versioners which can archive a file
+// using the blocks it was last scanned with, instead of reading and hashing
+// all of it again.
+type BlockArchiver interface {
+	ArchiveBlocks(filePath string, file protocol.FileInfo) error
+}
+
 type FileVersion struct {
 	VersionTime time.Time `json:"versionTime"`
 	ModTime     time.Time `json:"modTime"`
@@ -69,6 +79,14 @@ func (v *versionerWithErrorContext) Archive(filePath string) error {
 	return v.wrapError(v.Versioner.Archive(filePath), "archive")
 }
 
+func (v *versionerWithErrorContext) ArchiveBlocks(filePath string, file protocol.FileInfo) error {
+	ba, ok := v.Versioner.(BlockArchiver)
+	if !ok {
+		return v.Archive(filePath)
+	}
+	return v.wrapError(ba.ArchiveBlocks(filePath, file), "archive")
+}
+
 func (v *versionerWithErrorContext) GetVersions() (map[string][]FileVersion, error) {
 	versions, err := v.Versioner.GetVersions()
 	return versions, v.wrapError(err, "get versions")
@@ -78,6 +96,11 @@ func (v *versionerWithErrorContext) Restore(filePath string, versionTime time.Ti
 	return v.wrapError(v.Versioner.Restore(filePath, versionTime), "restore")
 }
 
+func (v *versionerWithErrorContext) Open(filePath string, versionTime time.Time) (fs.File, error) {
+	fd, err := v.Versioner.Open(filePath, versionTime)
+	return fd, v.wrapError(err, "open")
+}
+
 func (v *versionerWithErrorContext) Clean(ctx context.Context) error {
 	return v.wrapError(v.Versioner.Clean(ctx), "clean")
 }
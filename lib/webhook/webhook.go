@@ -0,0 +1,320 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package webhook posts events to the webhooks in the configuration.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/audit"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	// Events not yet posted to a webhook are queued up to this many, after
+	// which new events are dropped until it catches up.
+	queueSize = 1000
+	// The delivery log keeps this many of the latest deliveries.
+	maxDeliveries = 100
+
+	defaultEventMask = events.AllEvents &^ events.LocalChangeDetected &^ events.RemoteChangeDetected
+	maxBackoff       = time.Minute
+)
+
+// A Delivery is the outcome of posting an event to a webhook.
+type Delivery struct {
+	URL       string           `json:"url"`
+	EventID   int              `json:"eventID"`
+	EventType events.EventType `json:"eventType"`
+	Time      time.Time        `json:"time"`
+	Attempts  int              `json:"attempts"`
+	Status    int              `json:"status,omitempty"` // of the last attempt
+	Error     string           `json:"error,omitempty"`
+}
+
+// The Service subscribes to events and posts those selected by each
+// webhook to it. Each webhook gets its own queue, and thus a webhook that
+// is down doesn't hold up the others.
+type Service struct {
+	cfg         config.Wrapper
+	evLogger    events.Logger
+	client      *http.Client
+	baseBackoff time.Duration
+
+	mut        sync.Mutex
+	deliveries []Delivery
+}
+
+func New(cfg config.Wrapper, evLogger events.Logger) *Service {
+	return &Service{
+		cfg:         cfg,
+		evLogger:    evLogger,
+		client:      &http.Client{},
+		baseBackoff: time.Second,
+		mut:         sync.NewMutex(),
+	}
+}
+
+// A queue holds the events to post to one webhook.
+type queue struct {
+	events chan queuedEvent
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type queuedEvent struct {
+	hook config.Webhook
+	ev   events.Event
+	data []byte
+}
+
+func (s *Service) Serve(ctx context.Context) error {
+	sub := s.evLogger.Subscribe(events.AllEvents)
+	defer sub.Unsubscribe()
+
+	queues := make(map[string]*queue)
+	defer func() {
+		for _, q := range queues {
+			q.cancel()
+			<-q.done
+		}
+	}()
+
+	for {
+		select {
+		case ev, ok := <-sub.C():
+			if !ok {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			s.dispatch(ctx, ev, queues)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// dispatch queues the event for each webhook that selects it, starting
+// and stopping queues as webhooks are added to and removed from the
+// configuration.
+func (s *Service) dispatch(ctx context.Context, ev events.Event, queues map[string]*queue) {
+	hooks := s.cfg.Options().Webhooks
+
+	configured := make(map[string]struct{}, len(hooks))
+	for _, hook := range hooks {
+		configured[hook.URL] = struct{}{}
+	}
+	for url, q := range queues {
+		if _, ok := configured[url]; !ok {
+			q.cancel()
+			delete(queues, url)
+		}
+	}
+	if len(hooks) == 0 {
+		return
+	}
+
+	if cfg, ok := ev.Data.(config.Configuration); ok {
+		// Secrets, including those of the webhooks, are never sent.
+		audit.Redact(&cfg)
+		ev.Data = cfg
+	}
+	data, err := json.Marshal(ev)
+	if err != nil {
+		l.Debugln("Marshalling event:", err)
+		return
+	}
+	var about eventSubject
+	_ = json.Unmarshal(data, &about)
+
+	for _, hook := range hooks {
+		if !selects(hook, ev.Type, about) {
+			continue
+		}
+		q, ok := queues[hook.URL]
+		if !ok {
+			q = s.startQueue(ctx)
+			queues[hook.URL] = q
+		}
+		select {
+		case q.events <- queuedEvent{hook, ev, data}:
+		default:
+			s.logDelivery(Delivery{
+				URL:       hook.URL,
+				EventID:   ev.GlobalID,
+				EventType: ev.Type,
+				Time:      time.Now(),
+				Error:     "dropped, too many events queued",
+			})
+		}
+	}
+}
+
+func (s *Service) startQueue(ctx context.Context) *queue {
+	ctx, cancel := context.WithCancel(ctx)
+	q := &queue{
+		events: make(chan queuedEvent, queueSize),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(q.done)
+		for {
+			select {
+			case qe := <-q.events:
+				s.deliver(ctx, qe)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return q
+}
+
+// deliver posts the event, retrying with backoff until it succeeds or
+// runs out of retries.
+func (s *Service) deliver(ctx context.Context, qe queuedEvent) {
+	d := Delivery{
+		URL:       qe.hook.URL,
+		EventID:   qe.ev.GlobalID,
+		EventType: qe.ev.Type,
+	}
+	backoff := s.baseBackoff
+	for {
+		d.Attempts++
+		d.Time = time.Now()
+		var err error
+		d.Status, err = s.post(ctx, qe.hook, qe.ev.Type, qe.data)
+		if err == nil {
+			d.Error = ""
+			break
+		}
+		d.Error = err.Error()
+		l.Debugf("Posting event %d to %s: %v", qe.ev.GlobalID, qe.hook.URL, err)
+		if d.Attempts > qe.hook.MaxRetries {
+			break
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+	s.logDelivery(d)
+}
+
+func (s *Service) post(ctx context.Context, hook config.Webhook, evType events.EventType, data []byte) (int, error) {
+	if hook.TimeoutS > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(hook.TimeoutS)*time.Second)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Syncthing-Event", evType.String())
+	if hook.Secret != "" {
+		req.Header.Set("X-Syncthing-Signature", "sha256="+Sign([]byte(hook.Secret), data))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func (s *Service) logDelivery(d Delivery) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	s.deliveries = append(s.deliveries, d)
+	if len(s.deliveries) > maxDeliveries {
+		s.deliveries = append(s.deliveries[:0], s.deliveries[len(s.deliveries)-maxDeliveries:]...)
+	}
+}
+
+// Deliveries returns the latest deliveries, oldest first.
+func (s *Service) Deliveries() []Delivery {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return append([]Delivery(nil), s.deliveries...)
+}
+
+func (s *Service) String() string {
+	return fmt.Sprintf("webhook.Service@%p", s)
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the data.
+func Sign(secret, data []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// eventSubject is the folder and device an event concerns, if any, as
+// found in the event data.
+type eventSubject struct {
+	Data struct {
+		Folder string `json:"folder"`
+		Device string `json:"device"`
+		ID     string `json:"id"` // device, for connection events
+	} `json:"data"`
+}
+
+func selects(hook config.Webhook, evType events.EventType, about eventSubject) bool {
+	mask := defaultEventMask
+	if len(hook.Events) > 0 {
+		mask = 0
+		for _, name := range hook.Events {
+			mask |= events.UnmarshalEventType(name)
+		}
+	}
+	if evType&mask == 0 {
+		return false
+	}
+	if len(hook.Folders) > 0 && !contains(hook.Folders, about.Data.Folder) {
+		return false
+	}
+	if len(hook.Devices) > 0 && !contains(hook.Devices, about.Data.Device) && !contains(hook.Devices, about.Data.ID) {
+		return false
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+// Copyright (C) 2024 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestWebhookDelivery(t *testing.T) {
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+	received := make(chan request, 10)
+	failures := 1
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if failures > 0 {
+			failures--
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		received <- request{r.Header, body}
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evLogger := events.NewLogger()
+	go evLogger.Serve(ctx)
+	cfg := config.Wrap("", config.Configuration{
+		Options: config.OptionsConfiguration{
+			Webhooks: []config.Webhook{{
+				URL:        srv.URL,
+				Events:     []string{"FolderCompletion"},
+				Folders:    []string{"default"},
+				Secret:     "secret",
+				MaxRetries: 1,
+			}},
+		},
+	}, protocol.LocalDeviceID, events.NoopLogger)
+
+	s := New(cfg, evLogger)
+	s.baseBackoff = time.Millisecond
+	go s.Serve(ctx)
+	// Wait for the subscription
+	time.Sleep(100 * time.Millisecond)
+
+	// Neither of these is selected by the webhook.
+	evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "default"})
+	evLogger.Log(events.FolderCompletion, map[string]interface{}{"folder": "other"})
+	evLogger.Log(events.FolderCompletion, map[string]interface{}{"folder": "default", "completion": 100})
+
+	var req request
+	select {
+	case req = <-received:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+
+	var ev struct {
+		Type events.EventType       `json:"type"`
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(req.body, &ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev.Type != events.FolderCompletion || ev.Data["folder"] != "default" {
+		t.Errorf("unexpected event %+v", ev)
+	}
+	if sig := req.header.Get("X-Syncthing-Signature"); sig != "sha256="+Sign([]byte("secret"), req.body) {
+		t.Errorf("unexpected signature %q", sig)
+	}
+
+	var deliveries []Delivery
+	for i := 0; i < 100; i++ {
+		if deliveries = s.Deliveries(); len(deliveries) > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("unexpected deliveries %+v", deliveries)
+	}
+	if d := deliveries[0]; d.Attempts != 2 || d.Status != http.StatusOK || d.Error != "" {
+		t.Errorf("unexpected delivery %+v", d)
+	}
+}
+
+func TestWebhookRedactsConfig(t *testing.T) {
+	received := make(chan []byte, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- body
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evLogger := events.NewLogger()
+	go evLogger.Serve(ctx)
+	raw := config.Configuration{
+		GUI: config.GUIConfiguration{
+			APIKey:    "apikeyapikeyapikey",
+			APITokens: []config.APIToken{{Name: "monitoring", Key: "tokenkeytokenkey"}},
+			OIDC:      config.OIDCConfiguration{ClientSecret: "clientsecret"},
+		},
+		Folders: []config.FolderConfiguration{{
+			ID:      "default",
+			Devices: []config.FolderDeviceConfiguration{{EncryptionPassword: "encryptionpassword"}},
+		}},
+		Options: config.OptionsConfiguration{
+			Webhooks: []config.Webhook{{URL: srv.URL, Secret: "hooksecret"}},
+		},
+	}
+	cfg := config.Wrap("", raw, protocol.LocalDeviceID, events.NoopLogger)
+
+	s := New(cfg, evLogger)
+	go s.Serve(ctx)
+	// Wait for the subscription
+	time.Sleep(100 * time.Millisecond)
+
+	evLogger.Log(events.ConfigSaved, raw)
+
+	var body []byte
+	select {
+	case body = <-received:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+	for _, secret := range []string{"apikeyapikeyapikey", "tokenkeytokenkey", "clientsecret", "encryptionpassword", "hooksecret"} {
+		if bytes.Contains(body, []byte(secret)) {
+			t.Errorf("delivered body contains %q", secret)
+		}
+	}
+	if !bytes.Contains(body, []byte(`"REDACTED"`)) {
+		t.Error("delivered body has nothing redacted")
+	}
+}
+
+func TestWebhookSelects(t *testing.T) {
+	subject := func(folder, device, id string) eventSubject {
+		var s eventSubject
+		s.Data.Folder, s.Data.Device, s.Data.ID = folder, device, id
+		return s
+	}
+	hook := config.Webhook{Devices: []string{"dev"}}
+	cases := []struct {
+		evType   events.EventType
+		about    eventSubject
+		expected bool
+	}{
+		{events.DeviceConnected, subject("", "", "dev"), true},
+		{events.RemoteIndexUpdated, subject("default", "dev", ""), true},
+		{events.RemoteIndexUpdated, subject("default", "other", ""), false},
+		{events.StateChanged, subject("default", "", ""), false},
+		// Not in the default events
+		{events.LocalChangeDetected, subject("", "dev", ""), false},
+	}
+	for i, tc := range cases {
+		if res := selects(hook, tc.evType, tc.about); res != tc.expected {
+			t.Errorf("%d: got %v, expected %v", i, res, tc.expected)
+		}
+	}
+}